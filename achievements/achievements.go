@@ -117,6 +117,7 @@ type GameStats struct {
 	TotalGames      int
 	TotalWins       int
 	WinStreak       int
+	DailyStreak     int // Consecutive days of daily challenges, set only after one
 	BestNetWorth    int64
 	TotalExits      int
 }
@@ -987,6 +988,46 @@ var AllAchievements = map[string]Achievement{
 		ProgressTracking:     true,
 		MaxProgress:          10,
 	},
+
+	// Daily Challenge Streak Chain
+	"daily_streak_3": {
+		ID:               "daily_streak_3",
+		Name:             "Morning Ritual",
+		Description:      "Finish the daily challenge 3 days in a row",
+		Icon:             "📅",
+		Category:         CategoryCareer,
+		Points:           15,
+		Rarity:           RarityRare,
+		ChainID:          "daily_streak",
+		ProgressTracking: true,
+		MaxProgress:      3,
+	},
+	"daily_streak_7": {
+		ID:                   "daily_streak_7",
+		Name:                 "Week in the Markets",
+		Description:          "Finish the daily challenge 7 days in a row",
+		Icon:                 "🗓️",
+		Category:             CategoryCareer,
+		Points:               35,
+		Rarity:               RarityEpic,
+		RequiredAchievements: []string{"daily_streak_3"},
+		ChainID:              "daily_streak",
+		ProgressTracking:     true,
+		MaxProgress:          7,
+	},
+	"daily_streak_30": {
+		ID:                   "daily_streak_30",
+		Name:                 "Creature of Habit",
+		Description:          "Finish the daily challenge 30 days in a row",
+		Icon:                 "🌅",
+		Category:             CategoryCareer,
+		Points:               100,
+		Rarity:               RarityLegendary,
+		RequiredAchievements: []string{"daily_streak_7"},
+		ChainID:              "daily_streak",
+		ProgressTracking:     true,
+		MaxProgress:          30,
+	},
 	
 	// Games Played Chain with Progress Tracking
 	"games_10": {
@@ -1235,6 +1276,12 @@ func checkAchievement(id string, stats GameStats) bool {
 		return stats.WinStreak >= 3
 	case "win_streak_5":
 		return stats.WinStreak >= 5
+	case "daily_streak_3":
+		return stats.DailyStreak >= 3
+	case "daily_streak_7":
+		return stats.DailyStreak >= 7
+	case "daily_streak_30":
+		return stats.DailyStreak >= 30
 		
 	// Challenge
 	case "easy_win":
@@ -5,7 +5,7 @@ import (
 )
 
 // CheckAchievementChain checks if a player has unlocked all prerequisites for an achievement
-func CheckAchievementChain(playerName, achievementID string) bool {
+func CheckAchievementChain(store database.Store, playerName, achievementID string) bool {
 	achievement, exists := AllAchievements[achievementID]
 	if !exists {
 		return false
@@ -17,7 +17,7 @@ func CheckAchievementChain(playerName, achievementID string) bool {
 	}
 	
 	// Check if player has all required achievements
-	playerAchievements, err := database.GetPlayerAchievements(playerName)
+	playerAchievements, err := store.GetPlayerAchievements(playerName)
 	if err != nil {
 		return false
 	}
@@ -38,7 +38,7 @@ func CheckAchievementChain(playerName, achievementID string) bool {
 }
 
 // GetNextInChain returns the next achievement in a chain after the given achievement
-func GetNextInChain(playerName, achievementID string) *Achievement {
+func GetNextInChain(store database.Store, playerName, achievementID string) *Achievement {
 	achievement, exists := AllAchievements[achievementID]
 	if !exists || achievement.ChainID == "" {
 		return nil
@@ -50,7 +50,7 @@ func GetNextInChain(playerName, achievementID string) *Achievement {
 		   len(achv.RequiredAchievements) > 0 &&
 		   contains(achv.RequiredAchievements, achievementID) {
 			// Check if player can unlock it
-			if CheckAchievementChain(playerName, achv.ID) {
+			if CheckAchievementChain(store, playerName, achv.ID) {
 				return &achv
 			}
 		}
@@ -71,14 +71,14 @@ func GetProgressiveAchievements() []Achievement {
 }
 
 // UpdateProgress updates progress toward a progressive achievement
-func UpdateProgress(playerName, achievementID string, currentProgress int) error {
+func UpdateProgress(store database.Store, playerName, achievementID string, currentProgress int) error {
 	achievement, exists := AllAchievements[achievementID]
 	if !exists || !achievement.ProgressTracking {
 		return nil // Not a progressive achievement
 	}
 	
 	// Update progress in database
-	err := database.UpdateAchievementProgress(playerName, achievementID, currentProgress, achievement.MaxProgress)
+	err := store.UpdateAchievementProgress(playerName, achievementID, currentProgress, achievement.MaxProgress)
 	if err != nil {
 		return err
 	}
@@ -86,9 +86,9 @@ func UpdateProgress(playerName, achievementID string, currentProgress int) error
 	// Check if achievement should be unlocked
 	if currentProgress >= achievement.MaxProgress {
 		// Check if chain requirements are met
-		if CheckAchievementChain(playerName, achievementID) {
+		if CheckAchievementChain(store, playerName, achievementID) {
 			// Check if not already unlocked
-			playerAchievements, err := database.GetPlayerAchievements(playerName)
+			playerAchievements, err := store.GetPlayerAchievements(playerName)
 			if err == nil {
 				alreadyUnlocked := false
 				for _, id := range playerAchievements {
@@ -100,7 +100,7 @@ func UpdateProgress(playerName, achievementID string, currentProgress int) error
 				
 				if !alreadyUnlocked {
 					// Unlock the achievement
-					err = database.UnlockAchievement(playerName, achievementID)
+					err = store.UnlockAchievement(playerName, achievementID)
 					if err != nil {
 						return err
 					}
@@ -140,7 +140,7 @@ func GetAllChains() []string {
 }
 
 // GetChainProgress returns progress through a chain (unlocked count / total count)
-func GetChainProgress(playerName, chainID string) (unlocked, total int) {
+func GetChainProgress(store database.Store, playerName, chainID string) (unlocked, total int) {
 	chainAchievements := GetAchievementsByChain(chainID)
 	total = len(chainAchievements)
 	
@@ -148,7 +148,7 @@ func GetChainProgress(playerName, chainID string) (unlocked, total int) {
 		return 0, 0
 	}
 	
-	playerAchievements, err := database.GetPlayerAchievements(playerName)
+	playerAchievements, err := store.GetPlayerAchievements(playerName)
 	if err != nil {
 		return 0, total
 	}
@@ -168,13 +168,13 @@ func GetChainProgress(playerName, chainID string) (unlocked, total int) {
 }
 
 // IsAchievementLocked checks if an achievement is locked due to chain requirements
-func IsAchievementLocked(playerName, achievementID string) bool {
-	return !CheckAchievementChain(playerName, achievementID)
+func IsAchievementLocked(store database.Store, playerName, achievementID string) bool {
+	return !CheckAchievementChain(store, playerName, achievementID)
 }
 
 // GetLockedAchievements returns all achievements that are locked for a player
-func GetLockedAchievements(playerName string) []Achievement {
-	playerAchievements, err := database.GetPlayerAchievements(playerName)
+func GetLockedAchievements(store database.Store, playerName string) []Achievement {
+	playerAchievements, err := store.GetPlayerAchievements(playerName)
 	if err != nil {
 		return []Achievement{}
 	}
@@ -195,8 +195,8 @@ func GetLockedAchievements(playerName string) []Achievement {
 }
 
 // GetUnlockedAchievements returns all achievements that are unlocked for a player
-func GetUnlockedAchievements(playerName string) []Achievement {
-	playerAchievementIDs, err := database.GetPlayerAchievements(playerName)
+func GetUnlockedAchievements(store database.Store, playerName string) []Achievement {
+	playerAchievementIDs, err := store.GetPlayerAchievements(playerName)
 	if err != nil {
 		return []Achievement{}
 	}
@@ -212,8 +212,8 @@ func GetUnlockedAchievements(playerName string) []Achievement {
 }
 
 // GetHiddenAchievements returns all hidden achievements (only shown after unlock)
-func GetHiddenAchievements(playerName string) []Achievement {
-	playerAchievements, err := database.GetPlayerAchievements(playerName)
+func GetHiddenAchievements(store database.Store, playerName string) []Achievement {
+	playerAchievements, err := store.GetPlayerAchievements(playerName)
 	if err != nil {
 		return []Achievement{}
 	}
@@ -235,8 +235,8 @@ func GetHiddenAchievements(playerName string) []Achievement {
 }
 
 // GetAvailableAchievements returns achievements that can be earned right now (not locked by chains)
-func GetAvailableAchievements(playerName string) []Achievement {
-	playerAchievements, err := database.GetPlayerAchievements(playerName)
+func GetAvailableAchievements(store database.Store, playerName string) []Achievement {
+	playerAchievements, err := store.GetPlayerAchievements(playerName)
 	if err != nil {
 		return []Achievement{}
 	}
@@ -248,7 +248,7 @@ func GetAvailableAchievements(playerName string) []Achievement {
 	
 	available := []Achievement{}
 	for _, achv := range AllAchievements {
-		if !unlockedMap[achv.ID] && CheckAchievementChain(playerName, achv.ID) {
+		if !unlockedMap[achv.ID] && CheckAchievementChain(store, playerName, achv.ID) {
 			available = append(available, achv)
 		}
 	}
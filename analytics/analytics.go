@@ -56,9 +56,9 @@ type TrendReport struct {
 }
 
 // GenerateTrendAnalysis generates trend analysis for a player
-func GenerateTrendAnalysis(playerName string, daysBack int) (*TrendReport, error) {
+func GenerateTrendAnalysis(store database.Store, playerName string, daysBack int) (*TrendReport, error) {
 	// Get game history
-	scores, err := database.GetTopScoresByPlayer(playerName, 1000)
+	scores, err := store.GetTopScoresByPlayer(playerName, 1000)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get game history: %v", err)
 	}
@@ -244,9 +244,9 @@ func generateInsights(last7, last30, allTime PerformanceTrend, allScores []datab
 }
 
 // CompareToGlobal compares player stats to global averages
-func CompareToGlobal(playerStats database.PlayerStats) (ComparisonStats, error) {
+func CompareToGlobal(store database.Store, playerStats database.PlayerStats) (ComparisonStats, error) {
 	// Get global stats (top 100 players as sample)
-	allScores, err := database.GetTopScoresByNetWorth(100, "all")
+	allScores, err := store.GetTopScoresByNetWorth(100, "all")
 	if err != nil {
 		return ComparisonStats{}, err
 	}
@@ -352,8 +352,8 @@ func calculateStdDev(scores []database.GameScore) float64 {
 }
 
 // GetMonthlyStats returns performance for a specific month
-func GetMonthlyStats(playerName string, year, month int) (*MonthlyReport, error) {
-	scores, err := database.GetTopScoresByPlayer(playerName, 1000)
+func GetMonthlyStats(store database.Store, playerName string, year, month int) (*MonthlyReport, error) {
+	scores, err := store.GetTopScoresByPlayer(playerName, 1000)
 	if err != nil {
 		return nil, err
 	}
@@ -398,24 +398,150 @@ func GetMonthlyStats(playerName string, year, month int) (*MonthlyReport, error)
 	}, nil
 }
 
-// GetTopInvestments returns best performing investments (placeholder - would need detailed game history)
-func GetTopInvestments(playerName string, limit int) ([]InvestmentSummary, error) {
-	// This would require the game_history_detailed table to be populated
-	// For now, return empty slice
-	return []InvestmentSummary{}, nil
+// GetTopInvestments returns a player's best performing investments across all recorded games
+func GetTopInvestments(store database.Store, playerName string, limit int) ([]InvestmentSummary, error) {
+	history, err := store.GetInvestmentHistory(playerName)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].ROI() > history[j].ROI()
+	})
+	if len(history) > limit {
+		history = history[:limit]
+	}
+
+	summaries := make([]InvestmentSummary, 0, len(history))
+	for _, inv := range history {
+		summaries = append(summaries, InvestmentSummary{
+			CompanyName:    inv.CompanyName,
+			Sector:         inv.Sector,
+			Outcome:        inv.Outcome,
+			AmountInvested: inv.AmountInvested,
+			ROI:            inv.ROI(),
+			ExitValue:      inv.ExitValue,
+			MonthsHeld:     inv.MonthsHeld,
+			GameDate:       inv.PlayedAt,
+		})
+	}
+	return summaries, nil
 }
 
 // InvestmentSummary represents a summary of an investment
 type InvestmentSummary struct {
-	CompanyName string
-	ROI         float64
-	ExitValue   int64
-	GameDate    time.Time
+	CompanyName    string
+	Sector         string
+	Outcome        string
+	AmountInvested int64
+	ROI            float64
+	ExitValue      int64
+	MonthsHeld     int
+	GameDate       time.Time
+}
+
+// PerformanceBreakdown is aggregate investment performance for one group (a sector,
+// a term type or a due diligence level)
+type PerformanceBreakdown struct {
+	Label           string
+	InvestmentCount int
+	AvgROI          float64
+	WinRate         float64 // % of investments that returned more than they cost
+	TotalInvested   int64
+	TotalReturned   int64
+}
+
+// GetSectorPerformance returns ROI by sector, best average first
+func GetSectorPerformance(store database.Store, playerName string) ([]SectorPerformance, error) {
+	history, err := store.GetInvestmentHistory(playerName)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]*SectorPerformance{}
+	var order []string
+	for _, inv := range history {
+		sp, ok := groups[inv.Sector]
+		roi := inv.ROI()
+		if !ok {
+			sp = &SectorPerformance{SectorName: inv.Sector, BestROI: roi, WorstROI: roi}
+			groups[inv.Sector] = sp
+			order = append(order, inv.Sector)
+		}
+		sp.InvestmentCount++
+		sp.AvgROI += roi
+		sp.TotalInvested += inv.AmountInvested
+		if roi > sp.BestROI {
+			sp.BestROI = roi
+		}
+		if roi < sp.WorstROI {
+			sp.WorstROI = roi
+		}
+	}
+
+	sectors := make([]SectorPerformance, 0, len(order))
+	for _, name := range order {
+		sp := groups[name]
+		sp.AvgROI /= float64(sp.InvestmentCount)
+		sectors = append(sectors, *sp)
+	}
+	sort.SliceStable(sectors, func(i, j int) bool {
+		return sectors[i].AvgROI > sectors[j].AvgROI
+	})
+	return sectors, nil
+}
+
+// GetTermsBreakdown returns investment performance by deal structure
+func GetTermsBreakdown(store database.Store, playerName string) ([]PerformanceBreakdown, error) {
+	return breakdownBy(store, playerName, func(inv database.InvestmentHistory) string { return inv.TermsType })
+}
+
+// GetDDLevelBreakdown returns investment performance by how much due diligence was done
+func GetDDLevelBreakdown(store database.Store, playerName string) ([]PerformanceBreakdown, error) {
+	return breakdownBy(store, playerName, func(inv database.InvestmentHistory) string { return inv.DDLevel })
+}
+
+func breakdownBy(store database.Store, playerName string, label func(database.InvestmentHistory) string) ([]PerformanceBreakdown, error) {
+	history, err := store.GetInvestmentHistory(playerName)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]*PerformanceBreakdown{}
+	var order []string
+	for _, inv := range history {
+		key := label(inv)
+		pb, ok := groups[key]
+		if !ok {
+			pb = &PerformanceBreakdown{Label: key}
+			groups[key] = pb
+			order = append(order, key)
+		}
+		pb.InvestmentCount++
+		pb.AvgROI += inv.ROI()
+		pb.TotalInvested += inv.AmountInvested
+		pb.TotalReturned += inv.ExitValue
+		if inv.ExitValue > inv.AmountInvested {
+			pb.WinRate++
+		}
+	}
+
+	breakdown := make([]PerformanceBreakdown, 0, len(order))
+	for _, key := range order {
+		pb := groups[key]
+		pb.AvgROI /= float64(pb.InvestmentCount)
+		pb.WinRate = pb.WinRate / float64(pb.InvestmentCount) * 100
+		breakdown = append(breakdown, *pb)
+	}
+	sort.SliceStable(breakdown, func(i, j int) bool {
+		return breakdown[i].AvgROI > breakdown[j].AvgROI
+	})
+	return breakdown, nil
 }
 
 // GetRecentGamesAnalysis provides quick analysis of recent games
-func GetRecentGamesAnalysis(playerName string, count int) (map[string]interface{}, error) {
-	scores, err := database.GetTopScoresByPlayer(playerName, count)
+func GetRecentGamesAnalysis(store database.Store, playerName string, count int) (map[string]interface{}, error) {
+	scores, err := store.GetTopScoresByPlayer(playerName, count)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,38 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/jamesacampbell/unicorn/database"
+)
+
+func TestInvestmentAnalyticsWithMemoryStore(t *testing.T) {
+	store := database.NewMemoryStore()
+	err := store.SaveGameHistory(database.GameHistory{PlayerName: "Alice", GameMode: "vc"}, []database.InvestmentHistory{
+		{CompanyName: "Winner", Sector: "FinTech", TermsType: "Preferred", AmountInvested: 100, ExitValue: 500},
+		{CompanyName: "Loser", Sector: "FinTech", TermsType: "Common", AmountInvested: 100, ExitValue: 0},
+		{CompanyName: "Flat", Sector: "BioTech", TermsType: "Preferred", AmountInvested: 100, ExitValue: 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.SaveGameHistory(database.GameHistory{PlayerName: "Bob"}, []database.InvestmentHistory{
+		{CompanyName: "Other", AmountInvested: 100, ExitValue: 1000},
+	})
+
+	top, err := GetTopInvestments(store, "Alice", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(top) != 2 || top[0].CompanyName != "Winner" || top[1].CompanyName != "Flat" {
+		t.Errorf("Expected Winner then Flat, got %+v", top)
+	}
+
+	terms, err := GetTermsBreakdown(store, "Alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(terms) != 2 || terms[0].Label != "Preferred" || terms[0].InvestmentCount != 2 {
+		t.Errorf("Expected Preferred to lead with 2 investments, got %+v", terms)
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+type DailyScore struct {
+	PlayerName string    `json:"player_name"`
+	Mode       string    `json:"mode"`
+	Modifier   string    `json:"modifier"`
+	Score      int64     `json:"score"`
+	ROI        float64   `json:"roi"`
+	PlayedAt   time.Time `json:"played_at"`
+}
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// Enable CORS
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	w.Header().Set("Content-Type", "application/json")
+
+	// Handle preflight
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// Only accept GET
+	if r.Method != "GET" {
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "Method not allowed. Use GET.",
+		})
+		return
+	}
+
+	date := r.URL.Query().Get("date")
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "date must be YYYY-MM-DD",
+		})
+		return
+	}
+
+	limit := 50
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+
+	// Connect to Vercel Postgres database
+	postgresURL := os.Getenv("POSTGRES_URL")
+	if postgresURL == "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "Database configuration error: POSTGRES_URL not set",
+		})
+		return
+	}
+
+	db, err := sql.Open("postgres", postgresURL)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": fmt.Sprintf("Database connection error: %v", err),
+		})
+		return
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT player_name, mode, modifier, score, roi, played_at
+		FROM daily_scores
+		WHERE challenge_date = $1
+		ORDER BY score DESC, played_at
+		LIMIT $2
+	`, date, limit)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": fmt.Sprintf("Failed to query scores: %v", err),
+		})
+		return
+	}
+	defer rows.Close()
+
+	scores := []DailyScore{}
+	for rows.Next() {
+		var s DailyScore
+		if err := rows.Scan(&s.PlayerName, &s.Mode, &s.Modifier, &s.Score, &s.ROI, &s.PlayedAt); err != nil {
+			continue
+		}
+		scores = append(scores, s)
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"date":   date,
+		"scores": scores,
+	})
+}
@@ -72,7 +72,8 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 
 	// Build query
 	query := "SELECT id, player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, played_at FROM game_scores"
-	whereClauses := []string{}
+	// Tournament rounds have their own standings and stay off the global board
+	whereClauses := []string{"tournament_id IS NULL"}
 	args := []interface{}{}
 	argIndex := 1
 
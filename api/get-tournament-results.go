@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+type TournamentScore struct {
+	PlayerName      string    `json:"player_name"`
+	Round           int       `json:"round"`
+	FinalNetWorth   int64     `json:"final_net_worth"`
+	ROI             float64   `json:"roi"`
+	SuccessfulExits int       `json:"successful_exits"`
+	PlayedAt        time.Time `json:"played_at"`
+}
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// Enable CORS
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	w.Header().Set("Content-Type", "application/json")
+
+	// Handle preflight
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// Only accept GET
+	if r.Method != "GET" {
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "Method not allowed. Use GET.",
+		})
+		return
+	}
+
+	tournamentID := r.URL.Query().Get("tournament_id")
+	if tournamentID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "tournament_id is required",
+		})
+		return
+	}
+
+	// Connect to Vercel Postgres database
+	postgresURL := os.Getenv("POSTGRES_URL")
+	if postgresURL == "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "Database configuration error: POSTGRES_URL not set",
+		})
+		return
+	}
+
+	db, err := sql.Open("postgres", postgresURL)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": fmt.Sprintf("Database connection error: %v", err),
+		})
+		return
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT player_name, tournament_round, final_net_worth, roi, successful_exits, played_at
+		FROM game_scores
+		WHERE tournament_id = $1
+		ORDER BY tournament_round, played_at
+	`, tournamentID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": fmt.Sprintf("Failed to query scores: %v", err),
+		})
+		return
+	}
+	defer rows.Close()
+
+	scores := []TournamentScore{}
+	for rows.Next() {
+		var s TournamentScore
+		if err := rows.Scan(&s.PlayerName, &s.Round, &s.FinalNetWorth, &s.ROI, &s.SuccessfulExits, &s.PlayedAt); err != nil {
+			continue
+		}
+		scores = append(scores, s)
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"tournament_id": tournamentID,
+		"scores":        scores,
+	})
+}
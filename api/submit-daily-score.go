@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+type DailyScoreSubmission struct {
+	PlayerName string  `json:"player_name"`
+	Date       string  `json:"date"`
+	Mode       string  `json:"mode"`
+	Modifier   string  `json:"modifier"`
+	Score      int64   `json:"score"`
+	ROI        float64 `json:"roi"`
+}
+
+// dailyRotation is the order modifiers come round in, one per UTC day. It must
+// match the rotation in the game's daily package.
+var dailyRotation = []string{"no_follow_ons", "deeptech_only", "double_volatility", "funding_winter"}
+
+// maxDailyScore bounds a score either side of zero, well past any net worth or
+// exit payout the game can produce
+const maxDailyScore = 1_000_000_000_000
+
+type Response struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	ID      string `json:"id,omitempty"`
+}
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	// Enable CORS
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	w.Header().Set("Content-Type", "application/json")
+
+	// Handle preflight
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// Only accept POST
+	if r.Method != "POST" {
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Method not allowed. Use POST.",
+		})
+		return
+	}
+
+	// Parse request
+	var submission DailyScoreSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Invalid JSON: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate input
+	if submission.PlayerName == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Player name is required",
+		})
+		return
+	}
+	date, err := time.Parse("2006-01-02", submission.Date)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "date must be YYYY-MM-DD",
+		})
+		return
+	}
+
+	// Only today's challenge counts, give or take a day for time zones
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if date.Before(today.AddDate(0, 0, -1)) || date.After(today.AddDate(0, 0, 1)) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: fmt.Sprintf("The %s daily challenge is closed", submission.Date),
+		})
+		return
+	}
+
+	// The modifier and mode must be the ones scheduled for that day
+	modifier := dailyRotation[(date.Unix()/86400)%int64(len(dailyRotation))]
+	mode := "vc"
+	if modifier == "funding_winter" {
+		mode = "founder"
+	}
+	if submission.Modifier != modifier || submission.Mode != mode {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: fmt.Sprintf("The %s daily challenge is %s %s, not %s %s", submission.Date, mode, modifier, submission.Mode, submission.Modifier),
+		})
+		return
+	}
+	if submission.Score < -maxDailyScore || submission.Score > maxDailyScore {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Score out of range",
+		})
+		return
+	}
+
+	// Connect to Vercel Postgres database
+	postgresURL := os.Getenv("POSTGRES_URL")
+	if postgresURL == "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Database configuration error: POSTGRES_URL not set",
+		})
+		return
+	}
+
+	db, err := sql.Open("postgres", postgresURL)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: fmt.Sprintf("Database connection error: %v", err),
+		})
+		return
+	}
+	defer db.Close()
+
+	// Create table if not exists (Postgres syntax)
+	createTableSQL := `
+	CREATE TABLE IF NOT EXISTS daily_scores (
+		id TEXT PRIMARY KEY,
+		challenge_date TEXT NOT NULL,
+		player_name TEXT NOT NULL,
+		mode TEXT NOT NULL,
+		modifier TEXT NOT NULL,
+		score BIGINT NOT NULL,
+		roi REAL NOT NULL,
+		played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (challenge_date, player_name)
+	);
+	CREATE INDEX IF NOT EXISTS idx_daily_date_score ON daily_scores(challenge_date, score DESC);
+	`
+
+	_, err = db.Exec(createTableSQL)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Failed to initialize database",
+		})
+		return
+	}
+
+	// One attempt per player per day: the first score stands
+	scoreID := uuid.New().String()
+	res, err := db.Exec(`
+		INSERT INTO daily_scores (id, challenge_date, player_name, mode, modifier, score, roi, played_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (challenge_date, player_name) DO NOTHING
+	`,
+		scoreID,
+		submission.Date,
+		submission.PlayerName,
+		submission.Mode,
+		submission.Modifier,
+		submission.Score,
+		submission.ROI,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: fmt.Sprintf("Failed to save score: %v", err),
+		})
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: fmt.Sprintf("%s already submitted the %s daily challenge", submission.PlayerName, submission.Date),
+		})
+		return
+	}
+
+	// Success response
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(Response{
+		Success: true,
+		Message: "Daily score submitted successfully!",
+		ID:      scoreID,
+	})
+}
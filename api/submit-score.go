@@ -19,6 +19,8 @@ type ScoreSubmission struct {
 	SuccessfulExits int     `json:"successful_exits"`
 	TurnsPlayed     int     `json:"turns_played"`
 	Difficulty      string  `json:"difficulty"`
+	TournamentID    string  `json:"tournament_id,omitempty"`
+	TournamentRound int     `json:"tournament_round,omitempty"`
 }
 
 type Response struct {
@@ -70,6 +72,15 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if submission.TournamentID != "" && submission.TournamentRound < 1 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: "Tournament scores need a round",
+		})
+		return
+	}
+
 	if submission.Difficulty == "" {
 		submission.Difficulty = "Medium"
 	}
@@ -122,6 +133,10 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 	CREATE INDEX IF NOT EXISTS idx_roi ON game_scores(roi DESC);
 	CREATE INDEX IF NOT EXISTS idx_player ON game_scores(player_name);
 	CREATE INDEX IF NOT EXISTS idx_difficulty ON game_scores(difficulty);
+	ALTER TABLE game_scores ADD COLUMN IF NOT EXISTS tournament_id TEXT;
+	ALTER TABLE game_scores ADD COLUMN IF NOT EXISTS tournament_round INTEGER;
+	CREATE INDEX IF NOT EXISTS idx_tournament ON game_scores(tournament_id);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_tournament_round_player ON game_scores(tournament_id, player_name, tournament_round) WHERE tournament_id IS NOT NULL;
 	`
 	
 	_, err = db.Exec(createTableSQL)
@@ -137,13 +152,15 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 	// Generate UUID for score
 	scoreID := uuid.New().String()
 
-	// Insert score (Postgres uses $1, $2, etc. instead of ?)
+	// Insert score (Postgres uses $1, $2, etc. instead of ?). Tournament rounds
+	// count once per player: the first score stands.
 	insertSQL := `
-		INSERT INTO game_scores (id, player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, played_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO game_scores (id, player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, played_at, tournament_id, tournament_round)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NULLIF($10, 0))
+		ON CONFLICT (tournament_id, player_name, tournament_round) WHERE tournament_id IS NOT NULL DO NOTHING
 	`
 
-	_, err = db.Exec(insertSQL,
+	res, err := db.Exec(insertSQL,
 		scoreID,
 		submission.PlayerName,
 		submission.FinalNetWorth,
@@ -152,6 +169,8 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		submission.TurnsPlayed,
 		submission.Difficulty,
 		time.Now().UTC(),
+		submission.TournamentID,
+		submission.TournamentRound,
 	)
 
 	if err != nil {
@@ -162,6 +181,14 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(Response{
+			Success: false,
+			Message: fmt.Sprintf("%s already submitted round %d of %s", submission.PlayerName, submission.TournamentRound, submission.TournamentID),
+		})
+		return
+	}
 
 	// Success response
 	w.WriteHeader(http.StatusCreated)
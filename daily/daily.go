@@ -0,0 +1,110 @@
+// Package daily builds the daily challenge: one seeded game per UTC day that every
+// player gets a single attempt at, with a rule modifier that rotates day to day.
+package daily
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/founder"
+	"github.com/jamesacampbell/unicorn/game"
+)
+
+// ModifierFundingWinter starts a founder game in the middle of a funding winter
+const ModifierFundingWinter = "funding_winter"
+
+// rotation is the order modifiers come round in, one per day
+var rotation = []string{
+	game.ModifierNoFollowOns,
+	game.ModifierDeepTechOnly,
+	game.ModifierDoubleVolatility,
+	ModifierFundingWinter,
+}
+
+var titles = map[string]string{
+	game.ModifierNoFollowOns:      "No Follow-Ons",
+	game.ModifierDeepTechOnly:     "DeepTech Only",
+	game.ModifierDoubleVolatility: "Double Volatility",
+	ModifierFundingWinter:         "Funding Winter",
+}
+
+var descriptions = map[string]string{
+	game.ModifierNoFollowOns:      "VC: pick your first checks well - follow-on rounds are closed to you",
+	game.ModifierDeepTechOnly:     "VC: the only deals in town are AI, bio, climate, hardware and space",
+	game.ModifierDoubleVolatility: "VC: valuations swing twice as hard as usual",
+	ModifierFundingWinter:         "Founder: you're starting up just as investors stop writing checks",
+}
+
+// Challenge is one day's scenario
+type Challenge struct {
+	Date     string // YYYY-MM-DD (UTC)
+	Seed     int64
+	Modifier string
+	Mode     string // "vc" or "founder"
+}
+
+// Today is the challenge for the current UTC date
+func Today() Challenge {
+	return For(time.Now())
+}
+
+// For is the challenge for the UTC date of t. Every player gets the same one.
+func For(t time.Time) Challenge {
+	date := t.UTC().Format(database.DailyDateFormat)
+	h := fnv.New64a()
+	h.Write([]byte(date))
+
+	day := t.UTC().Unix() / 86400
+	modifier := rotation[day%int64(len(rotation))]
+	mode := "vc"
+	if modifier == ModifierFundingWinter {
+		mode = "founder"
+	}
+	return Challenge{Date: date, Seed: int64(h.Sum64() >> 1), Modifier: modifier, Mode: mode}
+}
+
+// Title is the modifier's display name
+func (c Challenge) Title() string {
+	return titles[c.Modifier]
+}
+
+// Description explains the day's rule
+func (c Challenge) Description() string {
+	return descriptions[c.Modifier]
+}
+
+// Result is the attempt to record when a player starts the challenge
+func (c Challenge) Result(playerName string) database.DailyResult {
+	return database.DailyResult{Date: c.Date, PlayerName: playerName, Mode: c.Mode, Modifier: c.Modifier}
+}
+
+// NewVCGame starts the day's VC game: Medium difficulty, no upgrades, no reputation
+func (c Challenge) NewVCGame(playerName, firmName string) (*game.GameState, error) {
+	if c.Mode != "vc" {
+		return nil, fmt.Errorf("the %s challenge is a %s game", c.Date, c.Mode)
+	}
+	return game.NewChallengeGame(playerName, firmName, game.MediumDifficulty, c.Modifier, c.Seed), nil
+}
+
+// NewFounderGame starts the day's founder game: the same seeded company for
+// everyone, with the same rolls, no upgrades, opening in a funding winter
+func (c Challenge) NewFounderGame(founderName string) (*founder.FounderState, error) {
+	if c.Mode != "founder" {
+		return nil, fmt.Errorf("the %s challenge is a %s game", c.Date, c.Mode)
+	}
+	templates, err := founder.LoadFounderStartups("founder/startups.json")
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no founder startups to choose from")
+	}
+
+	rng := rand.New(rand.NewSource(c.Seed))
+	fs := founder.NewFounderGameWithSeed(founderName, templates[rng.Intn(len(templates))], nil, c.Seed)
+	fs.StartInFundingWinter()
+	return fs, nil
+}
@@ -0,0 +1,94 @@
+package daily
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/founder"
+	"github.com/jamesacampbell/unicorn/game"
+)
+
+func TestFor(t *testing.T) {
+	morning := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	evening := time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)
+	if For(morning) != For(evening) {
+		t.Errorf("Everyone should get the same challenge all day, got %+v and %+v", For(morning), For(evening))
+	}
+
+	// Four days cover every modifier, and only the funding winter is a founder game
+	seen := map[string]bool{}
+	for i := 0; i < len(rotation); i++ {
+		c := For(morning.AddDate(0, 0, i))
+		seen[c.Modifier] = true
+		if (c.Modifier == ModifierFundingWinter) != (c.Mode == "founder") {
+			t.Errorf("%s: %s should not be a %s game", c.Date, c.Modifier, c.Mode)
+		}
+		if c.Title() == "" || c.Description() == "" {
+			t.Errorf("%s has no title or description", c.Modifier)
+		}
+	}
+	if len(seen) != len(rotation) {
+		t.Errorf("Expected every modifier in %d days, got %v", len(rotation), seen)
+	}
+
+	c := Challenge{Date: "2026-03-01", Seed: 42, Modifier: game.ModifierDeepTechOnly, Mode: "vc"}
+	a, err := c.NewVCGame("Ada", "Ada Capital")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := c.NewVCGame("Grace", "Grace Ventures")
+	if a.AvailableStartups[0].Name != b.AvailableStartups[0].Name {
+		t.Errorf("The day's deal flow should be the same for everyone, got %s and %s", a.AvailableStartups[0].Name, b.AvailableStartups[0].Name)
+	}
+	for _, s := range a.AvailableStartups {
+		if !game.IsDeepTech(s.Category) {
+			t.Errorf("DeepTech-only challenge dealt %s (%s)", s.Name, s.Category)
+		}
+	}
+	if _, err := c.NewFounderGame("Ada"); err == nil {
+		t.Error("A VC challenge shouldn't start a founder game")
+	}
+}
+
+func TestChallengeGamesPlayOutTheSame(t *testing.T) {
+	c := Challenge{Date: "2026-03-04", Seed: 42, Modifier: ModifierFundingWinter, Mode: "founder"}
+	play := func(name string) *founder.FounderState {
+		fs, err := c.NewFounderGame(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for month := 0; month < 24 && !fs.HasExited; month++ {
+			fs.ProcessMonth()
+			rand.Intn(100) // Draws from the global source mustn't change the game
+		}
+		return fs
+	}
+	a, b := play("Ada"), play("Grace")
+	if a.Cash != b.Cash || a.MRR != b.MRR || a.Customers != b.Customers {
+		t.Errorf("Same challenge should play out the same, got cash %d/%d, MRR %d/%d, customers %d/%d",
+			a.Cash, b.Cash, a.MRR, b.MRR, a.Customers, b.Customers)
+	}
+	if len(a.Competitors) != len(b.Competitors) {
+		t.Errorf("Expected the same competitors, got %d and %d", len(a.Competitors), len(b.Competitors))
+	}
+
+	c = Challenge{Date: "2026-03-01", Seed: 42, Modifier: game.ModifierDeepTechOnly, Mode: "vc"}
+	vc := func(name string) *game.GameState {
+		gs, err := c.NewVCGame(name, name+" Capital")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := gs.MakeInvestment(0, 50000); err != nil {
+			t.Fatal(err)
+		}
+		for month := 0; month < 24; month++ {
+			gs.ProcessTurn()
+			rand.Intn(100)
+		}
+		return gs
+	}
+	if x, y := vc("Ada"), vc("Grace"); x.Portfolio.NetWorth != y.Portfolio.NetWorth {
+		t.Errorf("Same challenge should end at the same net worth, got %d and %d", x.Portfolio.NetWorth, y.Portfolio.NetWorth)
+	}
+}
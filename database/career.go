@@ -0,0 +1,144 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Career is a player's personal wealth across Founder and VC games
+type Career struct {
+	PlayerName    string
+	NetWorth      int64
+	FounderExits  int
+	FundsAnchored int
+	AngelGames    int
+	UpdatedAt     time.Time
+}
+
+// CareerEvent is one entry in a player's career history
+type CareerEvent struct {
+	GameMode      string // "founder" or "vc"
+	Event         string
+	Amount        int64 // Change in personal net worth
+	NetWorthAfter int64
+	CreatedAt     time.Time
+}
+
+// GetCareer returns a player's career, or an empty one if they haven't started
+func (s *SQLiteStore) GetCareer(playerName string) (*Career, error) {
+	c := &Career{PlayerName: playerName}
+	err := s.conn().QueryRow(`
+		SELECT net_worth, founder_exits, funds_anchored, angel_games, updated_at
+		FROM career_profiles
+		WHERE player_name = ?
+	`, playerName).Scan(&c.NetWorth, &c.FounderExits, &c.FundsAnchored, &c.AngelGames, &c.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get career: %v", err)
+	}
+	return c, nil
+}
+
+// RecordCareerEvent applies a change in personal net worth and logs it to the career history
+func (s *SQLiteStore) RecordCareerEvent(c *Career, gameMode, event string, amount int64) error {
+	c.NetWorth += amount
+	if c.NetWorth < 0 {
+		c.NetWorth = 0
+	}
+
+	tx, err := s.begin()
+	if err != nil {
+		return fmt.Errorf("failed to record career event: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT INTO career_profiles (player_name, net_worth, founder_exits, funds_anchored, angel_games, updated_at)
+		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(player_name) DO UPDATE SET
+			net_worth = excluded.net_worth,
+			founder_exits = excluded.founder_exits,
+			funds_anchored = excluded.funds_anchored,
+			angel_games = excluded.angel_games,
+			updated_at = CURRENT_TIMESTAMP
+	`, c.PlayerName, c.NetWorth, c.FounderExits, c.FundsAnchored, c.AngelGames)
+	if err != nil {
+		return fmt.Errorf("failed to save career: %v", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO career_history (player_name, game_mode, event, amount, net_worth_after)
+		VALUES (?, ?, ?, ?, ?)
+	`, c.PlayerName, gameMode, event, amount, c.NetWorth)
+	if err != nil {
+		return fmt.Errorf("failed to save career history: %v", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetCareerHistory returns a player's most recent career events
+func (s *SQLiteStore) GetCareerHistory(playerName string, limit int) ([]CareerEvent, error) {
+	rows, err := s.conn().Query(`
+		SELECT game_mode, event, amount, net_worth_after, created_at
+		FROM career_history
+		WHERE player_name = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`, playerName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get career history: %v", err)
+	}
+	defer rows.Close()
+
+	var events []CareerEvent
+	for rows.Next() {
+		var e CareerEvent
+		if err := rows.Scan(&e.GameMode, &e.Event, &e.Amount, &e.NetWorthAfter, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// AddNetworkContacts remembers people a founder met, for deal flow in later VC games
+func (s *SQLiteStore) AddNetworkContacts(playerName, sourceCompany string, contacts []string) error {
+	for _, contact := range contacts {
+		_, err := s.conn().Exec(`
+			INSERT OR IGNORE INTO career_network (player_name, contact_name, source_company)
+			VALUES (?, ?, ?)
+		`, playerName, contact, sourceCompany)
+		if err != nil {
+			return fmt.Errorf("failed to save network contact: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetNetworkContacts returns a player's network, most recent first
+func (s *SQLiteStore) GetNetworkContacts(playerName string) ([]string, error) {
+	rows, err := s.conn().Query(`
+		SELECT contact_name FROM career_network
+		WHERE player_name = ?
+		ORDER BY added_at DESC
+	`, playerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network: %v", err)
+	}
+	defer rows.Close()
+
+	var contacts []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, name)
+	}
+	return contacts, nil
+}
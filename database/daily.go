@@ -0,0 +1,157 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DailyDateFormat is how challenge dates are written: one challenge per UTC day
+const DailyDateFormat = "2006-01-02"
+
+// DailyResult is one player's attempt at a day's challenge. It's recorded when the
+// game starts, so quitting still uses up the day's attempt.
+type DailyResult struct {
+	Date       string // YYYY-MM-DD
+	PlayerName string
+	Mode       string // "vc" or "founder"
+	Modifier   string
+	Score      int64 // Net worth in VC games, exit payout in founder games
+	ROI        float64
+	Finished   bool
+	PlayedAt   time.Time
+}
+
+// ActiveDailyStreak is the streak still alive on today: one finished yesterday or
+// today keeps it going, anything older has lapsed
+func (p *Profile) ActiveDailyStreak(today string) int {
+	if p.LastDailyDate == today || p.LastDailyDate == previousDay(today) {
+		return p.DailyStreak
+	}
+	return 0
+}
+
+// extendStreak is the streak after finishing the challenge for date
+func extendStreak(streak int, lastDate, date string) int {
+	switch lastDate {
+	case date:
+		return streak
+	case previousDay(date):
+		return streak + 1
+	}
+	return 1
+}
+
+func previousDay(date string) string {
+	d, err := time.Parse(DailyDateFormat, date)
+	if err != nil {
+		return ""
+	}
+	return d.AddDate(0, 0, -1).Format(DailyDateFormat)
+}
+
+// StartDailyChallenge records a player's attempt at a day's challenge. Each player
+// gets one attempt per day; a second one is refused.
+func (s *SQLiteStore) StartDailyChallenge(r DailyResult) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO daily_results (challenge_date, player_name, mode, modifier)
+		VALUES (?, ?, ?, ?)
+	`, r.Date, r.PlayerName, r.Mode, r.Modifier)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE") {
+			return fmt.Errorf("%s has already played the %s daily challenge", r.PlayerName, r.Date)
+		}
+		return fmt.Errorf("failed to start daily challenge: %v", err)
+	}
+	return nil
+}
+
+// FinishDailyChallenge records the score for a started attempt and extends the
+// player's streak. It returns the streak after this game.
+func (s *SQLiteStore) FinishDailyChallenge(date, playerName string, score int64, roi float64) (int, error) {
+	tx, err := s.begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to finish daily challenge: %v", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+		UPDATE daily_results SET score = ?, roi = ?, finished = 1
+		WHERE challenge_date = ? AND player_name = ? AND finished = 0
+	`, score, roi, date, playerName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to finish daily challenge: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return 0, fmt.Errorf("%s has no unfinished %s daily challenge", playerName, date)
+	}
+
+	var streak, best int
+	var lastDate string
+	err = tx.QueryRow(`
+		SELECT daily_streak, best_daily_streak, last_daily_date FROM profiles WHERE name = ?
+	`, playerName).Scan(&streak, &best, &lastDate)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, fmt.Errorf("failed to get daily streak: %v", err)
+	}
+	streak = extendStreak(streak, lastDate, date)
+	if streak > best {
+		best = streak
+	}
+	_, err = tx.Exec(`
+		UPDATE profiles SET daily_streak = ?, best_daily_streak = ?, last_daily_date = ?
+		WHERE name = ?
+	`, streak, best, date, playerName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update daily streak: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to finish daily challenge: %v", err)
+	}
+	return streak, nil
+}
+
+// GetDailyResult returns a player's attempt at a day's challenge, or nil if they
+// haven't played it
+func (s *SQLiteStore) GetDailyResult(date, playerName string) (*DailyResult, error) {
+	r := &DailyResult{}
+	err := s.conn().QueryRow(`
+		SELECT challenge_date, player_name, mode, modifier, score, roi, finished, played_at
+		FROM daily_results
+		WHERE challenge_date = ? AND player_name = ?
+	`, date, playerName).Scan(&r.Date, &r.PlayerName, &r.Mode, &r.Modifier, &r.Score, &r.ROI, &r.Finished, &r.PlayedAt)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily result: %v", err)
+	}
+	return r, nil
+}
+
+// GetDailyLeaderboard returns the finished attempts at a day's challenge, best first
+func (s *SQLiteStore) GetDailyLeaderboard(date string, limit int) ([]DailyResult, error) {
+	rows, err := s.conn().Query(`
+		SELECT challenge_date, player_name, mode, modifier, score, roi, finished, played_at
+		FROM daily_results
+		WHERE challenge_date = ? AND finished = 1
+		ORDER BY score DESC, played_at
+		LIMIT ?
+	`, date, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily leaderboard: %v", err)
+	}
+	defer rows.Close()
+
+	var results []DailyResult
+	for rows.Next() {
+		var r DailyResult
+		if err := rows.Scan(&r.Date, &r.PlayerName, &r.Mode, &r.Modifier, &r.Score, &r.ROI, &r.Finished, &r.PlayedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan daily result: %v", err)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestDailyChallenge(t *testing.T) {
+	sqlite, err := NewSQLiteStore(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteStore failed: %v", err)
+	}
+	defer sqlite.Close()
+
+	for name, store := range map[string]Store{"sqlite": sqlite, "memory": NewMemoryStore()} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := store.CreateProfile("Ada"); err != nil {
+				t.Fatal(err)
+			}
+			play := func(date string, score int64) int {
+				t.Helper()
+				if err := store.StartDailyChallenge(DailyResult{Date: date, PlayerName: "Ada", Mode: "vc", Modifier: "no_follow_ons"}); err != nil {
+					t.Fatalf("StartDailyChallenge(%s) failed: %v", date, err)
+				}
+				streak, err := store.FinishDailyChallenge(date, "Ada", score, 10)
+				if err != nil {
+					t.Fatalf("FinishDailyChallenge(%s) failed: %v", date, err)
+				}
+				return streak
+			}
+
+			if streak := play("2026-03-01", 100); streak != 1 {
+				t.Errorf("First day should start a streak of 1, got %d", streak)
+			}
+			if err := store.StartDailyChallenge(DailyResult{Date: "2026-03-01", PlayerName: "Ada", Mode: "vc"}); err == nil {
+				t.Error("A second attempt on the same day should be refused")
+			}
+			if _, err := store.FinishDailyChallenge("2026-03-01", "Ada", 999, 0); err == nil {
+				t.Error("A finished attempt shouldn't be scored again")
+			}
+			if streak := play("2026-03-02", 200); streak != 2 {
+				t.Errorf("Consecutive days should extend the streak to 2, got %d", streak)
+			}
+			// Skipping a day starts over, but the best streak is kept
+			if streak := play("2026-03-04", 300); streak != 1 {
+				t.Errorf("A missed day should reset the streak, got %d", streak)
+			}
+			p, _ := store.GetProfile("Ada")
+			if p.DailyStreak != 1 || p.BestDailyStreak != 2 || p.LastDailyDate != "2026-03-04" {
+				t.Errorf("Unexpected profile streak: %+v", p)
+			}
+			if p.ActiveDailyStreak("2026-03-05") != 1 || p.ActiveDailyStreak("2026-03-06") != 0 {
+				t.Error("A streak should stay alive for a day, then lapse")
+			}
+
+			// Started but unfinished attempts stay off the leaderboard
+			if err := store.StartDailyChallenge(DailyResult{Date: "2026-03-04", PlayerName: "Grace", Mode: "vc"}); err != nil {
+				t.Fatal(err)
+			}
+			board, err := store.GetDailyLeaderboard("2026-03-04", 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(board) != 1 || board[0].PlayerName != "Ada" || board[0].Score != 300 {
+				t.Errorf("Expected only Ada's finished game on the board, got %+v", board)
+			}
+			if r, _ := store.GetDailyResult("2026-03-04", "Grace"); r == nil || r.Finished {
+				t.Errorf("Grace's attempt should be recorded as unfinished, got %+v", r)
+			}
+		})
+	}
+}
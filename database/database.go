@@ -4,7 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 	"math"
-	"strings"
+	"os"
+	"path/filepath"
 	"time"
 
 	_ "modernc.org/sqlite"
@@ -34,157 +35,111 @@ type PlayerStats struct {
 	WinRate         float64 // % of games with positive ROI
 }
 
-var db *sql.DB
+// SQLiteStore is the local on-disk Store
+type SQLiteStore struct {
+	db *sql.DB
+	tx *sql.Tx // Set on the store InTransaction hands its callback
+}
 
-// InitDB initializes the database connection and creates tables
-func InitDB(dbPath string) error {
-	var err error
-	db, err = sql.Open("sqlite", dbPath)
-	if err != nil {
-		return fmt.Errorf("failed to open database: %v", err)
-	}
-
-	// Create tables
-	createTablesSQL := `
-	CREATE TABLE IF NOT EXISTS game_scores (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT NOT NULL,
-		final_net_worth INTEGER NOT NULL,
-		roi REAL NOT NULL,
-		successful_exits INTEGER NOT NULL,
-		turns_played INTEGER NOT NULL,
-		difficulty TEXT NOT NULL,
-		played_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE TABLE IF NOT EXISTS player_achievements (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT NOT NULL,
-		achievement_id TEXT NOT NULL,
-		unlocked_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-		UNIQUE(player_name, achievement_id)
-	);
-
-	CREATE TABLE IF NOT EXISTS player_upgrades (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT NOT NULL,
-		upgrade_id TEXT NOT NULL,
-		purchased_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-		UNIQUE(player_name, upgrade_id)
-	);
-
-	CREATE TABLE IF NOT EXISTS player_profiles (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT UNIQUE NOT NULL,
-		level INTEGER DEFAULT 1,
-		experience_points INTEGER DEFAULT 0,
-		total_points_earned INTEGER DEFAULT 0,
-		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-		last_played DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE TABLE IF NOT EXISTS player_level_history (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT NOT NULL,
-		level INTEGER NOT NULL,
-		reached_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE TABLE IF NOT EXISTS achievement_progress (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT NOT NULL,
-		achievement_id TEXT NOT NULL,
-		current_progress INTEGER DEFAULT 0,
-		max_progress INTEGER NOT NULL,
-		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-		UNIQUE(player_name, achievement_id)
-	);
-
-	CREATE TABLE IF NOT EXISTS vc_reputation (
-		player_name TEXT PRIMARY KEY,
-		performance_score REAL DEFAULT 50.0,
-		founder_score REAL DEFAULT 50.0,
-		market_score REAL DEFAULT 50.0,
-		total_games_played INTEGER DEFAULT 0,
-		successful_exits INTEGER DEFAULT 0,
-		avg_roi_last_5 REAL DEFAULT 0.0,
-		last_updated DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE TABLE IF NOT EXISTS game_history_detailed (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		player_name TEXT NOT NULL,
-		game_mode TEXT NOT NULL,
-		difficulty TEXT NOT NULL,
-		final_net_worth INTEGER,
-		roi REAL,
-		successful_exits INTEGER,
-		turns_played INTEGER,
-		investments_made INTEGER,
-		best_investment_roi REAL,
-		worst_investment_roi REAL,
-		avg_investment_amount INTEGER,
-		total_invested INTEGER,
-		played_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_net_worth ON game_scores(final_net_worth DESC);
-	CREATE INDEX IF NOT EXISTS idx_roi ON game_scores(roi DESC);
-	CREATE INDEX IF NOT EXISTS idx_player ON game_scores(player_name);
-	CREATE INDEX IF NOT EXISTS idx_difficulty ON game_scores(difficulty);
-	CREATE INDEX IF NOT EXISTS idx_player_achievements ON player_achievements(player_name);
-	CREATE INDEX IF NOT EXISTS idx_player_upgrades ON player_upgrades(player_name);
-	CREATE INDEX IF NOT EXISTS idx_player_profiles ON player_profiles(player_name);
-	CREATE INDEX IF NOT EXISTS idx_level_history ON player_level_history(player_name);
-	CREATE INDEX IF NOT EXISTS idx_achievement_progress ON achievement_progress(player_name);
-	CREATE INDEX IF NOT EXISTS idx_game_history ON game_history_detailed(player_name, played_at DESC);
-	`
+// dbConn is what queries run against: the database, or a transaction in progress
+type dbConn interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
 
-	_, err = db.Exec(createTablesSQL)
-	if err != nil {
-		return fmt.Errorf("failed to create tables: %v", err)
+// dbTx is a transaction a method opens for a multi-statement write
+type dbTx interface {
+	dbConn
+	Commit() error
+	Rollback() error
+}
+
+// joinedTx runs a method's statements inside an outer transaction; the outer
+// InTransaction call decides whether they commit
+type joinedTx struct{ *sql.Tx }
+
+func (joinedTx) Commit() error   { return nil }
+func (joinedTx) Rollback() error { return nil }
+
+func (s *SQLiteStore) conn() dbConn {
+	if s.tx != nil {
+		return s.tx
 	}
+	return s.db
+}
 
-	// Add level_up_points column if it doesn't exist (migration)
-	_, err = db.Exec(`
-		ALTER TABLE player_profiles 
-		ADD COLUMN level_up_points INTEGER DEFAULT 0
-	`)
-	// Ignore error if column already exists
-	if err != nil && !strings.Contains(err.Error(), "duplicate column") {
-		// Only return error if it's not a "column already exists" error
-		return fmt.Errorf("failed to add level_up_points column: %v", err)
+func (s *SQLiteStore) begin() (dbTx, error) {
+	if s.tx != nil {
+		return joinedTx{s.tx}, nil
 	}
+	return s.db.Begin()
+}
 
-	// Add mode column to game_scores if it doesn't exist (migration)
-	_, err = db.Exec(`
-		ALTER TABLE game_scores 
-		ADD COLUMN mode TEXT NOT NULL DEFAULT 'vc'
-	`)
-	// Ignore error if column already exists
-	if err != nil && !strings.Contains(err.Error(), "duplicate column") {
-		return fmt.Errorf("failed to add mode column: %v", err)
+// InTransaction runs fn against a store whose writes all commit together, or not
+// at all if fn returns an error
+func (s *SQLiteStore) InTransaction(fn func(Store) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	if err := fn(&SQLiteStore{db: s.db, tx: tx}); err != nil {
+		tx.Rollback()
+		return err
 	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
 
-	// Add index on mode
-	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_mode ON game_scores(mode)`)
+// DefaultPath returns where the game keeps its database
+// (~/.config/unicorn on Linux, ~/Library/Application Support/unicorn on macOS)
+func DefaultPath() string {
+	configDir, err := os.UserConfigDir()
 	if err != nil {
-		return fmt.Errorf("failed to create idx_mode index: %v", err)
+		configDir = os.Getenv("HOME")
 	}
+	unicornDir := filepath.Join(configDir, "unicorn")
 
-	return nil
+	// Create config directory if it doesn't exist
+	os.MkdirAll(unicornDir, 0755)
+	return filepath.Join(unicornDir, "unicorn_scores.db")
 }
 
-// CloseDB closes the database connection
-func CloseDB() error {
-	if db != nil {
-		return db.Close()
+// OpenSQLite opens a SQLite database without touching the schema
+func OpenSQLite(dbPath string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %v", err)
 	}
-	return nil
+	return &SQLiteStore{db: db}, nil
+}
+
+// NewSQLiteStore opens a SQLite database and brings its schema up to date. It
+// refuses to run against a schema written by a newer build.
+func NewSQLiteStore(dbPath string) (*SQLiteStore, error) {
+	s, err := OpenSQLite(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Migrate(); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the database connection
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
 }
 
 // SaveGameScore saves a completed game to the database
-func SaveGameScore(score GameScore) error {
+func (s *SQLiteStore) SaveGameScore(score GameScore) error {
 	query := `
 		INSERT INTO game_scores (player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, mode, played_at)
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
@@ -195,7 +150,7 @@ func SaveGameScore(score GameScore) error {
 		mode = "vc"
 	}
 
-	_, err := db.Exec(query,
+	_, err := s.conn().Exec(query,
 		score.PlayerName,
 		score.FinalNetWorth,
 		score.ROI,
@@ -214,7 +169,7 @@ func SaveGameScore(score GameScore) error {
 }
 
 // GetTopScoresByNetWorth returns the top N scores by net worth
-func GetTopScoresByNetWorth(limit int, difficulty string) ([]GameScore, error) {
+func (s *SQLiteStore) GetTopScoresByNetWorth(limit int, difficulty string) ([]GameScore, error) {
 	var query string
 	var args []interface{}
 
@@ -237,7 +192,7 @@ func GetTopScoresByNetWorth(limit int, difficulty string) ([]GameScore, error) {
 		args = []interface{}{difficulty, limit}
 	}
 
-	rows, err := db.Query(query, args...)
+	rows, err := s.conn().Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query top scores: %v", err)
 	}
@@ -266,7 +221,7 @@ func GetTopScoresByNetWorth(limit int, difficulty string) ([]GameScore, error) {
 }
 
 // GetTopScoresByROI returns the top N scores by ROI
-func GetTopScoresByROI(limit int, difficulty string) ([]GameScore, error) {
+func (s *SQLiteStore) GetTopScoresByROI(limit int, difficulty string) ([]GameScore, error) {
 	var query string
 	var args []interface{}
 
@@ -289,7 +244,7 @@ func GetTopScoresByROI(limit int, difficulty string) ([]GameScore, error) {
 		args = []interface{}{difficulty, limit}
 	}
 
-	rows, err := db.Query(query, args...)
+	rows, err := s.conn().Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query top scores: %v", err)
 	}
@@ -318,7 +273,7 @@ func GetTopScoresByROI(limit int, difficulty string) ([]GameScore, error) {
 }
 
 // GetPlayerStats returns aggregate statistics for a player
-func GetPlayerStats(playerName string) (*PlayerStats, error) {
+func (s *SQLiteStore) GetPlayerStats(playerName string) (*PlayerStats, error) {
 	query := `
 		SELECT 
 			COUNT(*) as total_games,
@@ -334,7 +289,7 @@ func GetPlayerStats(playerName string) (*PlayerStats, error) {
 	var stats PlayerStats
 	stats.PlayerName = playerName
 
-	err := db.QueryRow(query, playerName).Scan(
+	err := s.conn().QueryRow(query, playerName).Scan(
 		&stats.TotalGames,
 		&stats.BestNetWorth,
 		&stats.BestROI,
@@ -357,7 +312,7 @@ func GetPlayerStats(playerName string) (*PlayerStats, error) {
 
 // GetPlayerStatsByMode returns aggregate statistics for a player filtered by game mode
 // gameMode: "vc" for VC mode (Easy/Medium/Hard/Expert) or "founder" for Founder mode
-func GetPlayerStatsByMode(playerName string, gameMode string) (*PlayerStats, error) {
+func (s *SQLiteStore) GetPlayerStatsByMode(playerName string, gameMode string) (*PlayerStats, error) {
 	var difficultyFilter string
 	if gameMode == "vc" {
 		// VC modes: Easy, Medium, Hard, Expert
@@ -384,7 +339,7 @@ func GetPlayerStatsByMode(playerName string, gameMode string) (*PlayerStats, err
 	var stats PlayerStats
 	stats.PlayerName = playerName
 
-	err := db.QueryRow(query, playerName).Scan(
+	err := s.conn().QueryRow(query, playerName).Scan(
 		&stats.TotalGames,
 		&stats.BestNetWorth,
 		&stats.BestROI,
@@ -406,7 +361,7 @@ func GetPlayerStatsByMode(playerName string, gameMode string) (*PlayerStats, err
 }
 
 // GetRecentGames returns the most recent N games
-func GetRecentGames(limit int) ([]GameScore, error) {
+func (s *SQLiteStore) GetRecentGames(limit int) ([]GameScore, error) {
 	query := `
 		SELECT id, player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, played_at
 		FROM game_scores
@@ -414,7 +369,7 @@ func GetRecentGames(limit int) ([]GameScore, error) {
 		LIMIT ?
 	`
 
-	rows, err := db.Query(query, limit)
+	rows, err := s.conn().Query(query, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query recent games: %v", err)
 	}
@@ -443,9 +398,9 @@ func GetRecentGames(limit int) ([]GameScore, error) {
 }
 
 // GetTotalGamesPlayed returns the total number of games in the database
-func GetTotalGamesPlayed() (int, error) {
+func (s *SQLiteStore) GetTotalGamesPlayed() (int, error) {
 	var count int
-	err := db.QueryRow("SELECT COUNT(*) FROM game_scores").Scan(&count)
+	err := s.conn().QueryRow("SELECT COUNT(*) FROM game_scores").Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get total games: %v", err)
 	}
@@ -455,13 +410,13 @@ func GetTotalGamesPlayed() (int, error) {
 // Achievement tracking
 
 // UnlockAchievement saves an unlocked achievement for a player
-func UnlockAchievement(playerName, achievementID string) error {
+func (s *SQLiteStore) UnlockAchievement(playerName, achievementID string) error {
 	query := `
 		INSERT OR IGNORE INTO player_achievements (player_name, achievement_id, unlocked_at)
 		VALUES (?, ?, ?)
 	`
 
-	_, err := db.Exec(query, playerName, achievementID, time.Now())
+	_, err := s.conn().Exec(query, playerName, achievementID, time.Now())
 	if err != nil {
 		return fmt.Errorf("failed to unlock achievement: %v", err)
 	}
@@ -470,7 +425,7 @@ func UnlockAchievement(playerName, achievementID string) error {
 }
 
 // GetPlayerAchievements returns all unlocked achievements for a player
-func GetPlayerAchievements(playerName string) ([]string, error) {
+func (s *SQLiteStore) GetPlayerAchievements(playerName string) ([]string, error) {
 	query := `
 		SELECT achievement_id
 		FROM player_achievements
@@ -478,7 +433,7 @@ func GetPlayerAchievements(playerName string) ([]string, error) {
 		ORDER BY unlocked_at ASC
 	`
 
-	rows, err := db.Query(query, playerName)
+	rows, err := s.conn().Query(query, playerName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query achievements: %v", err)
 	}
@@ -497,7 +452,7 @@ func GetPlayerAchievements(playerName string) ([]string, error) {
 }
 
 // GetPlayerAchievementCount returns total achievements unlocked by player
-func GetPlayerAchievementCount(playerName string) (int, error) {
+func (s *SQLiteStore) GetPlayerAchievementCount(playerName string) (int, error) {
 	var count int
 	query := `
 		SELECT COUNT(*)
@@ -505,7 +460,7 @@ func GetPlayerAchievementCount(playerName string) (int, error) {
 		WHERE player_name = ?
 	`
 
-	err := db.QueryRow(query, playerName).Scan(&count)
+	err := s.conn().QueryRow(query, playerName).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get achievement count: %v", err)
 	}
@@ -514,8 +469,8 @@ func GetPlayerAchievementCount(playerName string) (int, error) {
 }
 
 // GetPlayerAchievementPoints returns total points from unlocked achievements
-func GetPlayerAchievementPoints(playerName string) (int, error) {
-	achievements, err := GetPlayerAchievements(playerName)
+func (s *SQLiteStore) GetPlayerAchievementPoints(playerName string) (int, error) {
+	achievements, err := s.GetPlayerAchievements(playerName)
 	if err != nil {
 		return 0, err
 	}
@@ -539,7 +494,7 @@ type AchievementLeaderboardEntry struct {
 }
 
 // GetAchievementLeaderboard returns top players by achievement count and points
-func GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error) {
+func (s *SQLiteStore) GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error) {
 	// Get all unique players with achievements
 	query := `
 		SELECT DISTINCT player_name
@@ -547,7 +502,7 @@ func GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error)
 		ORDER BY player_name
 	`
 
-	rows, err := db.Query(query)
+	rows, err := s.conn().Query(query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query players: %v", err)
 	}
@@ -565,7 +520,7 @@ func GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error)
 	// Build leaderboard entries
 	leaderboard := []AchievementLeaderboardEntry{}
 	for _, playerName := range players {
-		achievements, err := GetPlayerAchievements(playerName)
+		achievements, err := s.GetPlayerAchievements(playerName)
 		if err != nil {
 			continue
 		}
@@ -577,7 +532,7 @@ func GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error)
 		}
 
 		// Get career level from profile
-		profile, err := GetPlayerProfile(playerName)
+		profile, err := s.GetPlayerProfile(playerName)
 		if err == nil {
 			entry.CareerLevel = profile.Level
 		}
@@ -608,13 +563,13 @@ func GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error)
 // Upgrade functions
 
 // PurchaseUpgrade saves a purchased upgrade for a player
-func PurchaseUpgrade(playerName, upgradeID string) error {
+func (s *SQLiteStore) PurchaseUpgrade(playerName, upgradeID string) error {
 	query := `
 		INSERT OR IGNORE INTO player_upgrades (player_name, upgrade_id, purchased_at)
 		VALUES (?, ?, ?)
 	`
 
-	_, err := db.Exec(query, playerName, upgradeID, time.Now())
+	_, err := s.conn().Exec(query, playerName, upgradeID, time.Now())
 	if err != nil {
 		return fmt.Errorf("failed to purchase upgrade: %v", err)
 	}
@@ -623,7 +578,7 @@ func PurchaseUpgrade(playerName, upgradeID string) error {
 }
 
 // GetPlayerUpgrades returns all purchased upgrades for a player
-func GetPlayerUpgrades(playerName string) ([]string, error) {
+func (s *SQLiteStore) GetPlayerUpgrades(playerName string) ([]string, error) {
 	query := `
 		SELECT upgrade_id
 		FROM player_upgrades
@@ -631,7 +586,7 @@ func GetPlayerUpgrades(playerName string) ([]string, error) {
 		ORDER BY purchased_at ASC
 	`
 
-	rows, err := db.Query(query, playerName)
+	rows, err := s.conn().Query(query, playerName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query upgrades: %v", err)
 	}
@@ -650,7 +605,7 @@ func GetPlayerUpgrades(playerName string) ([]string, error) {
 }
 
 // HasUpgrade checks if a player has a specific upgrade
-func HasUpgrade(playerName, upgradeID string) (bool, error) {
+func (s *SQLiteStore) HasUpgrade(playerName, upgradeID string) (bool, error) {
 	query := `
 		SELECT COUNT(*)
 		FROM player_upgrades
@@ -658,7 +613,7 @@ func HasUpgrade(playerName, upgradeID string) (bool, error) {
 	`
 
 	var count int
-	err := db.QueryRow(query, playerName, upgradeID).Scan(&count)
+	err := s.conn().QueryRow(query, playerName, upgradeID).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("failed to check upgrade: %v", err)
 	}
@@ -667,7 +622,7 @@ func HasUpgrade(playerName, upgradeID string) (bool, error) {
 }
 
 // GetWinStreak calculates current win streak for a player
-func GetWinStreak(playerName string) (int, error) {
+func (s *SQLiteStore) GetWinStreak(playerName string) (int, error) {
 	query := `
 		SELECT roi
 		FROM game_scores
@@ -676,7 +631,7 @@ func GetWinStreak(playerName string) (int, error) {
 		LIMIT 10
 	`
 
-	rows, err := db.Query(query, playerName)
+	rows, err := s.conn().Query(query, playerName)
 	if err != nil {
 		return 0, err
 	}
@@ -713,7 +668,7 @@ type PlayerProfile struct {
 }
 
 // GetPlayerProfile retrieves or creates a player's profile
-func GetPlayerProfile(playerName string) (*PlayerProfile, error) {
+func (s *SQLiteStore) GetPlayerProfile(playerName string) (*PlayerProfile, error) {
 	// Try to get existing profile
 	query := `
 		SELECT player_name, level, experience_points, total_points_earned, COALESCE(level_up_points, 0), created_at, last_played
@@ -722,7 +677,7 @@ func GetPlayerProfile(playerName string) (*PlayerProfile, error) {
 	`
 
 	var profile PlayerProfile
-	err := db.QueryRow(query, playerName).Scan(
+	err := s.conn().QueryRow(query, playerName).Scan(
 		&profile.PlayerName,
 		&profile.Level,
 		&profile.ExperiencePoints,
@@ -738,7 +693,7 @@ func GetPlayerProfile(playerName string) (*PlayerProfile, error) {
 			INSERT INTO player_profiles (player_name, level, experience_points, total_points_earned, level_up_points)
 			VALUES (?, 1, 0, 0, 0)
 		`
-		_, err := db.Exec(insertQuery, playerName)
+		_, err := s.conn().Exec(insertQuery, playerName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create player profile: %v", err)
 		}
@@ -781,9 +736,9 @@ func GetLevelRequirement(level int) int {
 
 // AddExperience adds XP to a player and handles level ups
 // Returns: leveledUp, newLevel, pointsEarned (from level ups), error
-func AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel int, pointsEarned int, err error) {
+func (s *SQLiteStore) AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel int, pointsEarned int, err error) {
 	// Get current profile
-	profile, err := GetPlayerProfile(playerName)
+	profile, err := s.GetPlayerProfile(playerName)
 	if err != nil {
 		return false, 0, 0, err
 	}
@@ -811,7 +766,7 @@ func AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel in
 				INSERT INTO player_level_history (player_name, level, reached_at)
 				VALUES (?, ?, CURRENT_TIMESTAMP)
 			`
-			_, err := db.Exec(historyQuery, playerName, currentLevel)
+			_, err := s.conn().Exec(historyQuery, playerName, currentLevel)
 			if err != nil {
 				return false, 0, 0, fmt.Errorf("failed to record level history: %v", err)
 			}
@@ -829,7 +784,7 @@ func AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel in
 		SET level = ?, experience_points = ?, total_points_earned = ?, level_up_points = ?, last_played = CURRENT_TIMESTAMP
 		WHERE player_name = ?
 	`
-	_, err = db.Exec(updateQuery, currentLevel, newXP, newTotal, newLevelUpPoints, playerName)
+	_, err = s.conn().Exec(updateQuery, currentLevel, newXP, newTotal, newLevelUpPoints, playerName)
 	if err != nil {
 		return false, 0, 0, fmt.Errorf("failed to update player profile: %v", err)
 	}
@@ -838,9 +793,9 @@ func AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel in
 }
 
 // GetTotalPlayerPoints returns the total points a player has (achievement points + level-up points)
-func GetTotalPlayerPoints(playerName string) (int, error) {
+func (s *SQLiteStore) GetTotalPlayerPoints(playerName string) (int, error) {
 	// Get level-up points from profile
-	profile, err := GetPlayerProfile(playerName)
+	profile, err := s.GetPlayerProfile(playerName)
 	if err != nil {
 		return 0, err
 	}
@@ -850,28 +805,47 @@ func GetTotalPlayerPoints(playerName string) (int, error) {
 }
 
 // UpdateLastPlayed updates the last played timestamp for a player
-func UpdateLastPlayed(playerName string) error {
+func (s *SQLiteStore) UpdateLastPlayed(playerName string) error {
 	query := `
 		UPDATE player_profiles
 		SET last_played = CURRENT_TIMESTAMP
 		WHERE player_name = ?
 	`
-	_, err := db.Exec(query, playerName)
+	_, err := s.conn().Exec(query, playerName)
 	if err != nil {
 		return fmt.Errorf("failed to update last played: %v", err)
 	}
 	return nil
 }
 
+// SavePlayerProfile overwrites a player's level and XP, creating the profile if needed
+func (s *SQLiteStore) SavePlayerProfile(profile *PlayerProfile) error {
+	query := `
+		INSERT INTO player_profiles (player_name, level, experience_points, total_points_earned, level_up_points)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(player_name) DO UPDATE SET
+			level = excluded.level,
+			experience_points = excluded.experience_points,
+			total_points_earned = excluded.total_points_earned,
+			level_up_points = excluded.level_up_points
+	`
+	_, err := s.conn().Exec(query, profile.PlayerName, profile.Level, profile.ExperiencePoints,
+		profile.TotalPointsEarned, profile.LevelUpPoints)
+	if err != nil {
+		return fmt.Errorf("failed to save player profile: %v", err)
+	}
+	return nil
+}
+
 // UpdateAchievementProgress updates progress toward a progressive achievement
-func UpdateAchievementProgress(playerName, achievementID string, currentProgress, maxProgress int) error {
+func (s *SQLiteStore) UpdateAchievementProgress(playerName, achievementID string, currentProgress, maxProgress int) error {
 	query := `
 		INSERT INTO achievement_progress (player_name, achievement_id, current_progress, max_progress, updated_at)
 		VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP)
 		ON CONFLICT(player_name, achievement_id) 
 		DO UPDATE SET current_progress = ?, updated_at = CURRENT_TIMESTAMP
 	`
-	_, err := db.Exec(query, playerName, achievementID, currentProgress, maxProgress, currentProgress)
+	_, err := s.conn().Exec(query, playerName, achievementID, currentProgress, maxProgress, currentProgress)
 	if err != nil {
 		return fmt.Errorf("failed to update achievement progress: %v", err)
 	}
@@ -879,13 +853,13 @@ func UpdateAchievementProgress(playerName, achievementID string, currentProgress
 }
 
 // GetAchievementProgress gets the current progress for an achievement
-func GetAchievementProgress(playerName, achievementID string) (current, max int, err error) {
+func (s *SQLiteStore) GetAchievementProgress(playerName, achievementID string) (current, max int, err error) {
 	query := `
 		SELECT current_progress, max_progress
 		FROM achievement_progress
 		WHERE player_name = ? AND achievement_id = ?
 	`
-	err = db.QueryRow(query, playerName, achievementID).Scan(&current, &max)
+	err = s.conn().QueryRow(query, playerName, achievementID).Scan(&current, &max)
 	if err == sql.ErrNoRows {
 		return 0, 0, nil // No progress yet
 	}
@@ -904,14 +878,14 @@ type ProgressInfo struct {
 }
 
 // GetAllProgress gets all achievement progress for a player
-func GetAllProgress(playerName string) (map[string]ProgressInfo, error) {
+func (s *SQLiteStore) GetAllProgress(playerName string) (map[string]ProgressInfo, error) {
 	query := `
 		SELECT achievement_id, current_progress, max_progress, updated_at
 		FROM achievement_progress
 		WHERE player_name = ?
 	`
 
-	rows, err := db.Query(query, playerName)
+	rows, err := s.conn().Query(query, playerName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query achievement progress: %v", err)
 	}
@@ -930,16 +904,16 @@ func GetAllProgress(playerName string) (map[string]ProgressInfo, error) {
 }
 
 // GetTopScoresByPlayer returns top scores for a specific player
-func GetTopScoresByPlayer(playerName string, limit int) ([]GameScore, error) {
+func (s *SQLiteStore) GetTopScoresByPlayer(playerName string, limit int) ([]GameScore, error) {
 	query := `
-		SELECT id, player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, played_at
+		SELECT id, player_name, final_net_worth, roi, successful_exits, turns_played, difficulty, mode, played_at
 		FROM game_scores
 		WHERE player_name = ?
 		ORDER BY played_at DESC
 		LIMIT ?
 	`
 
-	rows, err := db.Query(query, playerName, limit)
+	rows, err := s.conn().Query(query, playerName, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query scores: %v", err)
 	}
@@ -956,6 +930,7 @@ func GetTopScoresByPlayer(playerName string, limit int) ([]GameScore, error) {
 			&score.SuccessfulExits,
 			&score.TurnsPlayed,
 			&score.Difficulty,
+			&score.Mode,
 			&score.PlayedAt,
 		)
 		if err != nil {
@@ -980,7 +955,7 @@ type VCReputation struct {
 }
 
 // GetVCReputation retrieves a player's VC reputation
-func GetVCReputation(playerName string) (*VCReputation, error) {
+func (s *SQLiteStore) GetVCReputation(playerName string) (*VCReputation, error) {
 	query := `
 		SELECT player_name, performance_score, founder_score, market_score,
 		       total_games_played, successful_exits, avg_roi_last_5, last_updated
@@ -990,7 +965,7 @@ func GetVCReputation(playerName string) (*VCReputation, error) {
 
 	var rep VCReputation
 	var lastUpdatedStr string
-	err := db.QueryRow(query, playerName).Scan(
+	err := s.conn().QueryRow(query, playerName).Scan(
 		&rep.PlayerName,
 		&rep.PerformanceScore,
 		&rep.FounderScore,
@@ -1026,7 +1001,7 @@ func GetVCReputation(playerName string) (*VCReputation, error) {
 }
 
 // SaveVCReputation saves or updates a player's VC reputation
-func SaveVCReputation(rep *VCReputation) error {
+func (s *SQLiteStore) SaveVCReputation(rep *VCReputation) error {
 	query := `
 		INSERT INTO vc_reputation (
 			player_name, performance_score, founder_score, market_score,
@@ -1042,7 +1017,7 @@ func SaveVCReputation(rep *VCReputation) error {
 			last_updated = CURRENT_TIMESTAMP
 	`
 
-	_, err := db.Exec(query,
+	_, err := s.conn().Exec(query,
 		rep.PlayerName,
 		rep.PerformanceScore,
 		rep.FounderScore,
@@ -1060,7 +1035,7 @@ func SaveVCReputation(rep *VCReputation) error {
 }
 
 // GetTopScoresByNetWorthAndMode returns the top N scores by net worth filtered by mode
-func GetTopScoresByNetWorthAndMode(limit int, difficulty, mode string) ([]GameScore, error) {
+func (s *SQLiteStore) GetTopScoresByNetWorthAndMode(limit int, difficulty, mode string) ([]GameScore, error) {
 	var query string
 	var args []interface{}
 
@@ -1078,7 +1053,7 @@ func GetTopScoresByNetWorthAndMode(limit int, difficulty, mode string) ([]GameSc
 		args = []interface{}{difficulty, mode, limit}
 	}
 
-	rows, err := db.Query(query, args...)
+	rows, err := s.conn().Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query top scores: %v", err)
 	}
@@ -1096,7 +1071,7 @@ func GetTopScoresByNetWorthAndMode(limit int, difficulty, mode string) ([]GameSc
 }
 
 // GetTopScoresByROIAndMode returns the top N scores by ROI filtered by mode
-func GetTopScoresByROIAndMode(limit int, difficulty, mode string) ([]GameScore, error) {
+func (s *SQLiteStore) GetTopScoresByROIAndMode(limit int, difficulty, mode string) ([]GameScore, error) {
 	var query string
 	var args []interface{}
 
@@ -1114,7 +1089,7 @@ func GetTopScoresByROIAndMode(limit int, difficulty, mode string) ([]GameScore,
 		args = []interface{}{difficulty, mode, limit}
 	}
 
-	rows, err := db.Query(query, args...)
+	rows, err := s.conn().Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query top scores: %v", err)
 	}
@@ -1132,7 +1107,7 @@ func GetTopScoresByROIAndMode(limit int, difficulty, mode string) ([]GameScore,
 }
 
 // GetRecentGamesAndMode returns recent games filtered by mode
-func GetRecentGamesAndMode(limit int, mode string) ([]GameScore, error) {
+func (s *SQLiteStore) GetRecentGamesAndMode(limit int, mode string) ([]GameScore, error) {
 	var query string
 	var args []interface{}
 
@@ -1144,7 +1119,7 @@ func GetRecentGamesAndMode(limit int, mode string) ([]GameScore, error) {
 		args = []interface{}{mode, limit}
 	}
 
-	rows, err := db.Query(query, args...)
+	rows, err := s.conn().Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query recent games: %v", err)
 	}
@@ -1162,9 +1137,9 @@ func GetRecentGamesAndMode(limit int, mode string) ([]GameScore, error) {
 }
 
 // HasVCReputation returns true if the player has a saved VC reputation
-func HasVCReputation(playerName string) bool {
+func (s *SQLiteStore) HasVCReputation(playerName string) bool {
 	var count int
-	err := db.QueryRow(`SELECT COUNT(*) FROM vc_reputation WHERE player_name = ?`, playerName).Scan(&count)
+	err := s.conn().QueryRow(`SELECT COUNT(*) FROM vc_reputation WHERE player_name = ?`, playerName).Scan(&count)
 	if err != nil {
 		return false
 	}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// GameHistory is one finished VC game with portfolio-level aggregates
+type GameHistory struct {
+	PlayerName      string
+	GameMode        string
+	Difficulty      string
+	FinalNetWorth   int64
+	ROI             float64
+	SuccessfulExits int
+	TurnsPlayed     int
+	PlayedAt        time.Time // Now if zero
+}
+
+// InvestmentHistory is one position from a finished game
+type InvestmentHistory struct {
+	GameID            int64
+	CompanyName       string
+	Sector            string
+	TermsType         string
+	DDLevel           string
+	Outcome           string
+	AmountInvested    int64
+	EntryValuation    int64
+	ExitValuation     int64
+	ExitValue         int64
+	MonthsHeld        int
+	RelationshipScore float64
+	PlayedAt          time.Time
+}
+
+// ROI is the position's return as a percentage of the amount invested
+func (i InvestmentHistory) ROI() float64 {
+	if i.AmountInvested <= 0 {
+		return 0
+	}
+	return float64(i.ExitValue-i.AmountInvested) / float64(i.AmountInvested) * 100
+}
+
+// SaveGameHistory records a finished game and every investment made in it
+func (s *SQLiteStore) SaveGameHistory(game GameHistory, investments []InvestmentHistory) error {
+	var totalInvested int64
+	bestROI, worstROI := 0.0, 0.0
+	for i, inv := range investments {
+		totalInvested += inv.AmountInvested
+		roi := inv.ROI()
+		if i == 0 || roi > bestROI {
+			bestROI = roi
+		}
+		if i == 0 || roi < worstROI {
+			worstROI = roi
+		}
+	}
+	avgInvested := int64(0)
+	if len(investments) > 0 {
+		avgInvested = totalInvested / int64(len(investments))
+	}
+	if game.PlayedAt.IsZero() {
+		game.PlayedAt = time.Now()
+	}
+
+	tx, err := s.begin()
+	if err != nil {
+		return fmt.Errorf("failed to save game history: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		INSERT INTO game_history_detailed (player_name, game_mode, difficulty, final_net_worth, roi,
+			successful_exits, turns_played, investments_made, best_investment_roi, worst_investment_roi,
+			avg_investment_amount, total_invested, played_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, game.PlayerName, game.GameMode, game.Difficulty, game.FinalNetWorth, game.ROI,
+		game.SuccessfulExits, game.TurnsPlayed, len(investments), bestROI, worstROI,
+		avgInvested, totalInvested, game.PlayedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save game history: %v", err)
+	}
+	gameID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to save game history: %v", err)
+	}
+
+	for _, inv := range investments {
+		_, err = tx.Exec(`
+			INSERT INTO game_investments (game_id, player_name, company_name, sector, terms_type, dd_level,
+				outcome, amount_invested, entry_valuation, exit_valuation, exit_value, months_held, relationship_score)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, gameID, game.PlayerName, inv.CompanyName, inv.Sector, inv.TermsType, inv.DDLevel,
+			inv.Outcome, inv.AmountInvested, inv.EntryValuation, inv.ExitValuation, inv.ExitValue,
+			inv.MonthsHeld, inv.RelationshipScore)
+		if err != nil {
+			return fmt.Errorf("failed to save investment %s: %v", inv.CompanyName, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetInvestmentHistory returns every recorded investment for a player, newest game first
+func (s *SQLiteStore) GetInvestmentHistory(playerName string) ([]InvestmentHistory, error) {
+	rows, err := s.conn().Query(`
+		SELECT i.game_id, i.company_name, i.sector, i.terms_type, i.dd_level, i.outcome,
+			i.amount_invested, i.entry_valuation, i.exit_valuation, i.exit_value,
+			i.months_held, i.relationship_score, g.played_at
+		FROM game_investments i
+		JOIN game_history_detailed g ON g.id = i.game_id
+		WHERE i.player_name = ?
+		ORDER BY g.played_at DESC, i.id
+	`, playerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get investment history: %v", err)
+	}
+	defer rows.Close()
+
+	var history []InvestmentHistory
+	for rows.Next() {
+		var inv InvestmentHistory
+		if err := rows.Scan(&inv.GameID, &inv.CompanyName, &inv.Sector, &inv.TermsType, &inv.DDLevel,
+			&inv.Outcome, &inv.AmountInvested, &inv.EntryValuation, &inv.ExitValuation, &inv.ExitValue,
+			&inv.MonthsHeld, &inv.RelationshipScore, &inv.PlayedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, inv)
+	}
+	return history, nil
+}
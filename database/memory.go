@@ -0,0 +1,882 @@
+package database
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory Store for tests and throwaway sessions. Nothing is
+// written to disk.
+type MemoryStore struct {
+	mu sync.Mutex
+
+	localProfiles map[string]*Profile
+	scores        []GameScore
+	profiles      map[string]*PlayerProfile
+	achievements  map[string][]string
+	upgrades      map[string][]string
+	reputations   map[string]VCReputation
+	progress      map[string]map[string]ProgressInfo
+	games         []GameHistory
+	investments   []InvestmentHistory
+	series        []savedSeries
+	careers       map[string]Career
+	careerEvents  map[string][]CareerEvent
+	contacts      map[string][]string
+	tournaments   []Tournament
+	results       []TournamentResult
+	dailies       []DailyResult
+}
+
+type savedSeries struct {
+	playerName string
+	gameMode   string
+	series     map[string][]int64
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		localProfiles: map[string]*Profile{},
+		profiles:      map[string]*PlayerProfile{},
+		achievements:  map[string][]string{},
+		upgrades:      map[string][]string{},
+		reputations:   map[string]VCReputation{},
+		progress:      map[string]map[string]ProgressInfo{},
+		careers:       map[string]Career{},
+		careerEvents:  map[string][]CareerEvent{},
+		contacts:      map[string][]string{},
+	}
+}
+
+// Close is a no-op
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+// InTransaction runs fn and puts everything back as it was if fn fails. It isn't
+// isolated: writes from other goroutines while fn runs are rolled back with it.
+func (m *MemoryStore) InTransaction(fn func(Store) error) error {
+	saved := m.snapshot()
+	if err := fn(m); err != nil {
+		m.restore(saved)
+		return err
+	}
+	return nil
+}
+
+// snapshot copies the store's contents, deep enough that later writes don't reach it
+func (m *MemoryStore) snapshot() *MemoryStore {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := &MemoryStore{
+		localProfiles: map[string]*Profile{},
+		scores:        slices.Clone(m.scores),
+		profiles:      map[string]*PlayerProfile{},
+		achievements:  map[string][]string{},
+		upgrades:      map[string][]string{},
+		reputations:   maps.Clone(m.reputations),
+		progress:      map[string]map[string]ProgressInfo{},
+		games:         slices.Clone(m.games),
+		investments:   slices.Clone(m.investments),
+		series:        slices.Clone(m.series),
+		careers:       maps.Clone(m.careers),
+		careerEvents:  map[string][]CareerEvent{},
+		contacts:      map[string][]string{},
+		tournaments:   slices.Clone(m.tournaments),
+		results:       slices.Clone(m.results),
+		dailies:       slices.Clone(m.dailies),
+	}
+	for k, p := range m.localProfiles {
+		copied := *p
+		s.localProfiles[k] = &copied
+	}
+	for k, p := range m.profiles {
+		copied := *p
+		s.profiles[k] = &copied
+	}
+	for k, v := range m.achievements {
+		s.achievements[k] = slices.Clone(v)
+	}
+	for k, v := range m.upgrades {
+		s.upgrades[k] = slices.Clone(v)
+	}
+	for k, v := range m.progress {
+		s.progress[k] = maps.Clone(v)
+	}
+	for k, v := range m.careerEvents {
+		s.careerEvents[k] = slices.Clone(v)
+	}
+	for k, v := range m.contacts {
+		s.contacts[k] = slices.Clone(v)
+	}
+	return s
+}
+
+// restore puts back the contents of a snapshot
+func (m *MemoryStore) restore(s *MemoryStore) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.localProfiles, m.scores, m.profiles = s.localProfiles, s.scores, s.profiles
+	m.achievements, m.upgrades, m.reputations, m.progress = s.achievements, s.upgrades, s.reputations, s.progress
+	m.games, m.investments, m.series = s.games, s.investments, s.series
+	m.careers, m.careerEvents, m.contacts = s.careers, s.careerEvents, s.contacts
+	m.tournaments, m.results, m.dailies = s.tournaments, s.results, s.dailies
+}
+
+// Scores
+
+func (m *MemoryStore) SaveGameScore(score GameScore) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if score.Mode == "" {
+		score.Mode = "vc"
+	}
+	score.ID = len(m.scores) + 1
+	m.scores = append(m.scores, score)
+	return nil
+}
+
+// filterScores returns matching scores sorted by less, capped at limit
+func (m *MemoryStore) filterScores(limit int, keep func(GameScore) bool, less func(a, b GameScore) bool) []GameScore {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []GameScore
+	for _, s := range m.scores {
+		if keep(s) {
+			out = append(out, s)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return less(out[i], out[j]) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func matchFilter(value, filter string) bool {
+	return filter == "" || filter == "all" || value == filter
+}
+
+func byNetWorth(a, b GameScore) bool { return a.FinalNetWorth > b.FinalNetWorth }
+func byROI(a, b GameScore) bool      { return a.ROI > b.ROI }
+func byRecent(a, b GameScore) bool   { return a.PlayedAt.After(b.PlayedAt) }
+
+func (m *MemoryStore) GetTopScoresByNetWorth(limit int, difficulty string) ([]GameScore, error) {
+	return m.GetTopScoresByNetWorthAndMode(limit, difficulty, "")
+}
+
+func (m *MemoryStore) GetTopScoresByROI(limit int, difficulty string) ([]GameScore, error) {
+	return m.GetTopScoresByROIAndMode(limit, difficulty, "")
+}
+
+func (m *MemoryStore) GetTopScoresByNetWorthAndMode(limit int, difficulty, mode string) ([]GameScore, error) {
+	return m.filterScores(limit, func(s GameScore) bool {
+		return matchFilter(s.Difficulty, difficulty) && matchFilter(s.Mode, mode)
+	}, byNetWorth), nil
+}
+
+func (m *MemoryStore) GetTopScoresByROIAndMode(limit int, difficulty, mode string) ([]GameScore, error) {
+	return m.filterScores(limit, func(s GameScore) bool {
+		return matchFilter(s.Difficulty, difficulty) && matchFilter(s.Mode, mode)
+	}, byROI), nil
+}
+
+func (m *MemoryStore) GetTopScoresByPlayer(playerName string, limit int) ([]GameScore, error) {
+	return m.filterScores(limit, func(s GameScore) bool { return s.PlayerName == playerName }, byRecent), nil
+}
+
+func (m *MemoryStore) GetRecentGames(limit int) ([]GameScore, error) {
+	return m.GetRecentGamesAndMode(limit, "")
+}
+
+func (m *MemoryStore) GetRecentGamesAndMode(limit int, mode string) ([]GameScore, error) {
+	return m.filterScores(limit, func(s GameScore) bool { return matchFilter(s.Mode, mode) }, byRecent), nil
+}
+
+func (m *MemoryStore) GetTotalGamesPlayed() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.scores), nil
+}
+
+func (m *MemoryStore) GetPlayerStats(playerName string) (*PlayerStats, error) {
+	return m.playerStats(playerName, func(GameScore) bool { return true }), nil
+}
+
+func (m *MemoryStore) GetPlayerStatsByMode(playerName string, gameMode string) (*PlayerStats, error) {
+	switch gameMode {
+	case "vc":
+		return m.playerStats(playerName, func(s GameScore) bool { return s.Difficulty != "Founder" }), nil
+	case "founder":
+		return m.playerStats(playerName, func(s GameScore) bool { return s.Difficulty == "Founder" }), nil
+	}
+	return nil, fmt.Errorf("invalid game mode: %s", gameMode)
+}
+
+func (m *MemoryStore) playerStats(playerName string, keep func(GameScore) bool) *PlayerStats {
+	games := m.filterScores(0, func(s GameScore) bool { return s.PlayerName == playerName && keep(s) }, byRecent)
+	stats := &PlayerStats{PlayerName: playerName, TotalGames: len(games)}
+	if len(games) == 0 {
+		return stats
+	}
+
+	var total int64
+	wins := 0
+	for i, g := range games {
+		if i == 0 || g.FinalNetWorth > stats.BestNetWorth {
+			stats.BestNetWorth = g.FinalNetWorth
+		}
+		if i == 0 || g.ROI > stats.BestROI {
+			stats.BestROI = g.ROI
+		}
+		stats.TotalExits += g.SuccessfulExits
+		total += g.FinalNetWorth
+		if g.ROI > 0 {
+			wins++
+		}
+	}
+	stats.AverageNetWorth = float64(total) / float64(len(games))
+	stats.WinRate = float64(wins) * 100 / float64(len(games))
+	return stats
+}
+
+func (m *MemoryStore) GetWinStreak(playerName string) (int, error) {
+	games := m.filterScores(10, func(s GameScore) bool { return s.PlayerName == playerName }, byRecent)
+	streak := 0
+	for _, g := range games {
+		if g.ROI <= 0 {
+			break
+		}
+		streak++
+	}
+	return streak, nil
+}
+
+// Profiles
+
+func (m *MemoryStore) ListProfiles() ([]Profile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var profiles []Profile
+	for _, p := range m.localProfiles {
+		profiles = append(profiles, *p)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		if !profiles[i].LastUsed.Equal(profiles[j].LastUsed) {
+			return profiles[i].LastUsed.After(profiles[j].LastUsed)
+		}
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles, nil
+}
+
+func (m *MemoryStore) GetProfile(name string) (*Profile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if p, ok := m.localProfiles[name]; ok {
+		out := *p
+		return &out, nil
+	}
+	return nil, nil
+}
+
+func (m *MemoryStore) CreateProfile(name string) (*Profile, error) {
+	name, err := ValidateProfileName(name)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	if _, ok := m.localProfiles[name]; ok {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("a profile named %s already exists", name)
+	}
+	m.localProfiles[name] = &Profile{Name: name, Theme: "classic", SubmitScores: true, CreatedAt: time.Now(), LastUsed: time.Now()}
+	m.mu.Unlock()
+	return m.GetProfile(name)
+}
+
+func (m *MemoryStore) SaveProfile(p *Profile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.localProfiles[p.Name]; ok {
+		existing.Theme, existing.AutoMode, existing.SubmitScores = p.Theme, p.AutoMode, p.SubmitScores
+	}
+	return nil
+}
+
+func (m *MemoryStore) UseProfile(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if p, ok := m.localProfiles[name]; ok {
+		p.LastUsed = time.Now()
+	}
+	return nil
+}
+
+func (m *MemoryStore) RenameProfile(oldName, newName string) error {
+	newName, err := ValidateProfileName(newName)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.localProfiles[newName]; ok {
+		return fmt.Errorf("a profile named %s already exists", newName)
+	}
+	m.moveOrDeletePlayer(oldName, newName)
+	return nil
+}
+
+func (m *MemoryStore) DeleteProfile(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.moveOrDeletePlayer(name, "")
+	return nil
+}
+
+// moveOrDeletePlayer refiles everything under oldName to newName, or drops it when
+// newName is empty. Callers hold the lock.
+func (m *MemoryStore) moveOrDeletePlayer(oldName, newName string) {
+	if p, ok := m.localProfiles[oldName]; ok && newName != "" {
+		p.Name = newName
+		m.localProfiles[newName] = p
+	}
+	delete(m.localProfiles, oldName)
+	if p, ok := m.profiles[oldName]; ok && newName != "" {
+		p.PlayerName = newName
+		m.profiles[newName] = p
+	}
+	delete(m.profiles, oldName)
+	if rep, ok := m.reputations[oldName]; ok && newName != "" {
+		rep.PlayerName = newName
+		m.reputations[newName] = rep
+	}
+	delete(m.reputations, oldName)
+	if c, ok := m.careers[oldName]; ok && newName != "" {
+		c.PlayerName = newName
+		m.careers[newName] = c
+	}
+	delete(m.careers, oldName)
+	moveKey(m.achievements, oldName, newName)
+	moveKey(m.upgrades, oldName, newName)
+	moveKey(m.progress, oldName, newName)
+	moveKey(m.careerEvents, oldName, newName)
+	moveKey(m.contacts, oldName, newName)
+
+	scores := m.scores[:0]
+	for _, s := range m.scores {
+		if s.PlayerName == oldName {
+			if newName == "" {
+				continue
+			}
+			s.PlayerName = newName
+		}
+		scores = append(scores, s)
+	}
+	m.scores = scores
+	// Game history is indexed by position, so deleted games are kept but orphaned
+	for i := range m.games {
+		if m.games[i].PlayerName == oldName {
+			m.games[i].PlayerName = newName
+		}
+	}
+	for i := range m.series {
+		if m.series[i].playerName == oldName {
+			m.series[i].playerName = newName
+		}
+	}
+	results := m.results[:0]
+	for _, r := range m.results {
+		if r.PlayerName == oldName {
+			if newName == "" {
+				continue
+			}
+			r.PlayerName = newName
+		}
+		results = append(results, r)
+	}
+	m.results = results
+	dailies := m.dailies[:0]
+	for _, d := range m.dailies {
+		if d.PlayerName == oldName {
+			if newName == "" {
+				continue
+			}
+			d.PlayerName = newName
+		}
+		dailies = append(dailies, d)
+	}
+	m.dailies = dailies
+}
+
+func moveKey[V any](values map[string]V, oldName, newName string) {
+	if v, ok := values[oldName]; ok && newName != "" {
+		values[newName] = v
+	}
+	delete(values, oldName)
+}
+
+func (m *MemoryStore) GetPlayerProfile(playerName string) (*PlayerProfile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	profile := m.profile(playerName)
+	out := *profile
+	out.NextLevelXP = GetLevelRequirement(out.Level + 1)
+	out.ProgressPercent = float64(out.ExperiencePoints) / float64(out.NextLevelXP) * 100
+	return &out, nil
+}
+
+// profile returns the player's profile, creating it if needed. Callers hold the lock.
+func (m *MemoryStore) profile(playerName string) *PlayerProfile {
+	if p, ok := m.profiles[playerName]; ok {
+		return p
+	}
+	p := &PlayerProfile{PlayerName: playerName, Level: 1, CreatedAt: time.Now(), LastPlayed: time.Now()}
+	m.profiles[playerName] = p
+	return p
+}
+
+func (m *MemoryStore) AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel int, pointsEarned int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p := m.profile(playerName)
+
+	p.ExperiencePoints += xpAmount
+	p.TotalPointsEarned += xpAmount
+	for p.ExperiencePoints >= GetLevelRequirement(p.Level+1) {
+		p.ExperiencePoints -= GetLevelRequirement(p.Level + 1)
+		p.Level++
+		pointsEarned += 10 * p.Level
+		leveledUp = true
+	}
+	p.LevelUpPoints += pointsEarned
+	p.LastPlayed = time.Now()
+	return leveledUp, p.Level, pointsEarned, nil
+}
+
+func (m *MemoryStore) GetTotalPlayerPoints(playerName string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.profile(playerName).LevelUpPoints, nil
+}
+
+func (m *MemoryStore) UpdateLastPlayed(playerName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if p, ok := m.profiles[playerName]; ok {
+		p.LastPlayed = time.Now()
+	}
+	return nil
+}
+
+func (m *MemoryStore) SavePlayerProfile(profile *PlayerProfile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p := m.profile(profile.PlayerName)
+	p.Level = profile.Level
+	p.ExperiencePoints = profile.ExperiencePoints
+	p.TotalPointsEarned = profile.TotalPointsEarned
+	p.LevelUpPoints = profile.LevelUpPoints
+	return nil
+}
+
+// Achievements and upgrades
+
+func (m *MemoryStore) UnlockAchievement(playerName, achievementID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.achievements[playerName] = appendUnique(m.achievements[playerName], achievementID)
+	return nil
+}
+
+func (m *MemoryStore) GetPlayerAchievements(playerName string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.achievements[playerName]...), nil
+}
+
+func (m *MemoryStore) GetPlayerAchievementCount(playerName string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.achievements[playerName]), nil
+}
+
+func (m *MemoryStore) GetPlayerAchievementPoints(playerName string) (int, error) {
+	count, err := m.GetPlayerAchievementCount(playerName)
+	return count * 10, err
+}
+
+func (m *MemoryStore) GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error) {
+	m.mu.Lock()
+	leaderboard := []AchievementLeaderboardEntry{}
+	for playerName, unlocked := range m.achievements {
+		entry := AchievementLeaderboardEntry{PlayerName: playerName, AchievementCount: len(unlocked)}
+		if p, ok := m.profiles[playerName]; ok {
+			entry.CareerLevel = p.Level
+		}
+		leaderboard = append(leaderboard, entry)
+	}
+	m.mu.Unlock()
+
+	sort.Slice(leaderboard, func(i, j int) bool {
+		if leaderboard[i].AchievementCount != leaderboard[j].AchievementCount {
+			return leaderboard[i].AchievementCount > leaderboard[j].AchievementCount
+		}
+		return leaderboard[i].PlayerName < leaderboard[j].PlayerName
+	})
+	if limit > 0 && limit < len(leaderboard) {
+		leaderboard = leaderboard[:limit]
+	}
+	return leaderboard, nil
+}
+
+func (m *MemoryStore) PurchaseUpgrade(playerName, upgradeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.upgrades[playerName] = appendUnique(m.upgrades[playerName], upgradeID)
+	return nil
+}
+
+func (m *MemoryStore) GetPlayerUpgrades(playerName string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.upgrades[playerName]...), nil
+}
+
+func (m *MemoryStore) HasUpgrade(playerName, upgradeID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range m.upgrades[playerName] {
+		if id == upgradeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+// Reputation and progress
+
+func (m *MemoryStore) GetVCReputation(playerName string) (*VCReputation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if rep, ok := m.reputations[playerName]; ok {
+		return &rep, nil
+	}
+	return &VCReputation{
+		PlayerName:       playerName,
+		PerformanceScore: 50.0,
+		FounderScore:     50.0,
+		MarketScore:      50.0,
+		LastUpdated:      time.Now(),
+	}, nil
+}
+
+func (m *MemoryStore) SaveVCReputation(rep *VCReputation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *rep
+	saved.LastUpdated = time.Now()
+	m.reputations[rep.PlayerName] = saved
+	return nil
+}
+
+func (m *MemoryStore) HasVCReputation(playerName string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.reputations[playerName]
+	return ok
+}
+
+func (m *MemoryStore) UpdateAchievementProgress(playerName, achievementID string, currentProgress, maxProgress int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.progress[playerName] == nil {
+		m.progress[playerName] = map[string]ProgressInfo{}
+	}
+	info, ok := m.progress[playerName][achievementID]
+	if !ok {
+		info = ProgressInfo{AchievementID: achievementID, MaxProgress: maxProgress}
+	}
+	info.CurrentProgress = currentProgress
+	info.UpdatedAt = time.Now()
+	m.progress[playerName][achievementID] = info
+	return nil
+}
+
+func (m *MemoryStore) GetAchievementProgress(playerName, achievementID string) (current, max int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	info := m.progress[playerName][achievementID]
+	return info.CurrentProgress, info.MaxProgress, nil
+}
+
+func (m *MemoryStore) GetAllProgress(playerName string) (map[string]ProgressInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	progress := make(map[string]ProgressInfo)
+	for id, info := range m.progress[playerName] {
+		progress[id] = info
+	}
+	return progress, nil
+}
+
+// Game history
+
+func (m *MemoryStore) SaveGameHistory(game GameHistory, investments []InvestmentHistory) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if game.PlayedAt.IsZero() {
+		game.PlayedAt = time.Now()
+	}
+	m.games = append(m.games, game)
+	gameID := int64(len(m.games))
+	for _, inv := range investments {
+		inv.GameID = gameID
+		inv.PlayedAt = game.PlayedAt
+		m.investments = append(m.investments, inv)
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetInvestmentHistory(playerName string) ([]InvestmentHistory, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Newest game first, each game's investments in the order they were saved
+	var history []InvestmentHistory
+	for gameID := int64(len(m.games)); gameID > 0; gameID-- {
+		if m.games[gameID-1].PlayerName != playerName {
+			continue
+		}
+		for _, inv := range m.investments {
+			if inv.GameID == gameID {
+				history = append(history, inv)
+			}
+		}
+	}
+	return history, nil
+}
+
+func (m *MemoryStore) SaveGameSeries(playerName, gameMode string, series map[string][]int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.series = append(m.series, savedSeries{playerName: playerName, gameMode: gameMode, series: series})
+	return nil
+}
+
+func (m *MemoryStore) GetLatestGameSeries(playerName, gameMode string) (map[string][]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.series) - 1; i >= 0; i-- {
+		if m.series[i].playerName == playerName && m.series[i].gameMode == gameMode {
+			return m.series[i].series, nil
+		}
+	}
+	return nil, nil
+}
+
+// Career
+
+func (m *MemoryStore) GetCareer(playerName string) (*Career, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.careers[playerName]; ok {
+		return &c, nil
+	}
+	return &Career{PlayerName: playerName}, nil
+}
+
+func (m *MemoryStore) RecordCareerEvent(c *Career, gameMode, event string, amount int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c.NetWorth += amount
+	if c.NetWorth < 0 {
+		c.NetWorth = 0
+	}
+	c.UpdatedAt = time.Now()
+	m.careers[c.PlayerName] = *c
+	m.careerEvents[c.PlayerName] = append(m.careerEvents[c.PlayerName], CareerEvent{
+		GameMode:      gameMode,
+		Event:         event,
+		Amount:        amount,
+		NetWorthAfter: c.NetWorth,
+		CreatedAt:     c.UpdatedAt,
+	})
+	return nil
+}
+
+func (m *MemoryStore) GetCareerHistory(playerName string, limit int) ([]CareerEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	all := m.careerEvents[playerName]
+	var events []CareerEvent
+	for i := len(all) - 1; i >= 0 && (limit <= 0 || len(events) < limit); i-- {
+		events = append(events, all[i])
+	}
+	return events, nil
+}
+
+func (m *MemoryStore) AddNetworkContacts(playerName, sourceCompany string, contacts []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, contact := range contacts {
+		m.contacts[playerName] = appendUnique(m.contacts[playerName], contact)
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetNetworkContacts(playerName string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	all := m.contacts[playerName]
+	contacts := make([]string, 0, len(all))
+	for i := len(all) - 1; i >= 0; i-- {
+		contacts = append(contacts, all[i])
+	}
+	return contacts, nil
+}
+
+// Tournaments
+
+func (m *MemoryStore) SaveTournament(t Tournament) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.tournaments {
+		if m.tournaments[i].ID == t.ID {
+			m.tournaments[i].Name = t.Name
+			m.tournaments[i].Definition = t.Definition
+			return nil
+		}
+	}
+	t.CreatedAt = time.Now()
+	m.tournaments = append(m.tournaments, t)
+	return nil
+}
+
+func (m *MemoryStore) GetTournament(id string) (*Tournament, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range m.tournaments {
+		if t.ID == id {
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MemoryStore) ListTournaments() ([]Tournament, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var tournaments []Tournament
+	for i := len(m.tournaments) - 1; i >= 0; i-- {
+		tournaments = append(tournaments, m.tournaments[i])
+	}
+	return tournaments, nil
+}
+
+func (m *MemoryStore) SaveTournamentResult(r TournamentResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.results {
+		if existing.TournamentID == r.TournamentID && existing.Round == r.Round && existing.PlayerName == r.PlayerName {
+			return fmt.Errorf("%s has already played round %d of %s", r.PlayerName, r.Round, r.TournamentID)
+		}
+	}
+	r.PlayedAt = time.Now()
+	m.results = append(m.results, r)
+	return nil
+}
+
+func (m *MemoryStore) GetTournamentResults(tournamentID string) ([]TournamentResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var results []TournamentResult
+	for _, r := range m.results {
+		if r.TournamentID == tournamentID {
+			results = append(results, r)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Round < results[j].Round })
+	return results, nil
+}
+
+// Daily challenges
+
+func (m *MemoryStore) StartDailyChallenge(r DailyResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.dailies {
+		if existing.Date == r.Date && existing.PlayerName == r.PlayerName {
+			return fmt.Errorf("%s has already played the %s daily challenge", r.PlayerName, r.Date)
+		}
+	}
+	r.Score, r.ROI, r.Finished, r.PlayedAt = 0, 0, false, time.Now()
+	m.dailies = append(m.dailies, r)
+	return nil
+}
+
+func (m *MemoryStore) FinishDailyChallenge(date, playerName string, score int64, roi float64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	found := false
+	for i := range m.dailies {
+		d := &m.dailies[i]
+		if d.Date == date && d.PlayerName == playerName && !d.Finished {
+			d.Score, d.ROI, d.Finished = score, roi, true
+			found = true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("%s has no unfinished %s daily challenge", playerName, date)
+	}
+	p, ok := m.localProfiles[playerName]
+	if !ok {
+		return extendStreak(0, "", date), nil
+	}
+	p.DailyStreak = extendStreak(p.DailyStreak, p.LastDailyDate, date)
+	p.LastDailyDate = date
+	if p.DailyStreak > p.BestDailyStreak {
+		p.BestDailyStreak = p.DailyStreak
+	}
+	return p.DailyStreak, nil
+}
+
+func (m *MemoryStore) GetDailyResult(date, playerName string) (*DailyResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range m.dailies {
+		if d.Date == date && d.PlayerName == playerName {
+			return &d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MemoryStore) GetDailyLeaderboard(date string, limit int) ([]DailyResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var results []DailyResult
+	for _, d := range m.dailies {
+		if d.Date == date && d.Finished {
+			results = append(results, d)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
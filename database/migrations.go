@@ -0,0 +1,505 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Migration is one ordered, transactional change to the schema
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(tx *sql.Tx) error
+	Down    func(tx *sql.Tx) error // nil = can't be rolled back
+}
+
+// MigrationState is a migration and whether it has been applied to the open database
+type MigrationState struct {
+	Version    int
+	Name       string
+	Applied    bool
+	AppliedAt  time.Time
+	Reversible bool
+}
+
+// migrations are applied in order. Never edit or reorder a released migration;
+// add a new one instead.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "baseline",
+		Up: execSQL(`
+			CREATE TABLE IF NOT EXISTS game_scores (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				final_net_worth INTEGER NOT NULL,
+				roi REAL NOT NULL,
+				successful_exits INTEGER NOT NULL,
+				turns_played INTEGER NOT NULL,
+				difficulty TEXT NOT NULL,
+				played_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS player_achievements (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				achievement_id TEXT NOT NULL,
+				unlocked_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(player_name, achievement_id)
+			);
+
+			CREATE TABLE IF NOT EXISTS player_upgrades (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				upgrade_id TEXT NOT NULL,
+				purchased_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(player_name, upgrade_id)
+			);
+
+			CREATE TABLE IF NOT EXISTS player_profiles (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT UNIQUE NOT NULL,
+				level INTEGER DEFAULT 1,
+				experience_points INTEGER DEFAULT 0,
+				total_points_earned INTEGER DEFAULT 0,
+				created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				last_played DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS player_level_history (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				level INTEGER NOT NULL,
+				reached_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS achievement_progress (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				achievement_id TEXT NOT NULL,
+				current_progress INTEGER DEFAULT 0,
+				max_progress INTEGER NOT NULL,
+				updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(player_name, achievement_id)
+			);
+
+			CREATE TABLE IF NOT EXISTS vc_reputation (
+				player_name TEXT PRIMARY KEY,
+				performance_score REAL DEFAULT 50.0,
+				founder_score REAL DEFAULT 50.0,
+				market_score REAL DEFAULT 50.0,
+				total_games_played INTEGER DEFAULT 0,
+				successful_exits INTEGER DEFAULT 0,
+				avg_roi_last_5 REAL DEFAULT 0.0,
+				last_updated DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS game_history_detailed (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				game_mode TEXT NOT NULL,
+				difficulty TEXT NOT NULL,
+				final_net_worth INTEGER,
+				roi REAL,
+				successful_exits INTEGER,
+				turns_played INTEGER,
+				investments_made INTEGER,
+				best_investment_roi REAL,
+				worst_investment_roi REAL,
+				avg_investment_amount INTEGER,
+				total_invested INTEGER,
+				played_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE INDEX IF NOT EXISTS idx_net_worth ON game_scores(final_net_worth DESC);
+			CREATE INDEX IF NOT EXISTS idx_roi ON game_scores(roi DESC);
+			CREATE INDEX IF NOT EXISTS idx_player ON game_scores(player_name);
+			CREATE INDEX IF NOT EXISTS idx_difficulty ON game_scores(difficulty);
+			CREATE INDEX IF NOT EXISTS idx_player_achievements ON player_achievements(player_name);
+			CREATE INDEX IF NOT EXISTS idx_player_upgrades ON player_upgrades(player_name);
+			CREATE INDEX IF NOT EXISTS idx_player_profiles ON player_profiles(player_name);
+			CREATE INDEX IF NOT EXISTS idx_level_history ON player_level_history(player_name);
+			CREATE INDEX IF NOT EXISTS idx_achievement_progress ON achievement_progress(player_name);
+			CREATE INDEX IF NOT EXISTS idx_game_history ON game_history_detailed(player_name, played_at DESC);
+		`),
+		// Dropping every table would wipe all player data, so the baseline stays put
+	},
+	{
+		Version: 2,
+		Name:    "player_profiles.level_up_points",
+		Up:      addColumn("player_profiles", "level_up_points", "INTEGER DEFAULT 0"),
+		Down:    execSQL(`ALTER TABLE player_profiles DROP COLUMN level_up_points`),
+	},
+	{
+		Version: 3,
+		Name:    "game_scores.mode",
+		Up: func(tx *sql.Tx) error {
+			if err := addColumn("game_scores", "mode", "TEXT NOT NULL DEFAULT 'vc'")(tx); err != nil {
+				return err
+			}
+			return execSQL(`CREATE INDEX IF NOT EXISTS idx_mode ON game_scores(mode)`)(tx)
+		},
+		Down: execSQL(`
+			DROP INDEX IF EXISTS idx_mode;
+			ALTER TABLE game_scores DROP COLUMN mode;
+		`),
+	},
+	{
+		Version: 4,
+		Name:    "career",
+		Up: execSQL(`
+			CREATE TABLE IF NOT EXISTS career_profiles (
+				player_name TEXT PRIMARY KEY,
+				net_worth INTEGER DEFAULT 0,
+				founder_exits INTEGER DEFAULT 0,
+				funds_anchored INTEGER DEFAULT 0,
+				angel_games INTEGER DEFAULT 0,
+				updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS career_history (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				game_mode TEXT NOT NULL,
+				event TEXT NOT NULL,
+				amount INTEGER DEFAULT 0,
+				net_worth_after INTEGER DEFAULT 0,
+				created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS career_network (
+				player_name TEXT NOT NULL,
+				contact_name TEXT NOT NULL,
+				source_company TEXT,
+				added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(player_name, contact_name)
+			);
+
+			CREATE INDEX IF NOT EXISTS idx_career_history ON career_history(player_name, created_at DESC);
+		`),
+		Down: execSQL(`
+			DROP TABLE IF EXISTS career_network;
+			DROP TABLE IF EXISTS career_history;
+			DROP TABLE IF EXISTS career_profiles;
+		`),
+	},
+	{
+		Version: 5,
+		Name:    "game_investments",
+		Up: execSQL(`
+			CREATE TABLE IF NOT EXISTS game_investments (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				game_id INTEGER NOT NULL,
+				player_name TEXT NOT NULL,
+				company_name TEXT NOT NULL,
+				sector TEXT,
+				terms_type TEXT,
+				dd_level TEXT,
+				outcome TEXT NOT NULL,
+				amount_invested INTEGER DEFAULT 0,
+				entry_valuation INTEGER DEFAULT 0,
+				exit_valuation INTEGER DEFAULT 0,
+				exit_value INTEGER DEFAULT 0,
+				months_held INTEGER DEFAULT 0,
+				relationship_score REAL DEFAULT 0,
+				FOREIGN KEY (game_id) REFERENCES game_history_detailed(id)
+			);
+
+			CREATE INDEX IF NOT EXISTS idx_game_investments ON game_investments(player_name, sector);
+		`),
+		Down: execSQL(`DROP TABLE IF EXISTS game_investments`),
+	},
+	{
+		Version: 6,
+		Name:    "game_timeseries",
+		Up: execSQL(`
+			CREATE TABLE IF NOT EXISTS game_timeseries (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				player_name TEXT NOT NULL,
+				game_mode TEXT NOT NULL,
+				series TEXT NOT NULL,
+				played_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE INDEX IF NOT EXISTS idx_game_timeseries ON game_timeseries(player_name, game_mode, played_at DESC);
+		`),
+		Down: execSQL(`DROP TABLE IF EXISTS game_timeseries`),
+	},
+	{
+		Version: 7,
+		Name:    "profiles",
+		Up: execSQL(`
+			CREATE TABLE IF NOT EXISTS profiles (
+				name TEXT PRIMARY KEY,
+				theme TEXT NOT NULL DEFAULT 'classic',
+				auto_mode INTEGER NOT NULL DEFAULT 0,
+				submit_scores INTEGER NOT NULL DEFAULT 1,
+				created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				last_used DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			-- Everyone who has played so far gets a profile
+			INSERT OR IGNORE INTO profiles (name)
+				SELECT player_name FROM player_profiles
+				UNION SELECT player_name FROM game_scores;
+		`),
+		Down: execSQL(`DROP TABLE IF EXISTS profiles`),
+	},
+	{
+		Version: 8,
+		Name:    "tournaments",
+		Up: execSQL(`
+			CREATE TABLE IF NOT EXISTS tournaments (
+				id TEXT PRIMARY KEY,
+				name TEXT NOT NULL,
+				definition TEXT NOT NULL,
+				created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE TABLE IF NOT EXISTS tournament_results (
+				tournament_id TEXT NOT NULL,
+				round INTEGER NOT NULL,
+				player_name TEXT NOT NULL,
+				final_net_worth INTEGER NOT NULL,
+				roi REAL NOT NULL,
+				successful_exits INTEGER NOT NULL,
+				played_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+				PRIMARY KEY (tournament_id, round, player_name)
+			);
+
+			CREATE INDEX IF NOT EXISTS idx_tournament_results_player ON tournament_results(player_name);
+		`),
+		Down: execSQL(`
+			DROP TABLE IF EXISTS tournament_results;
+			DROP TABLE IF EXISTS tournaments;
+		`),
+	},
+	{
+		Version: 9,
+		Name:    "daily_challenge",
+		Up: func(tx *sql.Tx) error {
+			for _, col := range []struct{ name, def string }{
+				{"daily_streak", "INTEGER NOT NULL DEFAULT 0"},
+				{"best_daily_streak", "INTEGER NOT NULL DEFAULT 0"},
+				{"last_daily_date", "TEXT NOT NULL DEFAULT ''"},
+			} {
+				if err := addColumn("profiles", col.name, col.def)(tx); err != nil {
+					return err
+				}
+			}
+			return execSQL(`
+				CREATE TABLE IF NOT EXISTS daily_results (
+					challenge_date TEXT NOT NULL,
+					player_name TEXT NOT NULL,
+					mode TEXT NOT NULL,
+					modifier TEXT NOT NULL,
+					score INTEGER NOT NULL DEFAULT 0,
+					roi REAL NOT NULL DEFAULT 0,
+					finished INTEGER NOT NULL DEFAULT 0,
+					played_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+					PRIMARY KEY (challenge_date, player_name)
+				);
+			`)(tx)
+		},
+		Down: execSQL(`
+			DROP TABLE IF EXISTS daily_results;
+			ALTER TABLE profiles DROP COLUMN last_daily_date;
+			ALTER TABLE profiles DROP COLUMN best_daily_streak;
+			ALTER TABLE profiles DROP COLUMN daily_streak;
+		`),
+	},
+}
+
+// LatestSchemaVersion is the schema version this build expects
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].Version
+}
+
+// execSQL runs a block of statements as one migration step
+func execSQL(statements string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		_, err := tx.Exec(statements)
+		return err
+	}
+}
+
+// addColumn adds a column unless it's already there (databases created before
+// versioned migrations may already have it)
+func addColumn(table, column, definition string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		exists, err := columnExists(tx, table, column)
+		if err != nil || exists {
+			return err
+		}
+		_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+		return err
+	}
+}
+
+func columnExists(tx *sql.Tx, table, column string) (bool, error) {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, colType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+			return false, err
+		}
+		if strings.EqualFold(name, column) {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+func (s *SQLiteStore) ensureMigrationsTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %v", err)
+	}
+	return nil
+}
+
+// SchemaVersion returns the newest migration applied to the open database (0 = none)
+func (s *SQLiteStore) SchemaVersion() (int, error) {
+	if err := s.ensureMigrationsTable(); err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	if err := s.db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %v", err)
+	}
+	return int(version.Int64), nil
+}
+
+// Migrate applies every pending migration
+func (s *SQLiteStore) Migrate() error {
+	return s.MigrateTo(LatestSchemaVersion())
+}
+
+// MigrateTo moves the schema up or down to the target version, one transaction per migration
+func (s *SQLiteStore) MigrateTo(target int) error {
+	if target < 0 || target > LatestSchemaVersion() {
+		return fmt.Errorf("unknown schema version %d (latest is %d)", target, LatestSchemaVersion())
+	}
+	current, err := s.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if current > LatestSchemaVersion() {
+		return fmt.Errorf("database schema v%d is newer than this build supports (v%d) - please upgrade unicorn",
+			current, LatestSchemaVersion())
+	}
+
+	// Check the whole way down before touching anything
+	for _, m := range migrations {
+		if m.Version <= current && m.Version > target && m.Down == nil {
+			return fmt.Errorf("can't migrate below v%d: migration %d (%s) can't be rolled back",
+				m.Version, m.Version, m.Name)
+		}
+	}
+
+	for _, m := range migrations {
+		if m.Version > current && m.Version <= target {
+			if err := s.applyMigration(m, true); err != nil {
+				return err
+			}
+		}
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.Version <= current && m.Version > target {
+			if err := s.applyMigration(m, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteStore) applyMigration(m Migration, up bool) error {
+	step := m.Up
+	direction := "up"
+	if !up {
+		step = m.Down
+		direction = "down"
+		if step == nil {
+			return fmt.Errorf("migration %d (%s) can't be rolled back", m.Version, m.Name)
+		}
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start migration %d: %v", m.Version, err)
+	}
+	defer tx.Rollback()
+
+	if err := step(tx); err != nil {
+		return fmt.Errorf("migration %d (%s) %s failed: %v", m.Version, m.Name, direction, err)
+	}
+	if up {
+		_, err = tx.Exec(`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.Version, m.Name)
+	} else {
+		_, err = tx.Exec(`DELETE FROM schema_migrations WHERE version = ?`, m.Version)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record migration %d: %v", m.Version, err)
+	}
+	return tx.Commit()
+}
+
+// MigrationStatus lists every known migration and whether it has been applied
+func (s *SQLiteStore) MigrationStatus() ([]MigrationState, error) {
+	if err := s.ensureMigrationsTable(); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Query(`SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %v", err)
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+
+	states := make([]MigrationState, 0, len(migrations))
+	for _, m := range migrations {
+		appliedAt, ok := applied[m.Version]
+		states = append(states, MigrationState{
+			Version:    m.Version,
+			Name:       m.Name,
+			Applied:    ok,
+			AppliedAt:  appliedAt,
+			Reversible: m.Down != nil,
+		})
+	}
+	return states, nil
+}
+
+// Backup writes a consistent copy of the open database to path
+func (s *SQLiteStore) Backup(path string) error {
+	if _, err := s.db.Exec(`VACUUM INTO ?`, path); err != nil {
+		return fmt.Errorf("failed to back up database: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	s, err := NewSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore failed: %v", err)
+	}
+	defer s.Close()
+
+	if v, _ := s.SchemaVersion(); v != LatestSchemaVersion() {
+		t.Fatalf("Expected schema v%d after opening, got v%d", LatestSchemaVersion(), v)
+	}
+
+	// Down to v1 and back up again
+	if err := s.MigrateTo(1); err != nil {
+		t.Fatalf("MigrateTo(1) failed: %v", err)
+	}
+	if _, err := s.db.Exec(`SELECT 1 FROM career_profiles`); err == nil {
+		t.Error("Rolling back to v1 should drop the career tables")
+	}
+	if err := s.MigrateTo(0); err == nil {
+		t.Error("The baseline migration shouldn't roll back")
+	}
+	if err := s.Migrate(); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+
+	// A schema from a newer build is refused
+	if _, err := s.db.Exec(`INSERT INTO schema_migrations (version, name) VALUES (?, 'future')`, LatestSchemaVersion()+1); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+	if _, err := NewSQLiteStore(path); err == nil {
+		t.Error("NewSQLiteStore should refuse a newer schema")
+	}
+}
@@ -0,0 +1,177 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Profile is a local player: the name their games, scores and achievements are
+// filed under, plus their preferences
+type Profile struct {
+	Name         string
+	Theme        string
+	AutoMode     bool // Default play mode for new VC games
+	SubmitScores bool // Send finished games to the global leaderboard
+	CreatedAt    time.Time
+	LastUsed     time.Time
+
+	// Daily challenge streak, kept up by FinishDailyChallenge
+	DailyStreak     int
+	BestDailyStreak int
+	LastDailyDate   string // YYYY-MM-DD of the last finished daily challenge
+}
+
+// playerTables is every table keyed by player_name, for renames and deletes
+var playerTables = []string{
+	"game_scores", "player_achievements", "player_upgrades", "player_profiles",
+	"player_level_history", "achievement_progress", "vc_reputation", "game_history_detailed",
+	"game_investments", "career_profiles", "career_history", "career_network", "game_timeseries",
+	"tournament_results", "daily_results",
+}
+
+// ValidateProfileName trims a profile name and checks it's usable
+func ValidateProfileName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("profile name can't be empty")
+	}
+	if len(name) > 30 {
+		return "", fmt.Errorf("profile name must be 30 characters or fewer")
+	}
+	return name, nil
+}
+
+// ListProfiles returns every local profile, most recently used first
+func (s *SQLiteStore) ListProfiles() ([]Profile, error) {
+	rows, err := s.conn().Query(`
+		SELECT name, theme, auto_mode, submit_scores, created_at, last_used,
+			daily_streak, best_daily_streak, last_daily_date
+		FROM profiles
+		ORDER BY last_used DESC, name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %v", err)
+	}
+	defer rows.Close()
+
+	var profiles []Profile
+	for rows.Next() {
+		var p Profile
+		if err := rows.Scan(&p.Name, &p.Theme, &p.AutoMode, &p.SubmitScores, &p.CreatedAt, &p.LastUsed,
+			&p.DailyStreak, &p.BestDailyStreak, &p.LastDailyDate); err != nil {
+			return nil, fmt.Errorf("failed to scan profile: %v", err)
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles, nil
+}
+
+// GetProfile returns a profile, or nil if there isn't one by that name
+func (s *SQLiteStore) GetProfile(name string) (*Profile, error) {
+	var p Profile
+	err := s.conn().QueryRow(`
+		SELECT name, theme, auto_mode, submit_scores, created_at, last_used,
+			daily_streak, best_daily_streak, last_daily_date
+		FROM profiles
+		WHERE name = ?
+	`, name).Scan(&p.Name, &p.Theme, &p.AutoMode, &p.SubmitScores, &p.CreatedAt, &p.LastUsed,
+		&p.DailyStreak, &p.BestDailyStreak, &p.LastDailyDate)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %v", err)
+	}
+	return &p, nil
+}
+
+// CreateProfile adds a profile with default preferences
+func (s *SQLiteStore) CreateProfile(name string) (*Profile, error) {
+	name, err := ValidateProfileName(name)
+	if err != nil {
+		return nil, err
+	}
+	if existing, err := s.GetProfile(name); err != nil {
+		return nil, err
+	} else if existing != nil {
+		return nil, fmt.Errorf("a profile named %s already exists", name)
+	}
+
+	if _, err := s.conn().Exec(`INSERT INTO profiles (name) VALUES (?)`, name); err != nil {
+		return nil, fmt.Errorf("failed to create profile: %v", err)
+	}
+	return s.GetProfile(name)
+}
+
+// SaveProfile stores a profile's preferences
+func (s *SQLiteStore) SaveProfile(p *Profile) error {
+	_, err := s.conn().Exec(`
+		UPDATE profiles SET theme = ?, auto_mode = ?, submit_scores = ?
+		WHERE name = ?
+	`, p.Theme, p.AutoMode, p.SubmitScores, p.Name)
+	if err != nil {
+		return fmt.Errorf("failed to save profile: %v", err)
+	}
+	return nil
+}
+
+// UseProfile marks a profile as the most recently used
+func (s *SQLiteStore) UseProfile(name string) error {
+	_, err := s.conn().Exec(`UPDATE profiles SET last_used = CURRENT_TIMESTAMP WHERE name = ?`, name)
+	if err != nil {
+		return fmt.Errorf("failed to update profile: %v", err)
+	}
+	return nil
+}
+
+// RenameProfile renames a profile and moves all of its games and progress with it
+func (s *SQLiteStore) RenameProfile(oldName, newName string) error {
+	newName, err := ValidateProfileName(newName)
+	if err != nil {
+		return err
+	}
+	if existing, err := s.GetProfile(newName); err != nil {
+		return err
+	} else if existing != nil {
+		return fmt.Errorf("a profile named %s already exists", newName)
+	}
+
+	tx, err := s.begin()
+	if err != nil {
+		return fmt.Errorf("failed to rename profile: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE profiles SET name = ? WHERE name = ?`, newName, oldName); err != nil {
+		return fmt.Errorf("failed to rename profile: %v", err)
+	}
+	for _, table := range playerTables {
+		query := fmt.Sprintf("UPDATE %s SET player_name = ? WHERE player_name = ?", table)
+		if _, err := tx.Exec(query, newName, oldName); err != nil {
+			return fmt.Errorf("failed to rename profile in %s: %v", table, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// DeleteProfile removes a profile and everything recorded for it
+func (s *SQLiteStore) DeleteProfile(name string) error {
+	tx, err := s.begin()
+	if err != nil {
+		return fmt.Errorf("failed to delete profile: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM profiles WHERE name = ?`, name); err != nil {
+		return fmt.Errorf("failed to delete profile: %v", err)
+	}
+	for _, table := range playerTables {
+		query := fmt.Sprintf("DELETE FROM %s WHERE player_name = ?", table)
+		if _, err := tx.Exec(query, name); err != nil {
+			return fmt.Errorf("failed to delete profile from %s: %v", table, err)
+		}
+	}
+	return tx.Commit()
+}
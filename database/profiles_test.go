@@ -0,0 +1,101 @@
+package database
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfileRenameAndDelete(t *testing.T) {
+	sqlite, err := NewSQLiteStore(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteStore failed: %v", err)
+	}
+	defer sqlite.Close()
+
+	for name, store := range map[string]Store{"sqlite": sqlite, "memory": NewMemoryStore()} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := store.CreateProfile("  Ada  "); err != nil {
+				t.Fatalf("CreateProfile failed: %v", err)
+			}
+			if _, err := store.CreateProfile("Ada"); err == nil {
+				t.Error("A second profile with the same name should be refused")
+			}
+			if _, err := store.CreateProfile(" "); err == nil {
+				t.Error("A blank profile name should be refused")
+			}
+
+			if err := store.SaveGameScore(GameScore{PlayerName: "Ada", FinalNetWorth: 1000, Difficulty: "Easy"}); err != nil {
+				t.Fatal(err)
+			}
+			if err := store.UnlockAchievement("Ada", "first_game"); err != nil {
+				t.Fatal(err)
+			}
+
+			// Rename carries games and achievements across
+			if err := store.RenameProfile("Ada", "Grace"); err != nil {
+				t.Fatalf("RenameProfile failed: %v", err)
+			}
+			if p, _ := store.GetProfile("Ada"); p != nil {
+				t.Error("Old profile name should be gone after rename")
+			}
+			if scores, _ := store.GetTopScoresByPlayer("Grace", 10); len(scores) != 1 {
+				t.Errorf("Expected the game to move to Grace, got %d scores", len(scores))
+			}
+			if achs, _ := store.GetPlayerAchievements("Grace"); len(achs) != 1 {
+				t.Errorf("Expected the achievement to move to Grace, got %v", achs)
+			}
+
+			// Delete removes the profile and everything under it
+			if err := store.DeleteProfile("Grace"); err != nil {
+				t.Fatalf("DeleteProfile failed: %v", err)
+			}
+			if profiles, _ := store.ListProfiles(); len(profiles) != 0 {
+				t.Errorf("Expected no profiles after delete, got %d", len(profiles))
+			}
+			if scores, _ := store.GetTopScoresByPlayer("Grace", 10); len(scores) != 0 {
+				t.Errorf("Expected Grace's games to be deleted, got %d", len(scores))
+			}
+		})
+	}
+}
+
+func TestInTransactionRollsBack(t *testing.T) {
+	sqlite, err := NewSQLiteStore(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteStore failed: %v", err)
+	}
+	defer sqlite.Close()
+
+	for name, store := range map[string]Store{"sqlite": sqlite, "memory": NewMemoryStore()} {
+		t.Run(name, func(t *testing.T) {
+			store.CreateProfile("Ada")
+			store.AddExperience("Ada", 500)
+			before, _ := store.GetPlayerProfile("Ada")
+
+			err := store.InTransaction(func(tx Store) error {
+				tx.CreateProfile("Grace")
+				tx.AddExperience("Ada", 1000)
+				tx.UnlockAchievement("Ada", "first_game")
+				tx.SaveGameScore(GameScore{PlayerName: "Ada", FinalNetWorth: 1000, Difficulty: "Easy"})
+				return fmt.Errorf("import failed")
+			})
+			if err == nil {
+				t.Fatal("InTransaction should return fn's error")
+			}
+
+			if p, _ := store.GetProfile("Grace"); p != nil {
+				t.Error("A profile created in a failed transaction should be gone")
+			}
+			if after, _ := store.GetPlayerProfile("Ada"); after.ExperiencePoints != before.ExperiencePoints {
+				t.Errorf("Expected XP back at %d, got %d", before.ExperiencePoints, after.ExperiencePoints)
+			}
+			if achs, _ := store.GetPlayerAchievements("Ada"); len(achs) != 0 {
+				t.Errorf("Expected no achievements, got %v", achs)
+			}
+			if scores, _ := store.GetTopScoresByPlayer("Ada", 10); len(scores) != 0 {
+				t.Errorf("Expected no scores, got %d", len(scores))
+			}
+		})
+	}
+}
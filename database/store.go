@@ -0,0 +1,120 @@
+package database
+
+// ScoreStore keeps finished games and the leaderboards built from them
+type ScoreStore interface {
+	SaveGameScore(score GameScore) error
+	GetTopScoresByNetWorth(limit int, difficulty string) ([]GameScore, error)
+	GetTopScoresByROI(limit int, difficulty string) ([]GameScore, error)
+	GetTopScoresByNetWorthAndMode(limit int, difficulty, mode string) ([]GameScore, error)
+	GetTopScoresByROIAndMode(limit int, difficulty, mode string) ([]GameScore, error)
+	GetTopScoresByPlayer(playerName string, limit int) ([]GameScore, error)
+	GetRecentGames(limit int) ([]GameScore, error)
+	GetRecentGamesAndMode(limit int, mode string) ([]GameScore, error)
+	GetTotalGamesPlayed() (int, error)
+	GetPlayerStats(playerName string) (*PlayerStats, error)
+	GetPlayerStatsByMode(playerName string, gameMode string) (*PlayerStats, error)
+	GetWinStreak(playerName string) (int, error)
+}
+
+// ProfileStore keeps local profiles, their preferences, levels and XP
+type ProfileStore interface {
+	ListProfiles() ([]Profile, error)
+	GetProfile(name string) (*Profile, error)
+	CreateProfile(name string) (*Profile, error)
+	SaveProfile(p *Profile) error
+	UseProfile(name string) error
+	RenameProfile(oldName, newName string) error
+	DeleteProfile(name string) error
+	GetPlayerProfile(playerName string) (*PlayerProfile, error)
+	AddExperience(playerName string, xpAmount int) (leveledUp bool, newLevel int, pointsEarned int, err error)
+	GetTotalPlayerPoints(playerName string) (int, error)
+	UpdateLastPlayed(playerName string) error
+	SavePlayerProfile(profile *PlayerProfile) error
+}
+
+// AchievementStore keeps unlocked achievements
+type AchievementStore interface {
+	UnlockAchievement(playerName, achievementID string) error
+	GetPlayerAchievements(playerName string) ([]string, error)
+	GetPlayerAchievementCount(playerName string) (int, error)
+	GetPlayerAchievementPoints(playerName string) (int, error)
+	GetAchievementLeaderboard(limit int) ([]AchievementLeaderboardEntry, error)
+}
+
+// UpgradeStore keeps purchased upgrades
+type UpgradeStore interface {
+	PurchaseUpgrade(playerName, upgradeID string) error
+	GetPlayerUpgrades(playerName string) ([]string, error)
+	HasUpgrade(playerName, upgradeID string) (bool, error)
+}
+
+// ReputationStore keeps a player's standing in the VC ecosystem
+type ReputationStore interface {
+	GetVCReputation(playerName string) (*VCReputation, error)
+	SaveVCReputation(rep *VCReputation) error
+	HasVCReputation(playerName string) bool
+}
+
+// ProgressStore keeps progress toward progressive achievements
+type ProgressStore interface {
+	UpdateAchievementProgress(playerName, achievementID string, currentProgress, maxProgress int) error
+	GetAchievementProgress(playerName, achievementID string) (current, max int, err error)
+	GetAllProgress(playerName string) (map[string]ProgressInfo, error)
+}
+
+// HistoryStore keeps per-investment and per-turn records of finished games
+type HistoryStore interface {
+	SaveGameHistory(game GameHistory, investments []InvestmentHistory) error
+	GetInvestmentHistory(playerName string) ([]InvestmentHistory, error)
+	SaveGameSeries(playerName, gameMode string, series map[string][]int64) error
+	GetLatestGameSeries(playerName, gameMode string) (map[string][]int64, error)
+}
+
+// CareerStore keeps personal wealth and founder network across games
+type CareerStore interface {
+	GetCareer(playerName string) (*Career, error)
+	RecordCareerEvent(c *Career, gameMode, event string, amount int64) error
+	GetCareerHistory(playerName string, limit int) ([]CareerEvent, error)
+	AddNetworkContacts(playerName, sourceCompany string, contacts []string) error
+	GetNetworkContacts(playerName string) ([]string, error)
+}
+
+// TournamentStore keeps tournament definitions and the rounds played in them
+type TournamentStore interface {
+	SaveTournament(t Tournament) error
+	GetTournament(id string) (*Tournament, error)
+	ListTournaments() ([]Tournament, error)
+	SaveTournamentResult(r TournamentResult) error
+	GetTournamentResults(tournamentID string) ([]TournamentResult, error)
+}
+
+// DailyStore keeps daily challenge attempts and the streaks they build
+type DailyStore interface {
+	StartDailyChallenge(r DailyResult) error
+	FinishDailyChallenge(date, playerName string, score int64, roi float64) (streak int, err error)
+	GetDailyResult(date, playerName string) (*DailyResult, error)
+	GetDailyLeaderboard(date string, limit int) ([]DailyResult, error)
+}
+
+// Store is everything the game persists. SQLiteStore is the on-disk implementation;
+// MemoryStore is for tests. A remote store only needs to satisfy this interface.
+type Store interface {
+	ScoreStore
+	ProfileStore
+	AchievementStore
+	UpgradeStore
+	ReputationStore
+	ProgressStore
+	HistoryStore
+	CareerStore
+	TournamentStore
+	DailyStore
+	// InTransaction runs fn so its writes land together or not at all
+	InTransaction(fn func(Store) error) error
+	Close() error
+}
+
+var (
+	_ Store = (*SQLiteStore)(nil)
+	_ Store = (*MemoryStore)(nil)
+)
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// SaveGameSeries stores a finished game's per-turn metrics (series name -> one value per turn)
+func (s *SQLiteStore) SaveGameSeries(playerName, gameMode string, series map[string][]int64) error {
+	data, err := json.Marshal(series)
+	if err != nil {
+		return fmt.Errorf("failed to encode game series: %v", err)
+	}
+	_, err = s.conn().Exec(`
+		INSERT INTO game_timeseries (player_name, game_mode, series)
+		VALUES (?, ?, ?)
+	`, playerName, gameMode, string(data))
+	if err != nil {
+		return fmt.Errorf("failed to save game series: %v", err)
+	}
+	return nil
+}
+
+// GetLatestGameSeries returns the per-turn metrics of a player's most recent game in a mode
+func (s *SQLiteStore) GetLatestGameSeries(playerName, gameMode string) (map[string][]int64, error) {
+	var data string
+	err := s.conn().QueryRow(`
+		SELECT series FROM game_timeseries
+		WHERE player_name = ? AND game_mode = ?
+		ORDER BY played_at DESC, id DESC
+		LIMIT 1
+	`, playerName, gameMode).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game series: %v", err)
+	}
+
+	series := map[string][]int64{}
+	if err := json.Unmarshal([]byte(data), &series); err != nil {
+		return nil, fmt.Errorf("failed to decode game series: %v", err)
+	}
+	return series, nil
+}
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Tournament is an organizer's tournament as saved on this machine. Definition is
+// the shared JSON the tournament package reads its rounds from.
+type Tournament struct {
+	ID         string
+	Name       string
+	Definition string
+	CreatedAt  time.Time
+}
+
+// TournamentResult is one player's finished game in one round of a tournament
+type TournamentResult struct {
+	TournamentID    string
+	Round           int // 1 for the first round
+	PlayerName      string
+	FinalNetWorth   int64
+	ROI             float64
+	SuccessfulExits int
+	PlayedAt        time.Time
+}
+
+// SaveTournament adds a tournament, or replaces the definition of one with the same ID
+func (s *SQLiteStore) SaveTournament(t Tournament) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO tournaments (id, name, definition)
+		VALUES (?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			name = excluded.name,
+			definition = excluded.definition
+	`, t.ID, t.Name, t.Definition)
+	if err != nil {
+		return fmt.Errorf("failed to save tournament: %v", err)
+	}
+	return nil
+}
+
+// GetTournament returns a saved tournament, or nil if there's none with that ID
+func (s *SQLiteStore) GetTournament(id string) (*Tournament, error) {
+	t := &Tournament{}
+	err := s.conn().QueryRow(`
+		SELECT id, name, definition, created_at
+		FROM tournaments
+		WHERE id = ?
+	`, id).Scan(&t.ID, &t.Name, &t.Definition, &t.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %v", err)
+	}
+	return t, nil
+}
+
+// ListTournaments returns every saved tournament, newest first
+func (s *SQLiteStore) ListTournaments() ([]Tournament, error) {
+	rows, err := s.conn().Query(`
+		SELECT id, name, definition, created_at
+		FROM tournaments
+		ORDER BY created_at DESC, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments: %v", err)
+	}
+	defer rows.Close()
+
+	var tournaments []Tournament
+	for rows.Next() {
+		var t Tournament
+		if err := rows.Scan(&t.ID, &t.Name, &t.Definition, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament: %v", err)
+		}
+		tournaments = append(tournaments, t)
+	}
+	return tournaments, nil
+}
+
+// SaveTournamentResult records a finished round. Each player gets one result per
+// round; a second one is refused.
+func (s *SQLiteStore) SaveTournamentResult(r TournamentResult) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO tournament_results (tournament_id, round, player_name, final_net_worth, roi, successful_exits)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, r.TournamentID, r.Round, r.PlayerName, r.FinalNetWorth, r.ROI, r.SuccessfulExits)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE") {
+			return fmt.Errorf("%s has already played round %d of %s", r.PlayerName, r.Round, r.TournamentID)
+		}
+		return fmt.Errorf("failed to save tournament result: %v", err)
+	}
+	return nil
+}
+
+// GetTournamentResults returns every result recorded for a tournament, by round
+func (s *SQLiteStore) GetTournamentResults(tournamentID string) ([]TournamentResult, error) {
+	rows, err := s.conn().Query(`
+		SELECT tournament_id, round, player_name, final_net_worth, roi, successful_exits, played_at
+		FROM tournament_results
+		WHERE tournament_id = ?
+		ORDER BY round, played_at, player_name
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament results: %v", err)
+	}
+	defer rows.Close()
+
+	var results []TournamentResult
+	for rows.Next() {
+		var r TournamentResult
+		if err := rows.Scan(&r.TournamentID, &r.Round, &r.PlayerName, &r.FinalNetWorth, &r.ROI, &r.SuccessfulExits, &r.PlayedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament result: %v", err)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
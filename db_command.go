@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+)
+
+const dbUsage = `usage: unicorn db <command>
+
+  migrate [version]   apply pending migrations (or migrate up/down to version)
+  status              show the schema version and every migration
+  backup [path]       copy the database (default: next to it, timestamped)`
+
+// runDBCommand handles `unicorn db ...` maintenance commands
+func runDBCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", dbUsage)
+	}
+
+	path := database.DefaultPath()
+	store, err := database.OpenSQLite(path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	switch args[0] {
+	case "migrate":
+		target := database.LatestSchemaVersion()
+		if len(args) > 1 {
+			v, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid version: %s", args[1])
+			}
+			target = v
+		}
+		before, err := store.SchemaVersion()
+		if err != nil {
+			return err
+		}
+		if err := store.MigrateTo(target); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Schema v%d → v%d\n", before, target)
+
+	case "status":
+		version, err := store.SchemaVersion()
+		if err != nil {
+			return err
+		}
+		states, err := store.MigrationStatus()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Database: %s\n", path)
+		fmt.Printf("Schema:   v%d (latest v%d)\n\n", version, database.LatestSchemaVersion())
+		for _, s := range states {
+			mark, applied := "  ", "pending"
+			if s.Applied {
+				mark, applied = "✓ ", s.AppliedAt.Format("2006-01-02 15:04")
+			}
+			reversible := ""
+			if !s.Reversible {
+				reversible = " (irreversible)"
+			}
+			fmt.Printf("%s%3d  %-34s %s%s\n", mark, s.Version, s.Name, applied, reversible)
+		}
+
+	case "backup":
+		dest := filepath.Join(filepath.Dir(path),
+			fmt.Sprintf("unicorn_scores-%s.db", time.Now().Format("20060102-150405")))
+		if len(args) > 1 {
+			dest = args[1]
+		}
+		if err := store.Backup(dest); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Backed up to %s\n", dest)
+
+	default:
+		return fmt.Errorf("unknown db command: %s\n\n%s", args[0], dbUsage)
+	}
+	return nil
+}
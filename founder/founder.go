@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"time"
 
 	"github.com/jamesacampbell/unicorn/assets"
 )
@@ -25,7 +26,7 @@ func LoadFounderStartups(filename string) ([]StartupTemplate, error) {
 	return templates, nil
 }
 
-func generateDealSize(avgDealSize int64, category string) int64 {
+func generateDealSize(rng *rand.Rand, avgDealSize int64, category string) int64 {
 	// If avgDealSize is 0 (no customers yet), use category-based defaults
 	if avgDealSize == 0 {
 		switch category {
@@ -46,19 +47,19 @@ func generateDealSize(avgDealSize int64, category string) int64 {
 	// Most deals cluster around avg, but some are much larger/smaller
 	var dealSize int64
 
-	if rand.Float64() < 0.7 {
+	if rng.Float64() < 0.7 {
 		// 70% of deals: within ±30% of avg
-		variation := -0.3 + rand.Float64()*0.6 // -30% to +30%
+		variation := -0.3 + rng.Float64()*0.6 // -30% to +30%
 		dealSize = int64(float64(avgDealSize) * (1.0 + variation))
 	} else {
 		// 30% of deals: wider range
-		if rand.Float64() < 0.5 {
+		if rng.Float64() < 0.5 {
 			// Smaller deals: 50% to 70% of avg
-			variation := 0.5 + rand.Float64()*0.2
+			variation := 0.5 + rng.Float64()*0.2
 			dealSize = int64(float64(avgDealSize) * variation)
 		} else {
 			// Larger deals: 130% to 200% of avg (enterprise deals)
-			variation := 1.3 + rand.Float64()*0.7
+			variation := 1.3 + rng.Float64()*0.7
 			dealSize = int64(float64(avgDealSize) * variation)
 		}
 	}
@@ -97,6 +98,12 @@ func generateDealSize(avgDealSize int64, category string) int64 {
 }
 
 func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades []string) *FounderState {
+	return NewFounderGameWithSeed(founderName, template, playerUpgrades, time.Now().UnixNano())
+}
+
+// NewFounderGameWithSeed starts a founder game whose every roll comes from seed.
+func NewFounderGameWithSeed(founderName string, template StartupTemplate, playerUpgrades []string, seed int64) *FounderState {
+	rng := rand.New(rand.NewSource(seed))
 	fs := &FounderState{
 		FounderName:        founderName,
 		CompanyName:        template.Name,
@@ -117,8 +124,8 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 		CustomerChurnRate:  template.BaseChurnRate,
 		BaseCAC:            template.BaseCAC,
 		Turn:               1,
-		MaxTurns:           60,                         // 5 years
-		ProductMaturity:    0.20 + rand.Float64()*0.25, // Randomize between 20-45% product maturity
+		MaxTurns:           60,                        // 5 years
+		ProductMaturity:    0.20 + rng.Float64()*0.25, // Randomize between 20-45% product maturity
 		MarketPenetration:  float64(template.InitialCustomers) / float64(template.TargetMarketSize),
 		TargetMarketSize:   template.TargetMarketSize,
 		CompetitionLevel:   template.CompetitionLevel,
@@ -169,13 +176,16 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 
 		// Roadmap tracking for achievements
 		CustomersLostDuringRoadmap: 0,
+
+		Seed: seed,
+		rng:  rng,
 	}
 
 	// Add randomness to initial cash (±20%) - only if not already randomized
 	// (If randomized in UI, use that value; otherwise randomize here)
 	if fs.Cash == template.InitialCash {
 		cashVariance := 0.20
-		cashMultiplier := 1.0 + (rand.Float64()*cashVariance*2 - cashVariance) // 0.8 to 1.2
+		cashMultiplier := 1.0 + (rng.Float64()*cashVariance*2 - cashVariance) // 0.8 to 1.2
 		fs.Cash = int64(float64(fs.Cash) * cashMultiplier)
 	}
 
@@ -183,7 +193,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 	competitionLevels := []string{"low", "medium", "high", "very_high"}
 	if fs.CompetitionLevel == template.CompetitionLevel {
 		// Only randomize if it's still the original template value
-		fs.CompetitionLevel = competitionLevels[rand.Intn(len(competitionLevels))]
+		fs.CompetitionLevel = competitionLevels[rng.Intn(len(competitionLevels))]
 	}
 
 	// Calculate initial churn based on product maturity
@@ -194,7 +204,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 	// At 100% maturity: (1.0 - 1.0) * 0.65 + 0.05 = 0.05 = 5% churn (minimum)
 	baseChurnFromMaturity := (1.0-fs.ProductMaturity)*0.65 + 0.05
 	// Add some variation (±10%)
-	churnVariation := -0.10 + rand.Float64()*0.20 // -10% to +10%
+	churnVariation := -0.10 + rng.Float64()*0.20 // -10% to +10%
 	fs.CustomerChurnRate = baseChurnFromMaturity * (1.0 + churnVariation)
 	fs.ChurnRate = fs.CustomerChurnRate
 
@@ -244,7 +254,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 		template.InitialTeam["customer_success"] + template.InitialTeam["marketing"]
 
 	// Calculate total equity for initial employees (0.5-1.5% each)
-	equityPerEmployee := 0.5 + rand.Float64()*1.0 // 0.5-1.5% per employee
+	equityPerEmployee := 0.5 + rng.Float64()*1.0 // 0.5-1.5% per employee
 	totalEmployeeEquity := float64(totalInitialEmployees) * equityPerEmployee
 
 	// Ensure we don't exceed equity pool
@@ -269,7 +279,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 			Name:          name,
 			Role:          RoleEngineer,
 			MonthlyCost:   avgSalary / 12,
-			Impact:        0.8 + rand.Float64()*0.4, // 0.8-1.2x impact
+			Impact:        0.8 + rng.Float64()*0.4, // 0.8-1.2x impact
 			IsExecutive:   false,
 			Equity:        equityPerEmployee,
 			VestingMonths: 48, // 4 year vesting
@@ -292,7 +302,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 			Name:          name,
 			Role:          RoleSales,
 			MonthlyCost:   avgSalary / 12,
-			Impact:        0.8 + rand.Float64()*0.4,
+			Impact:        0.8 + rng.Float64()*0.4,
 			IsExecutive:   false,
 			Equity:        equityPerEmployee,
 			VestingMonths: 48,
@@ -315,7 +325,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 			Name:          name,
 			Role:          RoleCustomerSuccess,
 			MonthlyCost:   avgSalary / 12,
-			Impact:        0.8 + rand.Float64()*0.4,
+			Impact:        0.8 + rng.Float64()*0.4,
 			IsExecutive:   false,
 			Equity:        equityPerEmployee,
 			VestingMonths: 48,
@@ -338,7 +348,7 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 			Name:          name,
 			Role:          RoleMarketing,
 			MonthlyCost:   avgSalary / 12,
-			Impact:        0.8 + rand.Float64()*0.4,
+			Impact:        0.8 + rng.Float64()*0.4,
 			IsExecutive:   false,
 			Equity:        equityPerEmployee,
 			VestingMonths: 48,
@@ -359,6 +369,18 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 	fs.CalculateTeamCost()
 	fs.CalculateRunway()
 
+	// Founding team options are struck at the initial 409A
+	fs.Run409A()
+	fs.Culture = 0.7
+	for _, team := range fs.icTeams() {
+		for i := range *team {
+			(*team)[i].StrikePrice = fs.CommonSharePrice()
+			(*team)[i].Level = 2
+			(*team)[i].Morale = 0.85
+			(*team)[i].Performance = 1.0
+		}
+	}
+
 	// Initialize new advanced features
 	InitializeAcquisitions(fs)
 	InitializePlatform(fs)
@@ -374,6 +396,11 @@ func NewFounderGame(founderName string, template StartupTemplate, playerUpgrades
 	return fs
 }
 
+// Rand returns the game's seeded random source for rolls made by the UI
+func (fs *FounderState) Rand() *rand.Rand {
+	return fs.rng
+}
+
 func formatCurrency(amount int64) string {
 	if amount < 0 {
 		return fmt.Sprintf("-$%s", formatCurrency(-amount))
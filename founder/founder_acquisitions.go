@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // InitializeAcquisitions initializes the acquisitions system
@@ -50,7 +49,7 @@ func (fs *FounderState) GenerateAcquisitionTargets() {
 	fs.AcquisitionTargets = activeTargets
 
 	// Generate 1-3 new targets
-	numTargets := 1 + rand.Intn(3)
+	numTargets := 1 + fs.rng.Intn(3)
 	for i := 0; i < numTargets; i++ {
 		target := fs.generateAcquisitionTarget()
 		if target != nil {
@@ -62,7 +61,7 @@ func (fs *FounderState) GenerateAcquisitionTargets() {
 // generateAcquisitionTarget creates a random acquisition target
 func (fs *FounderState) generateAcquisitionTarget() *AcquisitionTarget {
 	// Target MRR: 5-50% of your MRR
-	targetMRR := int64(float64(fs.MRR) * (0.05 + rand.Float64()*0.45))
+	targetMRR := int64(float64(fs.MRR) * (0.05 + fs.rng.Float64()*0.45))
 	if targetMRR < 1000 {
 		targetMRR = 1000 // Minimum $1k MRR
 	}
@@ -78,7 +77,7 @@ func (fs *FounderState) generateAcquisitionTarget() *AcquisitionTarget {
 	}
 
 	// Acquisition cost: 2-10x revenue multiple
-	revenueMultiple := 2.0 + rand.Float64()*8.0
+	revenueMultiple := 2.0 + fs.rng.Float64()*8.0
 	acquisitionCost := int64(float64(targetMRR*12) * revenueMultiple)
 	if acquisitionCost < 500000 {
 		acquisitionCost = 500000 // Minimum $500k
@@ -88,13 +87,13 @@ func (fs *FounderState) generateAcquisitionTarget() *AcquisitionTarget {
 	}
 
 	// Integration cost: 20-50% of acquisition cost
-	integrationCost := int64(float64(acquisitionCost) * (0.20 + rand.Float64()*0.30))
+	integrationCost := int64(float64(acquisitionCost) * (0.20 + fs.rng.Float64()*0.30))
 
 	// Synergy bonus: 10-50% revenue boost
-	synergyBonus := 0.10 + rand.Float64()*0.40
+	synergyBonus := 0.10 + fs.rng.Float64()*0.40
 
 	// Risk level
-	riskRoll := rand.Float64()
+	riskRoll := fs.rng.Float64()
 	risk := "medium"
 	if riskRoll < 0.3 {
 		risk = "low"
@@ -105,13 +104,13 @@ func (fs *FounderState) generateAcquisitionTarget() *AcquisitionTarget {
 	// Technology/IP gained
 	technologies := []string{}
 	techOptions := []string{"API Integration", "Mobile SDK", "Analytics Engine", "ML Models", "Customer Data", "Brand Assets"}
-	numTech := 1 + rand.Intn(3)
+	numTech := 1 + fs.rng.Intn(3)
 	for i := 0; i < numTech && i < len(techOptions); i++ {
-		technologies = append(technologies, techOptions[rand.Intn(len(techOptions))])
+		technologies = append(technologies, techOptions[fs.rng.Intn(len(techOptions))])
 	}
 
 	// Team size: 2-10 people
-	teamSize := 2 + rand.Intn(9)
+	teamSize := 2 + fs.rng.Intn(9)
 
 	// Company names
 	companyNames := []string{
@@ -120,7 +119,7 @@ func (fs *FounderState) generateAcquisitionTarget() *AcquisitionTarget {
 	}
 
 	return &AcquisitionTarget{
-		Name:            companyNames[rand.Intn(len(companyNames))],
+		Name:            companyNames[fs.rng.Intn(len(companyNames))],
 		Category:        fs.Category,
 		MRR:             targetMRR,
 		Customers:       customers,
@@ -159,7 +158,7 @@ func (fs *FounderState) AcquireCompany(targetIndex int) (*Acquisition, error) {
 		MRRGained:         target.MRR,
 		TeamGained:        target.TeamSize,
 		Success:           false, // Will be determined after integration
-		IntegrationMonths: 3 + rand.Intn(4), // 3-6 months
+		IntegrationMonths: 3 + fs.rng.Intn(4), // 3-6 months
 		IntegrationProgress: 0,
 		SynergyRealized:   0.0,
 	}
@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 
@@ -22,7 +21,7 @@ func (fs *FounderState) StartPartnership(partnerType string) (*Partnership, erro
 		return nil, fmt.Errorf("unknown partnership type: %s", partnerType)
 	}
 
-	partner := partnerList[rand.Intn(len(partnerList))]
+	partner := partnerList[fs.rng.Intn(len(partnerList))]
 
 	// Calculate costs and benefits
 	var cost, mrrBoost int64
@@ -31,41 +30,41 @@ func (fs *FounderState) StartPartnership(partnerType string) (*Partnership, erro
 
 	switch partnerType {
 	case "distribution":
-		cost = 50000 + rand.Int63n(100000)                             // $50-150k
-		mrrBoost = int64(float64(fs.MRR) * (0.1 + rand.Float64()*0.2)) // 10-30% MRR boost
+		cost = 50000 + fs.rng.Int63n(100000)                             // $50-150k
+		mrrBoost = int64(float64(fs.MRR) * (0.1 + fs.rng.Float64()*0.2)) // 10-30% MRR boost
 		if mrrBoost == 0 && fs.MRR == 0 {
 			// Minimum boost even with no MRR - helps acquire first customers
-			mrrBoost = 5000 + rand.Int63n(15000) // $5-20k/month minimum
+			mrrBoost = 5000 + fs.rng.Int63n(15000) // $5-20k/month minimum
 		}
-		churnReduction = 0.01 + rand.Float64()*0.02 // 1-3% churn reduction
-		duration = 12 + rand.Intn(12)               // 12-24 months
+		churnReduction = 0.01 + fs.rng.Float64()*0.02 // 1-3% churn reduction
+		duration = 12 + fs.rng.Intn(12)               // 12-24 months
 	case "technology":
-		cost = 30000 + rand.Int63n(70000)                                // $30-100k
-		mrrBoost = int64(float64(fs.MRR) * (0.05 + rand.Float64()*0.15)) // 5-20% MRR boost
+		cost = 30000 + fs.rng.Int63n(70000)                                // $30-100k
+		mrrBoost = int64(float64(fs.MRR) * (0.05 + fs.rng.Float64()*0.15)) // 5-20% MRR boost
 		if mrrBoost == 0 && fs.MRR == 0 {
 			// Minimum boost even with no MRR - product integration helps attract customers
-			mrrBoost = 3000 + rand.Int63n(7000) // $3-10k/month minimum
+			mrrBoost = 3000 + fs.rng.Int63n(7000) // $3-10k/month minimum
 		}
-		churnReduction = 0.02 + rand.Float64()*0.03 // 2-5% churn reduction
-		duration = 12 + rand.Intn(24)               // 12-36 months
+		churnReduction = 0.02 + fs.rng.Float64()*0.03 // 2-5% churn reduction
+		duration = 12 + fs.rng.Intn(24)               // 12-36 months
 	case "co-marketing":
-		cost = 25000 + rand.Int63n(50000)                                // $25-75k
-		mrrBoost = int64(float64(fs.MRR) * (0.15 + rand.Float64()*0.25)) // 15-40% MRR boost
+		cost = 25000 + fs.rng.Int63n(50000)                                // $25-75k
+		mrrBoost = int64(float64(fs.MRR) * (0.15 + fs.rng.Float64()*0.25)) // 15-40% MRR boost
 		if mrrBoost == 0 && fs.MRR == 0 {
 			// Minimum boost even with no MRR - marketing helps acquire customers
-			mrrBoost = 8000 + rand.Int63n(12000) // $8-20k/month minimum
+			mrrBoost = 8000 + fs.rng.Int63n(12000) // $8-20k/month minimum
 		}
-		churnReduction = 0.005 + rand.Float64()*0.015 // 0.5-2% churn reduction
-		duration = 6 + rand.Intn(12)                  // 6-18 months
+		churnReduction = 0.005 + fs.rng.Float64()*0.015 // 0.5-2% churn reduction
+		duration = 6 + fs.rng.Intn(12)                  // 6-18 months
 	case "data":
-		cost = 40000 + rand.Int63n(60000)                                // $40-100k
-		mrrBoost = int64(float64(fs.MRR) * (0.08 + rand.Float64()*0.12)) // 8-20% MRR boost
+		cost = 40000 + fs.rng.Int63n(60000)                                // $40-100k
+		mrrBoost = int64(float64(fs.MRR) * (0.08 + fs.rng.Float64()*0.12)) // 8-20% MRR boost
 		if mrrBoost == 0 && fs.MRR == 0 {
 			// Minimum boost even with no MRR - analytics help attract customers
-			mrrBoost = 4000 + rand.Int63n(8000) // $4-12k/month minimum
+			mrrBoost = 4000 + fs.rng.Int63n(8000) // $4-12k/month minimum
 		}
-		churnReduction = 0.01 + rand.Float64()*0.02 // 1-3% churn reduction
-		duration = 12 + rand.Intn(24)               // 12-36 months
+		churnReduction = 0.01 + fs.rng.Float64()*0.02 // 1-3% churn reduction
+		duration = 12 + fs.rng.Intn(24)               // 12-36 months
 	}
 
 	if cost > fs.Cash {
@@ -138,7 +137,7 @@ func (fs *FounderState) LaunchAffiliateProgram(commission float64) error {
 		return fmt.Errorf("affiliate program already running")
 	}
 
-	setupCost := int64(20000 + rand.Int63n(30000)) // $20-50k setup
+	setupCost := int64(20000 + fs.rng.Int63n(30000)) // $20-50k setup
 	if setupCost > fs.Cash {
 		return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(setupCost))
 	}
@@ -148,9 +147,9 @@ func (fs *FounderState) LaunchAffiliateProgram(commission float64) error {
 	fs.AffiliateProgram = &AffiliateProgram{
 		LaunchedMonth:      fs.Turn,
 		Commission:         commission / 100,  // Convert % to decimal
-		Affiliates:         5 + rand.Intn(10), // Start with 5-15 affiliates
+		Affiliates:         5 + fs.rng.Intn(10), // Start with 5-15 affiliates
 		SetupCost:          setupCost,
-		MonthlyPlatformFee: 5000 + rand.Int63n(5000), // $5-10k/month
+		MonthlyPlatformFee: 5000 + fs.rng.Int63n(5000), // $5-10k/month
 		MonthlyRevenue:     0,
 		CustomersAcquired:  0,
 	}
@@ -174,8 +173,8 @@ func (fs *FounderState) UpdateAffiliateProgram() []string {
 	// Calculate affiliate sales (each affiliate brings 0-2 customers/month)
 	newCustomers := 0
 	for i := 0; i < prog.Affiliates; i++ {
-		if rand.Float64() < 0.3 { // 30% chance per affiliate
-			newCustomers += 1 + rand.Intn(2)
+		if fs.rng.Float64() < 0.3 { // 30% chance per affiliate
+			newCustomers += 1 + fs.rng.Intn(2)
 		}
 	}
 
@@ -202,7 +201,7 @@ func (fs *FounderState) UpdateAffiliateProgram() []string {
 		var totalMRR int64
 		var dealSizes []int64 // Store deal sizes for customer tracking
 		for i := 0; i < newCustomers; i++ {
-			dealSize := generateDealSize(baseDealSize, fs.Category)
+			dealSize := generateDealSize(fs.rng, baseDealSize, fs.Category)
 			fs.updateDealSizeRange(dealSize)
 			totalMRR += dealSize
 			dealSizes = append(dealSizes, dealSize)
@@ -236,8 +235,8 @@ func (fs *FounderState) UpdateAffiliateProgram() []string {
 			newCustomers, formatCurrency(totalMRR), formatCurrency(commissionPaid)))
 
 		// Affiliates grow over time if successful
-		if rand.Float64() < 0.2 {
-			prog.Affiliates += 1 + rand.Intn(3)
+		if fs.rng.Float64() < 0.2 {
+			prog.Affiliates += 1 + fs.rng.Intn(3)
 		}
 	}
 
@@ -305,7 +304,7 @@ func (fs *FounderState) LaunchReferralProgram(rewardPerReferral int64, rewardTyp
 		return fmt.Errorf("need at least 10 customers to launch referral program")
 	}
 
-	setupCost := int64(10000 + rand.Int63n(20000)) // $10-30k setup
+	setupCost := int64(10000 + fs.rng.Int63n(20000)) // $10-30k setup
 	if setupCost > fs.Cash {
 		return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(setupCost))
 	}
@@ -313,12 +312,12 @@ func (fs *FounderState) LaunchReferralProgram(rewardPerReferral int64, rewardTyp
 	fs.Cash -= setupCost
 
 	// Monthly budget: 2-5% of MRR, minimum $5k
-	monthlyBudget := int64(float64(fs.MRR) * (0.02 + rand.Float64()*0.03))
+	monthlyBudget := int64(float64(fs.MRR) * (0.02 + fs.rng.Float64()*0.03))
 	if monthlyBudget < 5000 {
 		monthlyBudget = 5000
 	}
 
-	platformFee := int64(2000 + rand.Int63n(3000)) // $2-5k/month platform fee
+	platformFee := int64(2000 + fs.rng.Int63n(3000)) // $2-5k/month platform fee
 
 	fs.ReferralProgram = &ReferralProgram{
 		LaunchedMonth:     fs.Turn,
@@ -358,7 +357,7 @@ func (fs *FounderState) UpdateReferralProgram() []string {
 
 	newReferrals := 0
 	for i := 0; i < fs.Customers; i++ {
-		if rand.Float64() < referralChance {
+		if fs.rng.Float64() < referralChance {
 			newReferrals++
 		}
 	}
@@ -384,7 +383,7 @@ func (fs *FounderState) UpdateReferralProgram() []string {
 
 		// Calculate new customers from referrals
 		// 60-80% of referrals convert to customers
-		conversionRate := 0.6 + rand.Float64()*0.2
+		conversionRate := 0.6 + fs.rng.Float64()*0.2
 		newCustomers := int(float64(newReferrals) * conversionRate)
 
 		if newCustomers > 0 {
@@ -408,7 +407,7 @@ func (fs *FounderState) UpdateReferralProgram() []string {
 			var totalMRR int64
 			var dealSizes []int64
 			for i := 0; i < newCustomers; i++ {
-				dealSize := generateDealSize(baseDealSize, fs.Category)
+				dealSize := generateDealSize(fs.rng, baseDealSize, fs.Category)
 				fs.updateDealSizeRange(dealSize)
 				totalMRR += dealSize
 				dealSizes = append(dealSizes, dealSize)
@@ -506,7 +505,7 @@ func (fs *FounderState) SetChairman(advisorName string) error {
 	}
 
 	// Calculate additional equity cost (chairman needs 1.5-2x equity)
-	additionalEquity := advisor.EquityCost * (0.5 + rand.Float64()*0.5) // 0.5-1x additional
+	additionalEquity := advisor.EquityCost * (0.5 + fs.rng.Float64()*0.5) // 0.5-1x additional
 	totalEquityNeeded := advisor.EquityCost + additionalEquity
 
 	// Check if we have enough equity pool
@@ -547,7 +546,7 @@ func (fs *FounderState) RemoveChairman() error {
 	// This will be handled in UpdateBoardSentiment
 	// Board pressure increases by 20-30 points
 	if fs.BoardPressure < 100 {
-		fs.BoardPressure += 20 + rand.Intn(11) // 20-30 point increase
+		fs.BoardPressure += 20 + fs.rng.Intn(11) // 20-30 point increase
 		if fs.BoardPressure > 100 {
 			fs.BoardPressure = 100
 		}
@@ -590,9 +589,9 @@ func (fs *FounderState) MitigateCrisis(event *RandomEvent) bool {
 	}
 
 	// 70% chance chairman successfully mitigates
-	if rand.Float64() < 0.7 {
+	if fs.rng.Float64() < 0.7 {
 		// Reduce impact by 30-50%
-		mitigationFactor := 0.5 + rand.Float64()*0.2 // 0.5-0.7 (30-50% reduction)
+		mitigationFactor := 0.5 + fs.rng.Float64()*0.2 // 0.5-0.7 (30-50% reduction)
 
 		// Apply mitigation to impact
 		if event.Impact.CashCost > 0 {
@@ -685,7 +684,7 @@ func (fs *FounderState) RemoveAdvisor(advisorName string, buybackEquity bool) er
 		// No buyback - advisor keeps equity but is removed from board
 		// This causes negative PR and board sentiment issues
 		if fs.BoardPressure < 100 {
-			fs.BoardPressure += 10 + rand.Intn(11) // 10-20 point increase
+			fs.BoardPressure += 10 + fs.rng.Intn(11) // 10-20 point increase
 			if fs.BoardPressure > 100 {
 				fs.BoardPressure = 100
 			}
@@ -746,7 +745,7 @@ func (fs *FounderState) FireBoardMember(memberName string) error {
 	// Serious consequences for firing an investor board member
 	// Board pressure increases significantly
 	if fs.BoardPressure < 100 {
-		fs.BoardPressure += 30 + rand.Intn(21) // 30-50 point increase
+		fs.BoardPressure += 30 + fs.rng.Intn(21) // 30-50 point increase
 		if fs.BoardPressure > 100 {
 			fs.BoardPressure = 100
 		}
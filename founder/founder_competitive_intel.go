@@ -46,6 +46,7 @@ func (fs *FounderState) CommissionIntelReport(competitorName string, cost int64)
 	}
 
 	fs.CompetitiveIntel.IntelReports = append(fs.CompetitiveIntel.IntelReports, report)
+	fs.createBattleCard(report)
 
 	return nil
 }
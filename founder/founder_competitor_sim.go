@@ -0,0 +1,391 @@
+package founder
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// competitorStages is the funding ladder competitors climb
+var competitorStages = []struct {
+	Name   string
+	Amount int64
+}{
+	{"Seed", 3000000},
+	{"Series A", 12000000},
+	{"Series B", 35000000},
+	{"Series C", 80000000},
+	{"Series D", 150000000},
+}
+
+// competitorRoadmap is the order competitors ship features in. Names line up with
+// the roadmap's competitor feature map so launches count against "Innovation Leader".
+var competitorRoadmap = []string{
+	"API", "SSO", "Analytics", "Integrations", "Mobile App",
+	"Security", "Workflow Automation", "SCIM Provisioning", "AI", "White Label",
+}
+
+const competitorCostPerHead = 12000 // Fully loaded monthly cost per competitor employee
+
+// isBigTech reports whether a competitor is a public tech giant rather than a startup
+func isBigTech(name string) bool {
+	return strings.HasPrefix(name, "Hooli") || name == "Gavin Belson's New Thing"
+}
+
+// initCompetitorCompany gives a new (or legacy) competitor a balance sheet and product
+func (fs *FounderState) initCompetitorCompany(comp *Competitor) {
+	stage := 0
+	switch comp.Threat {
+	case "medium":
+		stage = 1
+	case "high":
+		stage = 2
+	case "critical":
+		stage = 3
+	}
+
+	if isBigTech(comp.Name) {
+		comp.Stage = "Public"
+		comp.TotalFunding = 0
+		comp.Cash = 1000000000
+		comp.Headcount = 80 + fs.rng.Intn(60)
+	} else {
+		comp.Stage = competitorStages[stage].Name
+		for i := 0; i <= stage; i++ {
+			comp.TotalFunding += competitorStages[i].Amount
+		}
+		comp.Cash = competitorStages[stage].Amount * 7 / 10
+		comp.Headcount = int(competitorStages[stage].Amount/200000) + fs.rng.Intn(5)
+	}
+
+	comp.ProductScore = 0.35 + float64(stage)*0.1 + fs.rng.Float64()*0.1
+	comp.PriceIndex = 0.8 + fs.rng.Float64()*0.4
+	comp.LastRaiseMonth = fs.Turn
+	comp.LastPriceMove = fs.Turn
+	for i := 0; i < stage && i < len(competitorRoadmap); i++ {
+		comp.Features = append(comp.Features, competitorRoadmap[i])
+	}
+}
+
+// OurCompetitiveStrength scores the player's product for head-to-head comparisons
+func (fs *FounderState) OurCompetitiveStrength() float64 {
+	completed := float64(len(fs.GetCompletedFeatures()))
+	return 0.3 + fs.ProductMaturity*0.4 + math.Min(0.3, completed*0.03)
+}
+
+// CompetitorStrength scores a competitor's product, feature set and pricing
+func CompetitorStrength(comp Competitor) float64 {
+	priceEdge := 0.0
+	if comp.PriceIndex > 0 {
+		priceEdge = (1 - comp.PriceIndex) * 0.3
+	}
+	return comp.ProductScore*0.7 + math.Min(0.3, float64(len(comp.Features))*0.03) + priceEdge
+}
+
+// headToHeadGap is how much stronger a competitor is than us (negative = we lead)
+func (fs *FounderState) headToHeadGap(comp Competitor) float64 {
+	return CompetitorStrength(comp) - fs.OurCompetitiveStrength()
+}
+
+// findCompetitor returns an active competitor by name
+func (fs *FounderState) findCompetitor(name string) *Competitor {
+	for i := range fs.Competitors {
+		if fs.Competitors[i].Name == name && fs.Competitors[i].Active {
+			return &fs.Competitors[i]
+		}
+	}
+	return nil
+}
+
+// pickDealCompetitor chooses who shows up in a deal, weighted by market share
+func (fs *FounderState) pickDealCompetitor() string {
+	total := 0.0
+	for _, c := range fs.Competitors {
+		if c.Active {
+			total += c.MarketShare
+		}
+	}
+	if total <= 0 {
+		return ""
+	}
+	roll := fs.rng.Float64() * total
+	for _, c := range fs.Competitors {
+		if !c.Active {
+			continue
+		}
+		roll -= c.MarketShare
+		if roll <= 0 {
+			return c.Name
+		}
+	}
+	return ""
+}
+
+// competitorDealPenalty is the close-rate hit from facing a competitor in a deal
+func (fs *FounderState) competitorDealPenalty(name string) float64 {
+	comp := fs.findCompetitor(name)
+	if comp == nil {
+		return 0.10
+	}
+	return math.Max(0, math.Min(0.35, 0.10+fs.headToHeadGap(*comp)*0.5))
+}
+
+// recordHeadToHead books a competitive deal result against the competitor
+func (fs *FounderState) recordHeadToHead(deal Deal, won bool) {
+	comp := fs.findCompetitor(deal.Competitor)
+	if comp == nil {
+		return
+	}
+	if won {
+		comp.DealsLost++
+		comp.MarketShare *= 0.99
+	} else {
+		comp.DealsWon++
+		comp.MarketShare *= 1.01
+	}
+}
+
+// competitorMarketMRR sizes the market competitors are selling into
+func (fs *FounderState) competitorMarketMRR() int64 {
+	market := fs.MRR * 8
+	if market < 1000000 {
+		market = 1000000
+	}
+	return market
+}
+
+// simulateCompetitor runs one month of a competitor's business
+func (fs *FounderState) simulateCompetitor(comp *Competitor) []string {
+	var messages []string
+
+	if comp.Stage == "" {
+		fs.initCompetitorCompany(comp)
+	}
+
+	// Head-to-head: the stronger product takes share
+	gap := fs.headToHeadGap(*comp)
+	comp.MarketShare *= 1 + math.Max(-0.08, math.Min(0.08, gap*0.1))
+	comp.MarketShare = math.Min(0.6, comp.MarketShare)
+	comp.MRR = int64(comp.MarketShare * float64(fs.competitorMarketMRR()))
+
+	// Burn and runway
+	burn := int64(comp.Headcount)*competitorCostPerHead - comp.MRR
+	if comp.Stage != "Public" {
+		comp.Cash -= burn
+	}
+	runway := 99
+	if burn > 0 && comp.Stage != "Public" {
+		runway = int(comp.Cash / burn)
+	}
+
+	// Fundraising when runway gets short
+	if runway < 6 && comp.Stage != "Public" && fs.Turn-comp.LastRaiseMonth >= 6 {
+		chance := 0.3 + comp.MarketShare*2 + (comp.ProductScore - 0.5)
+		if fs.EconomicEvent != nil && fs.EconomicEvent.Active {
+			chance *= 0.5
+		}
+		if fs.rng.Float64() < chance {
+			next := 0
+			for i, st := range competitorStages {
+				if st.Name == comp.Stage {
+					next = i + 1
+				}
+			}
+			if next >= len(competitorStages) {
+				next = len(competitorStages) - 1
+			}
+			round := competitorStages[next]
+			comp.Stage = round.Name
+			comp.Cash += round.Amount
+			comp.TotalFunding += round.Amount
+			comp.LastRaiseMonth = fs.Turn
+			runway = 99
+			messages = append(messages, fmt.Sprintf("💰 %s raised a $%s %s — expect them to hire and ship faster",
+				comp.Name, formatCurrency(round.Amount), round.Name))
+		}
+	}
+
+	// Out of money: shut down or get bought
+	if comp.Cash <= 0 && comp.Stage != "Public" {
+		comp.Active = false
+		comp.ExitMonth = fs.Turn
+		if fs.rng.Float64() < 0.5 {
+			comp.ExitType = "acquired"
+			messages = append(messages, fmt.Sprintf("🏳️  %s ran out of runway and was acqui-hired", comp.Name))
+		} else {
+			comp.ExitType = "shutdown"
+			messages = append(messages, fmt.Sprintf("🪦 %s ran out of money and shut down", comp.Name))
+		}
+		fs.MonthlyGrowthRate *= 1.05 // Their customers go shopping
+		return messages
+	}
+
+	// Hiring follows runway
+	if runway > 12 {
+		comp.Headcount += 1 + comp.Headcount/15
+	} else if runway < 4 && comp.Headcount > 5 {
+		comp.Headcount -= comp.Headcount / 5
+		messages = append(messages, fmt.Sprintf("✂️  %s laid off staff to extend runway", comp.Name))
+	}
+
+	// Roadmap: headcount drives launches
+	comp.RoadmapProgress += math.Max(0.05, math.Min(0.35, float64(comp.Headcount)/200))
+	if comp.RoadmapProgress >= 1 {
+		comp.RoadmapProgress = 0
+		if msg := fs.competitorLaunch(comp); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+
+	// Pricing moves
+	if fs.Turn-comp.LastPriceMove >= 4 {
+		if gap < -0.05 && runway > 6 && comp.PriceIndex > 0.6 {
+			cut := 0.10 + fs.rng.Float64()*0.10
+			comp.PriceIndex = math.Max(0.6, comp.PriceIndex*(1-cut))
+			comp.LastPriceMove = fs.Turn
+			messages = append(messages, fmt.Sprintf("💸 %s cut prices %.0f%% to win deals back", comp.Name, cut*100))
+		} else if gap > 0.15 && comp.PriceIndex < 1.3 {
+			comp.PriceIndex *= 1.10
+			comp.LastPriceMove = fs.Turn
+			messages = append(messages, fmt.Sprintf("💲 %s raised prices 10%% on the back of their product lead", comp.Name))
+		}
+	}
+
+	// Taking our customers when they're ahead
+	if gap > 0 && fs.Customers > 0 && fs.rng.Float64() < gap {
+		if msg := fs.competitorStealCustomers(comp, gap); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+
+	// Poaching: freshly funded competitors go after unhappy staff
+	poachChance := 0.03
+	if fs.Turn-comp.LastRaiseMonth <= 3 {
+		poachChance += 0.08
+	}
+	poachChance *= 1.2 - fs.AverageMorale()
+	if fs.rng.Float64() < poachChance {
+		if msg := fs.competitorPoach(comp); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+
+	// Startups get bought by big tech once they matter
+	if !isBigTech(comp.Name) && comp.MarketShare > 0.10 && fs.rng.Float64() < 0.01 {
+		comp.Active = false
+		comp.ExitType = "acquired"
+		comp.ExitMonth = fs.Turn
+		messages = append(messages, fmt.Sprintf("🤝 %s was acquired by Hooli — one less rival, one bigger one", comp.Name))
+	}
+
+	return messages
+}
+
+// competitorLaunch ships the next feature on a competitor's roadmap
+func (fs *FounderState) competitorLaunch(comp *Competitor) string {
+	var next string
+	for _, f := range competitorRoadmap {
+		launched := false
+		for _, have := range comp.Features {
+			if have == f {
+				launched = true
+				break
+			}
+		}
+		if !launched {
+			next = f
+			break
+		}
+	}
+
+	comp.ProductScore = math.Min(1.0, comp.ProductScore+0.03)
+	comp.MarketShare *= 1.05
+	if next == "" {
+		return ""
+	}
+	comp.Features = append(comp.Features, next)
+
+	if fs.ProductRoadmap == nil {
+		fs.InitializeProductRoadmap()
+	}
+	fs.ProductRoadmap.CompetitorLaunches = append(fs.ProductRoadmap.CompetitorLaunches, CompetitorFeatureLaunch{
+		FeatureName:    next,
+		CompetitorName: comp.Name,
+		MonthLaunched:  fs.Turn,
+	})
+	return fmt.Sprintf("⚠️  %s launched %s! Consider your product roadmap", comp.Name, next)
+}
+
+// competitorStealCustomers moves a few of our customers to a stronger competitor
+func (fs *FounderState) competitorStealCustomers(comp *Competitor, gap float64) string {
+	active := fs.GetActiveCustomers()
+	if len(active) == 0 {
+		return ""
+	}
+
+	count := int(math.Ceil(float64(len(active)) * comp.MarketShare * gap * 0.2))
+	if count > len(active)/10 {
+		count = len(active) / 10
+	}
+	if count < 1 {
+		return ""
+	}
+
+	var mrrLost int64
+	for _, idx := range fs.rng.Perm(len(active))[:count] {
+		fs.churnCustomer(active[idx].ID)
+		mrrLost += active[idx].DealSize
+	}
+	fs.syncMRR()
+	comp.MarketShare *= 1.02
+
+	return fmt.Sprintf("🔥 %s won %d of your customers with a better offering (-$%s MRR)",
+		comp.Name, count, formatCurrency(mrrLost))
+}
+
+// competitorPoach hires away the employee most open to leaving
+func (fs *FounderState) competitorPoach(comp *Competitor) string {
+	var team *[]Employee
+	best := -1
+	bestScore := 0.0
+	for _, t := range []*[]Employee{&fs.Team.Engineers, &fs.Team.Sales} {
+		for i, e := range *t {
+			score := e.Performance * (1.1 - e.Morale)
+			if score > bestScore {
+				bestScore = score
+				best = i
+				team = t
+			}
+		}
+	}
+	if team == nil {
+		return ""
+	}
+
+	e := (*team)[best]
+	*team = append((*team)[:best], (*team)[best+1:]...)
+	msg := fmt.Sprintf("👔 %s poached %s (%s) from you!", comp.Name, e.Name, e.Role)
+	if equityMsg := fs.departEmployee(e, "poached"); equityMsg != "" {
+		msg += " " + equityMsg
+	}
+	comp.Headcount++
+	fs.CalculateTeamCost()
+	fs.CalculateRunway()
+	return msg
+}
+
+// CompetitorPriceIndex is the share-weighted price of active competitors relative to ours
+func (fs *FounderState) CompetitorPriceIndex() float64 {
+	total, weighted := 0.0, 0.0
+	for _, c := range fs.Competitors {
+		if !c.Active || c.PriceIndex <= 0 {
+			continue
+		}
+		total += c.MarketShare
+		weighted += c.MarketShare * c.PriceIndex
+	}
+	if total <= 0 {
+		return 1.0
+	}
+	return weighted / total
+}
@@ -3,7 +3,6 @@ package founder
 import (
 	"fmt"
 	"math"
-	"math/rand"
 )
 
 // LaunchContentProgram starts a content marketing and SEO initiative
@@ -76,7 +75,7 @@ func (fs *FounderState) UpdateContentProgram() []string {
 
 	// SEO score improves slowly (takes 3-6 months to see results)
 	if fs.ContentProgram.MonthsActive >= 3 && fs.ContentProgram.SEOScore < 90 {
-		seoIncrease := 5 + rand.Intn(5) // 5-10 points per month
+		seoIncrease := 5 + fs.rng.Intn(5) // 5-10 points per month
 		if fs.ContentProgram.MonthlyBudget >= 40000 {
 			seoIncrease += 5
 		}
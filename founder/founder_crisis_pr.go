@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // SpawnPRCrisis generates a PR crisis
@@ -26,7 +25,7 @@ func (fs *FounderState) SpawnPRCrisis() *PRCrisis {
 		baseProbability = 0.08 // 8% if brand score low
 	}
 
-	if rand.Float64() > baseProbability {
+	if fs.rng.Float64() > baseProbability {
 		return nil
 	}
 
@@ -37,10 +36,10 @@ func (fs *FounderState) SpawnPRCrisis() *PRCrisis {
 		"vape_in_office", "karaoke_video_leak", "linkedin_thoughtleader_post",
 		"all_hands_bloopers", "zoom_background_fail", "demo_day_meltdown",
 	}
-	crisisType := crisisTypes[rand.Intn(len(crisisTypes))]
+	crisisType := crisisTypes[fs.rng.Intn(len(crisisTypes))]
 
 	// Severity
-	severityRoll := rand.Float64()
+	severityRoll := fs.rng.Float64()
 	severity := "minor"
 	if severityRoll < 0.2 {
 		severity = "critical"
@@ -54,14 +53,14 @@ func (fs *FounderState) SpawnPRCrisis() *PRCrisis {
 	mediaOutlets := []string{"TechCrunch", "WSJ", "Forbes", "Bloomberg", "The Verge", "Trade Publication"}
 	numOutlets := 1
 	if severity == "critical" {
-		numOutlets = 3 + rand.Intn(3) // 3-5 outlets
+		numOutlets = 3 + fs.rng.Intn(3) // 3-5 outlets
 	} else if severity == "major" {
-		numOutlets = 2 + rand.Intn(2) // 2-3 outlets
+		numOutlets = 2 + fs.rng.Intn(2) // 2-3 outlets
 	}
 
 	mediaCoverage := []string{}
 	for i := 0; i < numOutlets && i < len(mediaOutlets); i++ {
-		mediaCoverage = append(mediaCoverage, mediaOutlets[rand.Intn(len(mediaOutlets))])
+		mediaCoverage = append(mediaCoverage, mediaOutlets[fs.rng.Intn(len(mediaOutlets))])
 	}
 
 	// Impact based on severity
@@ -70,25 +69,25 @@ func (fs *FounderState) SpawnPRCrisis() *PRCrisis {
 
 	switch severity {
 	case "critical":
-		cacImpact = 1.5 + rand.Float64()*0.5  // 1.5-2.0x CAC
-		churnImpact = 0.08 + rand.Float64()*0.04 // 8-12% churn
-		brandDamage = 0.3 + rand.Float64()*0.2   // 30-50% brand damage
-		durationMonths = 9 + rand.Intn(4)        // 9-12 months
+		cacImpact = 1.5 + fs.rng.Float64()*0.5  // 1.5-2.0x CAC
+		churnImpact = 0.08 + fs.rng.Float64()*0.04 // 8-12% churn
+		brandDamage = 0.3 + fs.rng.Float64()*0.2   // 30-50% brand damage
+		durationMonths = 9 + fs.rng.Intn(4)        // 9-12 months
 	case "major":
-		cacImpact = 1.3 + rand.Float64()*0.3  // 1.3-1.6x CAC
-		churnImpact = 0.05 + rand.Float64()*0.03 // 5-8% churn
-		brandDamage = 0.2 + rand.Float64()*0.2   // 20-40% brand damage
-		durationMonths = 6 + rand.Intn(4)        // 6-9 months
+		cacImpact = 1.3 + fs.rng.Float64()*0.3  // 1.3-1.6x CAC
+		churnImpact = 0.05 + fs.rng.Float64()*0.03 // 5-8% churn
+		brandDamage = 0.2 + fs.rng.Float64()*0.2   // 20-40% brand damage
+		durationMonths = 6 + fs.rng.Intn(4)        // 6-9 months
 	case "moderate":
-		cacImpact = 1.2 + rand.Float64()*0.2  // 1.2-1.4x CAC
-		churnImpact = 0.03 + rand.Float64()*0.02 // 3-5% churn
-		brandDamage = 0.1 + rand.Float64()*0.1   // 10-20% brand damage
-		durationMonths = 3 + rand.Intn(4)        // 3-6 months
+		cacImpact = 1.2 + fs.rng.Float64()*0.2  // 1.2-1.4x CAC
+		churnImpact = 0.03 + fs.rng.Float64()*0.02 // 3-5% churn
+		brandDamage = 0.1 + fs.rng.Float64()*0.1   // 10-20% brand damage
+		durationMonths = 3 + fs.rng.Intn(4)        // 3-6 months
 	case "minor":
-		cacImpact = 1.1 + rand.Float64()*0.1  // 1.1-1.2x CAC
-		churnImpact = 0.01 + rand.Float64()*0.02 // 1-3% churn
-		brandDamage = 0.05 + rand.Float64()*0.05 // 5-10% brand damage
-		durationMonths = 1 + rand.Intn(3)        // 1-3 months
+		cacImpact = 1.1 + fs.rng.Float64()*0.1  // 1.1-1.2x CAC
+		churnImpact = 0.01 + fs.rng.Float64()*0.02 // 1-3% churn
+		brandDamage = 0.05 + fs.rng.Float64()*0.05 // 5-10% brand damage
+		durationMonths = 1 + fs.rng.Intn(3)        // 1-3 months
 	}
 
 	crisis := PRCrisis{
@@ -139,7 +138,7 @@ func (fs *FounderState) RespondToPRCrisis(responseType string) error {
 	case "deny":
 		cost = 10000 // Cheap but risky
 		effectiveness = 0.2 // 20% effective
-		if rand.Float64() < 0.5 {
+		if fs.rng.Float64() < 0.5 {
 			outcome = "escalated" // 50% chance makes it worse
 		} else {
 			outcome = "contained"
@@ -148,7 +147,7 @@ func (fs *FounderState) RespondToPRCrisis(responseType string) error {
 	case "apologize":
 		cost = 50000 // Moderate cost
 		effectiveness = 0.5 // 50% effective
-		if rand.Float64() < 0.3 {
+		if fs.rng.Float64() < 0.3 {
 			outcome = "escalated"
 		} else {
 			outcome = "contained"
@@ -157,7 +156,7 @@ func (fs *FounderState) RespondToPRCrisis(responseType string) error {
 	case "transparent":
 		cost = 100000 // Higher cost
 		effectiveness = 0.8 // 80% effective
-		if rand.Float64() < 0.1 {
+		if fs.rng.Float64() < 0.1 {
 			outcome = "escalated"
 		} else {
 			outcome = "resolved"
@@ -166,7 +165,7 @@ func (fs *FounderState) RespondToPRCrisis(responseType string) error {
 	case "aggressive":
 		cost = 200000 // Expensive (legal)
 		effectiveness = 0.4 // 40% effective (can backfire)
-		if rand.Float64() < 0.4 {
+		if fs.rng.Float64() < 0.4 {
 			outcome = "escalated" // 40% chance backfires
 		} else {
 			outcome = "contained"
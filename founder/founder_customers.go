@@ -3,16 +3,15 @@ package founder
 import (
 	"fmt"
 	"math"
-	"math/rand"
 )
 
 func (fs *FounderState) addCustomer(dealSize int64, source string) Customer {
 	// Determine if contract is perpetual (80% chance) or fixed term (20% chance)
 	termMonths := 0 // Default to perpetual
-	if rand.Float64() < 0.2 {
+	if fs.rng.Float64() < 0.2 {
 		// Fixed term contracts: 6, 12, 24, or 36 months
 		terms := []int{6, 12, 24, 36}
-		termMonths = terms[rand.Intn(len(terms))]
+		termMonths = terms[fs.rng.Intn(len(terms))]
 	}
 
 	// Initial health score based on product maturity and source
@@ -123,10 +122,7 @@ func (fs *FounderState) RecalculateChurnRate() {
 
 	// CS team reduces churn
 	// COO counts as 3x CS reps
-	csImpact := 0.0
-	for _, cs := range fs.Team.CustomerSuccess {
-		csImpact += (cs.Impact * 0.02) // Each CS rep reduces churn by ~2%
-	}
+	csImpact := fs.TeamOutput(RoleCustomerSuccess) * 0.02 // Each CS rep reduces churn by ~2%
 	for _, exec := range fs.Team.Executives {
 		if exec.Role == RoleCOO {
 			csImpact += (exec.Impact * 0.02) // COO has 3x impact already built into Impact field
@@ -183,7 +179,7 @@ func (fs *FounderState) SpendOnMarketing(amount int64) int {
 
 	// Even partial CAC spend should occasionally yield a customer (25% chance)
 	if newCustomers == 0 && amount > 0 && amount < fs.CustomerAcquisitionCost {
-		if rand.Float64() < 0.25 {
+		if fs.rng.Float64() < 0.25 {
 			newCustomers = 1
 		}
 	}
@@ -209,7 +205,7 @@ func (fs *FounderState) SpendOnMarketing(amount int64) int {
 	var totalMRR int64
 	var dealSizes []int64 // Store deal sizes for customer tracking
 	for i := 0; i < newCustomers; i++ {
-		dealSize := generateDealSize(baseDealSize, fs.Category)
+		dealSize := generateDealSize(fs.rng, baseDealSize, fs.Category)
 		fs.updateDealSizeRange(dealSize)
 		totalMRR += dealSize
 		dealSizes = append(dealSizes, dealSize)
@@ -255,10 +251,15 @@ func (fs *FounderState) SolicitCustomerFeedback() error {
 	fs.ProductMaturity = math.Min(1.0, fs.ProductMaturity+improvement)
 
 	// Customer feedback also reduces churn by 3-10%
-	churnReduction := 0.03 + rand.Float64()*0.07                               // 3-10% reduction
+	churnReduction := 0.03 + fs.rng.Float64()*0.07                             // 3-10% reduction
 	fs.CustomerChurnRate = math.Max(0.01, fs.CustomerChurnRate-churnReduction) // Minimum 1% churn
 	fs.ChurnRate = fs.CustomerChurnRate
 
+	// Feedback also surfaces what customers want built next
+	if candidates := fs.GetAvailableFeaturesToStart(); len(candidates) > 0 {
+		fs.RecordFeatureRequest(candidates[fs.rng.Intn(len(candidates))].Name)
+	}
+
 	return nil
 }
 
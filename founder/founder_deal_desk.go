@@ -0,0 +1,448 @@
+package founder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const founderDealCapacity = 5 // Deals the founder can personally run alongside everything else
+
+// Features buyers in each segment may insist on before signing
+var segmentFeatureAsks = map[string][]string{
+	"Enterprise": {"Enterprise SSO", "SCIM Provisioning", "Security Suite", "REST API", "Integrations Hub", "Advanced Analytics"},
+	"Mid-Market": {"REST API", "Integrations Hub", "Advanced Analytics", "Mobile App", "Workflow Automation"},
+	"SMB":        {},
+	"Startup":    {},
+}
+
+// RepCapacity is how many active deals a rep can work at once
+func RepCapacity(rep Employee) int {
+	switch rep.Level {
+	case 1:
+		return 6
+	case 3:
+		return 10
+	case 4:
+		return 12
+	default:
+		return 8
+	}
+}
+
+// RepQuota is a rep's monthly new-MRR quota (roughly 4x OTE in ARR per year)
+func RepQuota(rep Employee) int64 {
+	return rep.MonthlyCost / 3
+}
+
+func (fs *FounderState) founderRepName() string {
+	return fs.FounderName + " (Founder)"
+}
+
+// DealsForRep counts active deals assigned to a rep
+func (fs *FounderState) DealsForRep(name string) int {
+	if fs.SalesPipeline == nil {
+		return 0
+	}
+	count := 0
+	for _, deal := range fs.SalesPipeline.ActiveDeals {
+		if deal.AssignedSalesRep == name {
+			count++
+		}
+	}
+	return count
+}
+
+// QuotaAttainment returns a rep's bookings this quarter as a fraction of quota
+func (fs *FounderState) QuotaAttainment(rep Employee) float64 {
+	if fs.SalesPipeline == nil || fs.SalesPipeline.RepBookings == nil {
+		return 0
+	}
+	monthsIntoQuarter := (fs.Turn-1)%3 + 1
+	quota := RepQuota(rep) * int64(monthsIntoQuarter)
+	if quota <= 0 {
+		return 0
+	}
+	return float64(fs.SalesPipeline.RepBookings[rep.Name]) / float64(quota)
+}
+
+// assignRep picks an owner for a new deal: enterprise deals go to the most senior rep
+// with room, everything else to the rep furthest behind quota. Returns "" when the
+// whole team (founder included) is at capacity.
+func (fs *FounderState) assignRep(deal Deal) string {
+	var available []Employee
+	for _, rep := range fs.Team.Sales {
+		if fs.DealsForRep(rep.Name) < RepCapacity(rep) {
+			available = append(available, rep)
+		}
+	}
+
+	if len(available) == 0 {
+		if fs.DealsForRep(fs.founderRepName()) < founderDealCapacity {
+			return fs.founderRepName()
+		}
+		return ""
+	}
+
+	if deal.Segment == "Enterprise" {
+		sort.SliceStable(available, func(i, j int) bool {
+			return available[i].Level > available[j].Level
+		})
+	} else {
+		sort.SliceStable(available, func(i, j int) bool {
+			return fs.QuotaAttainment(available[i]) < fs.QuotaAttainment(available[j])
+		})
+	}
+	return available[0].Name
+}
+
+// rebalanceUnassignedDeals hands unowned deals to reps who have freed up capacity
+func (fs *FounderState) rebalanceUnassignedDeals() {
+	for i := range fs.SalesPipeline.ActiveDeals {
+		deal := &fs.SalesPipeline.ActiveDeals[i]
+		if deal.AssignedSalesRep == "" {
+			deal.AssignedSalesRep = fs.assignRep(*deal)
+		}
+	}
+}
+
+// dealRequirements rolls the features and competitor a buyer brings to a deal
+func (fs *FounderState) dealRequirements(deal *Deal) {
+	asks := segmentFeatureAsks[deal.Segment]
+	if len(asks) > 0 {
+		count := fs.rng.Intn(2)
+		if deal.Segment == "Enterprise" {
+			count = 1 + fs.rng.Intn(2)
+		}
+		perm := fs.rng.Perm(len(asks))
+		for i := 0; i < count && i < len(perm); i++ {
+			deal.RequiredFeatures = append(deal.RequiredFeatures, asks[perm[i]])
+		}
+	}
+
+	if deal.Segment == "Enterprise" {
+		deal.SecurityReview = "required"
+	}
+
+	if fs.rng.Float64() < 0.5 {
+		deal.Competitor = fs.pickDealCompetitor()
+	}
+}
+
+// findActiveDeal looks up an open deal by ID
+func (fs *FounderState) findActiveDeal(dealID int) *Deal {
+	if fs.SalesPipeline == nil {
+		return nil
+	}
+	for i := range fs.SalesPipeline.ActiveDeals {
+		if fs.SalesPipeline.ActiveDeals[i].ID == dealID {
+			return &fs.SalesPipeline.ActiveDeals[i]
+		}
+	}
+	return nil
+}
+
+// GetDeal returns a copy of an open deal
+func (fs *FounderState) GetDeal(dealID int) (Deal, bool) {
+	deal := fs.findActiveDeal(dealID)
+	if deal == nil {
+		return Deal{}, false
+	}
+	return *deal, true
+}
+
+// GetBattleCard returns the battle card for a competitor, if intel has built one
+func (fs *FounderState) GetBattleCard(competitor string) *BattleCard {
+	if fs.CompetitiveIntel == nil {
+		return nil
+	}
+	for i := range fs.CompetitiveIntel.BattleCards {
+		if fs.CompetitiveIntel.BattleCards[i].CompetitorName == competitor {
+			return &fs.CompetitiveIntel.BattleCards[i]
+		}
+	}
+	return nil
+}
+
+// hasCompletedFeature reports whether a roadmap feature has shipped
+func (fs *FounderState) hasCompletedFeature(name string) bool {
+	for _, f := range fs.GetCompletedFeatures() {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingFeatures returns the features a deal needs that haven't shipped
+func (fs *FounderState) MissingFeatures(deal Deal) []string {
+	var missing []string
+	for _, name := range deal.RequiredFeatures {
+		if !fs.hasCompletedFeature(name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// RunDiscovery uncovers what the buyer actually needs and who else they're talking to
+func (fs *FounderState) RunDiscovery(dealID int) (string, error) {
+	deal := fs.findActiveDeal(dealID)
+	if deal == nil {
+		return "", fmt.Errorf("deal not found")
+	}
+	if deal.DiscoveryDone {
+		return "", fmt.Errorf("discovery already done for %s", deal.CompanyName)
+	}
+	if deal.Stage != "lead" && deal.Stage != "qualified" {
+		return "", fmt.Errorf("discovery happens before the demo")
+	}
+
+	deal.DiscoveryDone = true
+	deal.CloseProbability += 0.10
+	deal.DaysInStage = 0
+
+	var found []string
+	if len(deal.RequiredFeatures) > 0 {
+		found = append(found, "needs "+strings.Join(deal.RequiredFeatures, ", "))
+	}
+	if deal.Competitor != "" {
+		found = append(found, "also evaluating "+deal.Competitor)
+	}
+	if deal.SecurityReview == "required" {
+		found = append(found, "security review required")
+	}
+	if len(found) == 0 {
+		found = append(found, "clean fit, no blockers")
+	}
+	return fmt.Sprintf("🔎 Discovery with %s: %s", deal.CompanyName, strings.Join(found, "; ")), nil
+}
+
+// StartPOC runs a proof of concept; the result lands over the next month or two
+func (fs *FounderState) StartPOC(dealID int) (string, error) {
+	deal := fs.findActiveDeal(dealID)
+	if deal == nil {
+		return "", fmt.Errorf("deal not found")
+	}
+	if deal.Stage != "demo" && deal.Stage != "negotiation" {
+		return "", fmt.Errorf("POCs run after the demo")
+	}
+	if deal.POCStatus != "" {
+		return "", fmt.Errorf("POC already %s", deal.POCStatus)
+	}
+
+	cost := int64(10000 + fs.rng.Intn(10000))
+	if deal.Segment == "Enterprise" {
+		cost *= 2
+	}
+	if fs.Cash < cost {
+		return "", fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
+	}
+
+	fs.Cash -= cost
+	deal.POCStatus = "running"
+	deal.POCMonthsLeft = 1 + fs.rng.Intn(2)
+	return fmt.Sprintf("🧪 POC started with %s ($%s, ~%d months)", deal.CompanyName, formatCurrency(cost), deal.POCMonthsLeft), nil
+}
+
+// StartSecurityReview puts the deal through the buyer's security questionnaire
+func (fs *FounderState) StartSecurityReview(dealID int) (string, error) {
+	deal := fs.findActiveDeal(dealID)
+	if deal == nil {
+		return "", fmt.Errorf("deal not found")
+	}
+	if deal.SecurityReview != "required" && deal.SecurityReview != "failed" {
+		return "", fmt.Errorf("no security review pending for %s", deal.CompanyName)
+	}
+
+	cost := int64(5000)
+	if fs.Cash < cost {
+		return "", fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
+	}
+	fs.Cash -= cost
+	deal.SecurityReview = "in_progress"
+	return fmt.Sprintf("🛡️  Security review underway with %s", deal.CompanyName), nil
+}
+
+// OfferDiscount trades deal size for close probability (max 30% off list)
+func (fs *FounderState) OfferDiscount(dealID int, percent float64) (string, error) {
+	deal := fs.findActiveDeal(dealID)
+	if deal == nil {
+		return "", fmt.Errorf("deal not found")
+	}
+	if deal.Stage != "negotiation" && deal.Stage != "demo" {
+		return "", fmt.Errorf("discounts are for late-stage deals")
+	}
+	if deal.ListPrice == 0 {
+		deal.ListPrice = deal.DealSize
+	}
+	if deal.Discount+percent > 0.30 {
+		return "", fmt.Errorf("already at %.0f%% off — finance won't approve more", deal.Discount*100)
+	}
+
+	deal.Discount += percent
+	deal.DealSize = int64(float64(deal.ListPrice) * (1 - deal.Discount))
+	deal.CloseProbability += percent * 0.8
+	if deal.CloseProbability > 0.95 {
+		deal.CloseProbability = 0.95
+	}
+	return fmt.Sprintf("🏷️  Offered %s %.0f%% off: now $%s/mo", deal.CompanyName, deal.Discount*100, formatCurrency(deal.DealSize)), nil
+}
+
+// advanceDealWork resolves POCs and security reviews that were in flight this month
+func (fs *FounderState) advanceDealWork(deal *Deal) []string {
+	var messages []string
+
+	if deal.POCStatus == "running" {
+		deal.POCMonthsLeft--
+		if deal.POCMonthsLeft <= 0 {
+			passChance := 0.4 + 0.4*fs.ProductMaturity
+			passChance -= 0.15 * float64(len(fs.MissingFeatures(*deal)))
+			if fs.TechnicalDebt != nil {
+				passChance -= float64(fs.TechnicalDebt.CurrentLevel) / 400.0
+			}
+			if fs.rng.Float64() < passChance {
+				deal.POCStatus = "passed"
+				deal.CloseProbability += 0.25
+				messages = append(messages, fmt.Sprintf("✅ POC passed at %s — champion is selling internally", deal.CompanyName))
+			} else {
+				deal.POCStatus = "failed"
+				deal.CloseProbability -= 0.20
+				messages = append(messages, fmt.Sprintf("❌ POC failed at %s", deal.CompanyName))
+			}
+		}
+	}
+
+	if deal.SecurityReview == "in_progress" {
+		passChance := 0.35
+		if fs.SecurityPosture != nil {
+			passChance += float64(fs.SecurityPosture.SecurityScore) / 200.0
+			passChance += 0.1 * float64(len(fs.SecurityPosture.ComplianceCerts))
+		}
+		if fs.hasCompletedFeature("Enterprise SSO") {
+			passChance += 0.15
+		}
+		if fs.hasCompletedFeature("Security Suite") {
+			passChance += 0.15
+		}
+		if fs.rng.Float64() < passChance {
+			deal.SecurityReview = "passed"
+			messages = append(messages, fmt.Sprintf("🛡️  Passed %s's security review", deal.CompanyName))
+		} else {
+			deal.SecurityReview = "failed"
+			deal.CloseProbability -= 0.10
+			messages = append(messages, fmt.Sprintf("⚠️  Failed %s's security review — fix gaps and retry", deal.CompanyName))
+		}
+	}
+
+	if deal.CloseProbability < 0.01 {
+		deal.CloseProbability = 0.01
+	}
+	return messages
+}
+
+// dealWinChance is the final close probability once competition and product gaps are in
+func (fs *FounderState) dealWinChance(deal Deal) (float64, string) {
+	chance := deal.CloseProbability
+	lossReason := ""
+	worst := 0.0
+
+	if missing := fs.MissingFeatures(deal); len(missing) > 0 {
+		penalty := 0.15 * float64(len(missing))
+		chance -= penalty
+		if penalty > worst {
+			worst = penalty
+			lossReason = "Missing features: " + strings.Join(missing, ", ")
+		}
+	}
+
+	if deal.Competitor != "" {
+		penalty := fs.competitorDealPenalty(deal.Competitor)
+		if card := fs.GetBattleCard(deal.Competitor); card != nil {
+			penalty -= card.WinRateBonus
+		}
+		chance -= penalty
+		if penalty > worst {
+			worst = penalty
+			lossReason = "Chose competitor: " + deal.Competitor
+		}
+	}
+
+	if deal.POCStatus == "failed" && 0.10 > worst {
+		lossReason = "POC didn't prove value"
+	}
+
+	if chance < 0.02 {
+		chance = 0.02
+	}
+	if chance > 0.95 {
+		chance = 0.95
+	}
+	return chance, lossReason
+}
+
+// dealBlocked reports why a deal can't close yet, if anything
+func dealBlocked(deal Deal) string {
+	if deal.SecurityReview == "required" || deal.SecurityReview == "failed" || deal.SecurityReview == "in_progress" {
+		return "security review"
+	}
+	if deal.POCStatus == "running" {
+		return "POC running"
+	}
+	return ""
+}
+
+// recordBooking credits a rep for closed MRR and settles quota at quarter end
+func (fs *FounderState) recordBooking(rep string, mrr int64) {
+	if fs.SalesPipeline.RepBookings == nil {
+		fs.SalesPipeline.RepBookings = make(map[string]int64)
+	}
+	fs.SalesPipeline.RepBookings[rep] += mrr
+}
+
+// settleQuarterQuotas rewards reps who hit quota and hurts morale for those who didn't
+func (fs *FounderState) settleQuarterQuotas() []string {
+	var messages []string
+	if fs.Turn%3 != 0 || len(fs.Team.Sales) == 0 {
+		return messages
+	}
+
+	hit := 0
+	for i := range fs.Team.Sales {
+		rep := &fs.Team.Sales[i]
+		attainment := fs.QuotaAttainment(*rep)
+		switch {
+		case attainment >= 1.0:
+			hit++
+			rep.Morale += 0.10
+		case attainment < 0.5:
+			rep.Morale -= 0.10
+		}
+		if rep.Morale > 1 {
+			rep.Morale = 1
+		}
+		if rep.Morale < 0 {
+			rep.Morale = 0
+		}
+	}
+	messages = append(messages, fmt.Sprintf("📊 Quarter close: %d/%d reps hit quota", hit, len(fs.Team.Sales)))
+	fs.SalesPipeline.RepBookings = make(map[string]int64)
+	return messages
+}
+
+// createBattleCard turns an intel report into sales positioning against that competitor
+func (fs *FounderState) createBattleCard(report IntelReport) {
+	card := BattleCard{
+		CompetitorName:  report.CompetitorName,
+		OurAdvantages:   []string{"Faster onboarding", "Better support"},
+		TheirAdvantages: report.Features,
+		ResponseTactics: []string{"Lead with time-to-value", "Offer reference customers"},
+		WinRateBonus:    0.10 + fs.rng.Float64()*0.10,
+		CreatedMonth:    fs.Turn,
+	}
+	if existing := fs.GetBattleCard(report.CompetitorName); existing != nil {
+		*existing = card
+		return
+	}
+	fs.CompetitiveIntel.BattleCards = append(fs.CompetitiveIntel.BattleCards, card)
+}
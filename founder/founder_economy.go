@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // SpawnEconomicEvent generates an economic downturn
@@ -13,16 +12,16 @@ func (fs *FounderState) SpawnEconomicEvent() *EconomicEvent {
 	}
 
 	// Probability: 5% per month after month 12
-	if rand.Float64() > 0.05 {
+	if fs.rng.Float64() > 0.05 {
 		return nil
 	}
 
 	// Event types
 	eventTypes := []string{"recession", "market_crash", "funding_winter", "sector_crash"}
-	eventType := eventTypes[rand.Intn(len(eventTypes))]
+	eventType := eventTypes[fs.rng.Intn(len(eventTypes))]
 
 	// Severity
-	severityRoll := rand.Float64()
+	severityRoll := fs.rng.Float64()
 	severity := "mild"
 	if severityRoll < 0.15 {
 		severity = "extreme"
@@ -38,33 +37,33 @@ func (fs *FounderState) SpawnEconomicEvent() *EconomicEvent {
 
 	switch severity {
 	case "extreme":
-		growthImpact = 0.3 + rand.Float64()*0.2  // 30-50% growth reduction
-		cacImpact = 1.4 + rand.Float64()*0.3    // 1.4-1.7x CAC
-		churnImpact = 0.10 + rand.Float64()*0.05 // 10-15% churn
+		growthImpact = 0.3 + fs.rng.Float64()*0.2  // 30-50% growth reduction
+		cacImpact = 1.4 + fs.rng.Float64()*0.3    // 1.4-1.7x CAC
+		churnImpact = 0.10 + fs.rng.Float64()*0.05 // 10-15% churn
 		fundingImpact = 0.2                       // 20% funding availability
-		customerBudgetCut = 0.4 + rand.Float64()*0.2 // 40-60% budget cuts
-		durationMonths = 18 + rand.Intn(6)       // 18-24 months
+		customerBudgetCut = 0.4 + fs.rng.Float64()*0.2 // 40-60% budget cuts
+		durationMonths = 18 + fs.rng.Intn(6)       // 18-24 months
 	case "severe":
-		growthImpact = 0.4 + rand.Float64()*0.2  // 40-60% growth reduction
-		cacImpact = 1.3 + rand.Float64()*0.2    // 1.3-1.5x CAC
-		churnImpact = 0.07 + rand.Float64()*0.03 // 7-10% churn
+		growthImpact = 0.4 + fs.rng.Float64()*0.2  // 40-60% growth reduction
+		cacImpact = 1.3 + fs.rng.Float64()*0.2    // 1.3-1.5x CAC
+		churnImpact = 0.07 + fs.rng.Float64()*0.03 // 7-10% churn
 		fundingImpact = 0.3                       // 30% funding availability
-		customerBudgetCut = 0.3 + rand.Float64()*0.2 // 30-50% budget cuts
-		durationMonths = 12 + rand.Intn(6)       // 12-18 months
+		customerBudgetCut = 0.3 + fs.rng.Float64()*0.2 // 30-50% budget cuts
+		durationMonths = 12 + fs.rng.Intn(6)       // 12-18 months
 	case "moderate":
-		growthImpact = 0.5 + rand.Float64()*0.2  // 50-70% growth reduction
-		cacImpact = 1.2 + rand.Float64()*0.2    // 1.2-1.4x CAC
-		churnImpact = 0.04 + rand.Float64()*0.03 // 4-7% churn
+		growthImpact = 0.5 + fs.rng.Float64()*0.2  // 50-70% growth reduction
+		cacImpact = 1.2 + fs.rng.Float64()*0.2    // 1.2-1.4x CAC
+		churnImpact = 0.04 + fs.rng.Float64()*0.03 // 4-7% churn
 		fundingImpact = 0.5                       // 50% funding availability
-		customerBudgetCut = 0.2 + rand.Float64()*0.2 // 20-40% budget cuts
-		durationMonths = 6 + rand.Intn(6)        // 6-12 months
+		customerBudgetCut = 0.2 + fs.rng.Float64()*0.2 // 20-40% budget cuts
+		durationMonths = 6 + fs.rng.Intn(6)        // 6-12 months
 	case "mild":
-		growthImpact = 0.7 + rand.Float64()*0.2  // 70-90% growth reduction
-		cacImpact = 1.1 + rand.Float64()*0.1    // 1.1-1.2x CAC
-		churnImpact = 0.02 + rand.Float64()*0.02 // 2-4% churn
+		growthImpact = 0.7 + fs.rng.Float64()*0.2  // 70-90% growth reduction
+		cacImpact = 1.1 + fs.rng.Float64()*0.1    // 1.1-1.2x CAC
+		churnImpact = 0.02 + fs.rng.Float64()*0.02 // 2-4% churn
 		fundingImpact = 0.7                       // 70% funding availability
-		customerBudgetCut = 0.1 + rand.Float64()*0.1 // 10-20% budget cuts
-		durationMonths = 3 + rand.Intn(4)        // 3-6 months
+		customerBudgetCut = 0.1 + fs.rng.Float64()*0.1 // 10-20% budget cuts
+		durationMonths = 3 + fs.rng.Intn(4)        // 3-6 months
 	}
 
 	event := EconomicEvent{
@@ -84,6 +83,23 @@ func (fs *FounderState) SpawnEconomicEvent() *EconomicEvent {
 	return &event
 }
 
+// StartInFundingWinter opens the game in a funding winter: investors are scarce
+// for the first 18 months while customers keep buying, mostly
+func (fs *FounderState) StartInFundingWinter() {
+	fs.EconomicEvent = &EconomicEvent{
+		Type:              "funding_winter",
+		Severity:          "severe",
+		Month:             fs.Turn,
+		DurationMonths:    18,
+		GrowthImpact:      0.98,
+		CACImpact:         1.01,
+		ChurnImpact:       0,
+		FundingImpact:     0.3,
+		CustomerBudgetCut: 0.1,
+		Active:            true,
+	}
+}
+
 // ExecuteSurvivalStrategy executes a survival strategy
 func (fs *FounderState) ExecuteSurvivalStrategy(strategy string) error {
 	validStrategies := map[string]bool{
@@ -104,7 +120,7 @@ func (fs *FounderState) ExecuteSurvivalStrategy(strategy string) error {
 	switch strategy {
 	case "cut_costs":
 		// Layoffs: reduce team by 20-40%
-		layoffPercent := 0.20 + rand.Float64()*0.20
+		layoffPercent := 0.20 + fs.rng.Float64()*0.20
 		engineersToLayoff := int(float64(len(fs.Team.Engineers)) * layoffPercent)
 		salesToLayoff := int(float64(len(fs.Team.Sales)) * layoffPercent)
 		csToLayoff := int(float64(len(fs.Team.CustomerSuccess)) * layoffPercent)
@@ -112,25 +128,26 @@ func (fs *FounderState) ExecuteSurvivalStrategy(strategy string) error {
 
 		// Remove employees
 		if engineersToLayoff > 0 && len(fs.Team.Engineers) > engineersToLayoff {
-			fs.Team.Engineers = fs.Team.Engineers[:len(fs.Team.Engineers)-engineersToLayoff]
+			fs.removeLowestPerformers(&fs.Team.Engineers, engineersToLayoff, "laid off")
 		}
 		if salesToLayoff > 0 && len(fs.Team.Sales) > salesToLayoff {
-			fs.Team.Sales = fs.Team.Sales[:len(fs.Team.Sales)-salesToLayoff]
+			fs.removeLowestPerformers(&fs.Team.Sales, salesToLayoff, "laid off")
 		}
 		if csToLayoff > 0 && len(fs.Team.CustomerSuccess) > csToLayoff {
-			fs.Team.CustomerSuccess = fs.Team.CustomerSuccess[:len(fs.Team.CustomerSuccess)-csToLayoff]
+			fs.removeLowestPerformers(&fs.Team.CustomerSuccess, csToLayoff, "laid off")
 		}
 		if marketingToLayoff > 0 && len(fs.Team.Marketing) > marketingToLayoff {
-			fs.Team.Marketing = fs.Team.Marketing[:len(fs.Team.Marketing)-marketingToLayoff]
+			fs.removeLowestPerformers(&fs.Team.Marketing, marketingToLayoff, "laid off")
 		}
 
+		fs.recordLayoff()
 		fs.CalculateTeamCost()
 		cost = 0 // No cash cost, but severance is implicit
 		effectiveness = 0.6
 		tradeoffs = []string{"Reduced team productivity", "Lower growth capacity", "Morale impact"}
 
 	case "pivot":
-		cost = 100000 + rand.Int63n(200000) // $100-300k
+		cost = 100000 + fs.rng.Int63n(200000) // $100-300k
 		if cost > fs.Cash {
 			return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
@@ -153,13 +170,13 @@ func (fs *FounderState) ExecuteSurvivalStrategy(strategy string) error {
 
 	case "acquire":
 		// Acquire struggling competitors cheap
-		cost = 200000 + rand.Int63n(300000) // $200-500k (cheap during downturn)
+		cost = 200000 + fs.rng.Int63n(300000) // $200-500k (cheap during downturn)
 		if cost > fs.Cash {
 			return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
 		fs.Cash -= cost
 		// Gain customers and MRR (simplified)
-		customersGained := 10 + rand.Intn(20)
+		customersGained := 10 + fs.rng.Intn(20)
 		mrrGained := int64(customersGained) * fs.AvgDealSize
 		fs.Customers += customersGained
 		fs.DirectCustomers += customersGained
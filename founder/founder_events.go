@@ -2,13 +2,12 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 func (fs *FounderState) SpawnCompetitor() *Competitor {
 	// 8% chance per month after month 6 (increased from 3% after month 12)
 	// This ensures competitors spawn more reliably
-	if fs.Turn < 6 || rand.Float64() > 0.08 {
+	if fs.Turn < 6 || fs.rng.Float64() > 0.08 {
 		return nil
 	}
 
@@ -40,20 +39,20 @@ func (fs *FounderState) SpawnCompetitor() *Competitor {
 		{"Bro", "Social app", "low"},
 	}
 
-	selected := siliconValleyStartups[rand.Intn(len(siliconValleyStartups))]
+	selected := siliconValleyStartups[fs.rng.Intn(len(siliconValleyStartups))]
 
 	// Determine threat level based on bias and randomness
 	threat := selected.ThreatBias
 
 	// 30% chance to deviate from bias
-	if rand.Float64() < 0.3 {
+	if fs.rng.Float64() < 0.3 {
 		// Can go up or down one level
-		if selected.ThreatBias == "high" && rand.Float64() < 0.5 {
+		if selected.ThreatBias == "high" && fs.rng.Float64() < 0.5 {
 			threat = "medium"
-		} else if selected.ThreatBias == "low" && rand.Float64() < 0.5 {
+		} else if selected.ThreatBias == "low" && fs.rng.Float64() < 0.5 {
 			threat = "medium"
 		} else if selected.ThreatBias == "medium" {
-			if rand.Float64() < 0.5 {
+			if fs.rng.Float64() < 0.5 {
 				threat = "high"
 			} else {
 				threat = "low"
@@ -64,11 +63,11 @@ func (fs *FounderState) SpawnCompetitor() *Competitor {
 	var marketShare float64
 	switch threat {
 	case "low":
-		marketShare = 0.01 + rand.Float64()*0.04 // 1-5%
+		marketShare = 0.01 + fs.rng.Float64()*0.04 // 1-5%
 	case "medium":
-		marketShare = 0.05 + rand.Float64()*0.10 // 5-15%
+		marketShare = 0.05 + fs.rng.Float64()*0.10 // 5-15%
 	case "high":
-		marketShare = 0.10 + rand.Float64()*0.15 // 10-25%
+		marketShare = 0.10 + fs.rng.Float64()*0.15 // 10-25%
 	}
 
 	comp := Competitor{
@@ -79,6 +78,7 @@ func (fs *FounderState) SpawnCompetitor() *Competitor {
 		MonthAppeared: fs.Turn,
 		Active:        true,
 	}
+	fs.initCompetitorCompany(&comp)
 
 	fs.Competitors = append(fs.Competitors, comp)
 	return &comp
@@ -104,7 +104,7 @@ func (fs *FounderState) HandleCompetitor(compIndex int, strategy string) (string
 		return fmt.Sprintf("Ignoring %s. They may gain market share.", comp.Name), nil
 
 	case "compete":
-		cost := int64(50000 + rand.Int63n(100000)) // $50-150k
+		cost := int64(50000 + fs.rng.Int63n(100000)) // $50-150k
 		if cost > fs.Cash {
 			return "", fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
@@ -125,7 +125,7 @@ func (fs *FounderState) HandleCompetitor(compIndex int, strategy string) (string
 			formatCurrency(cost), comp.Name, comp.Threat), nil
 
 	case "partner":
-		cost := int64(100000 + rand.Int63n(150000)) // $100-250k
+		cost := int64(100000 + fs.rng.Int63n(150000)) // $100-250k
 		if cost > fs.Cash {
 			return "", fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
@@ -142,7 +142,7 @@ func (fs *FounderState) HandleCompetitor(compIndex int, strategy string) (string
 		var totalMRR int64
 		var dealSizes []int64 // Store deal sizes for customer tracking
 		for i := 0; i < newCustomers; i++ {
-			dealSize := generateDealSize(fs.AvgDealSize, fs.Category)
+			dealSize := generateDealSize(fs.rng, fs.AvgDealSize, fs.Category)
 			fs.updateDealSizeRange(dealSize)
 			totalMRR += dealSize
 			dealSizes = append(dealSizes, dealSize)
@@ -183,18 +183,15 @@ func (fs *FounderState) UpdateCompetitors() []string {
 			continue
 		}
 
+		// Run the competitor's business: funding, hiring, launches, pricing, poaching
+		messages = append(messages, fs.simulateCompetitor(comp)...)
+		if !comp.Active {
+			continue
+		}
+
 		// Ignored competitors grow stronger
 		if comp.Strategy == "ignore" {
-			comp.MarketShare *= 1.05 // Grow 5% per month
-
-			// May increase threat level
-			if comp.MarketShare > 0.20 && comp.Threat != "high" {
-				comp.Threat = "high"
-				messages = append(messages, fmt.Sprintf("⚠️  %s is now a HIGH threat!", comp.Name))
-			} else if comp.MarketShare > 0.10 && comp.Threat == "low" {
-				comp.Threat = "medium"
-				messages = append(messages, fmt.Sprintf("⚠️  %s is now a MEDIUM threat", comp.Name))
-			}
+			comp.MarketShare *= 1.02
 		}
 
 		// Competing with them slows their growth
@@ -202,107 +199,24 @@ func (fs *FounderState) UpdateCompetitors() []string {
 			comp.MarketShare *= 0.95 // Shrink 5% per month
 			if comp.MarketShare < 0.02 {
 				comp.Active = false
+				comp.ExitType = "shutdown"
+				comp.ExitMonth = fs.Turn
 				messages = append(messages, fmt.Sprintf("✅ %s has exited the market!", comp.Name))
+				continue
 			}
 		}
 
-		// AI Competitor Actions - Silicon Valley companies are strategic
-		if rand.Float64() < 0.15 { // 15% chance per month of competitor action
-			actionType := rand.Float64()
-
-			if actionType < 0.4 {
-				// Steal customers (40% chance)
-				customersStolen := int(float64(fs.Customers) * comp.MarketShare * (0.05 + rand.Float64()*0.10)) // 5-15% of their market share
-				if customersStolen > 0 && fs.Customers > 0 {
-					// Cap at reasonable amount
-					if customersStolen > fs.Customers/10 {
-						customersStolen = fs.Customers / 10
-					}
-
-					mrrLost := int64(customersStolen) * fs.AvgDealSize
-					fs.Customers -= customersStolen
-					fs.DirectCustomers -= customersStolen
-					fs.MRR -= mrrLost
-					fs.DirectMRR -= mrrLost
-
-					// Hooli is especially aggressive
-					if comp.Name == "Hooli" || comp.Name == "Hooli Search" {
-						customersStolen = int(float64(customersStolen) * 1.5)
-						mrrLost = int64(float64(mrrLost) * 1.5)
-					}
-
-					messages = append(messages, fmt.Sprintf("🔥 %s stole %d customers from you! (-$%s MRR)",
-						comp.Name, customersStolen, formatCurrency(mrrLost)))
-					comp.MarketShare *= 1.1 // Competitor gains market share
-				}
-			} else if actionType < 0.7 {
-				// Launch competing product/feature (30% chance)
-				// This increases their threat and market share
-				if comp.Threat != "high" {
-					comp.Threat = "high"
-				}
-				comp.MarketShare *= 1.15
-
-				// Your CAC increases due to competition
-				fs.BaseCAC = int64(float64(fs.BaseCAC) * 1.1)
-
-				// Hooli launches "Box" style competing products
-				if comp.Name == "Hooli" || comp.Name == "Hooli Box" {
-					messages = append(messages, fmt.Sprintf("🚨 %s launched a competing product! Your CAC increased by 10%%",
-						comp.Name))
-				} else {
-					messages = append(messages, fmt.Sprintf("🚨 %s launched a competing feature! Market competition increased",
-						comp.Name))
-				}
-			} else if actionType < 0.85 {
-				// Aggressive pricing/promotion (15% chance)
-				// Competitor undercuts you, reducing your growth
-				growthReduction := 0.05 + rand.Float64()*0.10 // 5-15% growth reduction
-				fs.MonthlyGrowthRate *= (1.0 - growthReduction)
-
-				if comp.Name == "Hooli" {
-					messages = append(messages, fmt.Sprintf("💰 %s launched aggressive pricing! Your growth rate reduced by %.0f%%",
-						comp.Name, growthReduction*100))
-				} else {
-					messages = append(messages, fmt.Sprintf("💰 %s is undercutting your prices! Growth slowed",
-						comp.Name))
-				}
-			} else {
-				// Poach talent (15% chance)
-				// Competitor hires away employees
-				if fs.Team.TotalEmployees > 0 {
-					employeesPoached := 1
-					if fs.Team.TotalEmployees > 10 {
-						employeesPoached = 1 + rand.Intn(2)
-					}
-
-					// Remove employees (simplified - just reduce team cost)
-					costReduction := int64(employeesPoached) * 8333 // ~$100k/year per employee
-					fs.MonthlyTeamCost -= costReduction
-					if fs.MonthlyTeamCost < 0 {
-						fs.MonthlyTeamCost = 0
-					}
-
-					// Reduce productivity (but don't drop below 95% if product was fully mature)
-					prevMaturity := fs.ProductMaturity
-					fs.ProductMaturity *= 0.98 // Slight reduction
-					if prevMaturity >= 1.0 && fs.ProductMaturity < 0.95 {
-						fs.ProductMaturity = 0.95
-					}
-
-					if comp.Name == "Hooli" || comp.Name == "Action Jack's Company" {
-						messages = append(messages, fmt.Sprintf("👔 %s poached %d employee(s) from you! Team productivity reduced",
-							comp.Name, employeesPoached))
-					} else {
-						messages = append(messages, fmt.Sprintf("👔 %s hired away %d employee(s)!",
-							comp.Name, employeesPoached))
-					}
-				}
-			}
+		// Threat follows market share
+		if comp.MarketShare > 0.20 && comp.Threat != "high" && comp.Threat != "critical" {
+			comp.Threat = "high"
+			messages = append(messages, fmt.Sprintf("⚠️  %s is now a HIGH threat!", comp.Name))
+		} else if comp.MarketShare > 0.10 && comp.Threat == "low" {
+			comp.Threat = "medium"
+			messages = append(messages, fmt.Sprintf("⚠️  %s is now a MEDIUM threat", comp.Name))
 		}
 
 		// Hooli-specific behaviors - they're always up to something
-		if (comp.Name == "Hooli" || comp.Name == "Gavin Belson's New Thing") && rand.Float64() < 0.1 {
+		if (comp.Name == "Hooli" || comp.Name == "Gavin Belson's New Thing") && fs.rng.Float64() < 0.1 {
 			// 10% chance Hooli does something dramatic
 			hooliActions := []string{
 				"launched a massive marketing campaign",
@@ -310,7 +224,7 @@ func (fs *FounderState) UpdateCompetitors() []string {
 				"hired away a key executive",
 				"filed a patent lawsuit",
 			}
-			action := hooliActions[rand.Intn(len(hooliActions))]
+			action := hooliActions[fs.rng.Intn(len(hooliActions))]
 
 			switch action {
 			case "launched a massive marketing campaign":
@@ -330,7 +244,7 @@ func (fs *FounderState) UpdateCompetitors() []string {
 				}
 				messages = append(messages, fmt.Sprintf("💼 Hooli %s! Product development slowed", action))
 			case "filed a patent lawsuit":
-				legalCost := int64(50000 + rand.Int63n(100000))
+				legalCost := int64(50000 + fs.rng.Int63n(100000))
 				fs.Cash -= legalCost
 				messages = append(messages, fmt.Sprintf("⚖️  Hooli %s! Legal costs: $%s", action, formatCurrency(legalCost)))
 			}
@@ -428,7 +342,7 @@ func (fs *FounderState) ExpandToMarket(region string) (*Market, error) {
 	var initialMRR int64
 	var dealSizes []int64 // Store deal sizes for customer tracking
 	for i := 0; i < initialCustomers; i++ {
-		dealSize := generateDealSize(fs.AvgDealSize, fs.Category)
+		dealSize := generateDealSize(fs.rng, fs.AvgDealSize, fs.Category)
 		fs.updateDealSizeRange(dealSize)
 		initialMRR += dealSize
 		dealSizes = append(dealSizes, dealSize)
@@ -466,17 +380,17 @@ func (fs *FounderState) ExpandToMarket(region string) (*Market, error) {
 	}
 
 	// Increase global churn rate due to operational complexity
-	fs.CustomerChurnRate += 0.01 + (rand.Float64() * 0.01)
+	fs.CustomerChurnRate += 0.01 + (fs.rng.Float64() * 0.01)
 
 	// Add regional competitors based on competition level
 	numCompetitors := 0
 	switch competition {
 	case "very_high":
-		numCompetitors = 2 + rand.Intn(2) // 2-3 competitors
+		numCompetitors = 2 + fs.rng.Intn(2) // 2-3 competitors
 	case "high":
-		numCompetitors = 1 + rand.Intn(2) // 1-2 competitors
+		numCompetitors = 1 + fs.rng.Intn(2) // 1-2 competitors
 	case "medium":
-		numCompetitors = rand.Intn(2) // 0-1 competitors
+		numCompetitors = fs.rng.Intn(2) // 0-1 competitors
 	case "low":
 		numCompetitors = 0 // No competitors
 	}
@@ -493,7 +407,7 @@ func (fs *FounderState) ExpandToMarket(region string) (*Market, error) {
 
 	if names, ok := regionalCompetitors[region]; ok && numCompetitors > 0 {
 		for i := 0; i < numCompetitors && i < len(names); i++ {
-			compName := names[rand.Intn(len(names))]
+			compName := names[fs.rng.Intn(len(names))]
 
 			// Check if competitor already exists
 			exists := false
@@ -506,18 +420,18 @@ func (fs *FounderState) ExpandToMarket(region string) (*Market, error) {
 
 			if !exists {
 				threatLevel := "medium"
-				marketShare := 0.05 + rand.Float64()*0.15 // 5-20% market share
+				marketShare := 0.05 + fs.rng.Float64()*0.15 // 5-20% market share
 
 				switch competition {
 				case "very_high":
 					threatLevel = "high"
-					marketShare = 0.10 + rand.Float64()*0.20 // 10-30%
+					marketShare = 0.10 + fs.rng.Float64()*0.20 // 10-30%
 				case "high":
 					threatLevel = "high"
-					marketShare = 0.08 + rand.Float64()*0.15 // 8-23%
+					marketShare = 0.08 + fs.rng.Float64()*0.15 // 8-23%
 				case "medium":
 					threatLevel = "medium"
-					marketShare = 0.05 + rand.Float64()*0.10 // 5-15%
+					marketShare = 0.05 + fs.rng.Float64()*0.10 // 5-15%
 				}
 
 				competitor := Competitor{
@@ -656,7 +570,7 @@ func (fs *FounderState) UpdateGlobalMarkets() []string {
 
 		// Now attempt growth
 		// Base growth rate - you need sales/marketing to grow in new markets
-		baseGrowth := 0.03 + (rand.Float64() * 0.02) // 3-5% base monthly growth
+		baseGrowth := 0.03 + (fs.rng.Float64() * 0.02) // 3-5% base monthly growth
 
 		// Count employees assigned to this market or "All" markets
 		salesInMarket := 0
@@ -742,7 +656,7 @@ func (fs *FounderState) UpdateGlobalMarkets() []string {
 			// Calculate MRR with variable deal sizes
 			var totalMRR int64
 			for i := 0; i < newCustomers; i++ {
-				dealSize := generateDealSize(fs.AvgDealSize, fs.Category)
+				dealSize := generateDealSize(fs.rng, fs.AvgDealSize, fs.Category)
 				fs.updateDealSizeRange(dealSize)
 				totalMRR += dealSize
 			}
@@ -783,7 +697,7 @@ func (fs *FounderState) UpdateGlobalMarkets() []string {
 // ============================================================================
 
 func (fs *FounderState) ExecutePivot(toStrategy string, reason string) (*Pivot, error) {
-	cost := int64(100000 + rand.Int63n(200000)) // $100-300k
+	cost := int64(100000 + fs.rng.Int63n(200000)) // $100-300k
 	if cost > fs.Cash {
 		return nil, fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 	}
@@ -791,7 +705,7 @@ func (fs *FounderState) ExecutePivot(toStrategy string, reason string) (*Pivot,
 	fs.Cash -= cost
 
 	// Lose customers during pivot (20-50%)
-	churnRate := 0.20 + rand.Float64()*0.30
+	churnRate := 0.20 + fs.rng.Float64()*0.30
 	customersLost := int(float64(fs.Customers) * churnRate)
 	mrrLost := int64(customersLost) * fs.AvgDealSize
 
@@ -822,7 +736,7 @@ func (fs *FounderState) ExecutePivot(toStrategy string, reason string) (*Pivot,
 		successChance += 0.20 // +20% if early (under 2 years)
 	}
 
-	success := rand.Float64() < successChance
+	success := fs.rng.Float64() < successChance
 
 	pivot := Pivot{
 		Month:         fs.Turn,
@@ -837,7 +751,7 @@ func (fs *FounderState) ExecutePivot(toStrategy string, reason string) (*Pivot,
 	if success {
 		fs.StartupType = toStrategy
 		// Expand market size on successful pivot
-		fs.TargetMarketSize = int(float64(fs.TargetMarketSize) * (1.5 + rand.Float64()))
+		fs.TargetMarketSize = int(float64(fs.TargetMarketSize) * (1.5 + fs.rng.Float64()))
 	}
 
 	fs.PivotHistory = append(fs.PivotHistory, pivot)
@@ -858,10 +772,10 @@ func (fs *FounderState) SpawnRandomEvent() *RandomEvent {
 
 	// Choose event type
 	eventTypes := []string{"economy", "regulation", "competition", "talent", "customer", "product", "legal", "press"}
-	eventType := eventTypes[rand.Intn(len(eventTypes))]
+	eventType := eventTypes[fs.rng.Intn(len(eventTypes))]
 
 	// 40% chance of positive event
-	isPositive := rand.Float64() < 0.4
+	isPositive := fs.rng.Float64() < 0.4
 
 	var event *RandomEvent
 
@@ -946,7 +860,7 @@ func (fs *FounderState) generateEconomyEvent(isPositive bool) *RandomEvent {
 		if fs.Category == "Hardware" || fs.Category == "Deep Tech" {
 			severity = "major"
 			impact.CACChange = 1.3 // +30% CAC due to tariffs
-			impact.CashCost = 20000 + rand.Int63n(30000)
+			impact.CashCost = 20000 + fs.rng.Int63n(30000)
 			return &RandomEvent{
 				Severity:    severity,
 				IsPositive:  false,
@@ -981,8 +895,8 @@ func (fs *FounderState) generateRegulationEvent(isPositive bool) *RandomEvent {
 		}
 	} else {
 		impact := EventImpact{
-			CashCost:       50000 + rand.Int63n(100000), // $50-150k compliance
-			GrowthChange:   0.90,                        // -10% growth
+			CashCost:       50000 + fs.rng.Int63n(100000), // $50-150k compliance
+			GrowthChange:   0.90,                          // -10% growth
 			DurationMonths: 12,
 		}
 
@@ -1040,12 +954,12 @@ func (fs *FounderState) generateCompetitionEvent(isPositive bool) *RandomEvent {
 		}
 	} else {
 		// Check for open source threat
-		if rand.Float64() < 0.3 {
+		if fs.rng.Float64() < 0.3 {
 			// Also spawn a competitor for the open source alternative
 			comp := Competitor{
 				Name:          "OSS Alternative",
 				Threat:        "high",
-				MarketShare:   0.05 + rand.Float64()*0.10,
+				MarketShare:   0.05 + fs.rng.Float64()*0.10,
 				Strategy:      "ignore",
 				MonthAppeared: fs.Turn,
 				Active:        true,
@@ -1068,7 +982,7 @@ func (fs *FounderState) generateCompetitionEvent(isPositive bool) *RandomEvent {
 
 		// Spawn an actual competitor entry for the well-funded competitor
 		compNames := []string{"Funded Rival", "Series B Startup", "Tiger Global Portfolio Co", "a16z Backed Co", "Sequoia Portfolio Co"}
-		compName := compNames[rand.Intn(len(compNames))]
+		compName := compNames[fs.rng.Intn(len(compNames))]
 		// Avoid duplicate names
 		for _, existing := range fs.Competitors {
 			if existing.Name == compName {
@@ -1079,7 +993,7 @@ func (fs *FounderState) generateCompetitionEvent(isPositive bool) *RandomEvent {
 		comp := Competitor{
 			Name:          compName,
 			Threat:        "high",
-			MarketShare:   0.10 + rand.Float64()*0.15,
+			MarketShare:   0.10 + fs.rng.Float64()*0.15,
 			Strategy:      "ignore",
 			MonthAppeared: fs.Turn,
 			Active:        true,
@@ -1116,7 +1030,7 @@ func (fs *FounderState) generateTalentEvent(isPositive bool) *RandomEvent {
 		// Key employees quit
 		numQuitting := 1
 		if fs.Team.TotalEmployees > 10 {
-			numQuitting = 1 + rand.Intn(2)
+			numQuitting = 1 + fs.rng.Intn(2)
 		}
 
 		return &RandomEvent{
@@ -1141,8 +1055,8 @@ func (fs *FounderState) generateCustomerEvent(isPositive bool) *RandomEvent {
 			Title:       "Enterprise Customer Win!",
 			Description: "Fortune 500 company signs major contract",
 			Impact: EventImpact{
-				CashCost:       -(50000 + rand.Int63n(150000)), // $50-200k deal
-				DurationMonths: 0,                              // One-time
+				CashCost:       -(50000 + fs.rng.Int63n(150000)), // $50-200k deal
+				DurationMonths: 0,                                // One-time
 			},
 		}
 	} else {
@@ -1179,8 +1093,8 @@ func (fs *FounderState) generateProductEvent(isPositive bool) *RandomEvent {
 			Title:       "Critical Bug in Production",
 			Description: "Major outage affects all customers for 48 hours",
 			Impact: EventImpact{
-				ChurnChange:    0.05,                       // +5% churn
-				CashCost:       10000 + rand.Int63n(20000), // Emergency fixes
+				ChurnChange:    0.05,                         // +5% churn
+				CashCost:       10000 + fs.rng.Int63n(20000), // Emergency fixes
 				DurationMonths: 1,
 			},
 		}
@@ -1206,7 +1120,7 @@ func (fs *FounderState) generateLegalEvent(isPositive bool) *RandomEvent {
 			Title:       "Patent Infringement Claim",
 			Description: "Large company alleges patent violation, requires legal defense",
 			Impact: EventImpact{
-				CashCost:       100000 + rand.Int63n(200000), // $100-300k legal fees
+				CashCost:       100000 + fs.rng.Int63n(200000), // $100-300k legal fees
 				DurationMonths: 0,
 			},
 		}
@@ -1274,24 +1188,16 @@ func (fs *FounderState) ProcessRandomEvents() []string {
 func (fs *FounderState) handleEmployeeLoss(count int) {
 	for i := 0; i < count; i++ {
 		// Randomly pick a team to lose from
-		roll := rand.Intn(4)
+		roll := fs.rng.Intn(4)
 		switch roll {
 		case 0:
-			if len(fs.Team.Engineers) > 0 {
-				fs.Team.Engineers = fs.Team.Engineers[:len(fs.Team.Engineers)-1]
-			}
+			fs.removeLastEmployees(&fs.Team.Engineers, 1, "quit")
 		case 1:
-			if len(fs.Team.Sales) > 0 {
-				fs.Team.Sales = fs.Team.Sales[:len(fs.Team.Sales)-1]
-			}
+			fs.removeLastEmployees(&fs.Team.Sales, 1, "quit")
 		case 2:
-			if len(fs.Team.CustomerSuccess) > 0 {
-				fs.Team.CustomerSuccess = fs.Team.CustomerSuccess[:len(fs.Team.CustomerSuccess)-1]
-			}
+			fs.removeLastEmployees(&fs.Team.CustomerSuccess, 1, "quit")
 		case 3:
-			if len(fs.Team.Marketing) > 0 {
-				fs.Team.Marketing = fs.Team.Marketing[:len(fs.Team.Marketing)-1]
-			}
+			fs.removeLastEmployees(&fs.Team.Marketing, 1, "quit")
 		}
 	}
 	fs.CalculateTeamCost()
@@ -119,7 +119,7 @@ func (fs *FounderState) GenerateTermSheetOptions(roundName string) []TermSheetOp
 }
 
 
-func GenerateInvestorNames(roundName string, amount int64) []string {
+func GenerateInvestorNames(rng *rand.Rand, roundName string, amount int64) []string {
 	var investors []string
 
 	// Angel/Pre-Seed: individual angels
@@ -159,46 +159,46 @@ func GenerateInvestorNames(roundName string, amount int64) []string {
 	switch roundName {
 	case "Angel", "Pre-Seed":
 		// 2-4 angels
-		count := 2 + rand.Intn(3)
+		count := 2 + rng.Intn(3)
 		for i := 0; i < count && i < len(angelInvestors); i++ {
-			investors = append(investors, angelInvestors[rand.Intn(len(angelInvestors))])
+			investors = append(investors, angelInvestors[rng.Intn(len(angelInvestors))])
 		}
 
 	case "Seed":
 		// Lead VC + 1-2 angels or micro VCs
-		investors = append(investors, seedFirms[rand.Intn(len(seedFirms))])
-		if rand.Float64() > 0.5 {
-			investors = append(investors, angelInvestors[rand.Intn(len(angelInvestors))])
+		investors = append(investors, seedFirms[rng.Intn(len(seedFirms))])
+		if rng.Float64() > 0.5 {
+			investors = append(investors, angelInvestors[rng.Intn(len(angelInvestors))])
 		}
 		if amount > 2000000 { // Larger seed rounds have more investors
-			investors = append(investors, seedFirms[rand.Intn(len(seedFirms))])
+			investors = append(investors, seedFirms[rng.Intn(len(seedFirms))])
 		}
 
 	case "Series A":
 		// Lead VC + co-investors
-		investors = append(investors, seriesAFirms[rand.Intn(len(seriesAFirms))])
+		investors = append(investors, seriesAFirms[rng.Intn(len(seriesAFirms))])
 		if amount > 10000000 {
-			investors = append(investors, seriesAFirms[rand.Intn(len(seriesAFirms))])
+			investors = append(investors, seriesAFirms[rng.Intn(len(seriesAFirms))])
 		}
 		// Sometimes strategic or family office
-		if rand.Float64() > 0.7 {
-			investors = append(investors, familyOffices[rand.Intn(len(familyOffices))])
+		if rng.Float64() > 0.7 {
+			investors = append(investors, familyOffices[rng.Intn(len(familyOffices))])
 		}
 
 	case "Series B", "Series C", "Series D":
 		// Growth firms + existing investors
-		investors = append(investors, growthFirms[rand.Intn(len(growthFirms))])
-		investors = append(investors, seriesAFirms[rand.Intn(len(seriesAFirms))])
+		investors = append(investors, growthFirms[rng.Intn(len(growthFirms))])
+		investors = append(investors, seriesAFirms[rng.Intn(len(seriesAFirms))])
 		if amount > 50000000 {
-			investors = append(investors, growthFirms[rand.Intn(len(growthFirms))])
+			investors = append(investors, growthFirms[rng.Intn(len(growthFirms))])
 		}
-		if rand.Float64() > 0.6 {
-			investors = append(investors, familyOffices[rand.Intn(len(familyOffices))])
+		if rng.Float64() > 0.6 {
+			investors = append(investors, familyOffices[rng.Intn(len(familyOffices))])
 		}
 
 	default:
 		// Generic round - mix it up
-		investors = append(investors, seriesAFirms[rand.Intn(len(seriesAFirms))])
+		investors = append(investors, seriesAFirms[rng.Intn(len(seriesAFirms))])
 	}
 
 	return investors
@@ -206,6 +206,16 @@ func GenerateInvestorNames(roundName string, amount int64) []string {
 
 
 func (fs *FounderState) RaiseFundingWithTerms(roundName string, option TermSheetOption) (success bool) {
+	// Generate investor names for this round
+	investors := fs.roundInvestors(roundName, option.Amount)
+	fs.closeFundingRound(roundName, option, investors)
+	fs.FundingRounds[len(fs.FundingRounds)-1].ProRataInvestors = investors[:1]
+
+	return true
+}
+
+// closeFundingRound books a round: cash in, cap table, and lead investor board seats
+func (fs *FounderState) closeFundingRound(roundName string, option TermSheetOption, investors []string) {
 	// Apply Better Terms upgrade (-5% equity given away)
 	equityToGive := option.Equity
 	hasBetterTerms := false
@@ -218,21 +228,31 @@ func (fs *FounderState) RaiseFundingWithTerms(roundName string, option TermSheet
 	if hasBetterTerms {
 		equityToGive = option.Equity * 0.95 // 5% reduction
 	}
-	
+
 	fs.Cash += option.Amount
 	fs.EquityGivenAway += equityToGive
 
-	// Generate investor names for this round
-	investors := GenerateInvestorNames(roundName, option.Amount)
+	// New money is senior to earlier rounds unless it took pari passu terms
+	prefMultiple, participating, pariPassu := preferenceTermsFor(option.Terms)
+	seniority := 1
+	if n := len(fs.FundingRounds); n > 0 {
+		seniority = fs.FundingRounds[n-1].Seniority
+		if !pariPassu {
+			seniority++
+		}
+	}
 
 	round := FundingRound{
-		RoundName:   roundName,
-		Amount:      option.Amount,
-		Valuation:   option.PreValuation,
-		EquityGiven: equityToGive, // Use reduced equity if Better Terms upgrade is active
-		Month:       fs.Turn,
-		Terms:       option.Terms,
-		Investors:   investors,
+		RoundName:       roundName,
+		Amount:          option.Amount,
+		Valuation:       option.PreValuation,
+		EquityGiven:     equityToGive, // Use reduced equity if Better Terms upgrade is active
+		Month:           fs.Turn,
+		Terms:           option.Terms,
+		Investors:       investors,
+		LiquidationPref: prefMultiple,
+		Participating:   participating,
+		Seniority:       seniority,
 	}
 	fs.FundingRounds = append(fs.FundingRounds, round)
 
@@ -277,9 +297,10 @@ func (fs *FounderState) RaiseFundingWithTerms(roundName string, option TermSheet
 		fs.BoardSeats++
 	}
 
-	fs.CalculateRunway()
+	// A priced round resets the fair market value of common
+	fs.Run409A()
 
-	return true
+	fs.CalculateRunway()
 }
 
 
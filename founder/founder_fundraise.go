@@ -0,0 +1,507 @@
+package founder
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jamesacampbell/unicorn/investors"
+)
+
+// InvestorProspect is an investor on the founder's fundraising target list
+type InvestorProspect struct {
+	Name         string
+	Stage        string  // "target", "first_meeting", "partner_meeting", "term_sheet", "passed"
+	Interest     float64 // 0-1, how keen the investor is on the company
+	MeetingsHeld int
+	PassReason   string  // Why the investor passed (if they did)
+	Strategy     string  // Roster firm's strategy ("" for angels and other names)
+	ThesisFit    float64 // How well the company fits a roster firm's thesis
+}
+
+// TermSheet is an offer from a specific investor during a fundraising process
+type TermSheet struct {
+	Investor      string
+	Amount        int64
+	PreValuation  int64
+	PostValuation int64
+	Equity        float64
+	BoardSeats    int
+	ProRata       bool // Investor gets pro-rata rights in future rounds
+	Terms         string
+	MonthIssued   int
+	ExpiresMonth  int
+	Negotiations  int // Times the founder has pushed back on this sheet
+}
+
+// FundraisingProcess tracks a multi-month raise from first meeting to close
+type FundraisingProcess struct {
+	RoundName         string
+	StartMonth        int
+	Deadline          int // Month after which the round is considered failed
+	TargetAmount      int64
+	Prospects         []InvestorProspect
+	TermSheets        []TermSheet
+	MeetingsThisMonth int
+	MaxMeetings       int     // Founder time is limited - meetings per month
+	Momentum          float64 // 0-1, FOMO built up from competing term sheets
+	Status            string  // "active", "closed", "failed", "abandoned"
+}
+
+// FundraiseAttempt is a historical record of a fundraising process
+type FundraiseAttempt struct {
+	RoundName  string
+	StartMonth int
+	EndMonth   int
+	Outcome    string // "closed", "failed", "abandoned"
+	TermSheets int
+	Amount     int64
+}
+
+const (
+	fundraiseDurationMonths = 6 // Rounds must close within six months
+	fundraiseCooldownMonths = 3 // Wait after a failed raise before trying again
+	termSheetExpiryMonths   = 2
+)
+
+// CanStartFundraise checks whether a new fundraising process can begin for the round
+func (fs *FounderState) CanStartFundraise(roundName string) (bool, string) {
+	if fs.ActiveFundraise != nil && fs.ActiveFundraise.Status == "active" {
+		return false, fmt.Sprintf("already raising %s", fs.ActiveFundraise.RoundName)
+	}
+	for _, round := range fs.FundingRounds {
+		if round.RoundName == roundName {
+			return false, fmt.Sprintf("%s already raised", roundName)
+		}
+	}
+	for _, attempt := range fs.FundraiseHistory {
+		if attempt.RoundName == roundName && attempt.Outcome == "failed" &&
+			fs.Turn-attempt.EndMonth < fundraiseCooldownMonths {
+			return false, fmt.Sprintf("investors just passed on your %s - wait %d more month(s)",
+				roundName, fundraiseCooldownMonths-(fs.Turn-attempt.EndMonth))
+		}
+	}
+	if len(fs.GenerateTermSheetOptions(roundName)) == 0 {
+		return false, fmt.Sprintf("unknown round: %s", roundName)
+	}
+	return true, ""
+}
+
+// StartFundraise builds an investor target list and opens a fundraising process
+func (fs *FounderState) StartFundraise(roundName string) (*FundraisingProcess, error) {
+	if ok, reason := fs.CanStartFundraise(roundName); !ok {
+		return nil, fmt.Errorf("%s", reason)
+	}
+
+	standard := fs.GenerateTermSheetOptions(roundName)[1]
+
+	// Build a target list of 6-8 unique investors from the round's investor pool
+	seen := make(map[string]bool)
+	var prospects []InvestorProspect
+	targetCount := 6 + fs.rng.Intn(3)
+
+	// Roster firms whose thesis covers the round come first, then insiders, then the market
+	if roundName != "Angel" {
+		for _, persona := range fs.rosterProspects(roundName) {
+			if len(prospects) >= targetCount-2 {
+				break
+			}
+			seen[persona.Firm] = true
+			fit := persona.ThesisFit(fs.Category, roundName)
+			prospects = append(prospects, InvestorProspect{
+				Name:      persona.Firm,
+				Stage:     "target",
+				Interest:  math.Min(1.0, fs.baseInvestorInterest()*(0.5+fit)),
+				Strategy:  persona.Strategy,
+				ThesisFit: fit,
+			})
+		}
+	}
+	for attempts := 0; len(prospects) < targetCount && attempts < 50; attempts++ {
+		for _, name := range GenerateInvestorNames(fs.rng, roundName, standard.Amount) {
+			if seen[name] || len(prospects) >= targetCount {
+				continue
+			}
+			seen[name] = true
+			prospects = append(prospects, InvestorProspect{
+				Name:     name,
+				Stage:    "target",
+				Interest: fs.baseInvestorInterest(),
+			})
+		}
+	}
+
+	process := &FundraisingProcess{
+		RoundName:    roundName,
+		StartMonth:   fs.Turn,
+		Deadline:     fs.Turn + fundraiseDurationMonths,
+		TargetAmount: standard.Amount,
+		Prospects:    prospects,
+		TermSheets:   []TermSheet{},
+		MaxMeetings:  3,
+		Status:       "active",
+	}
+	fs.ActiveFundraise = process
+
+	return process, nil
+}
+
+// FundraiseMetricsScore rates how fundable the company looks right now (0-1).
+// It blends ARR growth, Rule of 40, burn multiple and the funding market.
+func (fs *FounderState) FundraiseMetricsScore() float64 {
+	score := 0.5
+
+	// ARR growth (monthly growth annualized)
+	annualGrowth := math.Pow(1+fs.MonthlyGrowthRate, 12) - 1
+	switch {
+	case annualGrowth >= 2.0: // Tripling
+		score += 0.2
+	case annualGrowth >= 1.0: // Doubling
+		score += 0.1
+	case annualGrowth < 0.2:
+		score -= 0.15
+	}
+
+	ruleOf40 := fs.CalculateRuleOf40()
+	switch {
+	case ruleOf40 >= 40:
+		score += 0.1
+	case ruleOf40 < 0:
+		score -= 0.1
+	}
+
+	burnMultiple := fs.CalculateBurnMultiple()
+	switch {
+	case burnMultiple == 0: // Profitable or too early to tell
+	case burnMultiple < 1.5:
+		score += 0.1
+	case burnMultiple > 3:
+		score -= 0.15
+	}
+
+	return math.Max(0.05, math.Min(1.0, score*fs.fundingMarketFactor()))
+}
+
+// fundingMarketFactor returns how open the funding market currently is (0.2-1.0)
+func (fs *FounderState) fundingMarketFactor() float64 {
+	if fs.EconomicEvent != nil && fs.EconomicEvent.Active && fs.EconomicEvent.FundingImpact > 0 {
+		return fs.EconomicEvent.FundingImpact
+	}
+	return 1.0
+}
+
+func (fs *FounderState) baseInvestorInterest() float64 {
+	interest := fs.FundraiseMetricsScore() + (fs.rng.Float64()*0.3 - 0.15)
+	return math.Max(0.05, math.Min(1.0, interest))
+}
+
+// TakeInvestorMeeting advances a prospect one step through the pitch process.
+// Investors who stay interested through a partner meeting issue a term sheet.
+func (fs *FounderState) TakeInvestorMeeting(prospectIndex int) (string, error) {
+	process := fs.ActiveFundraise
+	if process == nil || process.Status != "active" {
+		return "", fmt.Errorf("no active fundraise")
+	}
+	if prospectIndex < 0 || prospectIndex >= len(process.Prospects) {
+		return "", fmt.Errorf("invalid investor")
+	}
+	if process.MeetingsThisMonth >= process.MaxMeetings {
+		return "", fmt.Errorf("no meeting slots left this month (%d/%d)", process.MeetingsThisMonth, process.MaxMeetings)
+	}
+
+	p := &process.Prospects[prospectIndex]
+	if p.Stage == "passed" || p.Stage == "term_sheet" {
+		return "", fmt.Errorf("%s is not taking meetings", p.Name)
+	}
+
+	process.MeetingsThisMonth++
+	p.MeetingsHeld++
+
+	// FOMO: competing term sheets make every remaining investor keener
+	effectiveInterest := math.Min(1.0, p.Interest+process.Momentum*0.3)
+
+	if fs.rng.Float64() > effectiveInterest+0.2 {
+		p.Stage = "passed"
+		p.PassReason = fs.investorPassReason()
+		if persona, ok := investors.ByFirm(p.Name); ok && p.ThesisFit < 0.8 {
+			p.PassReason = thesisPassReason(persona)
+		}
+		return fmt.Sprintf("❌ %s passed: %s", p.Name, p.PassReason), nil
+	}
+
+	switch p.Stage {
+	case "target":
+		p.Stage = "first_meeting"
+		p.Interest = math.Min(1.0, p.Interest+0.05)
+		return fmt.Sprintf("☕ First meeting with %s went well - they want to bring it to the partners", p.Name), nil
+	case "first_meeting":
+		p.Stage = "partner_meeting"
+		p.Interest = math.Min(1.0, p.Interest+0.05)
+		return fmt.Sprintf("🏛️  %s invited you to pitch the full partnership", p.Name), nil
+	default:
+		p.Stage = "term_sheet"
+		sheet := fs.generateTermSheet(p.Name, effectiveInterest)
+		process.TermSheets = append(process.TermSheets, sheet)
+		process.Momentum = math.Min(1.0, process.Momentum+0.35)
+		return fmt.Sprintf("📄 %s issued a term sheet: $%s at $%s pre-money",
+			p.Name, formatCurrency(sheet.Amount), formatCurrency(sheet.PreValuation)), nil
+	}
+}
+
+func (fs *FounderState) investorPassReason() string {
+	reasons := []string{
+		"growth isn't there yet",
+		"burn is too high for the revenue",
+		"market looks too crowded",
+		"not a fit for our thesis",
+		"too early for us",
+	}
+	if fs.fundingMarketFactor() < 1.0 {
+		reasons = append(reasons, "we're pausing new deals in this market", "LPs have us focused on the existing portfolio")
+	}
+	return reasons[fs.rng.Intn(len(reasons))]
+}
+
+// generateTermSheet prices a term sheet off the standard round, metrics and competition
+func (fs *FounderState) generateTermSheet(investor string, interest float64) TermSheet {
+	standard := fs.GenerateTermSheetOptions(fs.ActiveFundraise.RoundName)[1]
+
+	// Metrics move the price between 0.6x and 1.6x of the standard round,
+	// competing term sheets add up to another 25% on top
+	priceFactor := 0.6 + fs.FundraiseMetricsScore()*0.8 + (interest-0.5)*0.2
+	priceFactor *= 1.0 + float64(len(fs.ActiveFundraise.TermSheets))*0.1*fs.ActiveFundraise.Momentum
+	priceFactor = math.Max(0.5, math.Min(2.0, priceFactor))
+
+	amount := int64(float64(standard.Amount) * (0.85 + fs.rng.Float64()*0.3))
+	preValuation := int64(float64(standard.PreValuation) * priceFactor)
+	postValuation := preValuation + amount
+
+	label := "Standard"
+	if priceFactor >= 1.2 {
+		label = "Founder-friendly"
+	} else if priceFactor < 0.85 {
+		label = "Investor-heavy"
+	}
+
+	return TermSheet{
+		Investor:      investor,
+		Amount:        amount,
+		PreValuation:  preValuation,
+		PostValuation: postValuation,
+		Equity:        float64(amount) / float64(postValuation) * 100,
+		BoardSeats:    standard.BoardSeatsOffered,
+		ProRata:       true,
+		Terms:         label,
+		MonthIssued:   fs.Turn,
+		ExpiresMonth:  fs.Turn + termSheetExpiryMonths,
+	}
+}
+
+// NegotiateTermSheet pushes back on a term sheet. ask is one of "valuation",
+// "board_seat" or "pro_rata". Leverage comes from competing term sheets; pushing
+// too hard without it can make the investor walk.
+func (fs *FounderState) NegotiateTermSheet(sheetIndex int, ask string) (string, error) {
+	process := fs.ActiveFundraise
+	if process == nil || process.Status != "active" {
+		return "", fmt.Errorf("no active fundraise")
+	}
+	if sheetIndex < 0 || sheetIndex >= len(process.TermSheets) {
+		return "", fmt.Errorf("invalid term sheet")
+	}
+	sheet := &process.TermSheets[sheetIndex]
+
+	switch ask {
+	case "valuation", "board_seat", "pro_rata":
+	default:
+		return "", fmt.Errorf("unknown negotiation ask: %s", ask)
+	}
+	if ask == "board_seat" && sheet.BoardSeats == 0 {
+		return "", fmt.Errorf("%s isn't asking for a board seat", sheet.Investor)
+	}
+	if ask == "pro_rata" && !sheet.ProRata {
+		return "", fmt.Errorf("%s already dropped pro-rata rights", sheet.Investor)
+	}
+
+	competing := len(process.TermSheets) - 1
+	successChance := 0.35 + float64(competing)*0.2 + process.Momentum*0.15 - float64(sheet.Negotiations)*0.15
+	walkChance := 0.1 + float64(sheet.Negotiations)*0.15 - float64(competing)*0.05
+	sheet.Negotiations++
+
+	roll := fs.rng.Float64()
+	if roll < successChance {
+		switch ask {
+		case "valuation":
+			bump := 0.08 + fs.rng.Float64()*0.12
+			sheet.PreValuation = int64(float64(sheet.PreValuation) * (1 + bump))
+			sheet.PostValuation = sheet.PreValuation + sheet.Amount
+			sheet.Equity = float64(sheet.Amount) / float64(sheet.PostValuation) * 100
+			return fmt.Sprintf("✓ %s raised the pre-money to $%s (+%.0f%%)",
+				sheet.Investor, formatCurrency(sheet.PreValuation), bump*100), nil
+		case "board_seat":
+			sheet.BoardSeats--
+			return fmt.Sprintf("✓ %s agreed to take %d board seat(s)", sheet.Investor, sheet.BoardSeats), nil
+		default:
+			sheet.ProRata = false
+			return fmt.Sprintf("✓ %s dropped their pro-rata rights", sheet.Investor), nil
+		}
+	}
+
+	if roll < successChance+math.Max(0.05, walkChance) {
+		investor := sheet.Investor
+		process.TermSheets = append(process.TermSheets[:sheetIndex], process.TermSheets[sheetIndex+1:]...)
+		for i := range process.Prospects {
+			if process.Prospects[i].Name == investor {
+				process.Prospects[i].Stage = "passed"
+				process.Prospects[i].PassReason = "pulled term sheet during negotiation"
+			}
+		}
+		process.Momentum = math.Max(0, process.Momentum-0.2)
+		return fmt.Sprintf("💔 %s pulled their term sheet - you pushed too hard", investor), nil
+	}
+
+	return fmt.Sprintf("🤝 %s held firm on their terms", sheet.Investor), nil
+}
+
+// AcceptTermSheet closes the round with the chosen investor as lead
+func (fs *FounderState) AcceptTermSheet(sheetIndex int) (*FundingRound, error) {
+	process := fs.ActiveFundraise
+	if process == nil || process.Status != "active" {
+		return nil, fmt.Errorf("no active fundraise")
+	}
+	if sheetIndex < 0 || sheetIndex >= len(process.TermSheets) {
+		return nil, fmt.Errorf("invalid term sheet")
+	}
+	sheet := process.TermSheets[sheetIndex]
+
+	option := TermSheetOption{
+		Amount:            sheet.Amount,
+		PostValuation:     sheet.PostValuation,
+		PreValuation:      sheet.PreValuation,
+		Equity:            sheet.Equity,
+		Terms:             sheet.Terms,
+		Description:       fmt.Sprintf("Led by %s", sheet.Investor),
+		BoardSeatsOffered: sheet.BoardSeats,
+	}
+
+	// Lead investor plus co-investors who got far enough to want in
+	investors := []string{sheet.Investor}
+	for _, p := range process.Prospects {
+		if p.Name != sheet.Investor && (p.Stage == "term_sheet" || p.Stage == "partner_meeting") && len(investors) < 3 {
+			investors = append(investors, p.Name)
+		}
+	}
+	investors = uniqueNames(append(investors, fs.insiderFollowOns()...))
+
+	fs.closeFundingRound(process.RoundName, option, investors)
+	round := &fs.FundingRounds[len(fs.FundingRounds)-1]
+	round.ProRataInvestors = nil
+	if sheet.ProRata {
+		round.ProRataInvestors = []string{sheet.Investor}
+	}
+
+	fs.finishFundraise("closed", sheet.Amount)
+	return round, nil
+}
+
+// AbandonFundraise walks away from the current process without closing
+func (fs *FounderState) AbandonFundraise() error {
+	if fs.ActiveFundraise == nil || fs.ActiveFundraise.Status != "active" {
+		return fmt.Errorf("no active fundraise")
+	}
+	fs.finishFundraise("abandoned", 0)
+	return nil
+}
+
+func (fs *FounderState) finishFundraise(outcome string, amount int64) {
+	process := fs.ActiveFundraise
+	process.Status = outcome
+	fs.FundraiseHistory = append(fs.FundraiseHistory, FundraiseAttempt{
+		RoundName:  process.RoundName,
+		StartMonth: process.StartMonth,
+		EndMonth:   fs.Turn,
+		Outcome:    outcome,
+		TermSheets: len(process.TermSheets),
+		Amount:     amount,
+	})
+	fs.ActiveFundraise = nil
+}
+
+// ProcessFundraising advances the active raise by a month: meeting slots reset,
+// term sheets expire, momentum decays and the round fails at its deadline.
+func (fs *FounderState) ProcessFundraising() []string {
+	process := fs.ActiveFundraise
+	if process == nil || process.Status != "active" {
+		return nil
+	}
+
+	var messages []string
+	process.MeetingsThisMonth = 0
+
+	// Expire stale term sheets
+	var live []TermSheet
+	for _, sheet := range process.TermSheets {
+		if fs.Turn > sheet.ExpiresMonth {
+			messages = append(messages, fmt.Sprintf("⏰ %s's term sheet expired", sheet.Investor))
+			for i := range process.Prospects {
+				if process.Prospects[i].Name == sheet.Investor {
+					process.Prospects[i].Stage = "passed"
+					process.Prospects[i].PassReason = "term sheet expired"
+				}
+			}
+			continue
+		}
+		live = append(live, sheet)
+	}
+	process.TermSheets = live
+
+	// Momentum fades when nothing is happening; interest tracks the latest metrics
+	if len(process.TermSheets) == 0 {
+		process.Momentum = math.Max(0, process.Momentum-0.15)
+	}
+	score := fs.FundraiseMetricsScore()
+	for i := range process.Prospects {
+		p := &process.Prospects[i]
+		if p.Stage == "passed" || p.Stage == "term_sheet" {
+			continue
+		}
+		p.Interest = math.Max(0.05, math.Min(1.0, p.Interest*0.7+score*0.3))
+	}
+
+	if fs.Turn > process.Deadline {
+		if len(process.TermSheets) > 0 {
+			messages = append(messages, fmt.Sprintf("⚠️  %s deadline passed - accept a term sheet before they expire", process.RoundName))
+			return messages
+		}
+		fs.finishFundraise("failed", 0)
+		fs.BoardPressure = int(math.Min(100, float64(fs.BoardPressure+20)))
+		messages = append(messages, fmt.Sprintf("💀 %s FAILED - no investor committed after %d months", process.RoundName, fundraiseDurationMonths))
+		return messages
+	}
+
+	active := 0
+	for _, p := range process.Prospects {
+		if p.Stage != "passed" {
+			active++
+		}
+	}
+	if active == 0 {
+		fs.finishFundraise("failed", 0)
+		fs.BoardPressure = int(math.Min(100, float64(fs.BoardPressure+20)))
+		messages = append(messages, fmt.Sprintf("💀 %s FAILED - every investor on your list passed", process.RoundName))
+		return messages
+	}
+
+	messages = append(messages, fmt.Sprintf("💼 Fundraising %s: %d investors in play, %d term sheet(s), %d month(s) left",
+		process.RoundName, active, len(process.TermSheets), process.Deadline-fs.Turn+1))
+	return messages
+}
+
+// FailedRaiseCount returns how many fundraising processes ended without a close
+func (fs *FounderState) FailedRaiseCount() int {
+	count := 0
+	for _, attempt := range fs.FundraiseHistory {
+		if attempt.Outcome == "failed" {
+			count++
+		}
+	}
+	return count
+}
@@ -3,7 +3,6 @@ package founder
 import (
 	"fmt"
 	"math"
-	"math/rand"
 )
 
 func (fs *FounderState) IsGameOver() bool {
@@ -12,7 +11,6 @@ func (fs *FounderState) IsGameOver() bool {
 
 func (fs *FounderState) GetAvailableExits() []ExitOption {
 	var exits []ExitOption
-	founderEquity := (100.0 - fs.EquityPool - fs.EquityGivenAway) / 100.0
 
 	// Calculate current valuation (simplified: ARR * multiple based on growth/profitability)
 	arr := fs.MRR * 12
@@ -50,8 +48,9 @@ func (fs *FounderState) GetAvailableExits() []ExitOption {
 		ipoReqs = append(ipoReqs, "✓ Strong growth rate")
 	}
 
-	ipoValuation := int64(float64(currentValuation) * 1.3)                 // 30% IPO premium
-	ipoFounderPayout := int64(float64(ipoValuation) * founderEquity * 0.2) // Can sell 20% at IPO
+	ipoValuation := int64(float64(currentValuation) * 1.3) // 30% IPO premium
+	// Preferred converts to common at IPO; the lockup limits what the founder can sell
+	ipoFounderPayout := int64(float64(fs.CalculateWaterfall(ipoValuation, true).FounderPayout) * IPOSellFraction)
 
 	exits = append(exits, ExitOption{
 		Type:          "ipo",
@@ -80,8 +79,8 @@ func (fs *FounderState) GetAvailableExits() []ExitOption {
 		acqReqs = append(acqReqs, "✓ Significant customer base")
 	}
 
-	acqValuation := int64(float64(currentValuation) * 1.1) // 10% acquisition premium
-	acqFounderPayout := int64(float64(acqValuation) * founderEquity)
+	acqValuation := int64(float64(currentValuation) * 1.1)                       // 10% acquisition premium
+	acqFounderPayout := fs.CalculateWaterfall(acqValuation, false).FounderPayout // Preferences paid first
 
 	exits = append(exits, ExitOption{
 		Type:          "acquisition",
@@ -112,7 +111,7 @@ func (fs *FounderState) GetAvailableExits() []ExitOption {
 	}
 
 	secondaryValuation := currentValuation
-	secondaryFounderPayout := int64(float64(secondaryValuation) * founderEquity * 0.5) // Sell 50% of your stake
+	secondaryFounderPayout := int64(float64(fs.CalculateWaterfall(secondaryValuation, true).FounderPayout) * SecondarySellFraction)
 
 	exits = append(exits, ExitOption{
 		Type:          "secondary",
@@ -168,7 +167,9 @@ func (fs *FounderState) GetFinalScore() (outcome string, valuation int64, founde
 	}
 
 	// Determine outcome
-	if fs.Cash <= 0 {
+	if fs.Cash <= 0 && fs.FailedRaiseCount() > 0 {
+		outcome = "SHUT DOWN - Failed to raise and ran out of cash"
+	} else if fs.Cash <= 0 {
 		outcome = "SHUT DOWN - Ran out of cash"
 	} else if fs.Turn > fs.MaxTurns {
 		if fs.MRR > 1000000 { // $1M+ MRR
@@ -206,19 +207,19 @@ func (fs *FounderState) CheckForAcquisition() *AcquisitionOffer {
 	}
 
 	// 5% chance per month after Series A for strategic acquirer
-	if rand.Float64() > 0.05 {
+	if fs.rng.Float64() > 0.05 {
 		return nil
 	}
 
 	// Calculate offer
-	multiple := 3.0 + rand.Float64()*3.0 // 3-6x revenue
+	multiple := 3.0 + fs.rng.Float64()*3.0 // 3-6x revenue
 	annualRevenue := fs.MRR * 12
 	offerAmount := int64(float64(annualRevenue) * multiple)
 
 	dueDiligence := "normal"
 	termsQuality := "good"
 
-	roll := rand.Float64()
+	roll := fs.rng.Float64()
 	if roll < 0.15 {
 		dueDiligence = "bad"
 		termsQuality = "poor"
@@ -235,7 +236,7 @@ func (fs *FounderState) CheckForAcquisition() *AcquisitionOffer {
 	}
 
 	offer := AcquisitionOffer{
-		Acquirer:     acquirers[rand.Intn(len(acquirers))],
+		Acquirer:     acquirers[fs.rng.Intn(len(acquirers))],
 		OfferAmount:  offerAmount,
 		Month:        fs.Turn,
 		DueDiligence: dueDiligence,
@@ -259,6 +260,11 @@ func (fs *FounderState) CheckForCompetitorAcquisition() *AcquisitionOffer {
 			continue
 		}
 
+		// Stronger competitors would rather take the market than buy it
+		if fs.headToHeadGap(*comp) > 0.2 {
+			continue
+		}
+
 		// Higher threat competitors are more likely to acquire
 		acquisitionChance := 0.02 // 2% base chance per month
 		if comp.Threat == "high" {
@@ -293,26 +299,31 @@ func (fs *FounderState) CheckForCompetitorAcquisition() *AcquisitionOffer {
 			acquisitionChance *= 2.0
 		}
 
-		if rand.Float64() > acquisitionChance {
+		if fs.rng.Float64() > acquisitionChance {
 			continue
 		}
 
 		// Calculate offer - competitors typically offer less than strategic acquirers
 		// They're buying to eliminate competition, not for strategic value
-		multiple := 2.0 + rand.Float64()*2.5 // 2-4.5x revenue (lower than strategic)
+		multiple := 2.0 + fs.rng.Float64()*2.5 // 2-4.5x revenue (lower than strategic)
 		annualRevenue := fs.MRR * 12
 		offerAmount := int64(float64(annualRevenue) * multiple)
 
 		// Hooli sometimes makes aggressive offers (but usually lowballs)
-		if comp.Name == "Hooli" && rand.Float64() < 0.2 {
+		if comp.Name == "Hooli" && fs.rng.Float64() < 0.2 {
 			// 20% chance Hooli makes a "Gavin Belson" style aggressive offer
 			offerAmount = int64(float64(offerAmount) * 1.5)
 		}
 
+		// Startups can only buy us with the cash (and stock) they actually have
+		if comp.Stage != "" && comp.Stage != "Public" && comp.Cash*2 < offerAmount {
+			continue
+		}
+
 		// Competitor offers are usually less favorable
 		dueDiligence := "normal"
 		termsQuality := "good"
-		if rand.Float64() < 0.3 {
+		if fs.rng.Float64() < 0.3 {
 			dueDiligence = "bad"
 			termsQuality = "poor"
 			offerAmount = int64(float64(offerAmount) * 0.7) // Competitors lowball more often
@@ -320,7 +331,7 @@ func (fs *FounderState) CheckForCompetitorAcquisition() *AcquisitionOffer {
 
 		// Hooli is known for bad terms
 		if comp.Name == "Hooli" || comp.Name == "Gavin Belson's New Thing" {
-			if rand.Float64() < 0.5 {
+			if fs.rng.Float64() < 0.5 {
 				dueDiligence = "bad"
 				termsQuality = "poor"
 			}
@@ -501,13 +512,13 @@ func (fs *FounderState) GetBoardGuidance() []string {
 	chairman := fs.GetChairman()
 	if chairman != nil && chairman.IsActive {
 		// Chairman provides guidance 60% of the time (vs 30% for regular advisors)
-		if rand.Float64() < 0.6 {
+		if fs.rng.Float64() < 0.6 {
 			impactMultiplier := 2.0 // Chairman has 2x impact
 
 			switch chairman.Expertise {
 			case "sales":
 				// Sales expertise helps with customer acquisition
-				boost := int64(float64(fs.MRR) * (0.02 + rand.Float64()*0.03) * impactMultiplier) // 4-10% boost (2x)
+				boost := int64(float64(fs.MRR) * (0.02 + fs.rng.Float64()*0.03) * impactMultiplier) // 4-10% boost (2x)
 				if boost > 0 {
 					fs.MRR += boost
 					fs.DirectMRR += boost
@@ -517,7 +528,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 			case "product":
 				// Product expertise improves product maturity
 				if fs.ProductMaturity < 1.0 {
-					improvement := (0.02 + rand.Float64()*0.03) * impactMultiplier // 4-10% improvement (2x)
+					improvement := (0.02 + fs.rng.Float64()*0.03) * impactMultiplier // 4-10% improvement (2x)
 					fs.ProductMaturity = math.Min(1.0, fs.ProductMaturity+improvement)
 					guidance = append(guidance, fmt.Sprintf("👔 %s (Chairman - Product) provided strategic product guidance (%.0f%% maturity gained)",
 						chairman.Name, improvement*100))
@@ -531,7 +542,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 			case "operations":
 				// Operations expertise reduces costs
 				if fs.MonthlyTeamCost > 50000 {
-					savings := int64(float64(fs.MonthlyTeamCost) * (0.01 + rand.Float64()*0.02) * impactMultiplier) // 2-6% savings (2x)
+					savings := int64(float64(fs.MonthlyTeamCost) * (0.01 + fs.rng.Float64()*0.02) * impactMultiplier) // 2-6% savings (2x)
 					fs.Cash += savings
 					guidance = append(guidance, fmt.Sprintf("👔 %s (Chairman - Operations) identified significant cost savings (+$%s this month)",
 						chairman.Name, formatCurrency(savings)))
@@ -539,7 +550,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 			case "strategy":
 				// Strategy expertise helps avoid bad decisions
 				if fs.CustomerChurnRate > 0.15 {
-					reduction := (0.01 + rand.Float64()*0.02) * impactMultiplier // 2-6% churn reduction (2x)
+					reduction := (0.01 + fs.rng.Float64()*0.02) * impactMultiplier // 2-6% churn reduction (2x)
 					fs.CustomerChurnRate = math.Max(0.01, fs.CustomerChurnRate-reduction)
 					guidance = append(guidance, fmt.Sprintf("👔 %s (Chairman - Strategy) provided strategic guidance to reduce churn (%.0f%% improvement)",
 						chairman.Name, reduction*100))
@@ -548,7 +559,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 
 			// Chairman also provides investor relations benefit
 			if fs.BoardPressure > 0 {
-				pressureReduction := 5 + rand.Intn(11) // 5-15 point reduction
+				pressureReduction := 5 + fs.rng.Intn(11) // 5-15 point reduction
 				fs.BoardPressure -= pressureReduction
 				if fs.BoardPressure < 0 {
 					fs.BoardPressure = 0
@@ -559,10 +570,10 @@ func (fs *FounderState) GetBoardGuidance() []string {
 		}
 
 		// Chairman represents company at events (saves founder time, unlocks opportunities)
-		if rand.Float64() < 0.3 {
+		if fs.rng.Float64() < 0.3 {
 			// 30% chance chairman attends event on your behalf
 			opportunityTypes := []string{"partnership", "customer", "fundraising"}
-			opportunityType := opportunityTypes[rand.Intn(len(opportunityTypes))]
+			opportunityType := opportunityTypes[fs.rng.Intn(len(opportunityTypes))]
 
 			switch opportunityType {
 			case "partnership":
@@ -585,11 +596,11 @@ func (fs *FounderState) GetBoardGuidance() []string {
 		}
 
 		// 30% chance per month a board member provides useful guidance
-		if rand.Float64() < 0.3 {
+		if fs.rng.Float64() < 0.3 {
 			switch member.Expertise {
 			case "sales":
 				// Sales expertise helps with customer acquisition - apply the boost
-				boost := int64(float64(fs.MRR) * (0.02 + rand.Float64()*0.03)) // 2-5% boost
+				boost := int64(float64(fs.MRR) * (0.02 + fs.rng.Float64()*0.03)) // 2-5% boost
 				if boost > 0 {
 					fs.MRR += boost
 					fs.DirectMRR += boost
@@ -599,7 +610,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 			case "product":
 				// Product expertise improves product maturity
 				if fs.ProductMaturity < 1.0 {
-					improvement := 0.02 + rand.Float64()*0.03 // 2-5% improvement
+					improvement := 0.02 + fs.rng.Float64()*0.03 // 2-5% improvement
 					fs.ProductMaturity = math.Min(1.0, fs.ProductMaturity+improvement)
 					guidance = append(guidance, fmt.Sprintf("🎯 %s (Product Advisor) helped improve product (%.0f%% maturity gained)",
 						member.Name, improvement*100))
@@ -621,7 +632,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 			case "operations":
 				// Operations expertise reduces costs
 				if fs.MonthlyTeamCost > 50000 {
-					savings := int64(float64(fs.MonthlyTeamCost) * (0.01 + rand.Float64()*0.02)) // 1-3% savings
+					savings := int64(float64(fs.MonthlyTeamCost) * (0.01 + fs.rng.Float64()*0.02)) // 1-3% savings
 					fs.Cash += savings
 					guidance = append(guidance, fmt.Sprintf("⚙️  %s (Operations Advisor) identified cost savings (+$%s this month)",
 						member.Name, formatCurrency(savings)))
@@ -629,7 +640,7 @@ func (fs *FounderState) GetBoardGuidance() []string {
 			case "strategy":
 				// Strategy expertise helps avoid bad decisions
 				if fs.CustomerChurnRate > 0.10 {
-					reduction := 0.01 + rand.Float64()*0.02 // 1-3% churn reduction
+					reduction := 0.01 + fs.rng.Float64()*0.02 // 1-3% churn reduction
 					fs.CustomerChurnRate = math.Max(0.01, fs.CustomerChurnRate-reduction)
 					guidance = append(guidance, fmt.Sprintf("🎓 %s (Strategy Advisor) helped reduce churn (%.0f%% improvement)",
 						member.Name, reduction*100))
@@ -680,6 +691,9 @@ func (fs *FounderState) UpdateBoardSentiment() {
 		score -= 15
 	}
 
+	// Each investor director reads the numbers through their fund's strategy
+	score += fs.boardStrategyAdjustment(score)
+
 	// Set sentiment
 	if score >= 75 {
 		fs.BoardSentiment = "happy"
@@ -706,7 +720,7 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 	}
 
 	// 12% chance per month (after month 3)
-	if fs.Turn < 3 || rand.Float64() > 0.12 {
+	if fs.Turn < 3 || fs.rng.Float64() > 0.12 {
 		return nil
 	}
 
@@ -728,7 +742,7 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 		opportunityTypes = append(opportunityTypes, "international_expansion_offer", "podcast_feature")
 	}
 
-	oppType := opportunityTypes[rand.Intn(len(opportunityTypes))]
+	oppType := opportunityTypes[fs.rng.Intn(len(opportunityTypes))]
 
 	var opp StrategicOpportunity
 
@@ -748,13 +762,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 			{"The Information", "The Information wants an exclusive deep-dive into your tech."},
 			{"Wired", "Wired is profiling the next wave of startup founders."},
 		}
-		press := pressOptions[rand.Intn(len(pressOptions))]
-		customers := 5 + rand.Intn(15)
+		press := pressOptions[fs.rng.Intn(len(pressOptions))]
+		customers := 5 + fs.rng.Intn(15)
 		opp = StrategicOpportunity{
 			Type:        "press",
 			Title:       fmt.Sprintf("📰 %s Feature", press.Name),
 			Description: fmt.Sprintf("%s This could significantly boost brand awareness and inbound leads.", press.Desc),
-			Cost:        10000 + rand.Int63n(15000),
+			Cost:        10000 + fs.rng.Int63n(15000),
 			Benefit:     fmt.Sprintf("+%d customers, -15%% CAC from brand awareness", customers),
 			Risk:        "Requires founder time and PR prep costs",
 			ExpiresIn:   2,
@@ -762,8 +776,8 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 
 	case "enterprise_pilot":
 		companies := []string{"Salesforce", "Microsoft", "Adobe", "Oracle", "SAP", "Cisco", "IBM", "Walmart", "JPMorgan", "Goldman Sachs"}
-		company := companies[rand.Intn(len(companies))]
-		dealSize := 50000 + rand.Int63n(150000)
+		company := companies[fs.rng.Intn(len(companies))]
+		dealSize := 50000 + fs.rng.Int63n(150000)
 		opp = StrategicOpportunity{
 			Type:        "enterprise_pilot",
 			Title:       fmt.Sprintf("🏢 %s Pilot Program", company),
@@ -775,8 +789,8 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 		}
 
 	case "bridge_round":
-		amount := 200000 + rand.Int63n(500000)
-		equity := 3.0 + rand.Float64()*5.0
+		amount := 200000 + fs.rng.Int63n(500000)
+		equity := 3.0 + fs.rng.Float64()*5.0
 		opp = StrategicOpportunity{
 			Type:        "bridge_round",
 			Title:       "💰 Bridge Round Opportunity",
@@ -800,13 +814,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 			{"Collision", "Collision conference in Toronto wants you as a featured startup."},
 			{"Y Combinator Demo Day", "YC invites you to present at Demo Day as a special guest."},
 		}
-		conf := conferences[rand.Intn(len(conferences))]
-		leads := 3 + rand.Intn(8)
+		conf := conferences[fs.rng.Intn(len(conferences))]
+		leads := 3 + fs.rng.Intn(8)
 		opp = StrategicOpportunity{
 			Type:        "conference",
 			Title:       fmt.Sprintf("🎤 %s", conf.Name),
 			Description: fmt.Sprintf("%s Great for leads and recruiting.", conf.Desc),
-			Cost:        5000 + rand.Int63n(10000),
+			Cost:        5000 + fs.rng.Int63n(10000),
 			Benefit:     fmt.Sprintf("+%d customers, -10%% CAC from credibility", leads),
 			Risk:        "Founder unavailable for 1 week, may not convert leads immediately",
 			ExpiresIn:   2,
@@ -814,7 +828,7 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 
 	case "talent":
 		companies := []string{"Google", "Meta", "Apple", "Netflix", "Stripe", "Airbnb", "Uber", "SpaceX", "OpenAI"}
-		company := companies[rand.Intn(len(companies))]
+		company := companies[fs.rng.Intn(len(companies))]
 		opp = StrategicOpportunity{
 			Type:        "talent",
 			Title:       fmt.Sprintf("⭐ Star Engineer from %s", company),
@@ -826,12 +840,12 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 		}
 
 	case "competitor_distress":
-		customers := 15 + rand.Intn(25)
+		customers := 15 + fs.rng.Intn(25)
 		opp = StrategicOpportunity{
 			Type:        "competitor_distress",
 			Title:       "🎯 Competitor in Distress",
 			Description: "Main competitor is struggling (layoffs, negative press). Perfect time to poach their customers.",
-			Cost:        50000 + rand.Int63n(150000),
+			Cost:        50000 + fs.rng.Int63n(150000),
 			Benefit:     fmt.Sprintf("+%d customers from their base, eliminate competitor", customers),
 			Risk:        "May inherit technical debt or unhappy customers",
 			ExpiresIn:   2,
@@ -849,13 +863,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 			{"Salesforce AppExchange", "Salesforce wants you on AppExchange."},
 			{"Zapier", "Zapier offers to build a native integration, exposing you to millions of users."},
 		}
-		partner := partners[rand.Intn(len(partners))]
-		customers := 10 + rand.Intn(20)
+		partner := partners[fs.rng.Intn(len(partners))]
+		customers := 10 + fs.rng.Intn(20)
 		opp = StrategicOpportunity{
 			Type:        "api_integration",
 			Title:       fmt.Sprintf("🔌 %s Integration", partner.Name),
 			Description: fmt.Sprintf("%s Their customer base would gain access to your product.", partner.Desc),
-			Cost:        15000 + rand.Int63n(35000),
+			Cost:        15000 + fs.rng.Int63n(35000),
 			Benefit:     fmt.Sprintf("+%d customers, +2%% ongoing growth from partner channel", customers),
 			Risk:        "Engineering time to build and maintain integration",
 			ExpiresIn:   2,
@@ -863,13 +877,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 
 	case "govt_contract":
 		agencies := []string{"DoD", "NASA", "FDA", "SEC", "Department of Education", "VA", "USDA"}
-		agency := agencies[rand.Intn(len(agencies))]
-		contractMRR := 20000 + rand.Intn(80000)
+		agency := agencies[fs.rng.Intn(len(agencies))]
+		contractMRR := 20000 + fs.rng.Intn(80000)
 		opp = StrategicOpportunity{
 			Type:        "govt_contract",
 			Title:       fmt.Sprintf("🏛️ %s Contract Opportunity", agency),
 			Description: fmt.Sprintf("The %s is evaluating your product for a multi-year contract. Government work = guaranteed revenue.", agency),
-			Cost:        25000 + rand.Int63n(50000),
+			Cost:        25000 + fs.rng.Int63n(50000),
 			Benefit:     fmt.Sprintf("+$%s/mo guaranteed MRR, 3-year contract", formatCurrency(int64(contractMRR))),
 			Risk:        "Government compliance requirements, slow procurement process",
 			ExpiresIn:   3,
@@ -886,13 +900,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 			{"Industry Podcast Host", "Top industry podcast (50K listeners) wants you as a guest."},
 			{"TikTok Business Creator", "A business TikTok creator with 1M followers wants to make a case study."},
 		}
-		inf := influencers[rand.Intn(len(influencers))]
-		customers := 8 + rand.Intn(20)
+		inf := influencers[fs.rng.Intn(len(influencers))]
+		customers := 8 + fs.rng.Intn(20)
 		opp = StrategicOpportunity{
 			Type:        "influencer",
 			Title:       fmt.Sprintf("📱 %s", inf.Name),
 			Description: inf.Desc,
-			Cost:        5000 + rand.Int63n(25000),
+			Cost:        5000 + fs.rng.Int63n(25000),
 			Benefit:     fmt.Sprintf("+%d customers from viral exposure", customers),
 			Risk:        "ROI uncertain, audience may not be target market",
 			ExpiresIn:   1,
@@ -903,7 +917,7 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 			Type:        "patent",
 			Title:       "📜 Patent Filing Opportunity",
 			Description: "Your legal team identified a key innovation that can be patented. This would create a strong competitive moat.",
-			Cost:        30000 + rand.Int63n(50000),
+			Cost:        30000 + fs.rng.Int63n(50000),
 			Benefit:     "Reduce all competitors' market share by 20%, defensible IP",
 			Risk:        "Long filing process, patent trolls may target you",
 			ExpiresIn:   3,
@@ -911,25 +925,25 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 
 	case "university_partnership":
 		universities := []string{"Stanford", "MIT", "Carnegie Mellon", "Georgia Tech", "UC Berkeley", "Caltech"}
-		uni := universities[rand.Intn(len(universities))]
-		customers := 5 + rand.Intn(10)
+		uni := universities[fs.rng.Intn(len(universities))]
+		customers := 5 + fs.rng.Intn(10)
 		opp = StrategicOpportunity{
 			Type:        "university_partnership",
 			Title:       fmt.Sprintf("🎓 %s Partnership", uni),
 			Description: fmt.Sprintf("%s wants to use your product in their program. Access to talent pipeline and academic customers.", uni),
-			Cost:        10000 + rand.Int63n(20000),
+			Cost:        10000 + fs.rng.Int63n(20000),
 			Benefit:     fmt.Sprintf("+%d customers, improved recruiting from %s", customers, uni),
 			Risk:        "Academic pricing expectations, support overhead",
 			ExpiresIn:   2,
 		}
 
 	case "white_label":
-		customers := 20 + rand.Intn(30)
+		customers := 20 + fs.rng.Intn(30)
 		opp = StrategicOpportunity{
 			Type:        "press", // Reuse press handler for simplicity (adds customers + reduces CAC)
 			Title:       "🏷️ White-Label Distribution Deal",
 			Description: "Major company wants to white-label your product under their brand. Instant scale but brand dilution risk.",
-			Cost:        30000 + rand.Int63n(50000),
+			Cost:        30000 + fs.rng.Int63n(50000),
 			Benefit:     fmt.Sprintf("+%d customers, -15%% CAC from distribution network", customers),
 			Risk:        "Your brand not visible, partner may demand exclusivity",
 			ExpiresIn:   2,
@@ -937,13 +951,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 
 	case "channel_partner":
 		partners := []string{"Accenture", "Deloitte", "KPMG", "PwC", "McKinsey Digital"}
-		partner := partners[rand.Intn(len(partners))]
-		customers := 10 + rand.Intn(15)
+		partner := partners[fs.rng.Intn(len(partners))]
+		customers := 10 + fs.rng.Intn(15)
 		opp = StrategicOpportunity{
 			Type:        "api_integration", // Similar mechanics: customers + growth boost
 			Title:       fmt.Sprintf("🤝 %s Channel Partnership", partner),
 			Description: fmt.Sprintf("%s wants to resell your product to their enterprise clients.", partner),
-			Cost:        20000 + rand.Int63n(40000),
+			Cost:        20000 + fs.rng.Int63n(40000),
 			Benefit:     fmt.Sprintf("+%d enterprise customers, +2%% ongoing growth", customers),
 			Risk:        "Channel conflict with direct sales, margin compression",
 			ExpiresIn:   2,
@@ -959,13 +973,13 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 			{"Brazil", "Brazilian tech accelerator wants to launch you in Latin America."},
 			{"India", "Indian IT services firm wants to bundle your product with their offerings."},
 		}
-		region := regions[rand.Intn(len(regions))]
-		customers := 15 + rand.Intn(20)
+		region := regions[fs.rng.Intn(len(regions))]
+		customers := 15 + fs.rng.Intn(20)
 		opp = StrategicOpportunity{
 			Type:        "press", // Reuse for customer acquisition
 			Title:       fmt.Sprintf("🌍 %s Market Entry", region.Name),
 			Description: region.Desc,
-			Cost:        40000 + rand.Int63n(60000),
+			Cost:        40000 + fs.rng.Int63n(60000),
 			Benefit:     fmt.Sprintf("+%d customers, -15%% CAC from local partner network", customers),
 			Risk:        "Localization costs, regulatory compliance, time zone challenges",
 			ExpiresIn:   3,
@@ -973,8 +987,8 @@ func (fs *FounderState) GenerateStrategicOpportunity() *StrategicOpportunity {
 
 	case "podcast_feature":
 		podcasts := []string{"All-In Podcast", "My First Million", "The Tim Ferriss Show", "How I Built This", "Acquired", "Lenny's Podcast"}
-		pod := podcasts[rand.Intn(len(podcasts))]
-		customers := 5 + rand.Intn(12)
+		pod := podcasts[fs.rng.Intn(len(podcasts))]
+		customers := 5 + fs.rng.Intn(12)
 		opp = StrategicOpportunity{
 			Type:        "conference", // Similar mechanics: customers + CAC reduction
 			Title:       fmt.Sprintf("🎙️ %s Guest Spot", pod),
@@ -996,11 +1010,20 @@ func (fs *FounderState) ProcessMonth() []string {
 
 func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 	var messages []string
+
+	// Baseline snapshot so charts start from where the company began
+	if len(fs.History) == 0 {
+		fs.RecordSnapshot()
+	}
 	fs.Turn++
 
 	// Update employee vesting
 	fs.UpdateEmployeeVesting()
 
+	// Morale, burnout, performance and attrition for each employee
+	peopleMsgs := fs.ProcessPeople()
+	messages = append(messages, peopleMsgs...)
+
 	// Ensure MRR is in sync before processing
 	fs.syncMRR()
 
@@ -1009,10 +1032,7 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 
 	// Engineer impact on product (reduces churn, increases sales)
 	// CTO counts as 3x engineers
-	engImpact := 1.0
-	for _, eng := range fs.Team.Engineers {
-		engImpact += (eng.Impact * 0.05) // Each engineer adds ~5% product improvement
-	}
+	engImpact := 1.0 + fs.TeamOutput(RoleEngineer)*0.05 // Each engineer adds ~5% product improvement
 	for _, exec := range fs.Team.Executives {
 		if exec.Role == RoleCTO {
 			engImpact += (exec.Impact * 0.05) // CTO has 3x impact already built into Impact field
@@ -1022,10 +1042,7 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 
 	// Sales team impact on growth
 	// CGO counts as 3x sales reps
-	salesImpact := 1.0
-	for _, sales := range fs.Team.Sales {
-		salesImpact += (sales.Impact * 0.1) // Each sales rep adds ~10% to close rate
-	}
+	salesImpact := 1.0 + fs.TeamOutput(RoleSales)*0.1 // Each sales rep adds ~10% to close rate
 	for _, exec := range fs.Team.Executives {
 		if exec.Role == RoleCGO {
 			salesImpact += (exec.Impact * 0.1) // CGO has 3x impact already built into Impact field
@@ -1071,10 +1088,7 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 
 	// CS team reduces churn
 	// COO counts as 3x CS reps
-	csImpact := 0.0
-	for _, cs := range fs.Team.CustomerSuccess {
-		csImpact += (cs.Impact * 0.02) // Each CS rep reduces churn by ~2%
-	}
+	csImpact := fs.TeamOutput(RoleCustomerSuccess) * 0.02 // Each CS rep reduces churn by ~2%
 	for _, exec := range fs.Team.Executives {
 		if exec.Role == RoleCOO {
 			csImpact += (exec.Impact * 0.02) // COO has 3x impact already built into Impact field
@@ -1106,10 +1120,10 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 		lostAffiliateCustomers = int(float64(len(activeAffiliateCustomers)) * actualChurn)
 
 		// Mark customers as churned (randomly select from active customers)
-		rand.Shuffle(len(activeDirectCustomers), func(i, j int) {
+		fs.rng.Shuffle(len(activeDirectCustomers), func(i, j int) {
 			activeDirectCustomers[i], activeDirectCustomers[j] = activeDirectCustomers[j], activeDirectCustomers[i]
 		})
-		rand.Shuffle(len(activeAffiliateCustomers), func(i, j int) {
+		fs.rng.Shuffle(len(activeAffiliateCustomers), func(i, j int) {
 			activeAffiliateCustomers[i], activeAffiliateCustomers[j] = activeAffiliateCustomers[j], activeAffiliateCustomers[i]
 		})
 
@@ -1238,6 +1252,14 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 	competitorPricingMsgs := fs.CheckCompetitorPricing()
 	messages = append(messages, competitorPricingMsgs...)
 
+	// Advance any in-progress fundraise (term sheet expiry, deadline)
+	fundraiseMsgs := fs.ProcessFundraising()
+	messages = append(messages, fundraiseMsgs...)
+
+	// 409A refresh, post-termination exercise windows, equity-driven attrition
+	equityMsgs := fs.ProcessEquityPlan()
+	messages = append(messages, equityMsgs...)
+
 	// Process sales pipeline - generate leads and move deals forward
 	if fs.MRR >= 50000 || fs.Customers >= 20 {
 		newDealsMsgs := fs.GenerateNewDeals()
@@ -1350,7 +1372,7 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 	messages = append(messages, eventMsgs...)
 
 	// 10. Spawn new random events (5% chance each month)
-	if rand.Float64() < 0.05 {
+	if fs.rng.Float64() < 0.05 {
 		if event := fs.SpawnRandomEvent(); event != nil {
 			messages = append(messages, fmt.Sprintf("⚡ EVENT: %s - %s", event.Title, event.Description))
 		}
@@ -1417,5 +1439,7 @@ func (fs *FounderState) ProcessMonthWithBaseline(baselineMRR int64) []string {
 	}
 	// If no customers, keep AvgDealSize from template (don't reset to 0)
 
+	fs.RecordSnapshot()
+
 	return messages
 }
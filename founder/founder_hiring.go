@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // ExecOffer represents a compensation package for an executive candidate
@@ -43,12 +42,12 @@ func (fs *FounderState) GenerateExecOffers(role EmployeeRole) ([]ExecOffer, erro
 		RoleCGO: {"Richard Hendricks", "Erlich Bachman", "Andrew Chen", "Alex Schultz", "Sean Ellis"},
 	}
 
-	name := execNames[role][rand.Intn(len(execNames[role]))]
-	baseImpact := 3.0 * (0.8 + rand.Float64()*0.4) // 2.4-3.6x
+	name := execNames[role][fs.rng.Intn(len(execNames[role]))]
+	baseImpact := 3.0 * (0.8 + fs.rng.Float64()*0.4) // 2.4-3.6x
 
 	// Generate 3 offers with different equity/cash tradeoffs
 	// Standard: balanced equity and salary
-	standardEquity := 2.0 + rand.Float64()*2.0 // 2-4%
+	standardEquity := 2.0 + fs.rng.Float64()*2.0 // 2-4%
 	if standardEquity > availableEquity {
 		standardEquity = availableEquity
 	}
@@ -136,6 +135,7 @@ func (fs *FounderState) HireExecWithOffer(offer ExecOffer) error {
 		VestedMonths:  0,
 		HasCliff:      false,
 		MonthHired:    fs.Turn,
+		StrikePrice:   fs.CommonSharePrice(),
 	}
 	fs.Team.Executives = append(fs.Team.Executives, employee)
 
@@ -157,7 +157,6 @@ func (fs *FounderState) HireExecWithOffer(offer ExecOffer) error {
 }
 
 func (fs *FounderState) HireEmployee(role EmployeeRole) error {
-	avgSalary := int64(100000)
 	var employee Employee
 
 	// C-level executives should use GenerateExecOffers + HireExecWithOffer
@@ -171,8 +170,8 @@ func (fs *FounderState) HireEmployee(role EmployeeRole) error {
 		}
 		return fs.HireExecWithOffer(offers[0]) // Use standard offer
 	} else {
-		monthlyCost := avgSalary / 12
-		
+		employee = fs.newHire(role, "USA") // Default to USA market
+
 		// Apply Quick Hire upgrade (first 3 hires cost 50% less)
 		hasQuickHire := false
 		for _, upgradeID := range fs.PlayerUpgrades {
@@ -182,17 +181,9 @@ func (fs *FounderState) HireEmployee(role EmployeeRole) error {
 			}
 		}
 		if hasQuickHire && fs.HiresCount < 3 {
-			monthlyCost = monthlyCost / 2 // 50% discount
+			employee.MonthlyCost = employee.MonthlyCost / 2 // 50% discount
 		}
 		fs.HiresCount++
-		
-		employee = Employee{
-			Role:           role,
-			MonthlyCost:    monthlyCost,
-			Impact:         0.8 + rand.Float64()*0.4,
-			IsExecutive:    false,
-			AssignedMarket: "USA", // Default to USA market
-		}
 
 		switch role {
 		case RoleEngineer:
@@ -221,16 +212,7 @@ func (fs *FounderState) HireEmployee(role EmployeeRole) error {
 
 
 func (fs *FounderState) HireEmployeeWithMarket(role EmployeeRole, market string) error {
-	avgSalary := int64(100000)
-
-	employee := Employee{
-		Role:           role,
-		MonthlyCost:    avgSalary / 12,
-		Impact:         0.8 + rand.Float64()*0.4,
-		IsExecutive:    false,
-		AssignedMarket: market,
-		MonthHired:     fs.Turn,
-	}
+	employee := fs.newHire(role, market)
 
 	switch role {
 	case RoleEngineer:
@@ -266,6 +248,7 @@ func (fs *FounderState) FireEmployee(role EmployeeRole) error {
 		for i, exec := range fs.Team.Executives {
 			if exec.Role == role {
 				fs.Team.Executives = append(fs.Team.Executives[:i], fs.Team.Executives[i+1:]...)
+				fs.departEmployee(exec, "fired")
 				fs.CalculateTeamCost()
 				fs.CalculateRunway()
 
@@ -283,25 +266,25 @@ func (fs *FounderState) FireEmployee(role EmployeeRole) error {
 	switch role {
 	case RoleEngineer:
 		if len(fs.Team.Engineers) > 0 {
-			fs.Team.Engineers = fs.Team.Engineers[:len(fs.Team.Engineers)-1]
+			fs.removeLowestPerformers(&fs.Team.Engineers, 1, "fired")
 		} else {
 			return fmt.Errorf("no engineers to fire")
 		}
 	case RoleSales:
 		if len(fs.Team.Sales) > 0 {
-			fs.Team.Sales = fs.Team.Sales[:len(fs.Team.Sales)-1]
+			fs.removeLowestPerformers(&fs.Team.Sales, 1, "fired")
 		} else {
 			return fmt.Errorf("no sales reps to fire")
 		}
 	case RoleCustomerSuccess:
 		if len(fs.Team.CustomerSuccess) > 0 {
-			fs.Team.CustomerSuccess = fs.Team.CustomerSuccess[:len(fs.Team.CustomerSuccess)-1]
+			fs.removeLowestPerformers(&fs.Team.CustomerSuccess, 1, "fired")
 		} else {
 			return fmt.Errorf("no CS reps to fire")
 		}
 	case RoleMarketing:
 		if len(fs.Team.Marketing) > 0 {
-			fs.Team.Marketing = fs.Team.Marketing[:len(fs.Team.Marketing)-1]
+			fs.removeLowestPerformers(&fs.Team.Marketing, 1, "fired")
 		} else {
 			return fmt.Errorf("no marketers to fire")
 		}
@@ -0,0 +1,134 @@
+package founder
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/jamesacampbell/unicorn/investors"
+)
+
+// Roster firms need at least this thesis fit to take a first meeting
+const minThesisFit = 0.6
+
+// rosterProspects returns the roster firms whose thesis covers this round, best fit first
+func (fs *FounderState) rosterProspects(roundName string) []investors.Persona {
+	type candidate struct {
+		persona investors.Persona
+		score   float64
+	}
+	candidates := []candidate{}
+	for _, p := range investors.Roster {
+		fit := p.ThesisFit(fs.Category, roundName)
+		if fit < minThesisFit {
+			continue
+		}
+		candidates = append(candidates, candidate{p, fit + fs.rng.Float64()*0.2})
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+
+	personas := make([]investors.Persona, 0, len(candidates))
+	for _, c := range candidates {
+		personas = append(personas, c.persona)
+	}
+	return personas
+}
+
+// insiderFollowOns returns earlier roster investors who take their pro-rata in the next round
+func (fs *FounderState) insiderFollowOns() []string {
+	seen := map[string]bool{}
+	insiders := []string{}
+	for _, round := range fs.FundingRounds {
+		for _, name := range round.ProRataInvestors {
+			persona, ok := investors.ByFirm(name)
+			if !ok || seen[name] {
+				continue
+			}
+			seen[name] = true
+			if fs.rng.Float64() < investors.FollowOnWillingness(persona.Strategy, persona.RiskTolerance, fs.FundraiseMetricsScore()) {
+				insiders = append(insiders, name)
+			}
+		}
+	}
+	return insiders
+}
+
+// roundInvestors picks a quick round's syndicate: the best-fit roster firm leads,
+// insiders follow on, and the usual market names fill out the rest
+func (fs *FounderState) roundInvestors(roundName string, amount int64) []string {
+	names := GenerateInvestorNames(fs.rng, roundName, amount)
+	if roundName == "Angel" {
+		return names
+	}
+
+	syndicate := []string{}
+	if leads := fs.rosterProspects(roundName); len(leads) > 0 {
+		syndicate = append(syndicate, leads[0].Firm)
+	}
+	syndicate = append(syndicate, fs.insiderFollowOns()...)
+	for i, name := range names {
+		if i == 0 && len(syndicate) > 0 {
+			continue // The roster lead replaces the generic lead
+		}
+		syndicate = append(syndicate, name)
+	}
+	return uniqueNames(syndicate)
+}
+
+func uniqueNames(names []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique
+}
+
+// thesisPassReason explains a pass from a firm whose thesis doesn't quite cover the company
+func thesisPassReason(persona investors.Persona) string {
+	return fmt.Sprintf("outside our thesis (%s)", persona.Thesis)
+}
+
+// boardStrategyAdjustment shifts the board's performance score by how each seated
+// investor's strategy reads the numbers: patient seed funds forgive a miss, growth
+// funds punish stalled growth and conservative funds punish burn
+func (fs *FounderState) boardStrategyAdjustment(score float64) float64 {
+	adjustment := 0.0
+	for _, m := range fs.BoardMembers {
+		if !m.IsActive || !m.HasBoardSeat || m.Type != "investor" {
+			continue
+		}
+		persona, ok := investors.ByFirm(m.Name)
+		if !ok {
+			continue
+		}
+		if score < 50 {
+			adjustment += (investors.BoardPatience(persona.Strategy) - 0.5) * 20
+		}
+		spending := investors.SpendingBias(persona.Strategy)
+		if spending > 0 && fs.MonthlyGrowthRate < 0.05 {
+			adjustment -= 5
+		} else if spending < 0 && fs.CashRunwayMonths <= 6 {
+			adjustment -= 5
+		}
+	}
+	return math.Max(-15, math.Min(15, adjustment))
+}
+
+// CareerNetwork lists the advisors and investors met building the company. They
+// become deal-flow sources if the founder later goes on to invest.
+func (fs *FounderState) CareerNetwork() []string {
+	names := []string{}
+	for _, m := range fs.BoardMembers {
+		if m.Type == "advisor" || m.Type == "independent" {
+			names = append(names, m.Name)
+		}
+	}
+	for _, round := range fs.FundingRounds {
+		names = append(names, round.Investors...)
+	}
+	return uniqueNames(names)
+}
@@ -2,7 +2,6 @@ package founder
 
 import (
 	"math"
-	"math/rand"
 )
 
 func (fs *FounderState) CalculateTeamCost() {
@@ -81,19 +80,19 @@ func (fs *FounderState) CalculateInfrastructureCosts() {
 	var computePercent float64
 	switch fs.Category {
 	case "SaaS":
-		computePercent = 0.10 + rand.Float64()*0.20 // 10-30% of deal size
+		computePercent = 0.10 + fs.rng.Float64()*0.20 // 10-30% of deal size
 	case "DeepTech":
-		computePercent = 0.20 + rand.Float64()*0.20 // 20-40% of deal size
+		computePercent = 0.20 + fs.rng.Float64()*0.20 // 20-40% of deal size
 	case "GovTech":
-		computePercent = 0.05 + rand.Float64()*0.10 // 5-15% of deal size
+		computePercent = 0.05 + fs.rng.Float64()*0.10 // 5-15% of deal size
 	case "Hardware":
-		computePercent = 0.15 + rand.Float64()*0.25 // 15-40% of deal size
+		computePercent = 0.15 + fs.rng.Float64()*0.25 // 15-40% of deal size
 	default:
-		computePercent = 0.10 + rand.Float64()*0.20 // 10-30% default
+		computePercent = 0.10 + fs.rng.Float64()*0.20 // 10-30% default
 	}
 
 	// ODC costs are typically 5-15% of deal size (support, data transfer, etc.)
-	odcPercent := 0.05 + rand.Float64()*0.10
+	odcPercent := 0.05 + fs.rng.Float64()*0.10
 
 	// Calculate costs based on each active customer's deal size
 	var totalComputeCost int64
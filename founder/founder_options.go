@@ -0,0 +1,379 @@
+package founder
+
+import (
+	"fmt"
+)
+
+const (
+	fullyDilutedShares         = 10000000 // Share count used to express strikes and tender prices per share
+	exerciseWindowMonths       = 3        // Standard 90-day post-termination exercise window
+	tenderOfferCooldown        = 12       // Months between company-run tender offers
+	valuation409ARefreshMonths = 12       // A 409A is good for a year (or until the next priced round)
+)
+
+// FormerEmployee holds vested options (or exercised shares) after someone leaves
+type FormerEmployee struct {
+	Name             string
+	Role             EmployeeRole
+	Equity           float64 // Vested equity % they walked out with
+	StrikePrice      float64
+	DepartedMonth    int
+	ExerciseDeadline int  // Last month to exercise before options return to the pool
+	Exercised        bool // Paid the strike and now holds common stock
+	Reason           string
+}
+
+// TenderOffer is a company-run secondary where employees and the founder sell to new investors
+type TenderOffer struct {
+	Month              int
+	Buyer              string
+	PricePerShare      float64
+	EmployeeEquitySold float64
+	FounderEquitySold  float64
+	EmployeeProceeds   int64
+	FounderProceeds    int64
+	Participants       int
+}
+
+func equityToShares(equity float64) float64 {
+	return equity / 100.0 * fullyDilutedShares
+}
+
+// vestedFraction returns the share of a grant that has vested (nothing before the cliff)
+func vestedFraction(e Employee) float64 {
+	if !e.HasCliff || e.VestingMonths <= 0 {
+		return 0
+	}
+	vested := float64(e.VestedMonths) / float64(e.VestingMonths)
+	if vested > 1 {
+		vested = 1
+	}
+	return vested
+}
+
+// heldVested is the vested equity an employee still holds after any tender sales
+func heldVested(e Employee) float64 {
+	held := e.Equity*vestedFraction(e) - e.SoldEquity
+	if held < 0 {
+		return 0
+	}
+	return held
+}
+
+// Calculate409A estimates the fair market value of common stock. Common trades at a
+// steep discount to the last preferred price early on and converges as the company matures.
+func (fs *FounderState) Calculate409A() int64 {
+	arrFloor := int64(float64(fs.MRR*12) * 3 * 0.3)
+
+	if len(fs.FundingRounds) == 0 {
+		value := fs.MRR * 12 * 3
+		if value < 2000000 {
+			value = 2000000
+		}
+		return int64(float64(value) * 0.2)
+	}
+
+	last := fs.FundingRounds[len(fs.FundingRounds)-1]
+	postMoney := last.Valuation + last.Amount
+
+	discount := 0.25
+	switch len(fs.FundingRounds) {
+	case 1:
+		discount = 0.25
+	case 2:
+		discount = 0.35
+	default:
+		discount = 0.45
+	}
+
+	value := int64(float64(postMoney) * discount)
+	if arrFloor > value {
+		value = arrFloor
+	}
+	return value
+}
+
+// Run409A commissions a new 409A valuation, resetting the strike price for new grants
+func (fs *FounderState) Run409A() string {
+	old := fs.Valuation409A
+	fs.Valuation409A = fs.Calculate409A()
+	fs.Last409AMonth = fs.Turn
+
+	if old == 0 {
+		return fmt.Sprintf("📑 409A valuation: common stock at $%.2f/share", fs.CommonSharePrice())
+	}
+	return fmt.Sprintf("📑 New 409A: common stock $%.2f → $%.2f/share",
+		float64(old)/fullyDilutedShares, fs.CommonSharePrice())
+}
+
+// CommonSharePrice is the current 409A fair market value per common share
+func (fs *FounderState) CommonSharePrice() float64 {
+	if fs.Valuation409A == 0 {
+		return float64(fs.Calculate409A()) / fullyDilutedShares
+	}
+	return float64(fs.Valuation409A) / fullyDilutedShares
+}
+
+// PreferredSharePrice is the price per share investors paid in the last round
+func (fs *FounderState) PreferredSharePrice() float64 {
+	if len(fs.FundingRounds) == 0 {
+		return 0
+	}
+	last := fs.FundingRounds[len(fs.FundingRounds)-1]
+	return float64(last.Valuation+last.Amount) / fullyDilutedShares
+}
+
+// IsUnderwater reports whether an employee's strike is above today's 409A
+func (fs *FounderState) IsUnderwater(e Employee) bool {
+	return e.Equity > 0 && e.StrikePrice > fs.CommonSharePrice()
+}
+
+// departEmployee handles the equity side of someone leaving: unvested options go back
+// to the pool immediately, vested options get a post-termination exercise window.
+func (fs *FounderState) departEmployee(e Employee, reason string) string {
+	if e.Equity <= 0 {
+		return ""
+	}
+
+	vested := heldVested(e)
+	unvested := e.Equity * (1 - vestedFraction(e))
+
+	fs.EquityAllocated -= unvested
+	if fs.EquityAllocated < 0 {
+		fs.EquityAllocated = 0
+	}
+
+	for i := range fs.CapTable {
+		if fs.CapTable[i].Name == e.Name && (fs.CapTable[i].Type == "employee" || fs.CapTable[i].Type == "executive") {
+			if vested > 0 {
+				fs.CapTable[i].Equity = vested
+				fs.CapTable[i].Type = "former_employee"
+			} else {
+				fs.CapTable = append(fs.CapTable[:i], fs.CapTable[i+1:]...)
+			}
+			break
+		}
+	}
+
+	if vested <= 0 {
+		return fmt.Sprintf("🔁 %s left before their cliff — %.2f%% returned to the option pool", e.Name, unvested)
+	}
+
+	fs.FormerEmployees = append(fs.FormerEmployees, FormerEmployee{
+		Name:             e.Name,
+		Role:             e.Role,
+		Equity:           vested,
+		StrikePrice:      e.StrikePrice,
+		DepartedMonth:    fs.Turn,
+		ExerciseDeadline: fs.Turn + exerciseWindowMonths,
+		Reason:           reason,
+	})
+
+	return fmt.Sprintf("🔁 %s left: %.2f%% unvested returned to pool, %.2f%% vested with %d months to exercise",
+		e.Name, unvested, vested, exerciseWindowMonths)
+}
+
+// removeLastEmployees drops n employees from the end of a team, settling their options
+func (fs *FounderState) removeLastEmployees(team *[]Employee, n int, reason string) []string {
+	var messages []string
+	for i := 0; i < n && len(*team) > 0; i++ {
+		last := (*team)[len(*team)-1]
+		*team = (*team)[:len(*team)-1]
+		if msg := fs.departEmployee(last, reason); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+	return messages
+}
+
+// processExerciseWindows resolves expired post-termination exercise windows
+func (fs *FounderState) processExerciseWindows() []string {
+	var messages []string
+	fmv := fs.CommonSharePrice()
+
+	for i := range fs.FormerEmployees {
+		fe := &fs.FormerEmployees[i]
+		if fe.Exercised || fe.Equity <= 0 || fs.Turn < fe.ExerciseDeadline {
+			continue
+		}
+
+		spread := fmv - fe.StrikePrice
+		exercise := false
+		if spread > 0 && fmv > 0 {
+			// Bigger spread = more worth the cash outlay (and the tax bill)
+			chance := 0.3 + 0.6*(spread/fmv)
+			exercise = fs.rng.Float64() < chance
+		}
+
+		if exercise {
+			cost := int64(fe.StrikePrice * equityToShares(fe.Equity))
+			fe.Exercised = true
+			fs.Cash += cost
+			messages = append(messages, fmt.Sprintf("🧾 %s exercised %.2f%% for $%s (strike $%.2f)",
+				fe.Name, fe.Equity, formatCurrency(cost), fe.StrikePrice))
+			continue
+		}
+
+		fs.EquityAllocated -= fe.Equity
+		if fs.EquityAllocated < 0 {
+			fs.EquityAllocated = 0
+		}
+		for j := range fs.CapTable {
+			if fs.CapTable[j].Name == fe.Name && fs.CapTable[j].Type == "former_employee" {
+				fs.CapTable = append(fs.CapTable[:j], fs.CapTable[j+1:]...)
+				break
+			}
+		}
+		reason := "couldn't afford to exercise"
+		if spread <= 0 {
+			reason = "options were underwater"
+		}
+		messages = append(messages, fmt.Sprintf("↩️  %s's %.2f%% expired unexercised (%s) — returned to pool",
+			fe.Name, fe.Equity, reason))
+		fe.Equity = 0
+	}
+
+	// Drop lapsed grants
+	kept := fs.FormerEmployees[:0]
+	for _, fe := range fs.FormerEmployees {
+		if fe.Equity > 0 {
+			kept = append(kept, fe)
+		}
+	}
+	fs.FormerEmployees = kept
+
+	return messages
+}
+
+// recentTenderOffer reports whether employees got liquidity in the last year
+func (fs *FounderState) recentTenderOffer() bool {
+	if len(fs.TenderOffers) == 0 {
+		return false
+	}
+	return fs.Turn-fs.TenderOffers[len(fs.TenderOffers)-1].Month < tenderOfferCooldown
+}
+
+// ProcessEquityPlan runs monthly: keeps the 409A current and settles exercise windows
+func (fs *FounderState) ProcessEquityPlan() []string {
+	var messages []string
+
+	if fs.Valuation409A == 0 || fs.Turn-fs.Last409AMonth >= valuation409ARefreshMonths {
+		messages = append(messages, fs.Run409A())
+	}
+
+	messages = append(messages, fs.processExerciseWindows()...)
+
+	return messages
+}
+
+// CanRunTenderOffer checks whether the company can organize a tender offer
+func (fs *FounderState) CanRunTenderOffer() (bool, string) {
+	hasPricedGrowthRound := false
+	for _, round := range fs.FundingRounds {
+		if round.RoundName != "Seed" {
+			hasPricedGrowthRound = true
+			break
+		}
+	}
+	if !hasPricedGrowthRound {
+		return false, "Tender offers need a Series A or later to attract buyers"
+	}
+	if fs.ActiveFundraise != nil {
+		return false, "Finish your fundraise before running a tender offer"
+	}
+	if len(fs.TenderOffers) > 0 && fs.recentTenderOffer() {
+		last := fs.TenderOffers[len(fs.TenderOffers)-1]
+		return false, fmt.Sprintf("Next tender offer available in month %d", last.Month+tenderOfferCooldown)
+	}
+	return true, ""
+}
+
+// RunTenderOffer sells vested employee shares and part of the founder's stake to a new
+// investor. founderSellPercent is the share of the founder's own stake sold (0-25%).
+func (fs *FounderState) RunTenderOffer(founderSellPercent float64) (*TenderOffer, error) {
+	if ok, reason := fs.CanRunTenderOffer(); !ok {
+		return nil, fmt.Errorf("%s", reason)
+	}
+	if founderSellPercent < 0 || founderSellPercent > 25 {
+		return nil, fmt.Errorf("founder can sell between 0%% and 25%% of their stake")
+	}
+
+	lastRound := fs.FundingRounds[len(fs.FundingRounds)-1]
+	buyers := GenerateInvestorNames(fs.rng, lastRound.RoundName, lastRound.Amount/4)
+	price := fs.PreferredSharePrice() * (0.8 + fs.rng.Float64()*0.15) // Common sells at a discount
+
+	offer := &TenderOffer{
+		Month:         fs.Turn,
+		Buyer:         buyers[0],
+		PricePerShare: price,
+	}
+
+	// Employees sell 10-25% of what has vested
+	sellVested := func(team []Employee) {
+		for i := range team {
+			e := &team[i]
+			vested := heldVested(*e)
+			if vested <= 0 {
+				continue
+			}
+			sold := vested * (0.10 + fs.rng.Float64()*0.15)
+			e.SoldEquity += sold
+			offer.EmployeeEquitySold += sold
+			offer.EmployeeProceeds += int64(equityToShares(sold) * price)
+			offer.Participants++
+			for j := range fs.CapTable {
+				if fs.CapTable[j].Name == e.Name && (fs.CapTable[j].Type == "employee" || fs.CapTable[j].Type == "executive") {
+					fs.CapTable[j].Equity = e.Equity - e.SoldEquity
+					break
+				}
+			}
+		}
+	}
+	sellVested(fs.Team.Executives)
+	sellVested(fs.Team.Engineers)
+	sellVested(fs.Team.Sales)
+	sellVested(fs.Team.CustomerSuccess)
+	sellVested(fs.Team.Marketing)
+
+	// Sold employee shares leave the pool without freeing room for new grants
+	fs.EquityAllocated -= offer.EmployeeEquitySold
+	fs.EquityPool -= offer.EmployeeEquitySold
+	fs.EquityGivenAway += offer.EmployeeEquitySold
+
+	founderStake := fs.founderStake()
+	if founderSellPercent > 0 && founderStake > 0 {
+		sold := founderStake * founderSellPercent / 100.0
+		offer.FounderEquitySold = sold
+		offer.FounderProceeds = int64(equityToShares(sold) * price)
+		fs.EquityGivenAway += sold
+		fs.FounderLiquidity += offer.FounderProceeds
+	}
+
+	if offer.EmployeeEquitySold+offer.FounderEquitySold <= 0 {
+		return nil, fmt.Errorf("no vested shares available to sell")
+	}
+
+	fs.CapTable = append(fs.CapTable, CapTableEntry{
+		Name:         offer.Buyer,
+		Type:         "secondary",
+		Equity:       offer.EmployeeEquitySold + offer.FounderEquitySold,
+		MonthGranted: fs.Turn,
+	})
+
+	// Liquidity is a morale boost; a founder cashing out a lot worries the board
+	if fs.TechnicalDebt != nil {
+		fs.TechnicalDebt.EngineerMorale += 0.10
+		if fs.TechnicalDebt.EngineerMorale > 1.0 {
+			fs.TechnicalDebt.EngineerMorale = 1.0
+		}
+	}
+	if founderSellPercent > 10 {
+		fs.BoardPressure += 10
+		if fs.BoardPressure > 100 {
+			fs.BoardPressure = 100
+		}
+	}
+
+	fs.TenderOffers = append(fs.TenderOffers, *offer)
+	return offer, nil
+}
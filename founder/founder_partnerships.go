@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // InitializePartnershipIntegrations initializes enhanced partnership system
@@ -112,7 +111,7 @@ func (fs *FounderState) LaunchCoMarketingCampaign(partnerName string) error {
 	}
 
 	// Campaign cost: $10-30k
-	cost := int64(10000 + rand.Int63n(20000))
+	cost := int64(10000 + fs.rng.Int63n(20000))
 	if cost > fs.Cash {
 		return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 	}
@@ -166,7 +165,7 @@ func (fs *FounderState) ProcessPartnershipIntegrations() []string {
 		// Calculate MRR contribution from revenue share
 		if pi.RevenueShare > 0 {
 			// Estimate partnership deals as 5-15% of new MRR
-			newMRRFromPartnership := int64(float64(fs.MRR) * pi.RevenueShare * (0.05 + rand.Float64()*0.10))
+			newMRRFromPartnership := int64(float64(fs.MRR) * pi.RevenueShare * (0.05 + fs.rng.Float64()*0.10))
 			pi.MRRContribution = newMRRFromPartnership
 			totalMRRContribution += newMRRFromPartnership
 		}
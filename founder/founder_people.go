@@ -0,0 +1,541 @@
+package founder
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	managerSpanOfControl  = 7  // Direct reports one manager (or exec) can handle
+	unmanagedTeamLimit    = 6  // ICs a team can have before it needs a manager
+	promotionTenureMonths = 12 // Minimum months between promotions
+	maxLevel              = 4
+)
+
+var levelTitles = map[int]string{1: "Junior", 2: "Mid", 3: "Senior", 4: "Staff"}
+
+var baseSalaryByRole = map[EmployeeRole]int64{
+	RoleEngineer:        130000,
+	RoleSales:           110000,
+	RoleCustomerSuccess: 80000,
+	RoleMarketing:       100000,
+}
+
+// marketPayMultiplier scales salary bands to local labor markets
+var marketPayMultiplier = map[string]float64{
+	"USA":         1.0,
+	"Europe":      0.80,
+	"Asia":        0.60,
+	"LATAM":       0.50,
+	"Middle East": 0.85,
+	"Africa":      0.45,
+	"Australia":   0.90,
+	"All":         0.90,
+}
+
+var hireFirstNames = []string{"Ava", "Ben", "Chloe", "Diego", "Elena", "Farah", "Gabe", "Hana", "Ivan", "Jade",
+	"Kofi", "Lena", "Mateo", "Nia", "Omar", "Priya", "Raj", "Sofia", "Theo", "Uma", "Wes", "Yara", "Zane"}
+var hireLastNames = []string{"Okafor", "Nguyen", "Garcia", "Patel", "Schmidt", "Tanaka", "Silva", "Cohen",
+	"Haddad", "Kowalski", "Murphy", "Ito", "Moreau", "Singh", "Larsen", "Diaz", "Park", "Rossi"}
+
+// LevelTitle returns the display title for a seniority level
+func LevelTitle(level int) string {
+	if title, ok := levelTitles[level]; ok {
+		return title
+	}
+	return "Mid"
+}
+
+func levelMultiplier(level int) float64 {
+	switch level {
+	case 1:
+		return 0.75
+	case 3:
+		return 1.25
+	case 4:
+		return 1.5
+	default:
+		return 1.0
+	}
+}
+
+// SalaryBand returns the annual salary for a role and level in a given market
+func SalaryBand(role EmployeeRole, level int, market string) int64 {
+	base, ok := baseSalaryByRole[role]
+	if !ok {
+		base = 100000
+	}
+	bandMultiplier := map[int]float64{1: 0.75, 2: 1.0, 3: 1.3, 4: 1.65}[level]
+	if bandMultiplier == 0 {
+		bandMultiplier = 1.0
+	}
+	payMultiplier, ok := marketPayMultiplier[market]
+	if !ok {
+		payMultiplier = 1.0
+	}
+	return int64(float64(base) * bandMultiplier * payMultiplier)
+}
+
+// newHire creates an individual contributor at a random seniority level
+func (fs *FounderState) newHire(role EmployeeRole, market string) Employee {
+	level := 2
+	roll := fs.rng.Float64()
+	if roll < 0.3 {
+		level = 1
+	} else if roll > 0.8 {
+		level = 3
+	}
+
+	// Names identify people for promotions, so avoid duplicates where we can
+	name := ""
+	for attempt := 0; attempt < 10; attempt++ {
+		name = hireFirstNames[fs.rng.Intn(len(hireFirstNames))] + " " + hireLastNames[fs.rng.Intn(len(hireLastNames))]
+		if fs.findEmployee(role, name) == nil {
+			break
+		}
+	}
+
+	return Employee{
+		Name:           name,
+		Role:           role,
+		MonthlyCost:    SalaryBand(role, level, market) / 12,
+		Impact:         0.8 + fs.rng.Float64()*0.4,
+		IsExecutive:    false,
+		AssignedMarket: market,
+		MonthHired:     fs.Turn,
+		Level:          level,
+		Morale:         0.75 + fs.rng.Float64()*0.15,
+		Performance:    1.0,
+	}
+}
+
+// initEmployee fills in simulation defaults for employees created before they existed
+func initEmployee(e *Employee) {
+	if e.Level != 0 {
+		return
+	}
+	e.Level = 2
+	e.Morale = 0.8
+	e.Performance = 1.0
+}
+
+// TeamForRole returns the team slice an individual contributor role belongs to
+func (fs *FounderState) TeamForRole(role EmployeeRole) *[]Employee {
+	switch role {
+	case RoleEngineer:
+		return &fs.Team.Engineers
+	case RoleSales:
+		return &fs.Team.Sales
+	case RoleCustomerSuccess:
+		return &fs.Team.CustomerSuccess
+	case RoleMarketing:
+		return &fs.Team.Marketing
+	}
+	return nil
+}
+
+func (fs *FounderState) icTeams() []*[]Employee {
+	return []*[]Employee{&fs.Team.Engineers, &fs.Team.Sales, &fs.Team.CustomerSuccess, &fs.Team.Marketing}
+}
+
+// hasTeamLead reports whether an executive runs this team (and can manage part of it)
+func (fs *FounderState) hasTeamLead(role EmployeeRole) bool {
+	lead := map[EmployeeRole]EmployeeRole{
+		RoleEngineer:        RoleCTO,
+		RoleSales:           RoleCGO,
+		RoleMarketing:       RoleCGO,
+		RoleCustomerSuccess: RoleCOO,
+	}[role]
+	for _, exec := range fs.Team.Executives {
+		if exec.Role == lead {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectiveImpact is what an employee actually delivers this month given level,
+// morale, burnout and crunch
+func (fs *FounderState) EffectiveImpact(e Employee) float64 {
+	if e.Level == 0 {
+		return e.Impact
+	}
+	impact := e.Impact * levelMultiplier(e.Level)
+	impact *= 0.6 + 0.5*e.Morale
+	impact *= 1.0 - 0.4*e.Burnout
+	if fs.CrunchMode {
+		impact *= 1.25
+	}
+	if e.IsManager {
+		impact *= 0.5 // Managers spend most of their time on people
+	}
+	return impact
+}
+
+// ManagementCoverage returns how many ICs a team has and how many it can support
+// without losing output to coordination overhead
+func (fs *FounderState) ManagementCoverage(role EmployeeRole) (ics int, capacity int) {
+	team := fs.TeamForRole(role)
+	if team == nil {
+		return 0, 0
+	}
+	managers := 0
+	for _, e := range *team {
+		if e.IsManager {
+			managers++
+		} else {
+			ics++
+		}
+	}
+	capacity = unmanagedTeamLimit + managers*managerSpanOfControl
+	if fs.hasTeamLead(role) {
+		capacity += managerSpanOfControl
+	}
+	return ics, capacity
+}
+
+// NeedsManager reports whether a team has outgrown its managers
+func (fs *FounderState) NeedsManager(role EmployeeRole) bool {
+	ics, capacity := fs.ManagementCoverage(role)
+	return ics > capacity
+}
+
+// managementFactor penalizes output for teams with too few managers
+func (fs *FounderState) managementFactor(role EmployeeRole) float64 {
+	ics, capacity := fs.ManagementCoverage(role)
+	if ics <= capacity {
+		return 1.0
+	}
+	return math.Max(0.6, 1.0-0.04*float64(ics-capacity))
+}
+
+// TeamOutput sums effective impact across a team, adjusted for management coverage
+func (fs *FounderState) TeamOutput(role EmployeeRole) float64 {
+	team := fs.TeamForRole(role)
+	if team == nil {
+		return 0
+	}
+	total := 0.0
+	for _, e := range *team {
+		total += fs.EffectiveImpact(e)
+	}
+	return total * fs.managementFactor(role)
+}
+
+// SetCrunchMode turns crunch time on or off: more output now, burnout later
+func (fs *FounderState) SetCrunchMode(on bool) {
+	fs.CrunchMode = on
+	if on {
+		fs.CrunchStartMonth = fs.Turn
+	}
+}
+
+// recordLayoff hurts culture and the morale of everyone who stayed
+func (fs *FounderState) recordLayoff() {
+	fs.LastLayoffMonth = fs.Turn
+	fs.Culture = math.Max(0, fs.Culture-0.10)
+	for _, team := range fs.icTeams() {
+		for i := range *team {
+			(*team)[i].Morale = math.Max(0, (*team)[i].Morale-0.15)
+		}
+	}
+}
+
+// removeLowestPerformers lets go of the n weakest performers on a team
+func (fs *FounderState) removeLowestPerformers(team *[]Employee, n int, reason string) []string {
+	sort.SliceStable(*team, func(i, j int) bool {
+		return (*team)[i].Performance > (*team)[j].Performance
+	})
+	return fs.removeLastEmployees(team, n, reason)
+}
+
+func (fs *FounderState) findEmployee(role EmployeeRole, name string) *Employee {
+	team := fs.TeamForRole(role)
+	if team == nil {
+		return nil
+	}
+	for i := range *team {
+		if (*team)[i].Name == name {
+			return &(*team)[i]
+		}
+	}
+	return nil
+}
+
+func (fs *FounderState) canPromote(e Employee) bool {
+	since := e.MonthHired
+	if e.LastPromotionMonth > since {
+		since = e.LastPromotionMonth
+	}
+	return e.Level < maxLevel && fs.Turn-since >= promotionTenureMonths
+}
+
+// PromotionCandidates lists employees eligible for promotion, best performers first
+func (fs *FounderState) PromotionCandidates() []Employee {
+	var candidates []Employee
+	for _, team := range fs.icTeams() {
+		for _, e := range *team {
+			if fs.canPromote(e) && e.Performance >= 1.0 {
+				candidates = append(candidates, e)
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Performance > candidates[j].Performance
+	})
+	return candidates
+}
+
+// PromoteEmployee moves an employee up a level and onto the next salary band
+func (fs *FounderState) PromoteEmployee(role EmployeeRole, name string) error {
+	e := fs.findEmployee(role, name)
+	if e == nil {
+		return fmt.Errorf("no %s named %s", role, name)
+	}
+	if e.Level >= maxLevel {
+		return fmt.Errorf("%s is already at the top level", name)
+	}
+	if !fs.canPromote(*e) {
+		return fmt.Errorf("%s was promoted or hired too recently", name)
+	}
+
+	e.Level++
+	e.MonthlyCost = SalaryBand(e.Role, e.Level, e.AssignedMarket) / 12
+	if e.IsManager {
+		e.MonthlyCost = int64(float64(e.MonthlyCost) * 1.15)
+	}
+	e.Morale = math.Min(1.0, e.Morale+0.2)
+	e.LastPromotionMonth = fs.Turn
+
+	// Promoting a weak performer reads as favoritism to the rest of the team
+	if e.Performance < 0.9 {
+		team := fs.TeamForRole(role)
+		for i := range *team {
+			if (*team)[i].Name != name {
+				(*team)[i].Morale = math.Max(0, (*team)[i].Morale-0.05)
+			}
+		}
+	}
+
+	fs.CalculateTeamCost()
+	fs.CalculateRunway()
+	return nil
+}
+
+// ManagerCandidate returns the best senior IC on a team to move into management
+func (fs *FounderState) ManagerCandidate(role EmployeeRole) *Employee {
+	team := fs.TeamForRole(role)
+	if team == nil {
+		return nil
+	}
+	var best *Employee
+	for i := range *team {
+		e := &(*team)[i]
+		if e.IsManager || e.Level < 3 {
+			continue
+		}
+		if best == nil || e.Performance > best.Performance {
+			best = e
+		}
+	}
+	return best
+}
+
+// MakeManager moves a senior IC into management
+func (fs *FounderState) MakeManager(role EmployeeRole, name string) error {
+	e := fs.findEmployee(role, name)
+	if e == nil {
+		return fmt.Errorf("no %s named %s", role, name)
+	}
+	if e.IsManager {
+		return fmt.Errorf("%s is already a manager", name)
+	}
+	if e.Level < 3 {
+		return fmt.Errorf("%s needs to be Senior or above to manage", name)
+	}
+
+	e.IsManager = true
+	e.MonthlyCost = int64(float64(e.MonthlyCost) * 1.15)
+	e.Morale = math.Min(1.0, e.Morale+0.1)
+
+	fs.CalculateTeamCost()
+	fs.CalculateRunway()
+	return nil
+}
+
+// moraleTarget is where an employee's morale drifts toward this month
+func (fs *FounderState) moraleTarget(e Employee, unmanaged bool) float64 {
+	target := 0.45 + 0.35*fs.Culture
+
+	if e.Equity > 0 {
+		if fs.IsUnderwater(e) {
+			target -= 0.15
+		} else if fs.Valuation409A > 0 && int64(e.Equity/100*float64(fs.Valuation409A)) > e.MonthlyCost*12 {
+			target += 0.10 // Options worth more than a year's salary
+		}
+	}
+	target -= 0.3 * e.Burnout
+	if fs.LastLayoffMonth > 0 && fs.Turn-fs.LastLayoffMonth < 6 {
+		target -= 0.15
+	}
+	if fs.recentTenderOffer() {
+		target += 0.05
+	}
+	if unmanaged {
+		target -= 0.10
+	}
+	if e.LastPromotionMonth > 0 && fs.Turn-e.LastPromotionMonth < 3 {
+		target += 0.10
+	}
+	// High performers stuck without a promotion get restless
+	if fs.canPromote(e) && e.Performance >= 1.1 && fs.Turn-e.MonthHired >= 24 {
+		target -= 0.10
+	}
+
+	return math.Max(0, math.Min(1, target))
+}
+
+// attritionRisk returns the monthly chance an employee quits and the main reason why
+func (fs *FounderState) attritionRisk(e Employee) (float64, string) {
+	risk := 0.005
+	reason := "left for a new opportunity"
+
+	if e.Morale < 0.5 {
+		risk += (0.5 - e.Morale) * 0.2
+		reason = "low morale"
+	}
+	if e.Burnout > 0.7 {
+		risk += 0.05
+		reason = "burned out"
+	}
+
+	equityRisk := 0.0
+	if fs.IsUnderwater(e) {
+		equityRisk = 0.02
+		reason = "options are underwater"
+	} else if e.Equity > 0 && e.VestingMonths > 0 && e.VestedMonths >= e.VestingMonths {
+		equityRisk = 0.015
+		reason = "fully vested, no more golden handcuffs"
+	}
+	if fs.recentTenderOffer() {
+		equityRisk *= 0.5
+	}
+
+	return risk + equityRisk, reason
+}
+
+// ProcessPeople runs the monthly individual employee simulation: burnout, morale,
+// performance and attrition
+func (fs *FounderState) ProcessPeople() []string {
+	var messages []string
+
+	// Culture slowly recovers unless the team is in crunch
+	if fs.CrunchMode {
+		fs.Culture = math.Max(0, fs.Culture-0.02)
+	} else if fs.Culture < 0.75 {
+		fs.Culture = math.Min(0.75, fs.Culture+0.01)
+	}
+
+	roles := []EmployeeRole{RoleEngineer, RoleSales, RoleCustomerSuccess, RoleMarketing}
+	for _, role := range roles {
+		team := fs.TeamForRole(role)
+		unmanaged := fs.NeedsManager(role)
+		if unmanaged && len(*team) > 0 && fs.Turn%3 == 0 {
+			messages = append(messages, fmt.Sprintf("🧭 %s team has outgrown its managers — output and morale are slipping", role))
+		}
+
+		for i := range *team {
+			e := &(*team)[i]
+			initEmployee(e)
+
+			// Burnout builds under crunch and on unmanaged teams
+			if fs.CrunchMode {
+				e.Burnout += 0.08
+			} else {
+				e.Burnout -= 0.05
+			}
+			if unmanaged {
+				e.Burnout += 0.02
+			}
+			e.Burnout = math.Max(0, math.Min(1, e.Burnout))
+
+			target := fs.moraleTarget(*e, unmanaged)
+			e.Morale += (target-e.Morale)*0.3 + (fs.rng.Float64()-0.5)*0.06
+			e.Morale = math.Max(0, math.Min(1, e.Morale))
+
+			sample := e.Impact * (0.6 + 0.5*e.Morale) * (1.0 - 0.4*e.Burnout) * (0.9 + fs.rng.Float64()*0.2)
+			e.Performance = 0.75*e.Performance + 0.25*sample
+		}
+	}
+
+	// Attrition, capped so a bad month doesn't empty the company
+	maxDepartures := 1 + fs.Team.TotalEmployees/15
+	departures := 0
+	for _, role := range roles {
+		team := fs.TeamForRole(role)
+		for i := 0; i < len(*team) && departures < maxDepartures; i++ {
+			e := (*team)[i]
+			risk, reason := fs.attritionRisk(e)
+			if fs.rng.Float64() >= risk {
+				continue
+			}
+
+			*team = append((*team)[:i], (*team)[i+1:]...)
+			i--
+			departures++
+			messages = append(messages, fmt.Sprintf("👋 %s (%s %s) quit — %s", e.Name, LevelTitle(e.Level), e.Role, reason))
+			if msg := fs.departEmployee(e, "quit"); msg != "" {
+				messages = append(messages, msg)
+			}
+
+			// Losing a strong performer rattles the rest of the team
+			if e.Performance >= 1.1 {
+				for j := range *team {
+					(*team)[j].Morale = math.Max(0, (*team)[j].Morale-0.03)
+				}
+			}
+		}
+	}
+
+	if departures > 0 {
+		fs.CalculateTeamCost()
+		fs.CalculateRunway()
+	}
+
+	if fs.CrunchMode && fs.Turn-fs.CrunchStartMonth >= 3 && fs.AverageBurnout() > 0.5 {
+		messages = append(messages, fmt.Sprintf("🔥 Crunch has run %d months — average burnout %.0f%%", fs.Turn-fs.CrunchStartMonth, fs.AverageBurnout()*100))
+	}
+
+	return messages
+}
+
+// AverageMorale returns mean morale across individual contributors
+func (fs *FounderState) AverageMorale() float64 {
+	total, count := 0.0, 0
+	for _, team := range fs.icTeams() {
+		for _, e := range *team {
+			total += e.Morale
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
+
+// AverageBurnout returns mean burnout across individual contributors
+func (fs *FounderState) AverageBurnout() float64 {
+	total, count := 0.0, 0
+	for _, team := range fs.icTeams() {
+		for _, e := range *team {
+			total += e.Burnout
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // InitializePlatform initializes the platform system
@@ -52,7 +51,7 @@ func (fs *FounderState) LaunchPlatform(platformType string) error {
 	}
 
 	// Setup cost: $100-300k
-	setupCost := int64(100000 + rand.Int63n(200000))
+	setupCost := int64(100000 + fs.rng.Int63n(200000))
 	if setupCost > fs.Cash {
 		return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(setupCost))
 	}
@@ -127,7 +126,7 @@ func (fs *FounderState) ProcessPlatformMetrics() []string {
 	}
 
 	// Third-party apps: grows with developers
-	if fs.PlatformMetrics.DeveloperCount > 50 && rand.Float64() < 0.1 {
+	if fs.PlatformMetrics.DeveloperCount > 50 && fs.rng.Float64() < 0.1 {
 		fs.PlatformMetrics.ThirdPartyApps++
 		messages = append(messages, fmt.Sprintf("📱 New third-party app launched on platform (Total: %d)", fs.PlatformMetrics.ThirdPartyApps))
 	}
@@ -135,7 +134,7 @@ func (fs *FounderState) ProcessPlatformMetrics() []string {
 	// Marketplace revenue: % of transactions
 	if fs.PlatformMetrics.PlatformType == "marketplace" {
 		// Marketplace takes 5-15% of transaction value
-		commissionRate := 0.05 + rand.Float64()*0.10
+		commissionRate := 0.05 + fs.rng.Float64()*0.10
 		// Estimate transaction volume as 2x MRR
 		transactionVolume := fs.MRR * 2
 		fs.PlatformMetrics.MarketplaceRevenue = int64(float64(transactionVolume) * commissionRate)
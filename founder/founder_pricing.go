@@ -3,7 +3,6 @@ package founder
 import (
 	"fmt"
 	"math"
-	"math/rand"
 )
 
 // InitializePricingStrategy sets up the default pricing model
@@ -234,10 +233,10 @@ func (fs *FounderState) ProcessPricingExperiment() []string {
 
 		// Generate results based on the test model
 		results := PricingResults{
-			ConversionRateChange: (rand.Float64()*0.20 - 0.10), // -10% to +10%
-			AvgDealSizeChange:    int64(rand.Float64()*4000 - 2000), // -$2k to +$2k
-			ChurnRateChange:      (rand.Float64()*0.06 - 0.03), // -3% to +3%
-			Confidence:           0.70 + rand.Float64()*0.25, // 70-95% confidence
+			ConversionRateChange: (fs.rng.Float64()*0.20 - 0.10), // -10% to +10%
+			AvgDealSizeChange:    int64(fs.rng.Float64()*4000 - 2000), // -$2k to +$2k
+			ChurnRateChange:      (fs.rng.Float64()*0.06 - 0.03), // -3% to +3%
+			Confidence:           0.70 + fs.rng.Float64()*0.25, // 70-95% confidence
 		}
 
 		// Adjust based on model characteristics
@@ -259,8 +258,9 @@ func (fs *FounderState) ProcessPricingExperiment() []string {
 		fs.ActiveExperiment.Results = results
 
 		messages = append(messages, fmt.Sprintf("🧪 Pricing experiment '%s' complete!", fs.ActiveExperiment.Name))
-		messages = append(messages, fmt.Sprintf("   Conversion Rate: %+.1f%% | Deal Size: %+$%s | Churn: %+.1f%%",
+		messages = append(messages, fmt.Sprintf("   Conversion Rate: %+.1f%% | Deal Size: %s$%s | Churn: %+.1f%%",
 			results.ConversionRateChange*100,
+			signPrefix(results.AvgDealSizeChange),
 			formatCurrency(int64(math.Abs(float64(results.AvgDealSizeChange)))),
 			results.ChurnRateChange*100))
 		messages = append(messages, fmt.Sprintf("   Confidence: %.0f%%", results.Confidence*100))
@@ -307,8 +307,9 @@ func (fs *FounderState) ApplyExperimentResults() error {
 		FromModel: oldModel,
 		ToModel:   fs.PricingStrategy.Model,
 		Reason:    fmt.Sprintf("Applied experiment: %s", fs.ActiveExperiment.Name),
-		Impact:    fmt.Sprintf("Conversion %+.1f%%, Deal Size %+$%s, Churn %+.1f%%",
+		Impact:    fmt.Sprintf("Conversion %+.1f%%, Deal Size %s$%s, Churn %+.1f%%",
 			results.ConversionRateChange*100,
+			signPrefix(results.AvgDealSizeChange),
 			formatCurrency(int64(math.Abs(float64(results.AvgDealSizeChange)))),
 			results.ChurnRateChange*100),
 	}
@@ -328,23 +329,10 @@ func (fs *FounderState) CheckCompetitorPricing() []string {
 		return messages
 	}
 
-	// Get average competitor pricing (simulate)
-	var avgCompetitorPrice int64 = fs.AvgDealSize // Default to same as ours
-
-	// Check if we have active competitors
-	if len(fs.Competitors) > 0 {
-		activeCompetitors := 0
-		for _, comp := range fs.Competitors {
-			if comp.Active {
-				activeCompetitors++
-			}
-		}
-
-		if activeCompetitors > 0 {
-			// Competitors typically price ±20% of market average
-			variance := 0.20
-			avgCompetitorPrice = int64(float64(fs.AvgDealSize) * (1.0 + (rand.Float64()*variance*2 - variance)))
-		}
+	// Competitors price relative to us; weight by who actually has the market
+	avgCompetitorPrice := int64(float64(fs.AvgDealSize) * fs.CompetitorPriceIndex())
+	if avgCompetitorPrice <= 0 {
+		return messages
 	}
 
 	// Check if we're significantly more expensive (>30% over market)
@@ -373,3 +361,10 @@ func GetPricingModelDescription(model string) string {
 	return descriptions[model]
 }
 
+// signPrefix returns "+" or "-" for displaying a signed dollar amount
+func signPrefix(v int64) string {
+	if v < 0 {
+		return "-"
+	}
+	return "+"
+}
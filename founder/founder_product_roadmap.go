@@ -3,7 +3,7 @@ package founder
 import (
 	"fmt"
 	"math"
-	"math/rand"
+	"strings"
 )
 
 // InitializeProductRoadmap creates the roadmap with available features
@@ -53,6 +53,18 @@ func getAvailableFeatures() []ProductFeature {
 			MarketAppealScore: 95,
 			Status:            "available",
 		},
+		{
+			Name:              "SCIM Provisioning",
+			Category:          "Security",
+			EngineerMonths:    2,
+			Cost:              30000,
+			ChurnReduction:    0.02,
+			CloseRateIncrease: 0.08,
+			DealSizeIncrease:  0.10,
+			MarketAppealScore: 75,
+			Status:            "available",
+			Prerequisites:     []string{"Enterprise SSO"},
+		},
 		{
 			Name:              "Advanced Analytics",
 			Category:          "Analytics",
@@ -85,6 +97,7 @@ func getAvailableFeatures() []ProductFeature {
 			DealSizeIncrease:  0.22,
 			MarketAppealScore: 88,
 			Status:            "available",
+			Prerequisites:     []string{"REST API"},
 		},
 		{
 			Name:              "Security Suite",
@@ -118,6 +131,7 @@ func getAvailableFeatures() []ProductFeature {
 			DealSizeIncrease:  0.30,
 			MarketAppealScore: 85,
 			Status:            "available",
+			Prerequisites:     []string{"Enterprise SSO"},
 		},
 		{
 			Name:              "Workflow Automation",
@@ -129,6 +143,7 @@ func getAvailableFeatures() []ProductFeature {
 			DealSizeIncrease:  0.20,
 			MarketAppealScore: 78,
 			Status:            "available",
+			Prerequisites:     []string{"REST API"},
 		},
 	}
 }
@@ -146,19 +161,16 @@ func (fs *FounderState) StartFeature(featureName string, engineers int) error {
 		return fmt.Errorf("only %d engineers available (need %d)", availableEngineers, engineers)
 	}
 
-	// Find the feature in available features
-	var featureTemplate *ProductFeature
-	for i := range fs.ProductRoadmap.AvailableFeatures {
-		if fs.ProductRoadmap.AvailableFeatures[i].Name == featureName {
-			featureTemplate = &fs.ProductRoadmap.AvailableFeatures[i]
-			break
-		}
-	}
-
+	// Find the feature in the core or advanced catalog
+	featureTemplate := fs.findFeatureTemplate(featureName)
 	if featureTemplate == nil {
 		return fmt.Errorf("feature not found: %s", featureName)
 	}
 
+	if missing := fs.MissingPrerequisites(featureName); len(missing) > 0 {
+		return fmt.Errorf("%s requires %s first", featureName, strings.Join(missing, ", "))
+	}
+
 	// Check if already in progress or completed
 	for _, f := range fs.ProductRoadmap.Features {
 		if f.Name == featureName && (f.Status == "in_progress" || f.Status == "completed") {
@@ -179,6 +191,9 @@ func (fs *FounderState) StartFeature(featureName string, engineers int) error {
 	newFeature.MonthStarted = fs.Turn
 	newFeature.DevelopmentProgress = 0
 	newFeature.AllocatedEngineers = engineers
+	if engineers > 0 {
+		newFeature.ExpectedMonth = fs.Turn + int(math.Ceil(float64(newFeature.EngineerMonths)/float64(engineers)))
+	}
 
 	fs.ProductRoadmap.Features = append(fs.ProductRoadmap.Features, newFeature)
 	fs.ProductRoadmap.InProgressCount++
@@ -253,7 +268,10 @@ func (fs *FounderState) ProcessRoadmapProgress() []string {
 		// Calculate progress based on engineers allocated
 		// Each engineer contributes ~25% progress per month per engineer-month needed
 		if feature.AllocatedEngineers > 0 {
-			progressPerMonth := (float64(feature.AllocatedEngineers) / float64(feature.EngineerMonths)) * 100.0
+			progressPerMonth := (float64(feature.AllocatedEngineers) / float64(feature.EngineerMonths)) * 100.0 * fs.roadmapVelocity()
+			if feature.Rushed {
+				progressPerMonth *= rushSpeedup
+			}
 			feature.DevelopmentProgress += int(progressPerMonth)
 
 			if feature.DevelopmentProgress >= 100 {
@@ -311,45 +329,21 @@ func (fs *FounderState) ProcessRoadmapProgress() []string {
 						feature.CloseRateIncrease*100,
 						feature.DealSizeIncrease*100))
 				}
+				messages = append(messages, fs.shipFeature(feature)...)
 			} else {
 				messages = append(messages, fmt.Sprintf("🔨 %s: %d%% complete (%d engineers working)",
 					feature.Name, feature.DevelopmentProgress, feature.AllocatedEngineers))
-			}
-		}
-	}
-
-	// Check if competitors launch features (creates pressure)
-	if rand.Float64() < 0.08 { // 8% chance per month
-		competitorFeatures := []string{"API", "Mobile App", "SSO", "Analytics", "Integrations", "Security"}
-		feature := competitorFeatures[rand.Intn(len(competitorFeatures))]
-
-		compName := "A competitor"
-		if len(fs.Competitors) > 0 {
-			activeComps := []Competitor{}
-			for _, c := range fs.Competitors {
-				if c.Active {
-					activeComps = append(activeComps, c)
+				if msg := fs.checkReleaseSlip(feature, progressPerMonth); msg != "" {
+					messages = append(messages, msg)
 				}
 			}
-			if len(activeComps) > 0 {
-				compName = activeComps[rand.Intn(len(activeComps))].Name
-			}
 		}
-
-		// Track competitor launch for innovation leader tracking
-		if fs.ProductRoadmap == nil {
-			fs.InitializeProductRoadmap()
-		}
-		launch := CompetitorFeatureLaunch{
-			FeatureName:    feature,
-			CompetitorName: compName,
-			MonthLaunched:  fs.Turn,
-		}
-		fs.ProductRoadmap.CompetitorLaunches = append(fs.ProductRoadmap.CompetitorLaunches, launch)
-
-		messages = append(messages, fmt.Sprintf("⚠️  %s launched a new %s feature! Consider your product roadmap", compName, feature))
 	}
 
+	// Bugs from recent rushed ships, and new asks from customers
+	messages = append(messages, fs.processShippedQuality()...)
+	messages = append(messages, fs.processCustomerRequests()...)
+
 	return messages
 }
 
@@ -366,6 +360,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.40,
 			MarketAppealScore: 100,
 			Status:            "available",
+			Prerequisites:     []string{"AI/ML Capabilities", "Workflow Automation"},
 		},
 		{
 			Name:              "Real-time Collaboration",
@@ -377,6 +372,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.25,
 			MarketAppealScore: 92,
 			Status:            "available",
+			Prerequisites:     []string{"Performance Optimization"},
 		},
 		{
 			Name:              "Custom Reporting Engine",
@@ -388,6 +384,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.20,
 			MarketAppealScore: 85,
 			Status:            "available",
+			Prerequisites:     []string{"Advanced Analytics"},
 		},
 		{
 			Name:              "Multi-tenant Architecture",
@@ -399,6 +396,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.35,
 			MarketAppealScore: 88,
 			Status:            "available",
+			Prerequisites:     []string{"Performance Optimization"},
 		},
 		{
 			Name:              "Global CDN & Edge Computing",
@@ -410,6 +408,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.15,
 			MarketAppealScore: 78,
 			Status:            "available",
+			Prerequisites:     []string{"Multi-tenant Architecture"},
 		},
 		{
 			Name:              "Advanced Compliance (SOC2/HIPAA)",
@@ -421,6 +420,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.30,
 			MarketAppealScore: 95,
 			Status:            "available",
+			Prerequisites:     []string{"Security Suite", "Enterprise SSO"},
 		},
 		{
 			Name:              "Predictive Analytics",
@@ -432,6 +432,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.28,
 			MarketAppealScore: 90,
 			Status:            "available",
+			Prerequisites:     []string{"Advanced Analytics", "AI/ML Capabilities"},
 		},
 		{
 			Name:              "No-Code Builder",
@@ -443,6 +444,7 @@ func getAdvancedFeatures() []ProductFeature {
 			DealSizeIncrease:  0.35,
 			MarketAppealScore: 96,
 			Status:            "available",
+			Prerequisites:     []string{"Workflow Automation", "Integrations Hub"},
 		},
 	}
 }
@@ -463,28 +465,13 @@ func (fs *FounderState) GetAvailableFeaturesToStart() []ProductFeature {
 		}
 	}
 
-	// Return core features not yet started
-	for _, f := range fs.ProductRoadmap.AvailableFeatures {
-		if !started[f.Name] {
+	// Core and advanced features not yet started whose prerequisites have shipped
+	for _, f := range fs.allFeatureTemplates() {
+		if !started[f.Name] && len(fs.MissingPrerequisites(f.Name)) == 0 {
 			available = append(available, f)
 		}
 	}
 
-	// If all core features are done (started or completed), unlock advanced features
-	coreRemaining := 0
-	for _, f := range fs.ProductRoadmap.AvailableFeatures {
-		if !started[f.Name] {
-			coreRemaining++
-		}
-	}
-	if coreRemaining == 0 {
-		for _, f := range getAdvancedFeatures() {
-			if !started[f.Name] {
-				available = append(available, f)
-			}
-		}
-	}
-
 	return available
 }
 
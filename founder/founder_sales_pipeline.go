@@ -75,7 +75,7 @@ func (fs *FounderState) GenerateNewDeals() []string {
 		vertical := ""
 		if fs.SelectedVertical != "" {
 			// 70% chance to get focused vertical
-			if rand.Float64() < 0.70 {
+			if fs.rng.Float64() < 0.70 {
 				vertical = fs.SelectedVertical
 			}
 		}
@@ -83,24 +83,22 @@ func (fs *FounderState) GenerateNewDeals() []string {
 		// Create the deal
 		deal := Deal{
 			ID:               fs.SalesPipeline.NextDealID,
-			CompanyName:      companyNames[rand.Intn(len(companyNames))],
+			CompanyName:      companyNames[fs.rng.Intn(len(companyNames))],
 			DealSize:         dealSize,
 			Stage:            "lead",
-			CloseProbability: 0.05 + rand.Float64()*0.10, // 5-15% initial probability
+			CloseProbability: 0.05 + fs.rng.Float64()*0.10, // 5-15% initial probability
 			DaysInStage:      0,
 			RequiredActions:  []string{"Qualify lead", "Schedule discovery call"},
 			AssignedSalesRep: "",
 			MonthCreated:     fs.Turn,
 			Segment:          segment,
 			Vertical:         vertical,
+			ListPrice:        dealSize,
 		}
+		fs.dealRequirements(&deal)
 
-		// Assign to sales rep if available
-		if len(fs.Team.Sales) > 0 {
-			deal.AssignedSalesRep = fs.Team.Sales[rand.Intn(len(fs.Team.Sales))].Name
-		} else {
-			deal.AssignedSalesRep = fs.FounderName + " (Founder)"
-		}
+		// Assign by rep capacity and quota; unowned deals wait for someone to free up
+		deal.AssignedSalesRep = fs.assignRep(deal)
 
 		fs.SalesPipeline.ActiveDeals = append(fs.SalesPipeline.ActiveDeals, deal)
 		fs.SalesPipeline.NextDealID++
@@ -126,16 +124,33 @@ func (fs *FounderState) ProcessPipeline() []string {
 	totalWon := 0
 	totalRevenue := int64(0)
 
+	fs.rebalanceUnassignedDeals()
+
 	// Process each active deal
 	for i := len(fs.SalesPipeline.ActiveDeals) - 1; i >= 0; i-- {
 		deal := &fs.SalesPipeline.ActiveDeals[i]
-		
+
 		// Age the deal
 		deal.DaysInStage += 30 // One month = ~30 days
 
+		messages = append(messages, fs.advanceDealWork(deal)...)
+
 		// Calculate progression probability based on stage and deal attributes
 		progressionChance := fs.SalesPipeline.ConversionRates[deal.Stage]
 
+		// Enterprise cycles are long; nobody working the deal means it drifts
+		if deal.Segment == "Enterprise" {
+			progressionChance *= 0.6
+		}
+		if deal.AssignedSalesRep == "" {
+			progressionChance *= 0.5
+		}
+
+		// Blocked deals can't close until the blocker clears
+		if deal.Stage == "negotiation" && dealBlocked(*deal) != "" {
+			progressionChance = 0
+		}
+
 		// Apply bonuses from features, ICP match, etc.
 		if fs.ProductRoadmap != nil {
 			_, closeRateBonus, _ := fs.GetFeatureBonuses()
@@ -148,29 +163,30 @@ func (fs *FounderState) ProcessPipeline() []string {
 		}
 
 		// Check if deal progresses
-		if rand.Float64() < progressionChance {
+		if fs.rng.Float64() < progressionChance {
 			switch deal.Stage {
 			case "lead":
 				deal.Stage = "qualified"
-				deal.CloseProbability = 0.15 + rand.Float64()*0.15 // 15-30%
+				deal.CloseProbability = 0.15 + fs.rng.Float64()*0.15 // 15-30%
 				deal.DaysInStage = 0
 				deal.RequiredActions = []string{"Conduct discovery call", "Send proposal"}
 
 			case "qualified":
 				deal.Stage = "demo"
-				deal.CloseProbability = 0.30 + rand.Float64()*0.20 // 30-50%
+				deal.CloseProbability = 0.30 + fs.rng.Float64()*0.20 // 30-50%
 				deal.DaysInStage = 0
 				deal.RequiredActions = []string{"Demo product", "Address objections"}
 
 			case "demo":
 				deal.Stage = "negotiation"
-				deal.CloseProbability = 0.50 + rand.Float64()*0.30 // 50-80%
+				deal.CloseProbability = 0.50 + fs.rng.Float64()*0.30 // 50-80%
 				deal.DaysInStage = 0
 				deal.RequiredActions = []string{"Negotiate terms", "Send contract"}
 
 			case "negotiation":
 				// Close the deal!
-				if rand.Float64() < deal.CloseProbability {
+				winChance, lossReason := fs.dealWinChance(*deal)
+				if fs.rng.Float64() < winChance {
 					// Won!
 					deal.Stage = "closed_won"
 					totalClosed++
@@ -194,13 +210,26 @@ func (fs *FounderState) ProcessPipeline() []string {
 					fs.TotalCustomersEver++
 					fs.MRR += deal.DealSize
 					fs.DirectMRR += deal.DealSize
+					fs.recordBooking(deal.AssignedSalesRep, deal.DealSize)
 				} else {
 					// Lost
 					deal.Stage = "closed_lost"
-					deal.LostReason = getRandomLostReason()
+					deal.LostReason = lossReason
+					if deal.LostReason == "" {
+						deal.LostReason = getRandomLostReason(fs.rng)
+					}
+					if fs.CompetitiveIntel != nil {
+						fs.CompetitiveIntel.WinLossInsights[deal.LostReason]++
+					}
 					totalClosed++
 				}
 
+				// Missing features feed the roadmap backlog
+				fs.recordDealFeatureRequests(*deal)
+				if deal.Competitor != "" {
+					fs.recordHeadToHead(*deal, deal.Stage == "closed_won")
+				}
+
 				// Move to closed deals
 				fs.SalesPipeline.ClosedDeals = append(fs.SalesPipeline.ClosedDeals, *deal)
 				fs.SalesPipeline.ActiveDeals = append(fs.SalesPipeline.ActiveDeals[:i], fs.SalesPipeline.ActiveDeals[i+1:]...)
@@ -208,9 +237,15 @@ func (fs *FounderState) ProcessPipeline() []string {
 		} else {
 			// Deal didn't progress - check if it's too old and should be marked lost
 			maxDaysInStage := 120 // 4 months max per stage
+			if deal.Segment == "Enterprise" {
+				maxDaysInStage = 180
+			}
 			if deal.DaysInStage > maxDaysInStage {
 				deal.Stage = "closed_lost"
 				deal.LostReason = "No response / Deal stalled"
+				if blocker := dealBlocked(*deal); blocker == "security review" {
+					deal.LostReason = "Security concerns"
+				}
 				fs.SalesPipeline.ClosedDeals = append(fs.SalesPipeline.ClosedDeals, *deal)
 				fs.SalesPipeline.ActiveDeals = append(fs.SalesPipeline.ActiveDeals[:i], fs.SalesPipeline.ActiveDeals[i+1:]...)
 				totalClosed++
@@ -229,6 +264,8 @@ func (fs *FounderState) ProcessPipeline() []string {
 		fs.SalesPipeline.WinRate = float64(wonCount) / float64(len(fs.SalesPipeline.ClosedDeals))
 	}
 
+	messages = append(messages, fs.settleQuarterQuotas()...)
+
 	// Report closed deals
 	if totalWon > 0 {
 		messages = append(messages, fmt.Sprintf("🎉 Closed %d deals worth $%s/month in new MRR!", totalWon, formatCurrency(totalRevenue)))
@@ -334,13 +371,13 @@ func (fs *FounderState) AccelerateDeal(dealID int, action string) error {
 
 	switch action {
 	case "demo":
-		cost = 5000 + rand.Int63n(5000) // $5-10k
+		cost = 5000 + fs.rng.Int63n(5000) // $5-10k
 		probabilityIncrease = 0.10      // +10% close probability
 	case "poc":
-		cost = 20000 + rand.Int63n(30000) // $20-50k
+		cost = 20000 + fs.rng.Int63n(30000) // $20-50k
 		probabilityIncrease = 0.20        // +20% close probability
 	case "travel":
-		cost = 2000 + rand.Int63n(3000) // $2-5k
+		cost = 2000 + fs.rng.Int63n(3000) // $2-5k
 		probabilityIncrease = 0.05      // +5% close probability
 	default:
 		return fmt.Errorf("invalid action: %s", action)
@@ -361,7 +398,7 @@ func (fs *FounderState) AccelerateDeal(dealID int, action string) error {
 }
 
 // getRandomLostReason returns a random reason for losing a deal
-func getRandomLostReason() string {
+func getRandomLostReason(rng *rand.Rand) string {
 	reasons := []string{
 		"Price too high",
 		"Chose competitor",
@@ -374,6 +411,6 @@ func getRandomLostReason() string {
 		"Lost to status quo",
 		"Product not mature enough",
 	}
-	return reasons[rand.Intn(len(reasons))]
+	return reasons[rng.Intn(len(reasons))]
 }
 
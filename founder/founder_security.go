@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // InitializeSecurity initializes the security system
@@ -46,16 +45,16 @@ func (fs *FounderState) SpawnSecurityIncident() *SecurityIncident {
 		baseProbability = 0.10 // 10% if security score < 30
 	}
 
-	if rand.Float64() > baseProbability {
+	if fs.rng.Float64() > baseProbability {
 		return nil
 	}
 
 	// Incident types
 	incidentTypes := []string{"data_breach", "ransomware", "ddos", "insider_threat", "vulnerability"}
-	incidentType := incidentTypes[rand.Intn(len(incidentTypes))]
+	incidentType := incidentTypes[fs.rng.Intn(len(incidentTypes))]
 
 	// Severity based on security score
-	severityRoll := rand.Float64()
+	severityRoll := fs.rng.Float64()
 	severity := "low"
 	if fs.SecurityPosture.SecurityScore < 40 {
 		if severityRoll < 0.3 {
@@ -77,24 +76,24 @@ func (fs *FounderState) SpawnSecurityIncident() *SecurityIncident {
 	customersAffected := 0
 	switch severity {
 	case "critical":
-		customersAffected = int(float64(fs.Customers) * (0.15 + rand.Float64()*0.15)) // 15-30%
+		customersAffected = int(float64(fs.Customers) * (0.15 + fs.rng.Float64()*0.15)) // 15-30%
 	case "high":
-		customersAffected = int(float64(fs.Customers) * (0.05 + rand.Float64()*0.10)) // 5-15%
+		customersAffected = int(float64(fs.Customers) * (0.05 + fs.rng.Float64()*0.10)) // 5-15%
 	case "medium":
-		customersAffected = int(float64(fs.Customers) * (0.01 + rand.Float64()*0.05)) // 1-5%
+		customersAffected = int(float64(fs.Customers) * (0.01 + fs.rng.Float64()*0.05)) // 1-5%
 	case "low":
-		customersAffected = int(float64(fs.Customers) * (0.001 + rand.Float64()*0.01)) // 0.1-1%
+		customersAffected = int(float64(fs.Customers) * (0.001 + fs.rng.Float64()*0.01)) // 0.1-1%
 	}
 
 	// Data exposed
 	dataTypes := []string{"PII", "financial", "health", "none"}
-	dataExposed := dataTypes[rand.Intn(len(dataTypes))]
+	dataExposed := dataTypes[fs.rng.Intn(len(dataTypes))]
 
 	// Response costs
-	responseCost := int64(50000 + rand.Int63n(150000)) // $50-200k
+	responseCost := int64(50000 + fs.rng.Int63n(150000)) // $50-200k
 	legalCosts := int64(0)
 	if severity == "critical" || severity == "high" {
-		legalCosts = int64(200000 + rand.Int63n(300000)) // $200-500k
+		legalCosts = int64(200000 + fs.rng.Int63n(300000)) // $200-500k
 	}
 
 	// Reputation damage
@@ -138,7 +137,7 @@ func (fs *FounderState) RespondToSecurityIncident(action string) error {
 	// Response actions
 	switch action {
 	case "contain":
-		cost := int64(50000 + rand.Int63n(100000)) // $50-150k
+		cost := int64(50000 + fs.rng.Int63n(100000)) // $50-150k
 		if cost > fs.Cash {
 			return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
@@ -148,7 +147,7 @@ func (fs *FounderState) RespondToSecurityIncident(action string) error {
 		incident.ReputationDamage *= 0.8 // Reduce damage by 20%
 
 	case "investigate":
-		cost := int64(100000 + rand.Int63n(200000)) // $100-300k
+		cost := int64(100000 + fs.rng.Int63n(200000)) // $100-300k
 		if cost > fs.Cash {
 			return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
@@ -158,7 +157,7 @@ func (fs *FounderState) RespondToSecurityIncident(action string) error {
 		incident.ReputationDamage *= 0.7 // Reduce damage by 30%
 
 	case "notify":
-		cost := int64(20000 + rand.Int63n(80000)) // $20-100k
+		cost := int64(20000 + fs.rng.Int63n(80000)) // $20-100k
 		if cost > fs.Cash {
 			return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
@@ -168,7 +167,7 @@ func (fs *FounderState) RespondToSecurityIncident(action string) error {
 		// Transparency reduces churn impact
 
 	case "defend":
-		cost := int64(200000 + rand.Int63n(300000)) // $200-500k
+		cost := int64(200000 + fs.rng.Int63n(300000)) // $200-500k
 		if cost > fs.Cash {
 			return fmt.Errorf("insufficient cash (need $%s)", formatCurrency(cost))
 		}
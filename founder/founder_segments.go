@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // InitializeSegments sets up default customer segments
@@ -226,7 +225,7 @@ func (fs *FounderState) GenerateDealSizeForSegment(segmentName string) int64 {
 		if seg.Name == segmentName {
 			// Generate deal size with ±30% variance
 			variance := 0.30
-			multiplier := 1.0 + (rand.Float64()*variance*2 - variance)
+			multiplier := 1.0 + (fs.rng.Float64()*variance*2 - variance)
 			dealSize := int64(float64(seg.AvgDealSize) * multiplier)
 			
 			// Apply ICP benefits if focused on this segment
@@ -289,13 +288,13 @@ func (fs *FounderState) GetSegmentConcentration() float64 {
 // based on ICP focus and current distribution
 func (fs *FounderState) SuggestSegmentForNewCustomer() string {
 	// If focused on a segment, 70% chance of getting that segment
-	if fs.SelectedICP != "" && rand.Float64() < 0.70 {
+	if fs.SelectedICP != "" && fs.rng.Float64() < 0.70 {
 		return fs.SelectedICP
 	}
 
 	// Otherwise, random distribution weighted by typical mix
 	// Enterprise: 10%, Mid-Market: 30%, SMB: 40%, Startup: 20%
-	roll := rand.Float64()
+	roll := fs.rng.Float64()
 	if roll < 0.10 {
 		return "Enterprise"
 	} else if roll < 0.40 {
@@ -330,7 +329,7 @@ func (fs *FounderState) ChangeICP(newSegment string) error {
 	}
 
 	// Lose 10-20% of customers from old segment due to pivot
-	lossRate := 0.10 + rand.Float64()*0.10
+	lossRate := 0.10 + fs.rng.Float64()*0.10
 	for i := range fs.CustomerSegments {
 		if fs.CustomerSegments[i].Name == fs.SelectedICP {
 			customersLost := int(float64(fs.CustomerSegments[i].Volume) * lossRate)
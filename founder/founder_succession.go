@@ -2,7 +2,6 @@ package founder
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // InitializeKeyPersonRisks initializes key person risk assessment
@@ -117,7 +116,7 @@ func (fs *FounderState) CreateSuccessionPlan(personName string, backupPerson str
 	}
 
 	// Training takes 3-6 months
-	trainingMonths := 3 + rand.Intn(4)
+	trainingMonths := 3 + fs.rng.Intn(4)
 
 	plan := SuccessionPlan{
 		PersonName:     personName,
@@ -153,26 +152,26 @@ func (fs *FounderState) SpawnKeyPersonEvent() *KeyPersonEvent {
 	}
 
 	totalProbability := float64(len(fs.KeyPersonRisks)) * 0.02
-	if rand.Float64() > totalProbability {
+	if fs.rng.Float64() > totalProbability {
 		return nil
 	}
 
 	// Select random key person
-	personIndex := rand.Intn(len(fs.KeyPersonRisks))
+	personIndex := fs.rng.Intn(len(fs.KeyPersonRisks))
 	person := fs.KeyPersonRisks[personIndex]
 
 	// Check retention score
-	if rand.Float64() < person.RetentionScore {
+	if fs.rng.Float64() < person.RetentionScore {
 		return nil // Person stays
 	}
 
 	// Event types
 	eventTypes := []string{"quit", "poached", "illness", "scandal", "death"}
-	eventType := eventTypes[rand.Intn(len(eventTypes))]
+	eventType := eventTypes[fs.rng.Intn(len(eventTypes))]
 
 	// Death is very rare
-	if eventType == "death" && rand.Float64() > 0.05 {
-		eventType = eventTypes[rand.Intn(len(eventTypes)-1)] // Re-roll excluding death
+	if eventType == "death" && fs.rng.Float64() > 0.05 {
+		eventType = eventTypes[fs.rng.Intn(len(eventTypes)-1)] // Re-roll excluding death
 	}
 
 	// Impact based on role
@@ -201,7 +200,7 @@ func (fs *FounderState) SpawnKeyPersonEvent() *KeyPersonEvent {
 	}
 
 	// Replacement cost
-	replacementCost := int64(50000 + rand.Int63n(150000)) // $50-200k
+	replacementCost := int64(50000 + fs.rng.Int63n(150000)) // $50-200k
 
 	// Recovery months
 	recoveryMonths := 3
@@ -0,0 +1,331 @@
+package founder
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	rushSpeedup          = 1.4 // Progress multiplier while a feature is rushed
+	rushDebtCost         = 5   // Tech debt added when the team starts cutting corners
+	rushedBugRiskMonths  = 3   // Months after a rushed ship where bugs surface
+	debtBugRiskMonths    = 2   // Same, for anything shipped on top of heavy tech debt
+	highDebtShipLevel    = 60  // Tech debt level where shipping gets risky
+	requestMilestoneStep = 5   // Announce customer demand every N requests
+)
+
+// allFeatureTemplates returns the core and advanced feature catalog
+func (fs *FounderState) allFeatureTemplates() []ProductFeature {
+	var templates []ProductFeature
+	if fs.ProductRoadmap != nil {
+		templates = append(templates, fs.ProductRoadmap.AvailableFeatures...)
+	}
+	return append(templates, getAdvancedFeatures()...)
+}
+
+// findFeatureTemplate looks a feature up in the core and advanced catalog
+func (fs *FounderState) findFeatureTemplate(name string) *ProductFeature {
+	if fs.ProductRoadmap != nil {
+		for i := range fs.ProductRoadmap.AvailableFeatures {
+			if fs.ProductRoadmap.AvailableFeatures[i].Name == name {
+				return &fs.ProductRoadmap.AvailableFeatures[i]
+			}
+		}
+	}
+	for _, f := range getAdvancedFeatures() {
+		if f.Name == name {
+			feature := f
+			return &feature
+		}
+	}
+	return nil
+}
+
+// MissingPrerequisites returns the prerequisites of a feature that haven't shipped
+func (fs *FounderState) MissingPrerequisites(name string) []string {
+	template := fs.findFeatureTemplate(name)
+	if template == nil {
+		return nil
+	}
+	var missing []string
+	for _, prereq := range template.Prerequisites {
+		if !fs.hasCompletedFeature(prereq) {
+			missing = append(missing, prereq)
+		}
+	}
+	return missing
+}
+
+// GetLockedFeatures returns unstarted features still waiting on prerequisites
+func (fs *FounderState) GetLockedFeatures() []ProductFeature {
+	if fs.ProductRoadmap == nil {
+		fs.InitializeProductRoadmap()
+	}
+
+	started := make(map[string]bool)
+	for _, f := range fs.ProductRoadmap.Features {
+		started[f.Name] = true
+	}
+
+	var locked []ProductFeature
+	for _, f := range fs.allFeatureTemplates() {
+		if !started[f.Name] && len(fs.MissingPrerequisites(f.Name)) > 0 {
+			locked = append(locked, f)
+		}
+	}
+	return locked
+}
+
+// roadmapVelocity is the tech-debt drag on engineering throughput
+func (fs *FounderState) roadmapVelocity() float64 {
+	if fs.TechnicalDebt == nil || fs.TechnicalDebt.VelocityImpact <= 0 {
+		return 1.0
+	}
+	return fs.TechnicalDebt.VelocityImpact
+}
+
+// RushFeature pushes an in-progress feature out faster, trading quality for speed
+func (fs *FounderState) RushFeature(name string) error {
+	if fs.ProductRoadmap == nil {
+		return fmt.Errorf("no product roadmap initialized")
+	}
+
+	for i := range fs.ProductRoadmap.Features {
+		feature := &fs.ProductRoadmap.Features[i]
+		if feature.Name != name || feature.Status != "in_progress" {
+			continue
+		}
+		if feature.Rushed {
+			return fmt.Errorf("%s is already being rushed", name)
+		}
+		feature.Rushed = true
+		if fs.TechnicalDebt == nil {
+			fs.InitializeTechnicalDebt()
+		}
+		fs.TechnicalDebt.CurrentLevel = int(math.Min(100, float64(fs.TechnicalDebt.CurrentLevel+rushDebtCost)))
+		return nil
+	}
+
+	return fmt.Errorf("feature not found or not in progress: %s", name)
+}
+
+// checkReleaseSlip moves a feature's projected ship month when it falls behind
+func (fs *FounderState) checkReleaseSlip(feature *ProductFeature, progressPerMonth float64) string {
+	if progressPerMonth <= 0 {
+		return ""
+	}
+
+	remaining := float64(100 - feature.DevelopmentProgress)
+	projected := fs.Turn + int(math.Ceil(remaining/progressPerMonth))
+	if feature.ExpectedMonth == 0 {
+		feature.ExpectedMonth = projected
+		return ""
+	}
+	if projected <= feature.ExpectedMonth {
+		return ""
+	}
+
+	slip := projected - feature.ExpectedMonth
+	feature.SlipMonths += slip
+	feature.ExpectedMonth = projected
+
+	cause := "understaffed"
+	if fs.roadmapVelocity() < 1.0 {
+		cause = "tech debt is slowing the team"
+	}
+	return fmt.Sprintf("📅 %s slipped %d month(s) to month %d (%s)", feature.Name, slip, projected, cause)
+}
+
+// shipFeature handles the side effects of a release: quality risk and the request backlog
+func (fs *FounderState) shipFeature(feature *ProductFeature) []string {
+	var messages []string
+
+	highDebt := fs.TechnicalDebt != nil && fs.TechnicalDebt.CurrentLevel > highDebtShipLevel
+	if feature.Rushed {
+		feature.BugRiskMonths = rushedBugRiskMonths
+		messages = append(messages, fmt.Sprintf("⚠️  %s was rushed out the door — watch for bugs", feature.Name))
+	} else if highDebt {
+		feature.BugRiskMonths = debtBugRiskMonths
+	}
+
+	for i, req := range fs.ProductRoadmap.FeatureRequests {
+		if req.FeatureName != feature.Name {
+			continue
+		}
+		messages = append(messages, fmt.Sprintf("📣 %s answers %d customer requests and %d lost deals",
+			feature.Name, req.Requests, req.LostDeals))
+		fs.ProductRoadmap.FeatureRequests = append(fs.ProductRoadmap.FeatureRequests[:i], fs.ProductRoadmap.FeatureRequests[i+1:]...)
+		break
+	}
+
+	return messages
+}
+
+// processShippedQuality rolls for bugs and incidents on recently shipped features
+func (fs *FounderState) processShippedQuality() []string {
+	var messages []string
+
+	for i := range fs.ProductRoadmap.Features {
+		feature := &fs.ProductRoadmap.Features[i]
+		if feature.Status != "completed" || feature.BugRiskMonths <= 0 || feature.MonthCompleted == fs.Turn {
+			continue
+		}
+		feature.BugRiskMonths--
+
+		bugChance, incidentChance := 0.15, 0.05
+		if feature.Rushed {
+			bugChance, incidentChance = 0.30, 0.10
+		}
+
+		roll := fs.rng.Float64()
+		switch {
+		case roll < incidentChance:
+			fs.CustomerChurnRate = math.Min(0.30, fs.CustomerChurnRate+0.015)
+			fs.ProductMaturity = math.Max(0, fs.ProductMaturity-0.02)
+			if fs.TechnicalDebt != nil {
+				fs.TechnicalDebt.CurrentLevel = int(math.Min(100, float64(fs.TechnicalDebt.CurrentLevel+5)))
+			}
+			messages = append(messages, fmt.Sprintf("🔥 INCIDENT: %s caused an outage! Churn +1.5%%, engineers pulled into firefighting", feature.Name))
+		case roll < incidentChance+bugChance:
+			fs.CustomerChurnRate = math.Min(0.30, fs.CustomerChurnRate+0.005)
+			messages = append(messages, fmt.Sprintf("🐛 Customers are hitting bugs in %s (churn +0.5%%)", feature.Name))
+		}
+	}
+
+	return messages
+}
+
+// RecordFeatureRequest adds demand for a feature to the backlog
+func (fs *FounderState) RecordFeatureRequest(name string) {
+	fs.recordFeatureDemand(name, false, 0)
+}
+
+func (fs *FounderState) recordFeatureDemand(name string, lostDeal bool, mrr int64) *FeatureRequest {
+	if fs.ProductRoadmap == nil {
+		fs.InitializeProductRoadmap()
+	}
+	if fs.hasCompletedFeature(name) {
+		return nil
+	}
+
+	var req *FeatureRequest
+	for i := range fs.ProductRoadmap.FeatureRequests {
+		if fs.ProductRoadmap.FeatureRequests[i].FeatureName == name {
+			req = &fs.ProductRoadmap.FeatureRequests[i]
+			break
+		}
+	}
+	if req == nil {
+		fs.ProductRoadmap.FeatureRequests = append(fs.ProductRoadmap.FeatureRequests, FeatureRequest{FeatureName: name})
+		req = &fs.ProductRoadmap.FeatureRequests[len(fs.ProductRoadmap.FeatureRequests)-1]
+	}
+
+	if lostDeal {
+		req.LostDeals++
+		req.LostMRR += mrr
+	} else {
+		req.Requests++
+	}
+	req.LastRequested = fs.Turn
+	return req
+}
+
+// recordDealFeatureRequests logs missing features from a closed deal: lost deals
+// count against the feature, won deals become customer asks
+func (fs *FounderState) recordDealFeatureRequests(deal Deal) {
+	lost := deal.Stage == "closed_lost"
+	for _, name := range fs.MissingFeatures(deal) {
+		fs.recordFeatureDemand(name, lost, deal.DealSize)
+	}
+}
+
+// processCustomerRequests has active customers ask for features they want
+func (fs *FounderState) processCustomerRequests() []string {
+	var messages []string
+	if fs.Customers == 0 {
+		return messages
+	}
+
+	chance := math.Min(0.6, float64(fs.Customers)/40.0)
+	if fs.rng.Float64() >= chance {
+		return messages
+	}
+
+	var candidates []string
+	for _, f := range fs.GetAvailableFeaturesToStart() {
+		candidates = append(candidates, f.Name)
+	}
+	for _, f := range fs.GetLockedFeatures() {
+		candidates = append(candidates, f.Name)
+	}
+	if len(candidates) == 0 {
+		return messages
+	}
+
+	name := candidates[fs.rng.Intn(len(candidates))]
+	if req := fs.recordFeatureDemand(name, false, 0); req != nil && req.Requests%requestMilestoneStep == 0 {
+		messages = append(messages, fmt.Sprintf("📣 %d customers have now asked for %s", req.Requests, name))
+	}
+	return messages
+}
+
+// Priority scores a request for the backlog: lost revenue weighs most, then open deals, then asks
+func (r FeatureRequest) Priority() float64 {
+	return float64(r.Requests)*10 + float64(r.LostDeals)*25 + float64(r.LostMRR+r.BlockedMRR)/500
+}
+
+// GetFeatureBacklog returns unshipped feature demand, highest priority first
+func (fs *FounderState) GetFeatureBacklog() []FeatureRequest {
+	if fs.ProductRoadmap == nil {
+		return nil
+	}
+
+	byName := make(map[string]*FeatureRequest)
+	var backlog []*FeatureRequest
+	for _, req := range fs.ProductRoadmap.FeatureRequests {
+		r := req
+		byName[r.FeatureName] = &r
+		backlog = append(backlog, &r)
+	}
+
+	// Open deals waiting on a feature count toward its priority
+	if fs.SalesPipeline != nil {
+		for _, deal := range fs.SalesPipeline.ActiveDeals {
+			if !deal.DiscoveryDone {
+				continue
+			}
+			for _, name := range fs.MissingFeatures(deal) {
+				r, ok := byName[name]
+				if !ok {
+					r = &FeatureRequest{FeatureName: name}
+					byName[name] = r
+					backlog = append(backlog, r)
+				}
+				r.BlockedDeals++
+				r.BlockedMRR += deal.DealSize
+			}
+		}
+	}
+
+	result := make([]FeatureRequest, 0, len(backlog))
+	for _, r := range backlog {
+		if !fs.hasCompletedFeature(r.FeatureName) {
+			result = append(result, *r)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Priority() > result[j].Priority()
+	})
+	return result
+}
+
+// FeatureDemand returns total asks plus lost and open deals for a feature
+func (fs *FounderState) FeatureDemand(name string) int {
+	for _, req := range fs.GetFeatureBacklog() {
+		if req.FeatureName == name {
+			return req.Requests + req.LostDeals + req.BlockedDeals
+		}
+	}
+	return 0
+}
@@ -1,6 +1,7 @@
 package founder
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -162,3 +163,344 @@ func TestGetFinalScore(t *testing.T) {
 		t.Errorf("Founder equity should be between 0 and 100, got %.2f", founderEquity)
 	}
 }
+
+func TestFundraiseProcess(t *testing.T) {
+	template := StartupTemplate{
+		ID:               "test",
+		Name:             "Test Startup",
+		Type:             "SaaS",
+		InitialCash:      100000,
+		InitialCustomers: 5,
+		InitialMRR:       5000,
+		AvgDealSize:      1000,
+		BaseChurnRate:    0.05,
+		BaseCAC:          1000,
+		TargetMarketSize: 10000,
+		CompetitionLevel: "medium",
+		InitialTeam: map[string]int{
+			"engineers":        2,
+			"sales":            1,
+			"customer_success": 1,
+			"marketing":        0,
+		},
+	}
+
+	fs := NewFounderGame("TestFounder", template, []string{})
+
+	process, err := fs.StartFundraise("Seed")
+	if err != nil {
+		t.Fatalf("StartFundraise failed: %v", err)
+	}
+	if len(process.Prospects) == 0 {
+		t.Error("Fundraise should start with a target list of investors")
+	}
+	if _, err := fs.StartFundraise("Seed"); err == nil {
+		t.Error("Should not be able to start a second fundraise while one is active")
+	}
+
+	// Let the deadline pass without any meetings
+	for fs.ActiveFundraise != nil && fs.Turn <= process.Deadline+1 {
+		fs.Turn++
+		fs.ProcessFundraising()
+	}
+
+	if fs.ActiveFundraise != nil {
+		t.Fatal("Fundraise should fail once the deadline passes with no term sheets")
+	}
+	if fs.FailedRaiseCount() != 1 {
+		t.Errorf("Expected 1 failed raise, got %d", fs.FailedRaiseCount())
+	}
+	if ok, _ := fs.CanStartFundraise("Seed"); ok {
+		t.Error("Should have to wait before retrying a failed raise")
+	}
+	if len(fs.FundingRounds) != 0 {
+		t.Error("A failed raise should not add funding")
+	}
+}
+
+func TestLiquidationWaterfall(t *testing.T) {
+	fs := &FounderState{FounderName: "TestFounder"}
+	fs.EquityGivenAway = 20
+	fs.FundingRounds = []FundingRound{
+		{RoundName: "Seed", Amount: 2000000, EquityGiven: 20, LiquidationPref: 2.0, Participating: true, Seniority: 1},
+	}
+
+	// Low exit: 2x participating preference eats most of the proceeds
+	wf := fs.CalculateWaterfall(5000000, false)
+	if wf.PreferenceTotal != 4000000 {
+		t.Errorf("Expected $4M in preferences, got %d", wf.PreferenceTotal)
+	}
+	if wf.FounderPayout >= 4000000 {
+		t.Errorf("Founder should get less than pro-rata 80%% on a low exit, got %d", wf.FounderPayout)
+	}
+	if wf.FounderPayout+wf.InvestorPayout+wf.EmployeePayout > wf.ExitValue {
+		t.Error("Waterfall paid out more than the exit value")
+	}
+
+	// IPO: preferred converts, everyone is paid pro-rata
+	wf = fs.CalculateWaterfall(100000000, true)
+	if wf.PreferenceTotal != 0 {
+		t.Errorf("IPO should convert all preferred, got %d in preferences", wf.PreferenceTotal)
+	}
+	if wf.FounderPayout != 80000000 {
+		t.Errorf("Expected founder to get $80M at IPO, got %d", wf.FounderPayout)
+	}
+}
+
+func TestFounderExitPayoutByExitType(t *testing.T) {
+	fs := &FounderState{FounderName: "TestFounder", HasExited: true, ExitValuation: 10000000}
+	for exitType, want := range map[string]int64{"acquisition": 10000000, "ipo": 2000000, "secondary": 5000000} {
+		fs.ExitType = exitType
+		if got := fs.FounderExitPayout(); got != want {
+			t.Errorf("%s: expected the founder to take home %d, got %d", exitType, want, got)
+		}
+	}
+}
+
+func TestOptionsReturnToPoolOnDeparture(t *testing.T) {
+	template := StartupTemplate{
+		ID:               "test",
+		Name:             "Test Startup",
+		Type:             "SaaS",
+		InitialCash:      100000,
+		InitialCustomers: 5,
+		InitialMRR:       5000,
+		AvgDealSize:      1000,
+		BaseChurnRate:    0.05,
+		BaseCAC:          1000,
+		TargetMarketSize: 10000,
+		CompetitionLevel: "medium",
+		InitialTeam: map[string]int{
+			"engineers":        2,
+			"sales":            1,
+			"customer_success": 1,
+			"marketing":        0,
+		},
+	}
+
+	fs := NewFounderGame("TestFounder", template, []string{})
+	if fs.Valuation409A <= 0 {
+		t.Fatal("New company should have an initial 409A")
+	}
+
+	// Half-vested engineer with an underwater strike
+	last := &fs.Team.Engineers[len(fs.Team.Engineers)-1]
+	last.HasCliff = true
+	last.VestedMonths = 24
+	last.StrikePrice = fs.CommonSharePrice() * 10
+	grant := last.Equity
+	allocatedBefore := fs.EquityAllocated
+
+	if err := fs.FireEmployee(RoleEngineer); err != nil {
+		t.Fatalf("FireEmployee failed: %v", err)
+	}
+	if got := allocatedBefore - fs.EquityAllocated; got < grant*0.49 || got > grant*0.51 {
+		t.Errorf("Expected unvested half (%.2f%%) back in the pool, got %.2f%%", grant/2, got)
+	}
+	if len(fs.FormerEmployees) != 1 {
+		t.Fatalf("Expected 1 former employee holding vested options, got %d", len(fs.FormerEmployees))
+	}
+
+	// Underwater options lapse when the exercise window closes
+	fs.Turn = fs.FormerEmployees[0].ExerciseDeadline
+	fs.processExerciseWindows()
+	if len(fs.FormerEmployees) != 0 {
+		t.Error("Underwater options should expire unexercised")
+	}
+	if got := allocatedBefore - fs.EquityAllocated; got < grant*0.99 {
+		t.Errorf("Expected the full grant back in the pool, got %.2f%% of %.2f%%", got, grant)
+	}
+}
+
+func TestTenderOfferKeepsGrantAndSplit(t *testing.T) {
+	fs := NewFounderGame("TestFounder", StartupTemplate{
+		ID: "test", Name: "Test Startup", Type: "SaaS", InitialCash: 100000,
+		InitialMRR: 5000, AvgDealSize: 1000, BaseChurnRate: 0.05, BaseCAC: 1000,
+		TargetMarketSize: 10000, InitialTeam: map[string]int{"engineers": 1},
+	}, []string{})
+	fs.FundingRounds = append(fs.FundingRounds, FundingRound{RoundName: "Series A", Amount: 5000000, Valuation: 20000000, EquityGiven: 20})
+	fs.EquityGivenAway = 20
+
+	e := &fs.Team.Engineers[0]
+	e.HasCliff, e.VestedMonths, e.VestingMonths = true, 24, 48
+	grant := e.Equity
+	stake := fs.founderStake()
+
+	offer, err := fs.RunTenderOffer(10)
+	if err != nil {
+		t.Fatalf("RunTenderOffer failed: %v", err)
+	}
+	e = &fs.Team.Engineers[0]
+	if e.Equity != grant || e.SoldEquity != offer.EmployeeEquitySold {
+		t.Errorf("Grant should stay %.3f%% with %.3f%% sold, got %.3f%% and %.3f%%", grant, offer.EmployeeEquitySold, e.Equity, e.SoldEquity)
+	}
+	// Half the grant vested; what's sold comes out of that half only
+	if held := heldVested(*e); held < grant/2-e.SoldEquity-1e-9 || held > grant/2-e.SoldEquity+1e-9 {
+		t.Errorf("Expected %.3f%% vested still held, got %.3f%%", grant/2-e.SoldEquity, held)
+	}
+	if offer.FounderEquitySold < stake*0.1-1e-9 || offer.FounderEquitySold > stake*0.1+1e-9 {
+		t.Errorf("Founder should sell 10%% of a %.2f%% stake, sold %.3f%%", stake, offer.FounderEquitySold)
+	}
+	// The waterfall sees the same founder stake the tender sold from
+	if got := fs.founderStake(); got < stake*0.9-1e-9 || got > stake*0.9+1e-9 {
+		t.Errorf("Expected the founder to keep %.3f%%, got %.3f%%", stake*0.9, got)
+	}
+}
+
+func TestTeamManagementAndCrunch(t *testing.T) {
+	fs := &FounderState{Culture: 0.7, rng: rand.New(rand.NewSource(1))}
+	for i := 0; i < 10; i++ {
+		e := fs.newHire(RoleEngineer, "USA")
+		e.Level = 3
+		e.Morale = 0.8
+		fs.Team.Engineers = append(fs.Team.Engineers, e)
+	}
+
+	if !fs.NeedsManager(RoleEngineer) {
+		t.Fatal("10 engineers with no managers should need a manager")
+	}
+	raw := 0.0
+	for _, e := range fs.Team.Engineers {
+		raw += fs.EffectiveImpact(e)
+	}
+	if fs.TeamOutput(RoleEngineer) >= raw {
+		t.Error("An unmanaged team should lose output to coordination overhead")
+	}
+
+	candidate := fs.ManagerCandidate(RoleEngineer)
+	if candidate == nil {
+		t.Fatal("Expected a senior engineer to be a manager candidate")
+	}
+	if err := fs.MakeManager(RoleEngineer, candidate.Name); err != nil {
+		t.Fatalf("MakeManager failed: %v", err)
+	}
+	if fs.NeedsManager(RoleEngineer) {
+		t.Error("One manager should cover a team of 9 ICs")
+	}
+
+	fs.SetCrunchMode(true)
+	for i := 0; i < 4; i++ {
+		fs.Turn++
+		fs.ProcessPeople()
+	}
+	if fs.AverageBurnout() < 0.2 {
+		t.Errorf("Four months of crunch should build burnout, got %.2f", fs.AverageBurnout())
+	}
+}
+
+func TestDealDeskAssignmentAndFeatureGaps(t *testing.T) {
+	fs := &FounderState{FounderName: "Ada", SalesPipeline: &SalesPipeline{}, rng: rand.New(rand.NewSource(1))}
+	rep := fs.newHire(RoleSales, "USA")
+	rep.Level = 1
+	fs.Team.Sales = append(fs.Team.Sales, rep)
+
+	for i := 0; i < RepCapacity(rep)+2; i++ {
+		deal := Deal{ID: i + 1, Segment: "SMB", Stage: "lead"}
+		deal.AssignedSalesRep = fs.assignRep(deal)
+		fs.SalesPipeline.ActiveDeals = append(fs.SalesPipeline.ActiveDeals, deal)
+	}
+	if got := fs.DealsForRep(rep.Name); got != RepCapacity(rep) {
+		t.Errorf("Rep should be filled to capacity %d, got %d", RepCapacity(rep), got)
+	}
+	if fs.DealsForRep(fs.founderRepName()) != 2 {
+		t.Error("Overflow deals should fall back to the founder")
+	}
+
+	deal := Deal{CloseProbability: 0.5, RequiredFeatures: []string{"Enterprise SSO"}}
+	withGap, reason := fs.dealWinChance(deal)
+	if withGap >= 0.5 {
+		t.Errorf("A missing required feature should lower win chance, got %.2f", withGap)
+	}
+	if reason != "Missing features: Enterprise SSO" {
+		t.Errorf("Unexpected loss reason %q", reason)
+	}
+}
+
+func TestRoadmapDependenciesAndBacklog(t *testing.T) {
+	fs := &FounderState{Cash: 1000000, Turn: 1}
+	fs.InitializeProductRoadmap()
+	for i := 0; i < 4; i++ {
+		fs.Team.Engineers = append(fs.Team.Engineers, Employee{Role: RoleEngineer})
+	}
+
+	if err := fs.StartFeature("SCIM Provisioning", 1); err == nil {
+		t.Fatal("SCIM should require Enterprise SSO first")
+	}
+	if err := fs.StartFeature("Enterprise SSO", 3); err != nil {
+		t.Fatalf("StartFeature failed: %v", err)
+	}
+
+	lost := Deal{Stage: "closed_lost", DealSize: 8000, RequiredFeatures: []string{"SCIM Provisioning"}}
+	fs.recordDealFeatureRequests(lost)
+	fs.RecordFeatureRequest("Mobile App")
+
+	backlog := fs.GetFeatureBacklog()
+	if len(backlog) != 2 || backlog[0].FeatureName != "SCIM Provisioning" {
+		t.Fatalf("Lost revenue should put SCIM at the top of the backlog, got %+v", backlog)
+	}
+
+	fs.TechnicalDebt = &TechnicalDebt{CurrentLevel: 70, VelocityImpact: 0.5}
+	for fs.Turn = 2; fs.Turn < 12 && !fs.hasCompletedFeature("Enterprise SSO"); fs.Turn++ {
+		fs.ProcessRoadmapProgress()
+	}
+	if !fs.hasCompletedFeature("Enterprise SSO") {
+		t.Fatal("Enterprise SSO should ship eventually")
+	}
+	for _, f := range fs.ProductRoadmap.Features {
+		if f.Name == "Enterprise SSO" && f.SlipMonths == 0 {
+			t.Error("Heavy tech debt should have slipped the release")
+		}
+	}
+	if err := fs.StartFeature("SCIM Provisioning", 1); err != nil {
+		t.Errorf("SCIM should unlock once SSO ships: %v", err)
+	}
+}
+
+func TestCompetitorSimulation(t *testing.T) {
+	fs := &FounderState{Turn: 10, ProductMaturity: 0.5, rng: rand.New(rand.NewSource(1))}
+	fs.InitializeProductRoadmap()
+
+	weak := Competitor{Name: "Aviato", Threat: "low", MarketShare: 0.05, Active: true}
+	strong := Competitor{Name: "Nucleus", Threat: "high", MarketShare: 0.20, Active: true}
+	fs.initCompetitorCompany(&weak)
+	fs.initCompetitorCompany(&strong)
+	strong.ProductScore = 0.95
+	fs.Competitors = []Competitor{weak, strong}
+
+	if fs.competitorDealPenalty("Nucleus") <= fs.competitorDealPenalty("Aviato") {
+		t.Error("A stronger competitor should hurt close rates more")
+	}
+
+	comp := &fs.Competitors[1]
+	comp.RoadmapProgress = 0.99
+	fs.simulateCompetitor(comp)
+	if len(fs.ProductRoadmap.CompetitorLaunches) == 0 {
+		t.Fatal("Competitor launches should feed the roadmap's competitor launch history")
+	}
+	if launch := fs.ProductRoadmap.CompetitorLaunches[0]; launch.CompetitorName != "Nucleus" {
+		t.Errorf("Launch attributed to %q, want Nucleus", launch.CompetitorName)
+	}
+}
+
+func TestRosterInvestorsFollowThesisAndStrategy(t *testing.T) {
+	fs := &FounderState{Category: "DeepTech", CashRunwayMonths: 12, rng: rand.New(rand.NewSource(1))}
+
+	firms := map[string]bool{}
+	for _, p := range fs.rosterProspects("Series A") {
+		firms[p.Firm] = true
+	}
+	if !firms["Index Ventures"] {
+		t.Error("A deep tech fund should want to meet a DeepTech Series A")
+	}
+	if firms["Greylock Partners"] {
+		t.Error("An enterprise SaaS fund should pass on a DeepTech company")
+	}
+
+	// A patient seed fund forgives a bad month that a conservative fund won't
+	fs.BoardMembers = []BoardMember{{Name: "Y Combinator", Type: "investor", IsActive: true, HasBoardSeat: true}}
+	patient := fs.boardStrategyAdjustment(30)
+	fs.BoardMembers[0].Name = "Sterling & Cooper"
+	if strict := fs.boardStrategyAdjustment(30); strict >= patient {
+		t.Errorf("Conservative director (%.1f) should be harsher than a seed fund (%.1f)", strict, patient)
+	}
+}
@@ -0,0 +1,43 @@
+package founder
+
+// MonthSnapshot is the company's key numbers at the end of one month
+type MonthSnapshot struct {
+	Month     int
+	Cash      int64
+	MRR       int64
+	NetBurn   int64 // Monthly costs less net revenue (negative = cash positive)
+	Runway    int   // Months of cash left (-1 = cash positive)
+	Customers int
+}
+
+// RecordSnapshot captures this month's numbers for charts and post-game history
+func (fs *FounderState) RecordSnapshot() {
+	fs.History = append(fs.History, MonthSnapshot{
+		Month:     fs.Turn,
+		Cash:      fs.Cash,
+		MRR:       fs.MRR,
+		NetBurn:   fs.NetBurn(),
+		Runway:    fs.CashRunwayMonths,
+		Customers: fs.Customers,
+	})
+}
+
+// NetBurn is monthly costs less net revenue, matching CalculateRunway
+func (fs *FounderState) NetBurn() int64 {
+	netRevenue := int64(float64(fs.MRR) * 0.67)
+	monthlyCosts := fs.MonthlyTeamCost + (int64(fs.Team.TotalEmployees) * 2000) + fs.MonthlyComputeCost + fs.MonthlyODCCost
+	return monthlyCosts - netRevenue
+}
+
+// HistorySeries returns every recorded series by name, for charts and saving with the game
+func (fs *FounderState) HistorySeries() map[string][]int64 {
+	series := map[string][]int64{}
+	for _, snap := range fs.History {
+		series["cash"] = append(series["cash"], snap.Cash)
+		series["mrr"] = append(series["mrr"], snap.MRR)
+		series["net_burn"] = append(series["net_burn"], snap.NetBurn)
+		series["runway"] = append(series["runway"], int64(snap.Runway))
+		series["customers"] = append(series["customers"], int64(snap.Customers))
+	}
+	return series
+}
@@ -1,5 +1,7 @@
 package founder
 
+import "math/rand"
+
 // EmployeeRole represents different types of employees
 type EmployeeRole string
 
@@ -28,6 +30,16 @@ type Employee struct {
 	HasCliff       bool    // Has cliff been reached
 	MonthHired     int     // Month when hired
 	AssignedMarket string  // Market assignment: "USA", "Europe", "Asia", "All", etc.
+	StrikePrice    float64 // Option strike per share, set from the 409A at grant
+	SoldEquity     float64 // Vested equity already sold in tender offers (part of Equity)
+
+	// Individual simulation
+	Level              int     // 1=Junior, 2=Mid, 3=Senior, 4=Staff
+	IsManager          bool    // Manages other ICs on their team
+	Morale             float64 // 0-1
+	Performance        float64 // Rolling output vs. expectations (1.0 = meets)
+	Burnout            float64 // 0-1, builds under crunch
+	LastPromotionMonth int
 }
 
 // CapTableEntry tracks individual equity ownership
@@ -109,6 +121,8 @@ type FounderState struct {
 	TargetMarketSize   int
 	CompetitionLevel   string
 	FundingRounds      []FundingRound
+	ActiveFundraise    *FundraisingProcess // Raise currently in progress (nil if none)
+	FundraiseHistory   []FundraiseAttempt  // Every fundraising process, including failures
 	EquityGivenAway    float64       // Total % equity given to investors
 	BoardSeats         int           // Board seats given to investors
 	BoardMembers       []BoardMember // All board members/advisors
@@ -135,6 +149,15 @@ type FounderState struct {
 	RandomEvents       []RandomEvent
 	ActiveEventEffects map[string]EventImpact // Events currently affecting the business
 	CapTable           []CapTableEntry        // Individual equity ownership tracking
+	Valuation409A      int64                  // Fair market value of common stock (sets strike prices)
+	Last409AMonth      int
+	FormerEmployees    []FormerEmployee // Departed employees holding vested options or shares
+	TenderOffers       []TenderOffer
+	FounderLiquidity   int64 // Cash the founder has taken off the table in tender offers
+	Culture            float64 // 0-1, company culture (drives baseline morale)
+	CrunchMode         bool
+	CrunchStartMonth   int
+	LastLayoffMonth    int
 
 	// Infrastructure costs
 	MonthlyComputeCost int64 // Cloud compute costs (scales with customers)
@@ -208,6 +231,11 @@ type FounderState struct {
 	
 	// Roadmap tracking for achievements
 	CustomersLostDuringRoadmap int // Track customers churned while features were in progress
+
+	History []MonthSnapshot // Per-month metrics for charts
+
+	Seed int64      // Same seed and same choices = same game
+	rng  *rand.Rand // Every roll in the game comes from Seed
 }
 
 // Customer represents an individual customer deal
@@ -242,6 +270,13 @@ type FundingRound struct {
 	Month       int
 	Terms       string   // "Founder-friendly", "Standard", "Investor-heavy"
 	Investors   []string // Names of investors in this round
+
+	ProRataInvestors []string // Investors holding pro-rata rights from this round
+
+	// Liquidation preference terms, applied in the exit waterfall
+	LiquidationPref float64 // Preference multiple (1.0 = 1x, 2.0 = 2x)
+	Participating   bool    // Takes preference AND shares in common proceeds
+	Seniority       int     // Higher is paid first; equal seniority is pari passu
 }
 
 // TermSheetOption represents different fundraising options to choose from
@@ -320,6 +355,23 @@ type Competitor struct {
 	MonthAppeared   int
 	Active          bool
 	LastActionMonth int // Prevent multiple actions in same turn
+
+	// Company simulation
+	Stage           string   // "Seed", "Series A", ..., "Public"
+	TotalFunding    int64
+	Cash            int64
+	Headcount       int
+	MRR             int64
+	ProductScore    float64  // 0-1 product strength
+	PriceIndex      float64  // Their price relative to ours (1.0 = parity)
+	Features        []string // Features they've launched
+	RoadmapProgress float64  // Progress toward their next launch (0-1)
+	LastRaiseMonth  int
+	LastPriceMove   int
+	DealsWon        int    // Head-to-head deals they took from us
+	DealsLost       int    // Head-to-head deals we took from them
+	ExitType        string // "", "acquired", "shutdown"
+	ExitMonth       int
 }
 
 // Market represents a geographic expansion
@@ -412,6 +464,22 @@ type ProductFeature struct {
 	MonthCompleted      int
 	DevelopmentProgress int // 0-100%
 	AllocatedEngineers  int // Engineers currently working on this
+	Prerequisites       []string // Features that must ship first
+	ExpectedMonth       int      // Projected ship month (moves when the release slips)
+	SlipMonths          int      // Total months the release has slipped
+	Rushed              bool     // Shipping fast at the cost of quality
+	BugRiskMonths       int      // Months after ship where bugs/incidents can surface
+}
+
+// FeatureRequest aggregates customer and prospect demand for a feature
+type FeatureRequest struct {
+	FeatureName   string
+	Requests      int   // Asks from customers and won deals
+	LostDeals     int   // Deals lost because it was missing
+	LostMRR       int64 // MRR from those lost deals
+	BlockedDeals  int   // Open deals currently waiting on it (computed)
+	BlockedMRR    int64 // MRR of those open deals (computed)
+	LastRequested int
 }
 
 // ProductRoadmap represents the product development pipeline
@@ -421,6 +489,7 @@ type ProductRoadmap struct {
 	CompletedCount    int
 	InProgressCount   int
 	CompetitorLaunches []CompetitorFeatureLaunch // Track competitor feature launches
+	FeatureRequests    []FeatureRequest          // Demand from customers and the pipeline
 }
 
 // CompetitorFeatureLaunch tracks when a competitor launched a feature
@@ -444,6 +513,16 @@ type Deal struct {
 	LostReason      string // if closed_lost
 	Segment         string // "Enterprise", "Mid-Market", "SMB", "Startup"
 	Vertical        string // Industry vertical
+
+	// Deal desk
+	ListPrice        int64    // Deal size before discounts
+	Discount         float64  // Fraction off list (max 0.30)
+	Competitor       string   // Competitor in the deal ("" = status quo)
+	RequiredFeatures []string // Roadmap features the buyer needs before signing
+	DiscoveryDone    bool
+	POCStatus        string // "", "running", "passed", "failed"
+	POCMonthsLeft    int
+	SecurityReview   string // "", "required", "in_progress", "passed", "failed"
 }
 
 // SalesPipeline represents the sales funnel and metrics
@@ -457,6 +536,7 @@ type SalesPipeline struct {
 	WinRate           float64
 	TotalDealsCreated int
 	NextDealID        int
+	RepBookings       map[string]int64 // Rep name -> new MRR closed this quarter
 }
 
 // PricingStrategy represents the company's pricing model
@@ -0,0 +1,331 @@
+package founder
+
+import (
+	"fmt"
+	"sort"
+)
+
+// WaterfallLine is what one holder receives from an exit
+type WaterfallLine struct {
+	Name       string
+	Class      string  // "founder", "investor", "employee", "advisor"
+	Ownership  float64 // As-converted % of the fully diluted cap table at exit
+	Preference int64   // Paid from the liquidation preference stack
+	Common     int64   // Paid from the common (as-converted) distribution
+	Total      int64
+	Converted  bool // Non-participating preferred that converted to common
+}
+
+// Waterfall is the full distribution of exit proceeds
+type Waterfall struct {
+	ExitValue       int64
+	Lines           []WaterfallLine
+	PreferenceTotal int64 // Total paid out as liquidation preferences
+	FounderPayout   int64
+	InvestorPayout  int64
+	EmployeePayout  int64 // Employees (vested options) and advisors
+}
+
+// preferenceTermsFor maps term sheet labels to liquidation preference terms
+func preferenceTermsFor(terms string) (multiple float64, participating bool, pariPassu bool) {
+	switch terms {
+	case "Founder-friendly":
+		return 1.0, false, true
+	case "Growth-focused":
+		return 1.0, true, false
+	case "Investor-heavy":
+		return 2.0, true, false
+	default:
+		return 1.0, false, false
+	}
+}
+
+// DescribePreference summarizes the liquidation preference a term sheet label carries
+func DescribePreference(terms string) string {
+	multiple, participating, pariPassu := preferenceTermsFor(terms)
+	return formatPreference(multiple, participating, pariPassu)
+}
+
+// PreferenceSummary summarizes the round's liquidation preference, e.g. "2x participating"
+func (r FundingRound) PreferenceSummary() string {
+	multiple := r.LiquidationPref
+	if multiple <= 0 {
+		multiple = 1.0
+	}
+	return formatPreference(multiple, r.Participating, false)
+}
+
+func formatPreference(multiple float64, participating bool, pariPassu bool) string {
+	summary := fmt.Sprintf("%.0fx non-participating", multiple)
+	if participating {
+		summary = fmt.Sprintf("%.0fx participating", multiple)
+	}
+	if pariPassu {
+		summary += ", pari passu"
+	}
+	return summary
+}
+
+type waterfallHolder struct {
+	line          WaterfallLine
+	units         float64
+	prefAmount    int64
+	participating bool
+	seniority     int
+	isPreferred   bool
+}
+
+// founderStake is the founder's own equity: everything not given to investors or
+// allocated from the pool (the unallocated pool reverts to the founder)
+func (fs *FounderState) founderStake() float64 {
+	stake := 100.0 - fs.EquityAllocated - fs.EquityGivenAway
+	if stake < 0 {
+		return 0
+	}
+	return stake
+}
+
+// CalculateWaterfall distributes exitValue across the cap table. Preferences are
+// paid by seniority (most senior first, pari passu within a tier), then the rest
+// goes to common. Non-participating preferred convert when common is worth more.
+// Unvested employee options are cancelled; the unallocated pool never counts.
+// forceConversion models an IPO, where all preferred converts to common.
+func (fs *FounderState) CalculateWaterfall(exitValue int64, forceConversion bool) Waterfall {
+	if exitValue < 0 {
+		exitValue = 0
+	}
+
+	var holders []*waterfallHolder
+
+	holders = append(holders, &waterfallHolder{
+		line:  WaterfallLine{Name: fs.FounderName + " (Founder)", Class: "founder"},
+		units: fs.founderStake(),
+	})
+
+	// Investors by round. Buybacks shrink EquityGivenAway, so scale rounds to match.
+	roundEquity := 0.0
+	for _, round := range fs.FundingRounds {
+		roundEquity += round.EquityGiven
+	}
+	scale := 1.0
+	if roundEquity > fs.EquityGivenAway && roundEquity > 0 {
+		scale = fs.EquityGivenAway / roundEquity
+	}
+	for i, round := range fs.FundingRounds {
+		name := round.RoundName
+		if len(round.Investors) > 0 {
+			name = round.Investors[0] + " (" + round.RoundName + ")"
+		}
+		multiple := round.LiquidationPref
+		if multiple <= 0 {
+			multiple = 1.0
+		}
+		seniority := round.Seniority
+		if seniority == 0 {
+			seniority = i + 1
+		}
+		holders = append(holders, &waterfallHolder{
+			line:          WaterfallLine{Name: name, Class: "investor"},
+			units:         round.EquityGiven * scale,
+			prefAmount:    int64(float64(round.Amount) * multiple),
+			participating: round.Participating,
+			seniority:     seniority,
+			isPreferred:   true,
+		})
+	}
+	// Equity given outside priced rounds (strategic deals etc.) is common
+	if other := fs.EquityGivenAway - roundEquity*scale; other > 0.01 {
+		holders = append(holders, &waterfallHolder{
+			line:  WaterfallLine{Name: "Other Investors", Class: "investor"},
+			units: other,
+		})
+	}
+
+	// Employees: only vested options are exercised at exit
+	employeeAllocated := 0.0
+	addEmployees := func(employees []Employee) {
+		for _, e := range employees {
+			if e.Equity <= 0 {
+				continue
+			}
+			employeeAllocated += e.Equity - e.SoldEquity
+			vested := heldVested(e)
+			if vested <= 0 {
+				continue
+			}
+			holders = append(holders, &waterfallHolder{
+				line:  WaterfallLine{Name: e.Name, Class: "employee"},
+				units: vested,
+			})
+		}
+	}
+	addEmployees(fs.Team.Executives)
+	addEmployees(fs.Team.Engineers)
+	addEmployees(fs.Team.Sales)
+	addEmployees(fs.Team.CustomerSuccess)
+	addEmployees(fs.Team.Marketing)
+
+	// Former employees still holding vested options or exercised shares
+	for _, fe := range fs.FormerEmployees {
+		if fe.Equity <= 0 {
+			continue
+		}
+		employeeAllocated += fe.Equity
+		holders = append(holders, &waterfallHolder{
+			line:  WaterfallLine{Name: fe.Name + " (former)", Class: "employee"},
+			units: fe.Equity,
+		})
+	}
+
+	// Advisors hold the rest of the allocated pool
+	if advisorUnits := fs.EquityAllocated - employeeAllocated; advisorUnits > 0.01 {
+		holders = append(holders, &waterfallHolder{
+			line:  WaterfallLine{Name: "Advisors", Class: "advisor"},
+			units: advisorUnits,
+		})
+	}
+
+	totalUnits := 0.0
+	for _, h := range holders {
+		totalUnits += h.units
+	}
+	for _, h := range holders {
+		if totalUnits > 0 {
+			h.line.Ownership = h.units / totalUnits * 100
+		}
+		if forceConversion && h.isPreferred {
+			h.line.Converted = true
+		}
+	}
+
+	// Iterate until no more non-participating preferred wants to convert
+	for pass := 0; pass <= len(holders); pass++ {
+		remaining := exitValue
+		for _, h := range holders {
+			h.line.Preference = 0
+			h.line.Common = 0
+		}
+
+		// Pay the preference stack, most senior tier first
+		var prefHolders []*waterfallHolder
+		for _, h := range holders {
+			if h.isPreferred && !h.line.Converted {
+				prefHolders = append(prefHolders, h)
+			}
+		}
+		sort.SliceStable(prefHolders, func(i, j int) bool {
+			return prefHolders[i].seniority > prefHolders[j].seniority
+		})
+		for i := 0; i < len(prefHolders); {
+			j := i
+			var tierTotal int64
+			for j < len(prefHolders) && prefHolders[j].seniority == prefHolders[i].seniority {
+				tierTotal += prefHolders[j].prefAmount
+				j++
+			}
+			paid := tierTotal
+			if paid > remaining {
+				paid = remaining
+			}
+			for k := i; k < j; k++ {
+				if tierTotal > 0 {
+					prefHolders[k].line.Preference = int64(float64(paid) * float64(prefHolders[k].prefAmount) / float64(tierTotal))
+				}
+			}
+			remaining -= paid
+			i = j
+		}
+
+		// Common distribution: common, converted and participating preferred
+		commonUnits := 0.0
+		for _, h := range holders {
+			if !h.isPreferred || h.line.Converted || h.participating {
+				commonUnits += h.units
+			}
+		}
+		pricePerUnit := 0.0
+		if commonUnits > 0 {
+			pricePerUnit = float64(remaining) / commonUnits
+		}
+		for _, h := range holders {
+			if !h.isPreferred || h.line.Converted || h.participating {
+				h.line.Common = int64(h.units * pricePerUnit)
+			}
+		}
+
+		// Would any non-participating holder do better converting?
+		changed := false
+		for _, h := range holders {
+			if h.isPreferred && !h.participating && !h.line.Converted &&
+				h.units*pricePerUnit > float64(h.line.Preference) {
+				h.line.Converted = true
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	wf := Waterfall{ExitValue: exitValue}
+	for _, h := range holders {
+		h.line.Total = h.line.Preference + h.line.Common
+		wf.PreferenceTotal += h.line.Preference
+		switch h.line.Class {
+		case "founder":
+			wf.FounderPayout += h.line.Total
+		case "investor":
+			wf.InvestorPayout += h.line.Total
+		default:
+			wf.EmployeePayout += h.line.Total
+		}
+		wf.Lines = append(wf.Lines, h.line)
+	}
+
+	return wf
+}
+
+// ExitWaterfall returns the waterfall for the exit the company actually took
+func (fs *FounderState) ExitWaterfall() Waterfall {
+	return fs.CalculateWaterfall(fs.ExitValuation, fs.ExitType == "ipo" || fs.ExitType == "secondary")
+}
+
+// IPOSellFraction is the share of their stake a founder can sell at IPO. The
+// rest is held under the lockup, so it's paper value rather than cash.
+const IPOSellFraction = 0.2
+
+// SecondarySellFraction is the share of their stake a founder sells to private
+// equity in a secondary; they keep the rest and stay on as CEO
+const SecondarySellFraction = 0.5
+
+// ExitSellFraction is how much of the founder's waterfall share an exit turns
+// into cash: all of it in an acquisition, only part at IPO or in a secondary
+func ExitSellFraction(exitType string) float64 {
+	switch exitType {
+	case "ipo":
+		return IPOSellFraction
+	case "secondary":
+		return SecondarySellFraction
+	}
+	return 1
+}
+
+// FounderExitPayout is the cash the founder takes home from the exit: the part
+// of their waterfall share the exit lets them sell
+func (fs *FounderState) FounderExitPayout() int64 {
+	return int64(float64(fs.ExitWaterfall().FounderPayout) * ExitSellFraction(fs.ExitType))
+}
+
+// AcceptAcquisitionOffer sells the company and pays the founder their waterfall share
+func (fs *FounderState) AcceptAcquisitionOffer(offer *AcquisitionOffer) Waterfall {
+	wf := fs.CalculateWaterfall(offer.OfferAmount, false)
+
+	fs.Cash = wf.FounderPayout
+	fs.HasExited = true
+	fs.ExitType = "acquisition"
+	fs.ExitValuation = offer.OfferAmount
+	fs.ExitMonth = fs.Turn
+	fs.Turn = fs.MaxTurns + 1 // End game
+
+	return wf
+}
@@ -1,20 +1,21 @@
 package game
 
 import (
-	"math/rand"
+	"github.com/jamesacampbell/unicorn/investors"
 )
 
 func (gs *GameState) InitializeAIPlayers() {
 	// Initialize LP commitments for AI players (use same difficulty multiplier as the player)
 	lpCommittedCapital, capitalCallSchedule := initializeLPCommitments(gs.Difficulty.StartingCash, gs.Difficulty.MaxTurns, gs.Difficulty.LPCommitMultiplier)
 
-	// Randomly select 3-5 AI players from the pool
-	allAIPlayers := []AIPlayer{
-		{
-			Name:          "CARL",
-			Firm:          "Sterling & Cooper",
-			Strategy:      "conservative",
-			RiskTolerance: 0.3,
+	// Randomly select 3-5 AI players from the shared investor roster
+	allAIPlayers := []AIPlayer{}
+	for _, persona := range investors.Roster {
+		allAIPlayers = append(allAIPlayers, AIPlayer{
+			Name:          persona.Name,
+			Firm:          persona.Firm,
+			Strategy:      persona.Strategy,
+			RiskTolerance: persona.RiskTolerance,
 			Portfolio: Portfolio{
 				Cash:                gs.Difficulty.StartingCash,
 				NetWorth:            gs.Difficulty.StartingCash,
@@ -29,235 +30,15 @@ func (gs *GameState) InitializeAIPlayers() {
 				LastCapitalCallTurn: 0,
 				CapitalCallSchedule: capitalCallSchedule,
 			},
-		},
-		{
-			Name:          "Sarah Chen",
-			Firm:          "Accel Partners",
-			Strategy:      "aggressive",
-			RiskTolerance: 0.8,
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Marcus Williams",
-			Firm:          "Sequoia Capital",
-			Strategy:      "balanced",
-			RiskTolerance: 0.5,
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Alex Rodriguez",
-			Firm:          "Tiger Global",
-			Strategy:      "aggressive",
-			RiskTolerance: 0.85, // Very aggressive growth investor
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Jessica Park",
-			Firm:          "Y Combinator",
-			Strategy:      "early_stage",
-			RiskTolerance: 0.6, // Early-stage specialist, moderate risk
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Raj Patel",
-			Firm:          "SoftBank Vision Fund",
-			Strategy:      "mega_fund",
-			RiskTolerance: 0.75, // Mega-fund, high valuations
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "David Kim",
-			Firm:          "Andreessen Horowitz",
-			Strategy:      "balanced",
-			RiskTolerance: 0.55, // Balanced approach, tech-focused
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Lisa Thompson",
-			Firm:          "Benchmark Capital",
-			Strategy:      "conservative",
-			RiskTolerance: 0.35, // Conservative, quality-focused
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Michael Chen",
-			Firm:          "First Round Capital",
-			Strategy:      "seed_focused",
-			RiskTolerance: 0.65, // Seed-stage specialist, higher risk tolerance
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Emily Rodriguez",
-			Firm:          "Greylock Partners",
-			Strategy:      "enterprise_focused",
-			RiskTolerance: 0.45, // Enterprise SaaS focus, moderate risk
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "James Wilson",
-			Firm:          "Index Ventures",
-			Strategy:      "deep_tech",
-			RiskTolerance: 0.7, // Deep tech focus, high risk/high reward
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
-		{
-			Name:          "Sophie Martin",
-			Firm:          "Lightspeed Venture Partners",
-			Strategy:      "consumer_focused",
-			RiskTolerance: 0.6, // Consumer products focus, moderate-high risk
-			Portfolio: Portfolio{
-				Cash:                gs.Difficulty.StartingCash,
-				NetWorth:            gs.Difficulty.StartingCash,
-				Turn:                1,
-				MaxTurns:            gs.Difficulty.MaxTurns,
-				InitialFundSize:     gs.Difficulty.StartingCash,
-				AnnualManagementFee: 0.02,
-				FollowOnReserve:     gs.Portfolio.FollowOnReserve,
-				CarryInterestPaid:   0,
-				LPCommittedCapital:  lpCommittedCapital,
-				LPCalledCapital:     0,
-				LastCapitalCallTurn: 0,
-				CapitalCallSchedule: capitalCallSchedule,
-			},
-		},
+		})
 	}
 
 	// Shuffle and select 3-5 players
-	rand.Shuffle(len(allAIPlayers), func(i, j int) {
+	gs.rng.Shuffle(len(allAIPlayers), func(i, j int) {
 		allAIPlayers[i], allAIPlayers[j] = allAIPlayers[j], allAIPlayers[i]
 	})
 
-	numPlayers := 3 + rand.Intn(3) // 3-5 AI players
+	numPlayers := 3 + gs.rng.Intn(3) // 3-5 AI players
 	if numPlayers > len(allAIPlayers) {
 		numPlayers = len(allAIPlayers)
 	}
@@ -275,13 +56,13 @@ func (gs *GameState) AIPlayerMakeInvestments() {
 		}
 
 		// AI investment strategy based on risk tolerance
-		targetInvestmentCount := 3 + rand.Intn(4) // Invest in 3-6 companies
+		targetInvestmentCount := 3 + gs.rng.Intn(4) // Invest in 3-6 companies
 		availableCash := ai.Portfolio.Cash
 
 		// Shuffle startups for variety
 		startups := make([]Startup, len(gs.AvailableStartups))
 		copy(startups, gs.AvailableStartups)
-		rand.Shuffle(len(startups), func(i, j int) {
+		gs.rng.Shuffle(len(startups), func(i, j int) {
 			startups[i], startups[j] = startups[j], startups[i]
 		})
 
@@ -408,8 +189,8 @@ func (gs *GameState) ProcessAITurns() {
 
 			// Apply same random events and volatility as player investments
 			// Random chance of an event happening (based on difficulty)
-			if rand.Float64() < gs.Difficulty.EventFrequency && len(gs.EventPool) > 0 {
-				event := gs.EventPool[rand.Intn(len(gs.EventPool))]
+			if gs.rng.Float64() < gs.Difficulty.EventFrequency && len(gs.EventPool) > 0 {
+				event := gs.EventPool[gs.rng.Intn(len(gs.EventPool))]
 
 				inv.CurrentValuation = int64(float64(inv.CurrentValuation) * event.Change)
 
@@ -419,7 +200,7 @@ func (gs *GameState) ProcessAITurns() {
 				}
 			} else {
 				// Natural growth/decline (random walk) - volatility based on difficulty
-				change := (rand.Float64()*2 - 1) * gs.Difficulty.Volatility
+				change := (gs.rng.Float64()*2 - 1) * gs.Difficulty.Volatility
 				inv.CurrentValuation = int64(float64(inv.CurrentValuation) * (1 + change))
 			}
 
@@ -0,0 +1,514 @@
+package game
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jamesacampbell/unicorn/investors"
+)
+
+// BoardDirector is a persistent seat on a portfolio company's board
+type BoardDirector struct {
+	Name       string
+	Firm       string // Fund behind an investor seat ("" for founders and independents)
+	Role       string // "player", "investor", "founder", "independent"
+	VoteWeight int
+	Investor   string // AIPlayer name backing an investor seat
+}
+
+// CompanyBoard is the board of one company
+type CompanyBoard struct {
+	CompanyName string
+	Directors   []BoardDirector
+}
+
+// DirectorVote is how one director voted
+type DirectorVote struct {
+	Director BoardDirector
+	ForA     bool
+}
+
+var independentDirectorNames = []string{
+	"Margaret Chen", "Robert Okafor", "Susan Whitfield", "David Albrecht",
+	"Linda Moreau", "Thomas Reyes", "Karen Lindqvist", "Michael Adeyemi",
+}
+
+// governanceVoteChance is the monthly chance a board with the player on it brings a routine decision
+const governanceVoteChance = 0.06
+
+// GetBoard returns the board for a company, seating any new investor directors
+func (gs *GameState) GetBoard(companyName string) *CompanyBoard {
+	var board *CompanyBoard
+	for i := range gs.Boards {
+		if gs.Boards[i].CompanyName == companyName {
+			board = &gs.Boards[i]
+			break
+		}
+	}
+	if board == nil {
+		gs.Boards = append(gs.Boards, CompanyBoard{CompanyName: companyName})
+		board = &gs.Boards[len(gs.Boards)-1]
+		board.Directors = append(board.Directors, BoardDirector{
+			Name:       gs.FounderNameFor(companyName),
+			Role:       "founder",
+			VoteWeight: 1,
+		})
+	}
+	for i := range gs.Portfolio.Investments {
+		if inv := &gs.Portfolio.Investments[i]; inv.CompanyName == companyName && inv.FounderName != "" {
+			board.Directors[0].Name = inv.FounderName
+		}
+	}
+
+	gs.seatInvestorDirectors(board)
+	return board
+}
+
+// seatInvestorDirectors adds seats for funds that have earned one, keeping the board odd-sized
+func (gs *GameState) seatInvestorDirectors(board *CompanyBoard) {
+	has := func(role, investor string) bool {
+		for _, d := range board.Directors {
+			if d.Role == role && d.Investor == investor {
+				return true
+			}
+		}
+		return false
+	}
+
+	if gs.HasBoardSeat(board.CompanyName) && !has("player", "") {
+		weight := 1
+		for _, inv := range gs.Portfolio.Investments {
+			if inv.CompanyName == board.CompanyName && inv.Terms.BoardSeatMultiplier > 1 {
+				weight = inv.Terms.BoardSeatMultiplier
+			}
+		}
+		board.Directors = append(board.Directors, BoardDirector{
+			Name: gs.PlayerName, Firm: gs.PlayerFirmName, Role: "player", VoteWeight: weight,
+		})
+	}
+
+	for _, ai := range gs.AIPlayers {
+		for _, inv := range ai.Portfolio.Investments {
+			if inv.CompanyName == board.CompanyName && inv.Terms.HasBoardSeat && !has("investor", ai.Name) {
+				board.Directors = append(board.Directors, BoardDirector{
+					Name: ai.Name, Firm: ai.Firm, Role: "investor", VoteWeight: 1, Investor: ai.Name,
+				})
+			}
+		}
+	}
+
+	// An independent director breaks ties once the board has an even number of votes
+	if board.totalVotes()%2 == 0 {
+		board.Directors = append(board.Directors, BoardDirector{
+			Name:       independentDirectorNames[gs.rng.Intn(len(independentDirectorNames))],
+			Role:       "independent",
+			VoteWeight: 1,
+		})
+	}
+}
+
+func (b *CompanyBoard) totalVotes() int {
+	total := 0
+	for _, d := range b.Directors {
+		total += d.VoteWeight
+	}
+	return total
+}
+
+// directorStake finds the investment behind an investor director's seat
+func (gs *GameState) directorStake(d BoardDirector, companyName string) *Investment {
+	for i := range gs.AIPlayers {
+		if gs.AIPlayers[i].Name != d.Investor {
+			continue
+		}
+		for j := range gs.AIPlayers[i].Portfolio.Investments {
+			if gs.AIPlayers[i].Portfolio.Investments[j].CompanyName == companyName {
+				return &gs.AIPlayers[i].Portfolio.Investments[j]
+			}
+		}
+	}
+	return nil
+}
+
+// preferenceStack totals the liquidation preferences that get paid before common
+func (gs *GameState) preferenceStack(companyName string) int64 {
+	var stack int64
+	add := func(inv Investment) {
+		if inv.CompanyName == companyName && inv.Terms.LiquidationPref > 0 {
+			stack += int64(float64(inv.AmountInvested) * inv.Terms.LiquidationPref)
+		}
+	}
+	for _, inv := range gs.Portfolio.Investments {
+		add(inv)
+	}
+	for _, ai := range gs.AIPlayers {
+		for _, inv := range ai.Portfolio.Investments {
+			add(inv)
+		}
+	}
+	return stack
+}
+
+// investorExitMultiple is what an investor takes home from a sale, given their preference
+func investorExitMultiple(inv *Investment, offerValue int64, prefStack int64) float64 {
+	if inv.AmountInvested <= 0 {
+		return 1
+	}
+	equity := inv.EquityPercent / 100
+	pref := float64(inv.AmountInvested) * inv.Terms.LiquidationPref
+	var payout float64
+	if inv.Terms.Participating {
+		payout = math.Min(pref, float64(offerValue)) + equity*math.Max(0, float64(offerValue-prefStack))
+	} else {
+		payout = math.Max(math.Min(pref, float64(offerValue)), equity*float64(offerValue))
+	}
+	return payout / float64(inv.AmountInvested)
+}
+
+// directorSupport is the chance a director votes for option A, driven by where they sit in the pref stack
+func (gs *GameState) directorSupport(d BoardDirector, vote BoardVote) float64 {
+	support := 0.5
+
+	switch d.Role {
+	case "founder":
+		switch vote.VoteType {
+		case "acquisition":
+			// Founders hold common: they only see what's left after the preference stack
+			if offerValue, ok := vote.Metadata["offerValue"].(int64); ok && offerValue > 0 {
+				commonShare := float64(offerValue-gs.preferenceStack(vote.CompanyName)) / float64(offerValue)
+				support = 0.15 + 0.6*math.Max(0, commonShare)
+			}
+		case "down_round":
+			support = 0.6 // Founders need the cash
+		case "option_pool", "budget_approval":
+			support = 0.85
+		case "debt_financing":
+			support = 0.7
+		case "secondary_sale":
+			support = 0.95
+		case "ceo_hire", "ceo_removal":
+			support = 0.1
+		case "strategic_pivot":
+			support = 0.7
+		}
+
+	case "investor":
+		strategy := ""
+		for _, ai := range gs.AIPlayers {
+			if ai.Name == d.Investor {
+				strategy = ai.Strategy
+			}
+		}
+		inv := gs.directorStake(d, vote.CompanyName)
+
+		switch vote.VoteType {
+		case "acquisition":
+			if offerValue, ok := vote.Metadata["offerValue"].(int64); ok && inv != nil {
+				multiple := investorExitMultiple(inv, offerValue, gs.preferenceStack(vote.CompanyName))
+				switch {
+				case multiple >= 3:
+					support = 0.8
+				case multiple >= 1:
+					support = 0.55
+				default:
+					support = 0.2
+				}
+			}
+			support += investors.ExitBias(strategy)
+		case "down_round":
+			support = 0.3
+			if inv != nil && inv.Terms.HasAntiDilution {
+				support += 0.15 // Protected investors mind less
+			}
+		case "option_pool":
+			support = 0.45
+		case "budget_approval":
+			support = 0.55 + investors.SpendingBias(strategy)
+		case "debt_financing":
+			support = 0.6 // Non-dilutive
+			if inv != nil && inv.Terms.LiquidationPref > 1 {
+				support -= 0.15 // Debt sits ahead of their preference
+			}
+		case "secondary_sale":
+			support = 0.35
+		case "ceo_hire", "ceo_removal":
+			support = 0.6
+		}
+
+	case "independent":
+		switch vote.VoteType {
+		case "acquisition":
+			if offerValue, ok := vote.Metadata["offerValue"].(int64); ok {
+				if currentVal, ok := vote.Metadata["currentValuation"].(int64); ok && offerValue >= currentVal {
+					support = 0.7
+				}
+			}
+		case "down_round":
+			support = 0.55
+		case "option_pool":
+			support = 0.7
+		case "ceo_hire":
+			support = 0.55
+		}
+	}
+
+	if shift, ok := vote.Metadata["lobby_"+d.Name].(float64); ok {
+		support += shift
+	}
+	return math.Max(0.02, math.Min(0.98, support))
+}
+
+// CastDirectorVotes has every director other than the player vote
+func (gs *GameState) CastDirectorVotes(vote BoardVote) []DirectorVote {
+	board := gs.GetBoard(vote.CompanyName)
+	votes := []DirectorVote{}
+	for _, d := range board.Directors {
+		if d.Role == "player" {
+			continue
+		}
+		votes = append(votes, DirectorVote{Director: d, ForA: gs.rng.Float64() < gs.directorSupport(d, vote)})
+	}
+	return votes
+}
+
+// DirectorLean returns the chance a director backs option A on a pending vote
+func (gs *GameState) DirectorLean(voteIndex int, directorName string) float64 {
+	if voteIndex < 0 || voteIndex >= len(gs.PendingBoardVotes) {
+		return 0.5
+	}
+	vote := gs.PendingBoardVotes[voteIndex]
+	for _, d := range gs.GetBoard(vote.CompanyName).Directors {
+		if d.Name == directorName {
+			return gs.directorSupport(d, vote)
+		}
+	}
+	return 0.5
+}
+
+// LobbyDirector works a director before a vote. Founders listen to investors they trust;
+// other funds listen to a strong reputation. Each director can be lobbied once per vote.
+func (gs *GameState) LobbyDirector(voteIndex int, directorName string, forA bool) (string, error) {
+	if voteIndex < 0 || voteIndex >= len(gs.PendingBoardVotes) {
+		return "", fmt.Errorf("invalid vote index")
+	}
+	vote := &gs.PendingBoardVotes[voteIndex]
+
+	var director *BoardDirector
+	board := gs.GetBoard(vote.CompanyName)
+	for i := range board.Directors {
+		if board.Directors[i].Name == directorName && board.Directors[i].Role != "player" {
+			director = &board.Directors[i]
+		}
+	}
+	if director == nil {
+		return "", fmt.Errorf("%s is not on the %s board", directorName, vote.CompanyName)
+	}
+	if vote.Metadata == nil {
+		vote.Metadata = map[string]interface{}{}
+	}
+	if _, done := vote.Metadata["lobby_"+director.Name]; done {
+		return "", fmt.Errorf("you've already lobbied %s on this vote", director.Name)
+	}
+
+	chance := 0.5
+	var founderInv *Investment
+	switch director.Role {
+	case "founder":
+		for i := range gs.Portfolio.Investments {
+			if gs.Portfolio.Investments[i].CompanyName == vote.CompanyName {
+				founderInv = &gs.Portfolio.Investments[i]
+				chance = founderInv.RelationshipScore / 100
+			}
+		}
+	case "investor":
+		if gs.PlayerReputation != nil {
+			chance = 0.3 + gs.PlayerReputation.GetAggregateReputation()/200
+		}
+	case "independent":
+		chance = 0.6
+	}
+
+	shift := 0.0
+	result := fmt.Sprintf("🤷 %s heard you out but isn't convinced", director.Name)
+	if gs.rng.Float64() < chance {
+		shift = 0.3
+		result = fmt.Sprintf("🤝 %s is coming around to your view", director.Name)
+	} else if founderInv != nil {
+		founderInv.RelationshipScore = ApplyRelationshipChange(founderInv.RelationshipScore, -2)
+	}
+	if !forA {
+		shift = -shift
+	}
+	vote.Metadata["lobby_"+director.Name] = shift
+	return result, nil
+}
+
+// ProcessGovernanceVotes brings routine decisions to boards the player sits on
+func (gs *GameState) ProcessGovernanceVotes() []string {
+	messages := []string{}
+
+	for _, inv := range gs.Portfolio.Investments {
+		if !inv.Terms.HasBoardSeat || gs.rng.Float64() >= governanceVoteChance {
+			continue
+		}
+		var startup *Startup
+		for i := range gs.AvailableStartups {
+			if gs.AvailableStartups[i].Name == inv.CompanyName {
+				startup = &gs.AvailableStartups[i]
+			}
+		}
+		if startup == nil {
+			continue
+		}
+
+		vote := gs.governanceVote(startup)
+		gs.PendingBoardVotes = append(gs.PendingBoardVotes, vote)
+		messages = append(messages, fmt.Sprintf("🏛️  BOARD VOTE REQUIRED: %s - %s", inv.CompanyName, vote.Title))
+	}
+
+	return messages
+}
+
+// governanceVote builds one routine board decision for a company
+func (gs *GameState) governanceVote(startup *Startup) BoardVote {
+	vote := BoardVote{
+		CompanyName:  startup.Name,
+		OptionA:      "Approve",
+		OptionB:      "Reject",
+		RequiresVote: true,
+		Turn:         gs.Portfolio.Turn,
+		Metadata:     map[string]interface{}{},
+	}
+
+	switch gs.rng.Intn(5) {
+	case 0:
+		pool := 0.05 + gs.rng.Float64()*0.05
+		vote.VoteType = "option_pool"
+		vote.Title = fmt.Sprintf("Expand option pool by %.0f%%", pool*100)
+		vote.Description = "Management wants a bigger pool to hire senior engineers. Every shareholder is diluted."
+		vote.ConsequenceA = "Pool expanded: dilution now, stronger hiring"
+		vote.ConsequenceB = "Pool unchanged: hiring plan scaled back"
+		vote.Metadata["poolPercent"] = pool
+	case 1:
+		increase := 0.15 + gs.rng.Float64()*0.25
+		vote.VoteType = "budget_approval"
+		vote.Title = fmt.Sprintf("Approve annual budget (+%.0f%% spend)", increase*100)
+		vote.Description = "The plan burns faster to chase growth."
+		vote.ConsequenceA = "Budget approved: faster growth, shorter runway"
+		vote.ConsequenceB = "Budget rejected: management must cut the plan"
+		vote.Metadata["spendIncrease"] = increase
+	case 2:
+		debt := int64(float64(startup.Valuation) * (0.05 + gs.rng.Float64()*0.1))
+		vote.VoteType = "debt_financing"
+		vote.Title = fmt.Sprintf("Take on $%s venture debt", formatCurrency(debt))
+		vote.Description = "Non-dilutive capital, but the lender sits ahead of every preference."
+		vote.ConsequenceA = "Debt drawn: more runway, more risk"
+		vote.ConsequenceB = "No debt: company relies on equity"
+		vote.Metadata["debtAmount"] = debt
+	case 3:
+		stake := 0.1 + gs.rng.Float64()*0.15
+		vote.VoteType = "secondary_sale"
+		vote.Title = fmt.Sprintf("Let the founder sell %.0f%% of their shares", stake*100)
+		vote.Description = "The founder wants liquidity. Investors worry about motivation."
+		vote.ConsequenceA = "Secondary approved: founder takes some money off the table"
+		vote.ConsequenceB = "Secondary blocked: founder stays fully exposed"
+		vote.Metadata["secondaryPercent"] = stake
+	default:
+		vote.VoteType = "ceo_hire"
+		vote.Title = "Hire an experienced outside CEO"
+		vote.Description = "Investors want a seasoned operator to run the company; the founder would move to CTO."
+		vote.ConsequenceA = "New CEO hired: steadier execution, unhappy founder"
+		vote.ConsequenceB = "Founder stays CEO"
+	}
+
+	return vote
+}
+
+// executeGovernanceOutcome applies the result of a routine board decision
+func (gs *GameState) executeGovernanceOutcome(vote BoardVote, passed bool) []string {
+	messages := []string{}
+
+	var startup *Startup
+	for i := range gs.AvailableStartups {
+		if gs.AvailableStartups[i].Name == vote.CompanyName {
+			startup = &gs.AvailableStartups[i]
+		}
+	}
+	var inv *Investment
+	for i := range gs.Portfolio.Investments {
+		if gs.Portfolio.Investments[i].CompanyName == vote.CompanyName {
+			inv = &gs.Portfolio.Investments[i]
+		}
+	}
+	if startup == nil || inv == nil {
+		return messages
+	}
+
+	// The founder remembers which side the player took on founder-sensitive votes
+	playerForA, _ := vote.Metadata["playerVotedForA"].(bool)
+	founderWantsA := vote.VoteType != "ceo_hire"
+
+	switch vote.VoteType {
+	case "option_pool":
+		if passed {
+			pool, _ := vote.Metadata["poolPercent"].(float64)
+			inv.EquityPercent *= 1 - pool
+			for _, seat := range gs.otherSeats() {
+				for i := range seat.Portfolio.Investments {
+					if seat.Portfolio.Investments[i].CompanyName == vote.CompanyName {
+						seat.Portfolio.Investments[i].EquityPercent *= 1 - pool
+					}
+				}
+			}
+			startup.GrowthPotential = math.Min(1.0, startup.GrowthPotential+0.03)
+			messages = append(messages, fmt.Sprintf("📊 %s expanded its option pool. Your stake: %.2f%%", vote.CompanyName, inv.EquityPercent))
+		} else {
+			startup.GrowthPotential = math.Max(0.1, startup.GrowthPotential-0.02)
+			messages = append(messages, fmt.Sprintf("📊 %s's option pool stays put; hiring slows", vote.CompanyName))
+		}
+	case "budget_approval":
+		if passed {
+			increase, _ := vote.Metadata["spendIncrease"].(float64)
+			startup.MonthlyCosts = int64(float64(startup.MonthlyCosts) * (1 + increase))
+			startup.GrowthPotential = math.Min(1.0, startup.GrowthPotential+0.05)
+			startup.RiskScore = math.Min(0.9, startup.RiskScore+0.03)
+			messages = append(messages, fmt.Sprintf("💸 %s's growth budget approved", vote.CompanyName))
+		} else {
+			startup.GrowthPotential = math.Max(0.1, startup.GrowthPotential-0.02)
+			messages = append(messages, fmt.Sprintf("✂️  %s's board sent the budget back for cuts", vote.CompanyName))
+		}
+	case "debt_financing":
+		if passed {
+			startup.RiskScore = math.Min(0.9, startup.RiskScore+0.04)
+			startup.GrowthPotential = math.Min(1.0, startup.GrowthPotential+0.03)
+			messages = append(messages, fmt.Sprintf("🏦 %s drew down venture debt", vote.CompanyName))
+		} else {
+			messages = append(messages, fmt.Sprintf("🏦 %s passed on venture debt", vote.CompanyName))
+		}
+	case "secondary_sale":
+		if passed {
+			messages = append(messages, fmt.Sprintf("💰 %s's founder sold shares in a secondary", vote.CompanyName))
+		} else {
+			messages = append(messages, fmt.Sprintf("🔒 %s's board blocked the founder's secondary", vote.CompanyName))
+		}
+	case "ceo_hire":
+		if passed {
+			startup.RiskScore = math.Max(0.1, startup.RiskScore-0.05)
+			startup.GrowthPotential = math.Min(1.0, startup.GrowthPotential+0.04)
+			messages = append(messages, fmt.Sprintf("👔 %s hired an outside CEO", vote.CompanyName))
+		} else {
+			messages = append(messages, fmt.Sprintf("👔 %s's founder remains CEO", vote.CompanyName))
+		}
+	}
+
+	if inv.FounderName != "" {
+		if playerForA == founderWantsA {
+			inv.RelationshipScore = ApplyRelationshipChange(inv.RelationshipScore, 4)
+		} else {
+			inv.RelationshipScore = ApplyRelationshipChange(inv.RelationshipScore, -6)
+			messages = append(messages, fmt.Sprintf("😠 %s noticed you voted against them", inv.FounderName))
+		}
+	}
+
+	return messages
+}
@@ -2,7 +2,6 @@ package game
 
 import (
 	"fmt"
-	"math/rand"
 	"strings"
 )
 
@@ -22,6 +21,15 @@ func (gs *GameState) HasAnyBoardSeat(companyName string) bool {
 		return true
 	}
 
+	// Check the other hot-seat players
+	for _, seat := range gs.otherSeats() {
+		for _, inv := range seat.Portfolio.Investments {
+			if inv.CompanyName == companyName && inv.Terms.HasBoardSeat {
+				return true
+			}
+		}
+	}
+
 	// Check AI players
 	for _, ai := range gs.AIPlayers {
 		for _, inv := range ai.Portfolio.Investments {
@@ -124,39 +132,19 @@ func (gs *GameState) ProcessBoardVote(voteIndex int, playerVote string) (string,
 		}
 	}
 
-	aiVotesA := 0
-	aiVotesB := 0
-
-	// Count AI board members (simulate other investors with board seats)
-	numAIBoardMembers := 2 + rand.Intn(2) // 2-3 AI board members
+	// Every other director votes their own interest
+	directorVotes := gs.CastDirectorVotes(*vote)
 
-	for i := 0; i < numAIBoardMembers; i++ {
-		// AI votes based on their strategy
-		voteChance := 0.5
-		if vote.VoteType == "acquisition" {
-			// AI more likely to accept acquisitions if good terms
-			if offerValue, ok := vote.Metadata["offerValue"].(int64); ok {
-				if currentVal, ok := vote.Metadata["currentValuation"].(int64); ok {
-					if offerValue >= currentVal {
-						voteChance = 0.7 // 70% chance to accept good offers
-					}
-				}
-			}
-		} else if vote.VoteType == "down_round" {
-			// AI less likely to accept down rounds
-			voteChance = 0.3
-		}
-
-		if rand.Float64() < voteChance {
-			aiVotesA++
+	// Count votes
+	totalVotesA := 0
+	totalVotesB := 0
+	for _, dv := range directorVotes {
+		if dv.ForA {
+			totalVotesA += dv.Director.VoteWeight
 		} else {
-			aiVotesB++
+			totalVotesB += dv.Director.VoteWeight
 		}
 	}
-
-	// Count votes
-	totalVotesA := aiVotesA
-	totalVotesB := aiVotesB
 	if votedForA {
 		totalVotesA += playerVoteWeight
 	} else {
@@ -189,6 +177,15 @@ func (gs *GameState) ProcessBoardVote(voteIndex int, playerVote string) (string,
 		voteOutcome += vote.ConsequenceB
 	}
 
+	// Name how each director voted
+	for _, dv := range directorVotes {
+		choice := vote.OptionB
+		if dv.ForA {
+			choice = vote.OptionA
+		}
+		voteOutcome += fmt.Sprintf(" | %s (%s): %s", dv.Director.Name, dv.Director.Role, choice)
+	}
+
 	return voteOutcome, votePassed, nil
 }
 
@@ -201,25 +198,31 @@ func (gs *GameState) ExecuteBoardVoteOutcome(vote BoardVote, passed bool) []stri
 			// Acquisition approved - execute it
 			if offerValue, ok := vote.Metadata["offerValue"].(int64); ok {
 				companyName := vote.CompanyName
-				for j := range gs.Portfolio.Investments {
-					if gs.Portfolio.Investments[j].CompanyName == companyName {
-						inv := &gs.Portfolio.Investments[j]
-						payout := int64((inv.EquityPercent / 100.0) * float64(offerValue))
-						returnMultiple := float64(payout) / float64(inv.AmountInvested)
-
-						messages = append(messages, fmt.Sprintf(
-							"🎉 %s ACQUIRED (Board Approved)! Your %.2f%% = $%s (%.1fx return)",
-							companyName,
-							inv.EquityPercent,
-							formatCurrency(payout),
-							returnMultiple,
-						))
-
-						gs.Portfolio.Cash += payout
-						gs.Portfolio.Investments = append(gs.Portfolio.Investments[:j], gs.Portfolio.Investments[j+1:]...)
-						break
+				// Every human fund still holding a stake sells at the offer
+				messages = append(messages, gs.EachSeat(func() []string {
+					seatMessages := []string{}
+					for j := range gs.Portfolio.Investments {
+						if gs.Portfolio.Investments[j].CompanyName == companyName {
+							inv := &gs.Portfolio.Investments[j]
+							payout := int64((inv.EquityPercent / 100.0) * float64(offerValue))
+							returnMultiple := float64(payout) / float64(inv.AmountInvested)
+
+							seatMessages = append(seatMessages, fmt.Sprintf(
+								"🎉 %s ACQUIRED (Board Approved)! Your %.2f%% = $%s (%.1fx return)",
+								companyName,
+								inv.EquityPercent,
+								formatCurrency(payout),
+								returnMultiple,
+							))
+
+							gs.Portfolio.Cash += payout
+							gs.recordExit(*inv, inv.AmountInvested, payout, "acquired")
+							gs.Portfolio.Investments = append(gs.Portfolio.Investments[:j], gs.Portfolio.Investments[j+1:]...)
+							break
+						}
 					}
-				}
+					return seatMessages
+				})...)
 			}
 		} else {
 			messages = append(messages, fmt.Sprintf(
@@ -241,6 +244,8 @@ func (gs *GameState) ExecuteBoardVoteOutcome(vote BoardVote, passed bool) []stri
 				vote.CompanyName,
 			))
 		}
+	default:
+		messages = append(messages, gs.executeGovernanceOutcome(vote, passed)...)
 	}
 
 	return messages
@@ -248,50 +253,30 @@ func (gs *GameState) ExecuteBoardVoteOutcome(vote BoardVote, passed bool) []stri
 
 // BoardMemberInfo represents information about a board member
 type BoardMemberInfo struct {
-	Name        string
-	Firm        string
-	Type        string // "player", "ai_investor", "founder"
-	VoteWeight  int
-	IsPlayer    bool
+	Name       string
+	Firm       string
+	Type       string // "player", "ai_investor", "founder", "independent"
+	VoteWeight int
+	IsPlayer   bool
 }
 
 // GetBoardMembers returns all board members for a company
 func (gs *GameState) GetBoardMembers(companyName string) []BoardMemberInfo {
 	members := []BoardMemberInfo{}
 
-	// Add player if they have a board seat
-	for _, inv := range gs.Portfolio.Investments {
-		if inv.CompanyName == companyName && inv.Terms.HasBoardSeat {
-			voteWeight := inv.Terms.BoardSeatMultiplier
-			if voteWeight == 0 {
-				voteWeight = 1
-			}
-			members = append(members, BoardMemberInfo{
-				Name:       gs.PlayerName,
-				Firm:       gs.PlayerFirmName,
-				Type:       "player",
-				VoteWeight: voteWeight,
-				IsPlayer:   true,
-			})
-			break
-		}
-	}
-
-	// Add AI investors with board seats
-	for _, ai := range gs.AIPlayers {
-		for _, inv := range ai.Portfolio.Investments {
-			if inv.CompanyName == companyName && inv.Terms.HasBoardSeat {
-				members = append(members, BoardMemberInfo{
-					Name:       ai.Name,
-					Firm:       ai.Firm,
-					Type:       "ai_investor",
-					VoteWeight: 1, // AI always has 1 vote
-					IsPlayer:   false,
-				})
-				break // Only add each AI once per company
-			}
+	for _, d := range gs.GetBoard(companyName).Directors {
+		memberType := d.Role
+		if d.Role == "investor" {
+			memberType = "ai_investor"
 		}
+		members = append(members, BoardMemberInfo{
+			Name:       d.Name,
+			Firm:       d.Firm,
+			Type:       memberType,
+			VoteWeight: d.VoteWeight,
+			IsPlayer:   d.Role == "player",
+		})
 	}
 
 	return members
-}
\ No newline at end of file
+}
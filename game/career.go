@@ -0,0 +1,95 @@
+package game
+
+import (
+	"fmt"
+	"math"
+)
+
+// CareerCapital is personal wealth and relationships a player carries into VC mode
+// from earlier games
+type CareerCapital struct {
+	Path       string   // "anchor" (GP commitment to a first fund) or "angel" (personal portfolio)
+	NetWorth   int64    // Personal net worth before this game
+	Commitment int64    // Personal money put to work this game
+	Network    []string // People met as a founder who can send deals
+}
+
+// Career paths need at least this much personal wealth
+const MinCareerCommitment = 100000
+
+// AnchorLPMatch is how many LP dollars follow each dollar a founder-turned-GP puts in
+const AnchorLPMatch = 2
+
+// CareerCommitmentFor is how much of a player's net worth goes into a career path
+func CareerCommitmentFor(netWorth int64) int64 {
+	return netWorth / 2
+}
+
+// ApplyCareerCapital sets the fund up around the player's own money and founder network
+func (gs *GameState) ApplyCareerCapital(c CareerCapital) ([]string, error) {
+	if c.Commitment < MinCareerCommitment {
+		return nil, fmt.Errorf("need at least $%s of personal capital", formatCurrency(MinCareerCommitment))
+	}
+	if c.Commitment > c.NetWorth {
+		return nil, fmt.Errorf("can't commit more than your net worth")
+	}
+
+	messages := []string{}
+	p := &gs.Portfolio
+	switch c.Path {
+	case "anchor":
+		// LPs back a GP with real skin in the game
+		raised := c.Commitment * (1 + AnchorLPMatch)
+		p.Cash += raised
+		p.InitialFundSize += raised
+		messages = append(messages, fmt.Sprintf("🏦 You anchored the fund with $%s of your own money - LPs added $%s",
+			formatCurrency(c.Commitment), formatCurrency(c.Commitment*AnchorLPMatch)))
+	case "angel":
+		// Your own checkbook: no LPs, no fees, no capital calls
+		p.Cash = c.Commitment
+		p.InitialFundSize = c.Commitment
+		p.FollowOnReserve = 0
+		p.OpportunityFund = 0
+		p.AnnualManagementFee = 0
+		p.LPCommittedCapital = 0
+		p.CapitalCallSchedule = nil
+		messages = append(messages, fmt.Sprintf("👼 Angel investing $%s of your own money", formatCurrency(c.Commitment)))
+	default:
+		return nil, fmt.Errorf("unknown career path: %s", c.Path)
+	}
+
+	gs.Career = &c
+	messages = append(messages, gs.applyNetworkDealFlow(c.Network)...)
+	gs.updateNetWorth()
+	return messages, nil
+}
+
+// applyNetworkDealFlow turns founder-mode contacts into warm intros to companies in the deal pool
+func (gs *GameState) applyNetworkDealFlow(network []string) []string {
+	messages := []string{}
+	candidates := gs.rng.Perm(len(gs.AvailableStartups))
+	for i, contact := range network {
+		if i >= 5 || i >= len(candidates) {
+			break
+		}
+		startup := &gs.AvailableStartups[candidates[i]]
+		startup.ReferredBy = contact
+		// Warm intros come pre-vetted
+		startup.GrowthPotential = math.Min(1.0, startup.GrowthPotential+0.05)
+		startup.RiskScore = math.Max(0.1, startup.RiskScore-0.05)
+		messages = append(messages, fmt.Sprintf("🤝 %s introduced you to %s", contact, startup.Name))
+	}
+	return messages
+}
+
+// CareerPersonalReturn is what the player's own money is worth at the end of the game
+func (gs *GameState) CareerPersonalReturn() int64 {
+	if gs.Career == nil || gs.Portfolio.InitialFundSize <= 0 {
+		return 0
+	}
+	if gs.Career.Path == "angel" {
+		return gs.Portfolio.NetWorth
+	}
+	multiple := float64(gs.Portfolio.NetWorth) / float64(gs.Portfolio.InitialFundSize)
+	return int64(float64(gs.Career.Commitment) * multiple)
+}
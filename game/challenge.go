@@ -0,0 +1,54 @@
+package game
+
+// Challenge modifiers bend one rule of a VC game for a daily challenge
+const (
+	ModifierNoFollowOns      = "no_follow_ons"     // Follow-on rounds are closed to the player
+	ModifierDeepTechOnly     = "deeptech_only"     // Deal flow is only hard-tech companies
+	ModifierDoubleVolatility = "double_volatility" // Valuations swing twice as hard
+)
+
+// deepTechCategories are the sectors a DeepTech-only deal flow keeps
+var deepTechCategories = map[string]bool{
+	"DeepTech":    true,
+	"AI/ML":       true,
+	"BioTech":     true,
+	"Biotech":     true,
+	"CleanTech":   true,
+	"ClimateTech": true,
+	"Hardware":    true,
+	"IoT":         true,
+	"Robotics":    true,
+	"SpaceTech":   true,
+}
+
+// IsDeepTech reports whether a startup category counts as DeepTech
+func IsDeepTech(category string) bool {
+	return deepTechCategories[category]
+}
+
+// NewChallengeGame starts a seeded game with a challenge modifier in play. Nobody
+// brings upgrades or reputation, so every player faces the same game.
+func NewChallengeGame(playerName, firmName string, difficulty Difficulty, modifier string, seed int64) *GameState {
+	if modifier == ModifierDoubleVolatility {
+		difficulty.Volatility *= 2
+	}
+	return newGame(playerName, firmName, difficulty, nil, nil, seed, modifier)
+}
+
+// volatilityScale multiplies monthly swings in company performance
+func (gs *GameState) volatilityScale() float64 {
+	if gs.Modifier == ModifierDoubleVolatility {
+		return 2
+	}
+	return 1
+}
+
+func deepTechOnly(startups []Startup) []Startup {
+	var kept []Startup
+	for _, s := range startups {
+		if IsDeepTech(s.Category) {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,330 @@
+package game
+
+import (
+	"fmt"
+)
+
+// DDWorkstream is one line of due diligence that runs over several months
+type DDWorkstream struct {
+	ID          string
+	Name        string
+	Description string
+	Cost        int64
+	Turns       int    // Months until the workstream reports back
+	Reveals     string // Hidden trait it uncovers
+}
+
+// GetDDWorkstreams returns the diligence workstreams a fund can run on a deal
+func GetDDWorkstreams() []DDWorkstream {
+	return []DDWorkstream{
+		{
+			ID:          "customer_calls",
+			Name:        "Customer Reference Calls",
+			Description: "Talk to customers about retention, spend and who really pays the bills",
+			Cost:        8000,
+			Turns:       1,
+			Reveals:     "churn, customer concentration",
+		},
+		{
+			ID:          "background_check",
+			Name:        "Founder Background Check",
+			Description: "Back-channel references and a background check on the founders",
+			Cost:        6000,
+			Turns:       1,
+			Reveals:     "founder integrity",
+		},
+		{
+			ID:          "technical_audit",
+			Name:        "Technical & IP Audit",
+			Description: "Code review, architecture and IP assignment audit",
+			Cost:        15000,
+			Turns:       2,
+			Reveals:     "IP issues",
+		},
+		{
+			ID:          "financial_review",
+			Name:        "Financial Review",
+			Description: "Bank statements, revenue recognition and burn reconciliation",
+			Cost:        12000,
+			Turns:       2,
+			Reveals:     "fraud risk",
+		},
+	}
+}
+
+// GetDDWorkstream looks a workstream up by ID
+func GetDDWorkstream(id string) (DDWorkstream, bool) {
+	for _, ws := range GetDDWorkstreams() {
+		if ws.ID == id {
+			return ws, true
+		}
+	}
+	return DDWorkstream{}, false
+}
+
+// revealHiddenTraits turns what a workstream digs up into findings
+func revealHiddenTraits(startup *Startup, workstreamID string) []DDFinding {
+	h := startup.Hidden
+	findings := []DDFinding{}
+
+	switch workstreamID {
+	case "customer_calls":
+		if h.RealChurn > h.ReportedChurn*1.5 {
+			findings = append(findings, DDFinding{
+				Type:     "red_flag",
+				Category: "market",
+				Description: fmt.Sprintf("Customers are churning at %.1f%%/mo, not the %.1f%% in the deck",
+					h.RealChurn*100, h.ReportedChurn*100),
+				Impact:     -0.10,
+				RiskImpact: 0.05,
+			})
+		} else {
+			findings = append(findings, DDFinding{
+				Type:        "green_flag",
+				Category:    "market",
+				Description: "Reference customers are happy and renewing; churn matches the deck",
+				Impact:      0.06,
+				RiskImpact:  -0.02,
+			})
+		}
+		if h.CustomerConcentration > 0.4 {
+			findings = append(findings, DDFinding{
+				Type:        "red_flag",
+				Category:    "financial",
+				Description: fmt.Sprintf("Top customer is %.0f%% of revenue", h.CustomerConcentration*100),
+				Impact:      -0.06,
+				RiskImpact:  0.04,
+			})
+		}
+	case "background_check":
+		if h.FounderIntegrity < 0.6 {
+			findings = append(findings, DDFinding{
+				Type:        "red_flag",
+				Category:    "founder",
+				Description: "Back-channel references contradict the founder's résumé; integrity concerns",
+				Impact:      -0.10,
+				RiskImpact:  0.07,
+			})
+		} else if h.FounderIntegrity > 0.85 {
+			findings = append(findings, DDFinding{
+				Type:        "green_flag",
+				Category:    "founder",
+				Description: "Former colleagues and investors vouch for the founder without hesitation",
+				Impact:      0.06,
+				RiskImpact:  -0.03,
+			})
+		} else {
+			findings = append(findings, DDFinding{
+				Type:        "neutral",
+				Category:    "founder",
+				Description: "Founder references check out",
+			})
+		}
+	case "technical_audit":
+		if h.IPIssues {
+			findings = append(findings, DDFinding{
+				Type:        "red_flag",
+				Category:    "legal",
+				Description: "Core IP was written at the founder's previous employer; assignment disputed",
+				Impact:      -0.15,
+				RiskImpact:  0.08,
+			})
+		} else {
+			findings = append(findings, DDFinding{
+				Type:        "green_flag",
+				Category:    "tech",
+				Description: "Clean codebase and all IP properly assigned to the company",
+				Impact:      0.05,
+				RiskImpact:  -0.02,
+			})
+		}
+	case "financial_review":
+		if h.FraudRisk > 0.25 {
+			findings = append(findings, DDFinding{
+				Type:        "red_flag",
+				Category:    "financial",
+				Description: "Revenue recognition irregularities and unexplained related-party payments",
+				Impact:      -0.15,
+				RiskImpact:  0.09,
+			})
+		} else {
+			findings = append(findings, DDFinding{
+				Type:        "neutral",
+				Category:    "financial",
+				Description: "Books reconcile with bank statements",
+			})
+		}
+	}
+
+	return findings
+}
+
+// ddRoundClock returns how many months until a round closes, with or without us.
+// Hot deals close fast, and rival funds shorten the clock.
+func (gs *GameState) ddRoundClock(startup *Startup) int {
+	months := 2 + gs.rng.Intn(3)
+	if startup.QualityTier == 1 || startup.GrowthPotential > 0.7 {
+		months--
+	}
+	if len(gs.AIPlayers) > 3 && gs.rng.Float64() < 0.5 {
+		months--
+	}
+	if months < 1 {
+		months = 1
+	}
+	return months
+}
+
+// StartDDWorkstreams kicks off diligence on a deal. The round keeps moving while it runs.
+func (gs *GameState) StartDDWorkstreams(startupIndex int, amount int64, terms InvestmentTerms, workstreams []string) error {
+	if startupIndex < 0 || startupIndex >= len(gs.AvailableStartups) {
+		return fmt.Errorf("invalid startup index")
+	}
+	if len(workstreams) == 0 {
+		return fmt.Errorf("pick at least one workstream")
+	}
+
+	startup := &gs.AvailableStartups[startupIndex]
+	for _, d := range gs.PendingDDDecisions {
+		if d.CompanyName == startup.Name {
+			return fmt.Errorf("diligence on %s is already under way", startup.Name)
+		}
+	}
+
+	var cost int64
+	for _, id := range workstreams {
+		ws, ok := GetDDWorkstream(id)
+		if !ok {
+			return fmt.Errorf("unknown workstream: %s", id)
+		}
+		cost += ws.Cost
+	}
+	if cost > gs.Portfolio.Cash {
+		return fmt.Errorf("insufficient funds for due diligence (need $%d)", cost)
+	}
+	gs.Portfolio.Cash -= cost
+
+	lead := "another fund"
+	if len(gs.AIPlayers) > 0 {
+		lead = gs.AIPlayers[gs.rng.Intn(len(gs.AIPlayers))].Firm
+	}
+
+	gs.PendingDDDecisions = append(gs.PendingDDDecisions, DDDecision{
+		CompanyName:      startup.Name,
+		StartupIndex:     startupIndex,
+		InvestmentAmount: amount,
+		Turn:             gs.Portfolio.Turn,
+		Terms:            terms,
+		Workstreams:      workstreams,
+		RoundCloseTurn:   gs.Portfolio.Turn + gs.ddRoundClock(startup),
+		LeadInvestor:     lead,
+	})
+	return nil
+}
+
+// IsComplete reports whether every workstream has reported back
+func (d DDDecision) IsComplete() bool {
+	return len(d.Completed) >= len(d.Workstreams)
+}
+
+func (d DDDecision) hasCompleted(id string) bool {
+	for _, c := range d.Completed {
+		if c == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessDueDiligence advances diligence workstreams and closes rounds the fund missed
+func (gs *GameState) ProcessDueDiligence() []string {
+	messages := []string{}
+	remaining := []DDDecision{}
+
+	for _, d := range gs.PendingDDDecisions {
+		if d.StartupIndex < 0 || d.StartupIndex >= len(gs.AvailableStartups) {
+			continue
+		}
+		startup := &gs.AvailableStartups[d.StartupIndex]
+
+		for _, id := range d.Workstreams {
+			ws, ok := GetDDWorkstream(id)
+			if !ok || d.hasCompleted(id) || gs.Portfolio.Turn-d.Turn < ws.Turns {
+				continue
+			}
+			findings := revealHiddenTraits(startup, id)
+			ApplyDDFindings(startup, findings)
+			d.Findings = append(d.Findings, findings...)
+			d.Completed = append(d.Completed, id)
+			messages = append(messages, fmt.Sprintf("🔍 %s: %s reported back", d.CompanyName, ws.Name))
+		}
+
+		if gs.Portfolio.Turn >= d.RoundCloseTurn {
+			messages = append(messages, fmt.Sprintf("⏰ %s's round closed without you - %s led it", d.CompanyName, d.LeadInvestor))
+			continue
+		}
+		if d.IsComplete() && gs.Portfolio.Turn-d.Turn == d.maxTurns() {
+			messages = append(messages, fmt.Sprintf("📋 Diligence on %s is done - decide before month %d", d.CompanyName, d.RoundCloseTurn))
+		}
+		remaining = append(remaining, d)
+	}
+
+	gs.PendingDDDecisions = remaining
+	return messages
+}
+
+func (d DDDecision) maxTurns() int {
+	longest := 0
+	for _, id := range d.Workstreams {
+		if ws, ok := GetDDWorkstream(id); ok && ws.Turns > longest {
+			longest = ws.Turns
+		}
+	}
+	return longest
+}
+
+// ResolveDDDecision invests in (or passes on) a deal under diligence
+func (gs *GameState) ResolveDDDecision(index int, invest bool) (string, error) {
+	if index < 0 || index >= len(gs.PendingDDDecisions) {
+		return "", fmt.Errorf("invalid diligence decision")
+	}
+	d := gs.PendingDDDecisions[index]
+
+	if !invest {
+		gs.PendingDDDecisions = append(gs.PendingDDDecisions[:index], gs.PendingDDDecisions[index+1:]...)
+		return fmt.Sprintf("✗ Passed on %s", d.CompanyName), nil
+	}
+
+	if err := gs.MakeInvestmentWithTerms(d.StartupIndex, d.InvestmentAmount, d.Terms); err != nil {
+		return "", err
+	}
+	gs.PendingDDDecisions = append(gs.PendingDDDecisions[:index], gs.PendingDDDecisions[index+1:]...)
+
+	inv := &gs.Portfolio.Investments[len(gs.Portfolio.Investments)-1]
+	inv.FounderName = gs.FounderNameFor(inv.CompanyName)
+	inv.HasDueDiligence = true
+	inv.RelationshipScore = ApplyRelationshipChange(
+		gs.CalculateInitialRelationship(d.Terms, true, d.InvestmentAmount),
+		float64(len(d.Completed)))
+	inv.LastInteraction = gs.Portfolio.Turn
+
+	return fmt.Sprintf("✓ Invested $%s in %s after %d diligence workstream(s)",
+		formatCurrency(d.InvestmentAmount), d.CompanyName, len(d.Completed)), nil
+}
+
+// hiddenTraitEvent picks the dramatic event a startup's hidden traits are heading toward
+func hiddenTraitEvent(h HiddenTraits) string {
+	switch {
+	case h.FraudRisk > 0.3:
+		return "fraud"
+	case h.IPIssues:
+		return "lawsuit"
+	case h.FounderIntegrity > 0 && h.FounderIntegrity < 0.5:
+		return "scandal"
+	case h.CustomerConcentration > 0.5:
+		return "customer_loss"
+	case h.ReportedChurn > 0 && h.RealChurn > h.ReportedChurn*2:
+		return "product_failure"
+	}
+	return ""
+}
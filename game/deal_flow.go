@@ -8,7 +8,7 @@ import (
 )
 
 // GenerateStartupsWithReputation generates startups based on player reputation
-func GenerateStartupsWithReputation(reputation *VCReputation, count int, filename string) ([]Startup, error) {
+func GenerateStartupsWithReputation(rng *rand.Rand, reputation *VCReputation, count int, filename string) ([]Startup, error) {
 	// Load base startups from file
 	file, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -46,7 +46,7 @@ func GenerateStartupsWithReputation(reputation *VCReputation, count int, filenam
 	}
 
 	// Shuffle startups
-	rand.Shuffle(len(allStartups), func(i, j int) {
+	rng.Shuffle(len(allStartups), func(i, j int) {
 		allStartups[i], allStartups[j] = allStartups[j], allStartups[i]
 	})
 
@@ -57,7 +57,7 @@ func GenerateStartupsWithReputation(reputation *VCReputation, count int, filenam
 		startup := allStartups[i]
 
 		// Determine which tier this startup slot should be
-		roll := rand.Float64()
+		roll := rng.Float64()
 		var tier string
 
 		if roll < tier1Percent {
@@ -69,7 +69,7 @@ func GenerateStartupsWithReputation(reputation *VCReputation, count int, filenam
 		}
 
 		// Adjust startup characteristics based on tier
-		startup = adjustStartupForTier(startup, tier)
+		startup = adjustStartupForTier(rng, startup, tier)
 
 		adjustedStartups = append(adjustedStartups, startup)
 	}
@@ -78,35 +78,35 @@ func GenerateStartupsWithReputation(reputation *VCReputation, count int, filenam
 }
 
 // adjustStartupForTier modifies startup characteristics based on deal quality tier
-func adjustStartupForTier(startup Startup, tier string) Startup {
+func adjustStartupForTier(rng *rand.Rand, startup Startup, tier string) Startup {
 	switch tier {
 	case "tier1": // Hot deals - lower risk, higher growth
 		// Reduce risk (to 0.2-0.4 range)
 		if startup.RiskScore > 0.4 {
-			startup.RiskScore = 0.2 + rand.Float64()*0.2 // 0.2-0.4
+			startup.RiskScore = 0.2 + rng.Float64()*0.2 // 0.2-0.4
 		}
 
 		// Increase growth potential (to 0.7-0.9 range)
 		if startup.GrowthPotential < 0.7 {
-			startup.GrowthPotential = 0.7 + rand.Float64()*0.2 // 0.7-0.9
+			startup.GrowthPotential = 0.7 + rng.Float64()*0.2 // 0.7-0.9
 		}
 
 		// Slightly higher initial valuation (hot deal premium)
-		startup.Valuation = int64(float64(startup.Valuation) * (1.1 + rand.Float64()*0.2)) // 1.1-1.3x
+		startup.Valuation = int64(float64(startup.Valuation) * (1.1 + rng.Float64()*0.2)) // 1.1-1.3x
 
 	case "tier2": // Standard deals - balanced
 		// Keep risk in 0.4-0.6 range
 		if startup.RiskScore < 0.4 {
-			startup.RiskScore = 0.4 + rand.Float64()*0.1
+			startup.RiskScore = 0.4 + rng.Float64()*0.1
 		} else if startup.RiskScore > 0.6 {
-			startup.RiskScore = 0.5 + rand.Float64()*0.1
+			startup.RiskScore = 0.5 + rng.Float64()*0.1
 		}
 
 		// Keep growth in 0.5-0.7 range
 		if startup.GrowthPotential < 0.5 {
-			startup.GrowthPotential = 0.5 + rand.Float64()*0.1
+			startup.GrowthPotential = 0.5 + rng.Float64()*0.1
 		} else if startup.GrowthPotential > 0.7 {
-			startup.GrowthPotential = 0.6 + rand.Float64()*0.1
+			startup.GrowthPotential = 0.6 + rng.Float64()*0.1
 		}
 
 		// Standard valuation (no adjustment)
@@ -114,16 +114,16 @@ func adjustStartupForTier(startup Startup, tier string) Startup {
 	case "tier3": // Struggling deals - higher risk, lower growth
 		// Increase risk (to 0.6-0.8 range)
 		if startup.RiskScore < 0.6 {
-			startup.RiskScore = 0.6 + rand.Float64()*0.2 // 0.6-0.8
+			startup.RiskScore = 0.6 + rng.Float64()*0.2 // 0.6-0.8
 		}
 
 		// Decrease growth potential (to 0.3-0.5 range)
 		if startup.GrowthPotential > 0.5 {
-			startup.GrowthPotential = 0.3 + rand.Float64()*0.2 // 0.3-0.5
+			startup.GrowthPotential = 0.3 + rng.Float64()*0.2 // 0.3-0.5
 		}
 
 		// Lower valuation (struggling company discount)
-		startup.Valuation = int64(float64(startup.Valuation) * (0.7 + rand.Float64()*0.2)) // 0.7-0.9x
+		startup.Valuation = int64(float64(startup.Valuation) * (0.7 + rng.Float64()*0.2)) // 0.7-0.9x
 	}
 
 	return startup
@@ -150,17 +150,23 @@ func GetDealFlowQualityMessage(reputation *VCReputation) string {
 func CalculateFounderReferralEffect(avgFounderRelationship float64, startup *Startup) {
 	bonus := GetFounderReferralBonus(avgFounderRelationship)
 
-	if bonus > 0 {
-		// Improve risk and growth slightly
+	if bonus != 0 {
+		// Improve (or, for a bad reputation with founders, worsen) risk and growth slightly
 		startup.RiskScore -= bonus / 2
 		if startup.RiskScore < 0.1 {
 			startup.RiskScore = 0.1
 		}
+		if startup.RiskScore > 0.9 {
+			startup.RiskScore = 0.9
+		}
 
 		startup.GrowthPotential += bonus
 		if startup.GrowthPotential > 1.0 {
 			startup.GrowthPotential = 1.0
 		}
+		if startup.GrowthPotential < 0.1 {
+			startup.GrowthPotential = 0.1
+		}
 	}
 }
 
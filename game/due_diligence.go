@@ -2,7 +2,6 @@ package game
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // DDDecision represents a due diligence opportunity before investment
@@ -10,7 +9,13 @@ type DDDecision struct {
 	CompanyName      string
 	StartupIndex     int
 	InvestmentAmount int64
-	Turn             int
+	Turn             int // Month diligence started
+	Terms            InvestmentTerms
+	Workstreams      []string    // Workstream IDs in flight
+	Completed        []string    // Workstreams that have reported back
+	Findings         []DDFinding // What diligence has uncovered so far
+	RoundCloseTurn   int         // Month the round closes, with or without us
+	LeadInvestor     string      // Fund leading the round
 }
 
 // DDLevel represents due diligence depth
@@ -71,7 +76,7 @@ func GetDDLevels() []DDLevel {
 }
 
 // PerformDueDiligence executes due diligence and generates findings
-func PerformDueDiligence(startup *Startup, level string) []DDFinding {
+func (gs *GameState) PerformDueDiligence(startup *Startup, level string) []DDFinding {
 	findings := []DDFinding{}
 
 	if level == "none" {
@@ -94,9 +99,11 @@ func PerformDueDiligence(startup *Startup, level string) []DDFinding {
 
 	// Standard DD: More detailed findings
 	if level == "standard" || level == "deep" {
-		// Founder quality check
-		founderRoll := rand.Float64()
-		if founderRoll < 0.15 { // 15% chance of red flag
+		// Founder quality check: reference calls surface the founder's real track record
+		founderRoll := gs.rng.Float64()
+		if startup.Hidden.FounderIntegrity > 0 {
+			findings = append(findings, revealHiddenTraits(startup, "background_check")...)
+		} else if founderRoll < 0.15 { // 15% chance of red flag
 			findings = append(findings, DDFinding{
 				Type:        "red_flag",
 				Category:    "founder",
@@ -123,7 +130,7 @@ func PerformDueDiligence(startup *Startup, level string) []DDFinding {
 		}
 
 		// Financial metrics check
-		financialRoll := rand.Float64()
+		financialRoll := gs.rng.Float64()
 		if financialRoll < 0.20 {
 			findings = append(findings, DDFinding{
 				Type:        "red_flag",
@@ -146,7 +153,7 @@ func PerformDueDiligence(startup *Startup, level string) []DDFinding {
 	// Deep DD: Additional technical and legal findings
 	if level == "deep" {
 		// Technical audit
-		techRoll := rand.Float64()
+		techRoll := gs.rng.Float64()
 		if techRoll < 0.18 {
 			findings = append(findings, DDFinding{
 				Type:        "red_flag",
@@ -174,8 +181,10 @@ func PerformDueDiligence(startup *Startup, level string) []DDFinding {
 		}
 
 		// Legal/compliance check
-		legalRoll := rand.Float64()
-		if legalRoll < 0.12 {
+		legalRoll := gs.rng.Float64()
+		if startup.Hidden.IPIssues {
+			findings = append(findings, revealHiddenTraits(startup, "technical_audit")...)
+		} else if legalRoll < 0.12 {
 			findings = append(findings, DDFinding{
 				Type:        "red_flag",
 				Category:    "legal",
@@ -194,7 +203,7 @@ func PerformDueDiligence(startup *Startup, level string) []DDFinding {
 		}
 
 		// Market positioning
-		marketRoll := rand.Float64()
+		marketRoll := gs.rng.Float64()
 		if marketRoll < 0.15 {
 			findings = append(findings, DDFinding{
 				Type:        "red_flag",
@@ -2,7 +2,9 @@ package game
 
 import (
 	"fmt"
-	"math/rand"
+	"math"
+
+	"github.com/jamesacampbell/unicorn/investors"
 )
 
 
@@ -31,8 +33,8 @@ func (gs *GameState) ProcessManagementFees() []string {
 		gs.Portfolio.Cash -= fee
 		gs.Portfolio.ManagementFeesCharged += fee
 
-		// Also charge AI players
-		for i := range gs.AIPlayers {
+		// Also charge AI players (once a month, alongside the first hot-seat fund)
+		for i := 0; gs.ActiveSeat == 0 && i < len(gs.AIPlayers); i++ {
 			aiFee := int64(float64(gs.AIPlayers[i].Portfolio.InitialFundSize) * monthlyFeeRate)
 			if gs.AIPlayers[i].Portfolio.Cash >= aiFee {
 				gs.AIPlayers[i].Portfolio.Cash -= aiFee
@@ -209,6 +211,13 @@ func (gs *GameState) ProcessDramaticEvents() []string {
 						} else {
 							eventMsg = "New competitor with better product. Losing customers."
 						}
+					case "customer_loss":
+						emoji = "📉"
+						if event.Severity == "severe" {
+							eventMsg = "Biggest customer walked! Half of revenue gone overnight."
+						} else {
+							eventMsg = "A major customer didn't renew. Revenue concentration bites."
+						}
 					case "product_failure":
 						emoji = "💥"
 						if event.Severity == "severe" {
@@ -222,26 +231,26 @@ func (gs *GameState) ProcessDramaticEvents() []string {
 					}
 
 					// Check if player invested
-					for j := range gs.Portfolio.Investments {
-						if gs.Portfolio.Investments[j].CompanyName == event.CompanyName {
-							inv := &gs.Portfolio.Investments[j]
-							inv.CurrentValuation = startup.Valuation
-
-							valuationDrop := oldValuation - startup.Valuation
-							dropPercent := float64(valuationDrop) / float64(oldValuation) * 100
-
-							messages = append(messages, fmt.Sprintf(
-								"%s %s: %s (Valuation: $%s → $%s, -%.0f%%)",
-								emoji,
-								event.CompanyName,
-								eventMsg,
-								formatCurrency(oldValuation),
-								formatCurrency(startup.Valuation),
-								dropPercent,
-							))
-							break
+					messages = append(messages, gs.EachSeat(func() []string {
+						inv := gs.findInvestment(event.CompanyName)
+						if inv == nil {
+							return nil
 						}
-					}
+						inv.CurrentValuation = startup.Valuation
+
+						valuationDrop := oldValuation - startup.Valuation
+						dropPercent := float64(valuationDrop) / float64(oldValuation) * 100
+
+						return []string{fmt.Sprintf(
+							"%s %s: %s (Valuation: $%s → $%s, -%.0f%%)",
+							emoji,
+							event.CompanyName,
+							eventMsg,
+							formatCurrency(oldValuation),
+							formatCurrency(startup.Valuation),
+							dropPercent,
+						)}
+					})...)
 
 					// Update AI investments
 					for k := range gs.AIPlayers {
@@ -275,11 +284,11 @@ func (gs *GameState) ProcessFundingRounds() []string {
 
 					if event.IsDownRound {
 						// Down round: pre-money is 60-90% of current valuation
-						downFactor := 0.6 + rand.Float64()*0.3 // 60%-90%
+						downFactor := 0.6 + gs.rng.Float64()*0.3 // 60%-90%
 						preMoneyVal = int64(float64(startup.Valuation) * downFactor)
 						
 						// Employee Option Pool Dilution: Even in down rounds, companies may set aside 10-15% for option pool
-						optionPoolPercent := 0.10 + rand.Float64()*0.05 // 10-15% in down rounds
+						optionPoolPercent := 0.10 + gs.rng.Float64()*0.05 // 10-15% in down rounds
 						effectivePostMoney := float64(preMoneyVal + event.RaiseAmount) / (1.0 - optionPoolPercent)
 						postMoneyVal = int64(effectivePostMoney)
 						dilutionFactor = float64(preMoneyVal) / float64(postMoneyVal)
@@ -287,7 +296,12 @@ func (gs *GameState) ProcessFundingRounds() []string {
 						// Check if any investor has board seat - down rounds require board approval
 						if gs.HasAnyBoardSeat(event.CompanyName) {
 							// Only create vote if player has board seat (player votes, AI votes are simulated)
-							if gs.HasBoardSeat(event.CompanyName) {
+							voteRequired := false
+							messages = append(messages, gs.EachSeat(func() []string {
+								if !gs.HasBoardSeat(event.CompanyName) {
+									return nil
+								}
+								voteRequired = true
 								// Create board vote for down round
 								vote := BoardVote{
 									CompanyName:  event.CompanyName,
@@ -308,10 +322,12 @@ func (gs *GameState) ProcessFundingRounds() []string {
 									},
 								}
 								gs.PendingBoardVotes = append(gs.PendingBoardVotes, vote)
-								messages = append(messages, fmt.Sprintf(
+								return []string{fmt.Sprintf(
 									"🏛️  BOARD VOTE REQUIRED: %s proposes a DOWN ROUND. Vote will be required.",
 									event.CompanyName,
-								))
+								)}
+							})...)
+							if voteRequired {
 								continue // Skip processing this round until vote is complete
 							}
 						}
@@ -321,7 +337,7 @@ func (gs *GameState) ProcessFundingRounds() []string {
 						
 						// Employee Option Pool Dilution: Companies typically set aside 15-20% of post-money
 						// for employee option pool in new rounds. This dilutes all existing shareholders.
-						optionPoolPercent := 0.15 + rand.Float64()*0.05 // 15-20% of post-money
+						optionPoolPercent := 0.15 + gs.rng.Float64()*0.05 // 15-20% of post-money
 						
 						// Calculate post-money valuation accounting for option pool
 						// If we raise $X, post-money = pre-money + $X
@@ -335,107 +351,122 @@ func (gs *GameState) ProcessFundingRounds() []string {
 						dilutionFactor = float64(preMoneyVal) / float64(postMoneyVal)
 					}
 
-					// Check for Portfolio Insurance upgrade
-					hasPortfolioInsurance := false
-					for _, upgradeID := range gs.PlayerUpgrades {
-						if upgradeID == "portfolio_insurance" {
-							hasPortfolioInsurance = true
-							break
-						}
-					}
-
-					// Update player's investment if they invested in this company
-					for j := range gs.Portfolio.Investments {
-						if gs.Portfolio.Investments[j].CompanyName == event.CompanyName {
-							inv := &gs.Portfolio.Investments[j]
-
-							// Check if Portfolio Insurance protects this investment from down rounds
-							shouldProtect := false
-							if event.IsDownRound && hasPortfolioInsurance && !gs.InsuranceUsed {
-								// First down round hit by Portfolio Insurance is protected
-								shouldProtect = true
-								gs.InsuranceUsed = true
-								gs.ProtectedCompany = event.CompanyName
+					// Every human fund in the company takes the round
+					messages = append(messages, gs.EachSeat(func() []string {
+						seatMessages := []string{}
+						// Check for Portfolio Insurance upgrade
+						hasPortfolioInsurance := false
+						for _, upgradeID := range gs.PlayerUpgrades {
+							if upgradeID == "portfolio_insurance" {
+								hasPortfolioInsurance = true
+								break
 							}
+						}
 
-							// If follow-on investment was made this turn, equity was already recalculated
-							// in MakeFollowOnInvestment based on post-money valuation, so we don't dilute again
-							if !inv.FollowOnThisTurn {
-								// Normal case: dilute existing equity (unless protected by Portfolio Insurance)
-								oldEquity := inv.EquityPercent
-								if !shouldProtect {
-									inv.EquityPercent *= dilutionFactor
-								} else {
-									// Portfolio Insurance protects this investment - no dilution
-									messages = append(messages, fmt.Sprintf(
-										"🛡️  PORTFOLIO INSURANCE: %s protected from down round dilution! Equity remains at %.2f%%",
-										event.CompanyName,
-										oldEquity,
-									))
+						// Update player's investment if they invested in this company
+						for j := range gs.Portfolio.Investments {
+							if gs.Portfolio.Investments[j].CompanyName == event.CompanyName {
+								inv := &gs.Portfolio.Investments[j]
+
+								// Check if Portfolio Insurance protects this investment from down rounds
+								shouldProtect := false
+								if event.IsDownRound && hasPortfolioInsurance && !gs.InsuranceUsed {
+									// First down round hit by Portfolio Insurance is protected
+									shouldProtect = true
+									gs.InsuranceUsed = true
+									gs.ProtectedCompany = event.CompanyName
 								}
 
-								// Record the round
-								inv.Rounds = append(inv.Rounds, FundingRound{
-									RoundName:        event.RoundName,
-									PreMoneyVal:      preMoneyVal,
-									InvestmentAmount: event.RaiseAmount,
-									PostMoneyVal:     postMoneyVal,
-									Month:            gs.Portfolio.Turn,
-								})
-
-								// Only show dilution messages if not protected by Portfolio Insurance
-								if !shouldProtect {
-									if event.IsDownRound {
-										messages = append(messages, fmt.Sprintf(
-											"⚠️  %s raised $%s in DOWN ROUND (%s)! Valuation dropped. Equity: %.2f%% → %.2f%%",
-											event.CompanyName,
-											formatCurrency(event.RaiseAmount),
-											event.RoundName,
-											oldEquity,
-											inv.EquityPercent,
-										))
+								// If follow-on investment was made this turn, equity was already recalculated
+								// in MakeFollowOnInvestment based on post-money valuation, so we don't dilute again
+								if !inv.FollowOnThisTurn {
+									// Normal case: dilute existing equity (unless protected by Portfolio Insurance)
+									oldEquity := inv.EquityPercent
+									if !shouldProtect {
+										inv.EquityPercent *= dilutionFactor
 									} else {
-										messages = append(messages, fmt.Sprintf(
-											"🚀 %s raised $%s in %s round! Your equity diluted from %.2f%% to %.2f%%",
+										// Portfolio Insurance protects this investment - no dilution
+										seatMessages = append(seatMessages, fmt.Sprintf(
+											"🛡️  PORTFOLIO INSURANCE: %s protected from down round dilution! Equity remains at %.2f%%",
 											event.CompanyName,
-											formatCurrency(event.RaiseAmount),
-											event.RoundName,
 											oldEquity,
-											inv.EquityPercent,
 										))
 									}
-								}
-							} else {
-								// Follow-on investment case: equity already calculated correctly, just record the round
-								oldEquity := inv.EquityPercent
-								inv.Rounds = append(inv.Rounds, FundingRound{
-									RoundName:        event.RoundName,
-									PreMoneyVal:      preMoneyVal,
-									InvestmentAmount: event.RaiseAmount,
-									PostMoneyVal:     postMoneyVal,
-									Month:            gs.Portfolio.Turn,
-								})
-
-								// Reset flag for next turn
-								inv.FollowOnThisTurn = false
 
-								messages = append(messages, fmt.Sprintf(
-									"🚀 %s raised $%s in %s round! Your equity: %.2f%% (includes your follow-on investment)",
-									event.CompanyName,
-									formatCurrency(event.RaiseAmount),
-									event.RoundName,
-									oldEquity,
-								))
+									// Record the round
+									inv.Rounds = append(inv.Rounds, FundingRound{
+										RoundName:        event.RoundName,
+										PreMoneyVal:      preMoneyVal,
+										InvestmentAmount: event.RaiseAmount,
+										PostMoneyVal:     postMoneyVal,
+										Month:            gs.Portfolio.Turn,
+									})
+
+									// Only show dilution messages if not protected by Portfolio Insurance
+									if !shouldProtect {
+										if event.IsDownRound {
+											seatMessages = append(seatMessages, fmt.Sprintf(
+												"⚠️  %s raised $%s in DOWN ROUND (%s)! Valuation dropped. Equity: %.2f%% → %.2f%%",
+												event.CompanyName,
+												formatCurrency(event.RaiseAmount),
+												event.RoundName,
+												oldEquity,
+												inv.EquityPercent,
+											))
+										} else {
+											seatMessages = append(seatMessages, fmt.Sprintf(
+												"🚀 %s raised $%s in %s round! Your equity diluted from %.2f%% to %.2f%%",
+												event.CompanyName,
+												formatCurrency(event.RaiseAmount),
+												event.RoundName,
+												oldEquity,
+												inv.EquityPercent,
+											))
+										}
+									}
+								} else {
+									// Follow-on investment case: equity already calculated correctly, just record the round
+									oldEquity := inv.EquityPercent
+									inv.Rounds = append(inv.Rounds, FundingRound{
+										RoundName:        event.RoundName,
+										PreMoneyVal:      preMoneyVal,
+										InvestmentAmount: event.RaiseAmount,
+										PostMoneyVal:     postMoneyVal,
+										Month:            gs.Portfolio.Turn,
+									})
+
+									// Reset flag for next turn
+									inv.FollowOnThisTurn = false
+
+									seatMessages = append(seatMessages, fmt.Sprintf(
+										"🚀 %s raised $%s in %s round! Your equity: %.2f%% (includes your follow-on investment)",
+										event.CompanyName,
+										formatCurrency(event.RaiseAmount),
+										event.RoundName,
+										oldEquity,
+									))
+								}
 							}
 						}
-					}
+						return seatMessages
+					})...)
 
 					// Update AI players' investments
 					for k := range gs.AIPlayers {
 						for j := range gs.AIPlayers[k].Portfolio.Investments {
 							if gs.AIPlayers[k].Portfolio.Investments[j].CompanyName == event.CompanyName {
-								inv := &gs.AIPlayers[k].Portfolio.Investments[j]
-								inv.EquityPercent *= dilutionFactor
+								ai := &gs.AIPlayers[k]
+								inv := &ai.Portfolio.Investments[j]
+								// Whether the fund takes its pro-rata follows its strategy
+								proRata := int64(float64(event.RaiseAmount) * inv.EquityPercent / 100)
+								performance := math.Min(1, float64(inv.CurrentValuation)/math.Max(1, float64(inv.InitialValuation))/3)
+								if inv.Terms.HasProRataRights && proRata > 0 && proRata <= ai.Portfolio.Cash &&
+									gs.rng.Float64() < investors.FollowOnWillingness(ai.Strategy, ai.RiskTolerance, performance) {
+									ai.Portfolio.Cash -= proRata
+									inv.AmountInvested += proRata
+								} else {
+									inv.EquityPercent *= dilutionFactor
+								}
 								inv.Rounds = append(inv.Rounds, FundingRound{
 									RoundName:        event.RoundName,
 									PreMoneyVal:      preMoneyVal,
@@ -451,11 +482,12 @@ func (gs *GameState) ProcessFundingRounds() []string {
 					startup.Valuation = postMoneyVal
 
 					// Also update current valuation for all investors
-					for j := range gs.Portfolio.Investments {
-						if gs.Portfolio.Investments[j].CompanyName == event.CompanyName {
-							gs.Portfolio.Investments[j].CurrentValuation = postMoneyVal
+					gs.EachSeat(func() []string {
+						if inv := gs.findInvestment(event.CompanyName); inv != nil {
+							inv.CurrentValuation = postMoneyVal
 						}
-					}
+						return nil
+					})
 					for k := range gs.AIPlayers {
 						for j := range gs.AIPlayers[k].Portfolio.Investments {
 							if gs.AIPlayers[k].Portfolio.Investments[j].CompanyName == event.CompanyName {
@@ -498,85 +530,91 @@ func (gs *GameState) ProcessAcquisitions() []string {
 					}
 
 					// Check if player invested in this company
-					for j := range gs.Portfolio.Investments {
-						if gs.Portfolio.Investments[j].CompanyName == event.CompanyName {
-							inv := &gs.Portfolio.Investments[j]
-
-							// Calculate payout
-							payout := int64((inv.EquityPercent / 100.0) * float64(offerValue))
-							returnMultiple := float64(payout) / float64(inv.AmountInvested)
-
-							// If player has board seat, require board vote for acquisitions (unless bad due diligence)
-							if inv.Terms.HasBoardSeat && event.DueDiligence != "bad" {
-								// Create board vote
-								vote := BoardVote{
-									CompanyName:  event.CompanyName,
-									VoteType:     "acquisition",
-									Title:        fmt.Sprintf("Acquisition Offer: $%s", formatCurrency(offerValue)),
-									Description:  fmt.Sprintf("Acquirer offers $%s (%.1fx EBITDA) for %s. Your payout would be $%s (%.1fx return).", formatCurrency(offerValue), event.OfferMultiple, event.CompanyName, formatCurrency(payout), returnMultiple),
-									OptionA:      "Accept",
-									OptionB:      "Reject",
-									ConsequenceA: fmt.Sprintf("Acquisition proceeds. You receive $%s.", formatCurrency(payout)),
-									ConsequenceB: "Acquisition rejected. Company continues operating independently.",
-									RequiresVote: true,
-									Turn:         gs.Portfolio.Turn,
-									Metadata: map[string]interface{}{
-										"offerValue":       offerValue,
-										"currentValuation": startup.Valuation,
-										"dueDiligence":     event.DueDiligence,
-										"offerMultiple":    event.OfferMultiple,
-									},
+					messages = append(messages, gs.EachSeat(func() []string {
+						seatMessages := []string{}
+						for j := range gs.Portfolio.Investments {
+							if gs.Portfolio.Investments[j].CompanyName == event.CompanyName {
+								inv := &gs.Portfolio.Investments[j]
+
+								// Calculate payout
+								payout := int64((inv.EquityPercent / 100.0) * float64(offerValue))
+								returnMultiple := float64(payout) / float64(inv.AmountInvested)
+
+								// If player has board seat, require board vote for acquisitions (unless bad due diligence)
+								if inv.Terms.HasBoardSeat && event.DueDiligence != "bad" {
+									// Create board vote
+									vote := BoardVote{
+										CompanyName:  event.CompanyName,
+										VoteType:     "acquisition",
+										Title:        fmt.Sprintf("Acquisition Offer: $%s", formatCurrency(offerValue)),
+										Description:  fmt.Sprintf("Acquirer offers $%s (%.1fx EBITDA) for %s. Your payout would be $%s (%.1fx return).", formatCurrency(offerValue), event.OfferMultiple, event.CompanyName, formatCurrency(payout), returnMultiple),
+										OptionA:      "Accept",
+										OptionB:      "Reject",
+										ConsequenceA: fmt.Sprintf("Acquisition proceeds. You receive $%s.", formatCurrency(payout)),
+										ConsequenceB: "Acquisition rejected. Company continues operating independently.",
+										RequiresVote: true,
+										Turn:         gs.Portfolio.Turn,
+										Metadata: map[string]interface{}{
+											"offerValue":       offerValue,
+											"currentValuation": startup.Valuation,
+											"dueDiligence":     event.DueDiligence,
+											"offerMultiple":    event.OfferMultiple,
+										},
+									}
+									gs.PendingBoardVotes = append(gs.PendingBoardVotes, vote)
+									seatMessages = append(seatMessages, fmt.Sprintf(
+										"🏛️  BOARD VOTE REQUIRED: %s received acquisition offer of $%s. Vote will be required.",
+										event.CompanyName,
+										formatCurrency(offerValue),
+									))
+									break // Don't execute acquisition yet - wait for vote
 								}
-								gs.PendingBoardVotes = append(gs.PendingBoardVotes, vote)
-								messages = append(messages, fmt.Sprintf(
-									"🏛️  BOARD VOTE REQUIRED: %s received acquisition offer of $%s. Vote will be required.",
-									event.CompanyName,
-									formatCurrency(offerValue),
-								))
-								break // Don't execute acquisition yet - wait for vote
-							}
 
-							// Add acquisition message based on due diligence
-							switch event.DueDiligence {
-							case "bad":
-								messages = append(messages, fmt.Sprintf(
-									"⚠️  %s acquisition FELL THROUGH! Due diligence issues. Offer was $%s (%.1fx EBITDA)",
-									event.CompanyName,
-									formatCurrency(offerValue),
-									event.OfferMultiple,
-								))
-							case "good":
-								messages = append(messages, fmt.Sprintf(
-									"🎉 %s ACQUIRED for $%s (%.1fx EBITDA)! Your %.2f%% = $%s (%.1fx return)",
-									event.CompanyName,
-									formatCurrency(offerValue),
-									event.OfferMultiple,
-									inv.EquityPercent,
-									formatCurrency(payout),
-									returnMultiple,
-								))
-								// Execute acquisition
-								gs.Portfolio.Cash += payout
-								// Remove investment from portfolio
-								gs.Portfolio.Investments = append(gs.Portfolio.Investments[:j], gs.Portfolio.Investments[j+1:]...)
-							default: // normal
-								messages = append(messages, fmt.Sprintf(
-									"💰 %s ACQUIRED for $%s (%.1fx EBITDA)! Your %.2f%% = $%s (%.1fx return)",
-									event.CompanyName,
-									formatCurrency(offerValue),
-									event.OfferMultiple,
-									inv.EquityPercent,
-									formatCurrency(payout),
-									returnMultiple,
-								))
-								// Execute acquisition
-								gs.Portfolio.Cash += payout
-								// Remove investment from portfolio
-								gs.Portfolio.Investments = append(gs.Portfolio.Investments[:j], gs.Portfolio.Investments[j+1:]...)
+								// Add acquisition message based on due diligence
+								switch event.DueDiligence {
+								case "bad":
+									seatMessages = append(seatMessages, fmt.Sprintf(
+										"⚠️  %s acquisition FELL THROUGH! Due diligence issues. Offer was $%s (%.1fx EBITDA)",
+										event.CompanyName,
+										formatCurrency(offerValue),
+										event.OfferMultiple,
+									))
+								case "good":
+									seatMessages = append(seatMessages, fmt.Sprintf(
+										"🎉 %s ACQUIRED for $%s (%.1fx EBITDA)! Your %.2f%% = $%s (%.1fx return)",
+										event.CompanyName,
+										formatCurrency(offerValue),
+										event.OfferMultiple,
+										inv.EquityPercent,
+										formatCurrency(payout),
+										returnMultiple,
+									))
+									// Execute acquisition
+									gs.Portfolio.Cash += payout
+									gs.recordExit(*inv, inv.AmountInvested, payout, "acquired")
+									// Remove investment from portfolio
+									gs.Portfolio.Investments = append(gs.Portfolio.Investments[:j], gs.Portfolio.Investments[j+1:]...)
+								default: // normal
+									seatMessages = append(seatMessages, fmt.Sprintf(
+										"💰 %s ACQUIRED for $%s (%.1fx EBITDA)! Your %.2f%% = $%s (%.1fx return)",
+										event.CompanyName,
+										formatCurrency(offerValue),
+										event.OfferMultiple,
+										inv.EquityPercent,
+										formatCurrency(payout),
+										returnMultiple,
+									))
+									// Execute acquisition
+									gs.Portfolio.Cash += payout
+									gs.recordExit(*inv, inv.AmountInvested, payout, "acquired")
+									// Remove investment from portfolio
+									gs.Portfolio.Investments = append(gs.Portfolio.Investments[:j], gs.Portfolio.Investments[j+1:]...)
+								}
+								break
 							}
-							break
 						}
-					}
+						return seatMessages
+					})...)
 
 					// Handle AI player acquisitions
 					if event.DueDiligence != "bad" {
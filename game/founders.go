@@ -29,16 +29,16 @@ var founderLastNames = []string{
 }
 
 // GenerateFounderName creates a realistic founder name
-func GenerateFounderName() string {
-	firstName := founderFirstNames[rand.Intn(len(founderFirstNames))]
-	lastName := founderLastNames[rand.Intn(len(founderLastNames))]
+func GenerateFounderName(rng *rand.Rand) string {
+	firstName := founderFirstNames[rng.Intn(len(founderFirstNames))]
+	lastName := founderLastNames[rng.Intn(len(founderLastNames))]
 	return firstName + " " + lastName
 }
 
 // CalculateInitialRelationship determines starting relationship score based on investment terms
-func CalculateInitialRelationship(terms InvestmentTerms, hasDueDiligence bool, amount int64) float64 {
+func (gs *GameState) CalculateInitialRelationship(terms InvestmentTerms, hasDueDiligence bool, amount int64) float64 {
 	// Base relationship: 50-70 range
-	relationship := 50.0 + float64(rand.Intn(21)) // 50-70
+	relationship := 50.0 + float64(gs.rng.Intn(21)) // 50-70
 
 	// Founder-friendly terms improve relationship
 	if terms.Type == "Common Stock" {
@@ -69,6 +69,12 @@ func CalculateInitialRelationship(terms InvestmentTerms, hasDueDiligence bool, a
 		relationship = 40.0
 	}
 
+	// Negotiated terms the founder felt squeezed on leave a mark
+	relationship -= terms.FounderFriction * 25
+	if relationship < 10.0 {
+		relationship = 10.0
+	}
+
 	return relationship
 }
 
@@ -113,9 +119,9 @@ type RelationshipEvent struct {
 }
 
 // GenerateRelationshipEvent creates a random relationship event
-func GenerateRelationshipEvent(inv *Investment, currentTurn int) *RelationshipEvent {
+func GenerateRelationshipEvent(rng *rand.Rand, inv *Investment, currentTurn int) *RelationshipEvent {
 	// 10% chance per turn for a relationship event
-	if rand.Float64() > 0.10 {
+	if rng.Float64() > 0.10 {
 		return nil
 	}
 
@@ -144,7 +150,7 @@ func GenerateRelationshipEvent(inv *Investment, currentTurn int) *RelationshipEv
 		{"neutral", "wants advice on hiring a key executive", 0.0},
 	}
 
-	event := events[rand.Intn(len(events))]
+	event := events[rng.Intn(len(events))]
 
 	return &RelationshipEvent{
 		CompanyName:    inv.CompanyName,
@@ -191,10 +197,10 @@ func GetRelationshipImpactOnExit(relationshipScore float64) float64 {
 }
 
 // CanBeFiredFromBoard checks if poor relationship leads to board removal
-func CanBeFiredFromBoard(relationshipScore float64) bool {
+func CanBeFiredFromBoard(rng *rand.Rand, relationshipScore float64) bool {
 	// Very poor relationships (<30) have chance of board removal
 	if relationshipScore < 30 {
-		return rand.Float64() < 0.05 // 5% chance per turn when very low
+		return rng.Float64() < 0.05 // 5% chance per turn when very low
 	}
 	return false
 }
@@ -205,11 +211,24 @@ func GetFounderReferralBonus(avgRelationshipScore float64) float64 {
 	// 80+ average = 10% better deal quality
 	// 70+ average = 5% better deal quality
 
+	// Below 45 founders actively warn each other off
 	if avgRelationshipScore >= 80 {
 		return 0.10
 	} else if avgRelationshipScore >= 70 {
 		return 0.05
+	} else if avgRelationshipScore < 45 {
+		return -0.05
 	}
 
 	return 0.0
 }
+
+// FounderNameFor returns the generated founder of a startup, or a fresh name for curated ones
+func (gs *GameState) FounderNameFor(companyName string) string {
+	for _, s := range gs.AvailableStartups {
+		if s.Name == companyName && s.Founder.Name != "" {
+			return s.Founder.Name
+		}
+	}
+	return GenerateFounderName(gs.rng)
+}
@@ -31,6 +31,12 @@ type InvestmentTerms struct {
 	HasAntiDilution     bool    // Anti-dilution protection
 	ConversionDiscount  float64 // Discount on conversion (for SAFE/Convertible)
 	ValuationCap        int64   // Valuation cap for SAFE conversion (0 = no cap)
+
+	// Negotiated term sheets
+	Participating        bool    // Participating preferred (pref + pro-rata share of the rest)
+	ProtectiveProvisions bool    // Veto over new rounds, sales and debt
+	PreMoneyValuation    int64   // Negotiated pre-money (0 = company's current valuation)
+	FounderFriction      float64 // How aggressive the founder felt the terms were (0-1+)
 }
 
 // Investment represents a player's investment in a startup
@@ -100,6 +106,13 @@ type Startup struct {
 	GrowthPotential        float64 // 0-1, higher is better
 	QualityTier            int     // 1=hot, 2=standard, 3=struggling (set by reputation)
 
+	// Procedurally generated deal flow
+	Stage      string         // "Pre-Seed", "Seed"
+	Generated  bool           // Created by StartupGenerator rather than loaded from JSON
+	Founder    FounderProfile // Founding CEO (generated startups only)
+	Hidden     HiddenTraits   // Uncovered through due diligence
+	ReferredBy string         // Contact from the player's founder network who made the intro
+
 	// Financial tracking
 	MonthlyRevenue          int64   // Revenue this month
 	MonthlyCosts            int64   // Costs this month (burn rate)
@@ -133,6 +146,8 @@ type Difficulty struct {
 	LPCommitMultiplier    float64 // LP committed capital as a multiple of starting cash
 	MaxInitialInvestments int     // Cap on new (first-check) investments per fund
 	OpportunityFundMultiple float64 // Opportunity fund as a multiple of starting cash (e.g., 1.5 = 150% of fund)
+	GeneratedStartups     int     // Procedural startups added to the curated deal pool
+	StartupQualityBias    float64 // Shifts generated deal quality (+ = more hot deals, fewer skeletons)
 }
 
 // AIPlayer represents a computer-controlled VC
@@ -167,6 +182,28 @@ type GameState struct {
 	ActiveValueAddActions []ValueAddAction // Ongoing value-add actions
 	PendingDDDecisions    []DDDecision     // Due diligence decisions waiting for player
 	SecondaryMarketOffers []SecondaryOffer // Offers to buy stakes
+	TermSheetWalkaways    []string         // Companies whose founders walked away from our terms
+	Boards                []CompanyBoard   // Persistent board composition per company
+	PortfolioPlan         PortfolioPlan    // Fund construction plan (zero until first used)
+
+	// Two-sided secondary market
+	MarketCycle             *MarketCycle     // Current economic cycle (drives secondary pricing)
+	SecondaryOrders         []SecondaryOrder // Resting bids and asks
+	SecondaryOrderSeq       int              // Last order ID issued
+	ContinuationVehicleDone bool             // GP-led continuation vehicle already run this fund
+
+	Career *CareerCapital // Personal capital and network carried in from earlier games (nil = classic game)
+
+	ExitedInvestments []InvestmentRecord // Positions realized this game, for post-game analytics
+	History           []TurnSnapshot     // Per-turn metrics for charts
+
+	Seed     int64      // Seeds the procedural deal flow (same seed = same startups)
+	Modifier string     // Challenge rule in play (see ModifierNoFollowOns etc.), "" for a normal game
+	rng      *rand.Rand // Every roll in the game comes from Seed, so the same choices play out the same
+
+	// Hot-seat multiplayer: the players not at the keyboard (nil in single-player)
+	Seats      []Seat
+	ActiveSeat int
 }
 
 // FundingRoundEvent represents a scheduled funding round
@@ -190,7 +227,7 @@ type AcquisitionEvent struct {
 type DramaticEvent struct {
 	CompanyName   string
 	ScheduledTurn int
-	EventType     string  // "cofounder_split", "scandal", "lawsuit", "pivot_fail", "fraud", "data_breach", "key_hire_quit"
+	EventType     string  // "cofounder_split", "scandal", "lawsuit", "pivot_fail", "fraud", "data_breach", "key_hire_quit", "customer_loss"
 	Severity      string  // "minor", "moderate", "severe"
 	ImpactPercent float64 // Valuation impact as a multiplier (0.3 = 70% drop)
 }
@@ -198,7 +235,7 @@ type DramaticEvent struct {
 // BoardVote represents a voting opportunity for board members
 type BoardVote struct {
 	CompanyName  string
-	VoteType     string // "acquisition", "down_round", "strategic_pivot", "ceo_removal"
+	VoteType     string // "acquisition", "down_round", "strategic_pivot", "ceo_removal", "option_pool", "budget_approval", "debt_financing", "secondary_sale", "ceo_hire"
 	Title        string
 	Description  string
 	OptionA      string                 // "Accept" / "Approve" / "Yes"
@@ -248,6 +285,8 @@ var (
 		LPCommitMultiplier:    3.0,     // LPs commit 3x starting cash
 		MaxInitialInvestments: 12,      // Up to 12 first-check bets
 		OpportunityFundMultiple: 1.5,  // $1.5M opportunity fund (150% of starting cash)
+		GeneratedStartups:     30,
+		StartupQualityBias:    0.10,
 	}
 
 	MediumDifficulty = Difficulty{
@@ -261,6 +300,8 @@ var (
 		LPCommitMultiplier:    3.0,     // LPs commit 3x starting cash
 		MaxInitialInvestments: 10,      // Up to 10 first-check bets
 		OpportunityFundMultiple: 1.5,  // $2.25M opportunity fund
+		GeneratedStartups:     40,
+		StartupQualityBias:    0.0,
 	}
 
 	HardDifficulty = Difficulty{
@@ -274,6 +315,8 @@ var (
 		LPCommitMultiplier:    3.5,     // LPs commit 3.5x starting cash
 		MaxInitialInvestments: 8,       // Up to 8 first-check bets
 		OpportunityFundMultiple: 1.25, // $2.5M opportunity fund (smaller relative to harder game)
+		GeneratedStartups:     45,
+		StartupQualityBias:    -0.05,
 	}
 
 	ExpertDifficulty = Difficulty{
@@ -287,6 +330,8 @@ var (
 		LPCommitMultiplier:    3.5,     // LPs commit 3.5x starting cash
 		MaxInitialInvestments: 8,       // Up to 8 first-check bets
 		OpportunityFundMultiple: 1.0,  // $2.5M opportunity fund (100% — harder to unlock on expert)
+		GeneratedStartups:     50,
+		StartupQualityBias:    -0.10,
 	}
 )
 
@@ -299,6 +344,7 @@ type PlayerScore struct {
 	NetWorth int64
 	ROI      float64
 	IsPlayer bool
+	IsHuman  bool // Any hot-seat player, not just the one at the keyboard
 }
 
 // initializeLPCommitments sets up LP commitments and capital call schedule.
@@ -330,9 +376,8 @@ func GenerateDefaultFirmName(playerName string) string {
 	return lastName + " Capital"
 }
 
-func NewGame(playerName string, firmName string, difficulty Difficulty, playerUpgrades []string) *GameState {
-	rand.Seed(time.Now().UnixNano())
-
+// newPortfolio opens a fund sized by the difficulty and the player's upgrades
+func newPortfolio(difficulty Difficulty, playerUpgrades []string) Portfolio {
 	// Follow-on reserve scales with difficulty so the fund can participate in
 	// expensive later rounds without being crushed by dilution. The previous
 	// formula ($100k + 18 × $50k ≈ $1M) was far too small relative to late-stage
@@ -370,29 +415,47 @@ func NewGame(playerName string, firmName string, difficulty Difficulty, playerUp
 	// Unlocked when a portfolio company hits 3x growth threshold.
 	opportunityFund := int64(float64(startingCash) * difficulty.OpportunityFundMultiple)
 
+	return Portfolio{
+		Cash:                startingCash,
+		NetWorth:            startingCash,
+		Turn:                1,
+		MaxTurns:            maxTurns,
+		InitialFundSize:     startingCash,
+		AnnualManagementFee: managementFee,
+		FollowOnReserve:     followOnReserve,
+		CarryInterestPaid:   0,
+		LPCommittedCapital:  lpCommittedCapital,
+		LPCalledCapital:     0,
+		LastCapitalCallTurn: 0,
+		CapitalCallSchedule: capitalCallSchedule,
+		OpportunityFund:         opportunityFund,
+		OpportunityFundUsed:      0,
+		OpportunityFundUnlocked: false,
+		OpportunityFundCompanies: []string{},
+	}
+}
+
+func NewGame(playerName string, firmName string, difficulty Difficulty, playerUpgrades []string) *GameState {
+	return NewGameWithSeed(playerName, firmName, difficulty, playerUpgrades, nil, time.Now().UnixNano())
+}
+
+// NewGameWithSeed starts a game whose deal flow and dice rolls come from seed.
+// A reputation known up front shapes the quality of the startups offered.
+func NewGameWithSeed(playerName string, firmName string, difficulty Difficulty, playerUpgrades []string, reputation *VCReputation, seed int64) *GameState {
+	return newGame(playerName, firmName, difficulty, playerUpgrades, reputation, seed, "")
+}
+
+func newGame(playerName string, firmName string, difficulty Difficulty, playerUpgrades []string, reputation *VCReputation, seed int64, modifier string) *GameState {
 	gs := &GameState{
 		PlayerName:     playerName,
 		PlayerFirmName: firmName,
-		Difficulty:     difficulty,
-		PlayerUpgrades: playerUpgrades,
-		Portfolio: Portfolio{
-			Cash:                startingCash,
-			NetWorth:            startingCash,
-			Turn:                1,
-			MaxTurns:            maxTurns,
-			InitialFundSize:     startingCash,
-			AnnualManagementFee: managementFee,
-			FollowOnReserve:     followOnReserve,
-			CarryInterestPaid:   0,
-			LPCommittedCapital:  lpCommittedCapital,
-			LPCalledCapital:     0,
-			LastCapitalCallTurn: 0,
-			CapitalCallSchedule: capitalCallSchedule,
-			OpportunityFund:         opportunityFund,
-			OpportunityFundUsed:      0,
-			OpportunityFundUnlocked: false,
-			OpportunityFundCompanies: []string{},
-		},
+		Difficulty:       difficulty,
+		PlayerUpgrades:   playerUpgrades,
+		PlayerReputation: reputation,
+		Seed:             seed,
+		rng:              rand.New(rand.NewSource(seed)),
+		Modifier:         modifier,
+		Portfolio:        newPortfolio(difficulty, playerUpgrades),
 	}
 
 	gs.LoadStartups(playerUpgrades, gs.PlayerReputation)
@@ -425,33 +488,48 @@ func (gs *GameState) LoadStartups(playerUpgrades []string, reputation *VCReputat
 
 		// Cap all initial valuations at $1M or less (pre-seed stage)
 		// Generate realistic pre-seed valuations between $250k - $1M
-		startup.Valuation = int64(250000 + rand.Intn(750000))
-
-		// Calculate risk and growth scores based on metrics
-		startup.RiskScore = gs.calculateRiskScore(&startup)
-		startup.GrowthPotential = gs.calculateGrowthPotential(&startup)
-
-		// Initialize financial metrics
-		startup.MonthlyRevenue = int64(startup.MonthlySales * startup.SalePrice)
-		startup.MonthlyCosts = int64(startup.GrossBurnRate * 1000) // Convert to actual dollars
-		startup.NetIncome = startup.MonthlyRevenue - startup.MonthlyCosts
-		startup.CustomerCount = startup.MonthlySales // Approximate
-		startup.MonthlyRecurringRevenue = startup.MonthlyRevenue
-		startup.RevenueGrowthRate = 0.05 // Default 5% growth
-		startup.Last409AValuation = startup.Valuation
-		startup.Last409AMonth = 0
-		startup.RevenueHistory = []int64{startup.MonthlyRevenue} // Initialize with first month
+		startup.Valuation = int64(250000 + gs.rng.Intn(750000))
+		startup.Stage = "Pre-Seed"
 
+		gs.initializeStartupMetrics(&startup)
 		allStartups = append(allStartups, startup)
 	}
 
+	// Curated startups get hidden traits too, so due diligence has something to find
+	generator := NewStartupGenerator(gs.Seed, gs.Difficulty)
+	for i := range allStartups {
+		generator.Reserve(allStartups[i].Name)
+		tier := 2
+		if score := allStartups[i].GrowthPotential - allStartups[i].RiskScore; score > 0.3 {
+			tier = 1
+		} else if score <= -0.1 {
+			tier = 3
+		}
+		allStartups[i].Hidden = generator.hiddenTraits(tier)
+	}
+
+	// Procedural deal flow alongside the curated startups
+	if gs.Difficulty.GeneratedStartups > 0 {
+		for _, startup := range generator.GenerateBatch(gs.Difficulty.GeneratedStartups) {
+			gs.initializeStartupMetrics(&startup)
+			allStartups = append(allStartups, startup)
+		}
+	}
+
+	if gs.Modifier == ModifierDeepTechOnly {
+		allStartups = deepTechOnly(allStartups)
+	}
+
 	// Apply reputation-based deal quality filtering
 	if reputation != nil {
 		aggregateRep := reputation.GetAggregateReputation()
 
 		// Tag each startup with a quality tier based on metrics
-		// Better growth/risk score = higher tier
+		// Better growth/risk score = higher tier (generated startups already have one)
 		for i := range allStartups {
+			if allStartups[i].Generated {
+				continue
+			}
 			score := allStartups[i].GrowthPotential - allStartups[i].RiskScore
 			if score > 0.3 {
 				allStartups[i].QualityTier = 1 // Hot deal
@@ -506,9 +584,9 @@ func (gs *GameState) LoadStartups(playerUpgrades []string, reputation *VCReputat
 		}
 
 		// Shuffle each tier
-		rand.Shuffle(len(tier1), func(i, j int) { tier1[i], tier1[j] = tier1[j], tier1[i] })
-		rand.Shuffle(len(tier2), func(i, j int) { tier2[i], tier2[j] = tier2[j], tier2[i] })
-		rand.Shuffle(len(tier3), func(i, j int) { tier3[i], tier3[j] = tier3[j], tier3[i] })
+		gs.rng.Shuffle(len(tier1), func(i, j int) { tier1[i], tier1[j] = tier1[j], tier1[i] })
+		gs.rng.Shuffle(len(tier2), func(i, j int) { tier2[i], tier2[j] = tier2[j], tier2[i] })
+		gs.rng.Shuffle(len(tier3), func(i, j int) { tier3[i], tier3[j] = tier3[j], tier3[i] })
 
 		// Select from each tier
 		selected := []Startup{}
@@ -528,7 +606,7 @@ func (gs *GameState) LoadStartups(playerUpgrades []string, reputation *VCReputat
 			remaining = append(remaining, tier1[tier1Count:]...)
 			remaining = append(remaining, tier2[tier2Count:]...)
 			remaining = append(remaining, tier3[tier3Count:]...)
-			rand.Shuffle(len(remaining), func(i, j int) { remaining[i], remaining[j] = remaining[j], remaining[i] })
+			gs.rng.Shuffle(len(remaining), func(i, j int) { remaining[i], remaining[j] = remaining[j], remaining[i] })
 			for i := 0; i < count-len(selected) && i < len(remaining); i++ {
 				selected = append(selected, remaining[i])
 			}
@@ -550,7 +628,7 @@ func (gs *GameState) LoadStartups(playerUpgrades []string, reputation *VCReputat
 		count += extraStartups
 
 		if len(allStartups) > count {
-			rand.Shuffle(len(allStartups), func(i, j int) {
+			gs.rng.Shuffle(len(allStartups), func(i, j int) {
 				allStartups[i], allStartups[j] = allStartups[j], allStartups[i]
 			})
 			gs.AvailableStartups = allStartups[:count]
@@ -560,6 +638,24 @@ func (gs *GameState) LoadStartups(playerUpgrades []string, reputation *VCReputat
 	}
 }
 
+// initializeStartupMetrics scores a startup and seeds its financials from its unit economics
+func (gs *GameState) initializeStartupMetrics(startup *Startup) {
+	// Calculate risk and growth scores based on metrics
+	startup.RiskScore = gs.calculateRiskScore(startup)
+	startup.GrowthPotential = gs.calculateGrowthPotential(startup)
+
+	// Initialize financial metrics
+	startup.MonthlyRevenue = int64(startup.MonthlySales * startup.SalePrice)
+	startup.MonthlyCosts = int64(startup.GrossBurnRate * 1000) // Convert to actual dollars
+	startup.NetIncome = startup.MonthlyRevenue - startup.MonthlyCosts
+	startup.CustomerCount = startup.MonthlySales // Approximate
+	startup.MonthlyRecurringRevenue = startup.MonthlyRevenue
+	startup.RevenueGrowthRate = 0.05 // Default 5% growth
+	startup.Last409AValuation = startup.Valuation
+	startup.Last409AMonth = 0
+	startup.RevenueHistory = []int64{startup.MonthlyRevenue} // Initialize with first month
+}
+
 // LoadEvents loads all possible game events
 
 func (gs *GameState) LoadEvents() {
@@ -611,18 +707,24 @@ func formatCurrency(amount int64) string {
 func (gs *GameState) ProcessTurn() []string {
 	messages := []string{}
 
-	// Process capital calls (before management fees, so fees are charged on larger fund)
-	capitalCallMessages := gs.ProcessCapitalCalls()
-	messages = append(messages, capitalCallMessages...)
+	// Baseline snapshot so charts start from where the fund began
+	gs.EachSeat(func() []string {
+		if len(gs.History) == 0 {
+			gs.RecordSnapshot()
+		}
+		return nil
+	})
 
 	// Process capital calls for AI players
 	for i := range gs.AIPlayers {
 		gs.processAICapitalCalls(i)
 	}
 
+	// Process capital calls (before management fees, so fees are charged on larger fund)
+	messages = append(messages, gs.EachSeat(gs.ProcessCapitalCalls)...)
+
 	// Process management fees
-	feeMessages := gs.ProcessManagementFees()
-	messages = append(messages, feeMessages...)
+	messages = append(messages, gs.EachSeat(gs.ProcessManagementFees)...)
 
 	// NOTE: Follow-on investments should be handled BEFORE this function is called
 	// Process funding rounds
@@ -630,24 +732,25 @@ func (gs *GameState) ProcessTurn() []string {
 	messages = append(messages, roundMessages...)
 
 	// Check for opportunity fund qualification (3x growth threshold)
-	newlyQualified := gs.CheckOpportunityFundQualification()
-	for _, company := range newlyQualified {
-		if !gs.Portfolio.OpportunityFundUnlocked || len(gs.Portfolio.OpportunityFundCompanies) == 1 {
-			// First unlock
-			messages = append(messages, fmt.Sprintf(
-				"🎯 Opportunity Fund unlocked! %s hit 3x growth — $%s available for breakout follow-ons.",
-				company, formatCurrency(gs.Portfolio.OpportunityFund)))
-		} else {
-			messages = append(messages, fmt.Sprintf(
-				"🎯 %s qualified for Opportunity Fund follow-on investment.",
-				company))
-		}
-	}
+	messages = append(messages, gs.EachSeat(gs.opportunityFundMessages)...)
+
+	// Advance due diligence and close rounds we didn't commit to in time
+	messages = append(messages, gs.EachSeat(gs.ProcessDueDiligence)...)
+
+	// Founder word of mouth shapes the deals still in market
+	messages = append(messages, gs.EachSeat(gs.ProcessFounderReferrals)...)
 
 	// Process dramatic events (scandals, co-founder splits, etc.)
 	dramaMessages := gs.ProcessDramaticEvents()
 	messages = append(messages, dramaMessages...)
 
+	// Routine board decisions at companies where we hold a seat
+	messages = append(messages, gs.EachSeat(gs.ProcessGovernanceVotes)...)
+
+	// Secondary market: fill listings, pull stale orders, post new ones
+	secondaryMessages := gs.ProcessSecondaryOrderBook()
+	messages = append(messages, secondaryMessages...)
+
 	// Process acquisitions
 	acqMessages := gs.ProcessAcquisitions()
 	messages = append(messages, acqMessages...)
@@ -665,30 +768,102 @@ func (gs *GameState) ProcessTurn() []string {
 			val409A := gs.Calculate409AValuation(startup)
 
 			// Show 409A for companies we're invested in
-			for _, inv := range gs.Portfolio.Investments {
-				if inv.CompanyName == startup.Name {
-					profitLossStr := ""
-					if startup.NetIncome >= 0 {
-						profitLossStr = fmt.Sprintf("Profit: $%s", formatCurrency(startup.NetIncome))
-					} else {
-						profitLossStr = fmt.Sprintf("Loss: $%s", formatCurrency(-startup.NetIncome))
-					}
-
-					messages = append(messages, fmt.Sprintf(
-						"?? %s 409A: $%s (FMV: $%s, Revenue: $%s/mo, %s)",
-						startup.Name,
-						formatCurrency(val409A),
-						formatCurrency(startup.Valuation),
-						formatCurrency(startup.MonthlyRevenue),
-						profitLossStr,
-					))
-					break
+			messages = append(messages, gs.EachSeat(func() []string {
+				if !gs.hasInvestmentIn(startup.Name) {
+					return nil
 				}
+				profitLossStr := ""
+				if startup.NetIncome >= 0 {
+					profitLossStr = fmt.Sprintf("Profit: $%s", formatCurrency(startup.NetIncome))
+				} else {
+					profitLossStr = fmt.Sprintf("Loss: $%s", formatCurrency(-startup.NetIncome))
+				}
+
+				return []string{fmt.Sprintf(
+					"?? %s 409A: $%s (FMV: $%s, Revenue: $%s/mo, %s)",
+					startup.Name,
+					formatCurrency(val409A),
+					formatCurrency(startup.Valuation),
+					formatCurrency(startup.MonthlyRevenue),
+					profitLossStr,
+				)}
+			})...)
+		}
+	}
+
+	// Update player investments based on company valuations, then move the clock
+	messages = append(messages, gs.EachSeat(gs.closeMonth)...)
+
+	// Process AI player turns
+	gs.ProcessAITurns()
+
+	gs.EachSeat(func() []string {
+		gs.RecordSnapshot()
+		return nil
+	})
+
+	// In hot-seat, market-wide news reaches every player's inbox
+	if gs.IsHotSeat() {
+		for i := range gs.Seats {
+			gs.Seats[i].Inbox = append(gs.Seats[i].Inbox, messages...)
+		}
+		return nil
+	}
+
+	return messages
+}
+
+// ProcessPortfolioMonth runs the player's side of the month once the market has
+// moved: value-add work, founder relationships and secondary offers
+func (gs *GameState) ProcessPortfolioMonth() []string {
+	messages := gs.ProcessActiveValueAddActions()
+
+	// Process relationships
+	for i := range gs.Portfolio.Investments {
+		inv := &gs.Portfolio.Investments[i]
+		if inv.FounderName != "" {
+			event := GenerateRelationshipEvent(gs.rng, inv, gs.Portfolio.Turn)
+			if event != nil {
+				inv.RelationshipScore = ApplyRelationshipChange(
+					inv.RelationshipScore,
+					event.ScoreChange)
+				messages = append(messages, event.Description)
 			}
 		}
 	}
 
-	// Update player investments based on company valuations
+	// Generate secondary offers
+	gs.SecondaryMarketOffers = append(gs.SecondaryMarketOffers, gs.GenerateSecondaryOffers()...)
+
+	// Process expirations
+	messages = append(messages, gs.ProcessSecondaryOfferExpirations()...)
+
+	return messages
+}
+
+// opportunityFundMessages announces portfolio companies that just hit the 3x threshold
+func (gs *GameState) opportunityFundMessages() []string {
+	messages := []string{}
+	newlyQualified := gs.CheckOpportunityFundQualification()
+	for _, company := range newlyQualified {
+		if !gs.Portfolio.OpportunityFundUnlocked || len(gs.Portfolio.OpportunityFundCompanies) == 1 {
+			// First unlock
+			messages = append(messages, fmt.Sprintf(
+				"🎯 Opportunity Fund unlocked! %s hit 3x growth — $%s available for breakout follow-ons.",
+				company, formatCurrency(gs.Portfolio.OpportunityFund)))
+		} else {
+			messages = append(messages, fmt.Sprintf(
+				"🎯 %s qualified for Opportunity Fund follow-on investment.",
+				company))
+		}
+	}
+	return messages
+}
+
+// closeMonth marks the fund's investments to the latest valuations and advances its clock
+func (gs *GameState) closeMonth() []string {
+	messages := []string{}
+
 	for i := range gs.Portfolio.Investments {
 		inv := &gs.Portfolio.Investments[i]
 
@@ -736,10 +911,6 @@ func (gs *GameState) ProcessTurn() []string {
 
 	gs.Portfolio.Turn++
 	gs.updateNetWorth()
-
-	// Process AI player turns
-	gs.ProcessAITurns()
-
 	return messages
 }
 
@@ -1224,7 +1395,7 @@ func (gs *GameState) generateNegativeNews(inv *Investment) string {
 	}
 
 	// Select random reason
-	reason := reasons[rand.Intn(len(reasons))]
+	reason := reasons[gs.rng.Intn(len(reasons))]
 
 	return fmt.Sprintf("?? %s: Valuation dropped below initial investment. %s", inv.CompanyName, reason)
 }
@@ -1,6 +1,8 @@
 package game
 
 import (
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -85,3 +87,426 @@ func TestGetFinalScore(t *testing.T) {
 	}
 }
 
+
+func TestStartupGeneratorIsSeeded(t *testing.T) {
+	a := NewStartupGenerator(42, MediumDifficulty).GenerateBatch(20)
+	b := NewStartupGenerator(42, MediumDifficulty).GenerateBatch(20)
+
+	names := make(map[string]bool)
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Valuation != b[i].Valuation || a[i].Founder.Name != b[i].Founder.Name {
+			t.Fatalf("Same seed should generate the same startup, got %s vs %s", a[i].Name, b[i].Name)
+		}
+		if names[a[i].Name] {
+			t.Errorf("Duplicate generated name %s", a[i].Name)
+		}
+		names[a[i].Name] = true
+	}
+
+	gs := &GameState{Difficulty: MediumDifficulty}
+	gen := NewStartupGenerator(7, MediumDifficulty)
+	top, bottom := gen.Generate(1), gen.Generate(3)
+	gs.initializeStartupMetrics(&top)
+	gs.initializeStartupMetrics(&bottom)
+	if top.GrowthPotential <= bottom.GrowthPotential {
+		t.Errorf("Tier 1 should outgrow tier 3: %.2f vs %.2f", top.GrowthPotential, bottom.GrowthPotential)
+	}
+}
+
+func TestSeededGameSetup(t *testing.T) {
+	a := NewGameWithSeed("Ada", "Ada Capital", MediumDifficulty, nil, nil, 42)
+	rand.Intn(100) // Other draws from the global source mustn't leak into setup
+	b := NewGameWithSeed("Grace", "Grace Ventures", MediumDifficulty, nil, nil, 42)
+
+	for i := range a.AvailableStartups {
+		if a.AvailableStartups[i].Name != b.AvailableStartups[i].Name || a.AvailableStartups[i].Valuation != b.AvailableStartups[i].Valuation {
+			t.Fatalf("Same seed should deal the same startups, got %s vs %s", a.AvailableStartups[i].Name, b.AvailableStartups[i].Name)
+		}
+	}
+	if len(a.AIPlayers) != len(b.AIPlayers) || a.AIPlayers[0].Name != b.AIPlayers[0].Name {
+		t.Error("Same seed should seat the same rivals")
+	}
+	if len(a.FundingRoundQueue) != len(b.FundingRoundQueue) || len(a.DramaticEventQueue) != len(b.DramaticEventQueue) {
+		t.Error("Same seed should schedule the same rounds and events")
+	}
+}
+
+func TestSeededGamesPlayOutTheSame(t *testing.T) {
+	play := func(name string) *GameState {
+		gs := NewGameWithSeed(name, name+" Capital", MediumDifficulty, nil, nil, 42)
+		for i := 0; i < 3; i++ {
+			if err := gs.MakeInvestment(i, 50000); err != nil {
+				t.Fatalf("MakeInvestment failed: %v", err)
+			}
+		}
+		gs.AIPlayerMakeInvestments()
+		for month := 0; month < 36; month++ {
+			gs.ProcessTurn()
+			gs.ProcessPortfolioMonth()
+			rand.Intn(100) // Draws from the global source mustn't change the game
+		}
+		return gs
+	}
+
+	a, b := play("Ada"), play("Grace")
+	if a.Portfolio.NetWorth != b.Portfolio.NetWorth {
+		t.Errorf("Same seed and moves should end at the same net worth, got %d and %d", a.Portfolio.NetWorth, b.Portfolio.NetWorth)
+	}
+	for i := range a.AIPlayers {
+		if a.AIPlayers[i].Portfolio.NetWorth != b.AIPlayers[i].Portfolio.NetWorth {
+			t.Errorf("%s ended at %d and %d", a.AIPlayers[i].Name, a.AIPlayers[i].Portfolio.NetWorth, b.AIPlayers[i].Portfolio.NetWorth)
+		}
+	}
+}
+
+func TestDDWorkstreamsRevealHiddenTraits(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	gs.AvailableStartups[0].Hidden = HiddenTraits{FounderIntegrity: 0.9, ReportedChurn: 0.02, RealChurn: 0.02, IPIssues: true}
+	terms := gs.GenerateTermOptions(&gs.AvailableStartups[0], 50000)[0]
+
+	if err := gs.StartDDWorkstreams(0, 50000, terms, []string{"technical_audit"}); err != nil {
+		t.Fatalf("StartDDWorkstreams failed: %v", err)
+	}
+	if err := gs.StartDDWorkstreams(1, 50000, terms, []string{"customer_calls"}); err != nil {
+		t.Fatalf("StartDDWorkstreams failed: %v", err)
+	}
+	gs.PendingDDDecisions[0].RoundCloseTurn = gs.Portfolio.Turn + 5
+	gs.PendingDDDecisions[1].RoundCloseTurn = gs.Portfolio.Turn + 1
+
+	gs.Portfolio.Turn += 2
+	gs.ProcessDueDiligence()
+
+	if len(gs.PendingDDDecisions) != 1 {
+		t.Fatalf("Expected the second round to close without us, have %d pending", len(gs.PendingDDDecisions))
+	}
+	d := gs.PendingDDDecisions[0]
+	if !d.IsComplete() || len(d.Findings) == 0 || d.Findings[0].Type != "red_flag" {
+		t.Errorf("Technical audit should have surfaced the IP dispute, got %+v", d.Findings)
+	}
+
+	if _, err := gs.ResolveDDDecision(0, true); err != nil {
+		t.Fatalf("ResolveDDDecision failed: %v", err)
+	}
+	if len(gs.Portfolio.Investments) != 1 || !gs.Portfolio.Investments[0].HasDueDiligence {
+		t.Error("Investing after diligence should add a diligenced investment")
+	}
+}
+
+func TestTermSheetNegotiation(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+
+	n, err := gs.OpenTermSheetNegotiation(0, 50000)
+	if err != nil {
+		t.Fatalf("OpenTermSheetNegotiation failed: %v", err)
+	}
+	n.CompetingOffers, n.Patience = 0, 4
+	gs.ProposeTermSheet(n, n.Proposal)
+	if n.Outcome != "accepted" {
+		t.Fatalf("Standard terms should be accepted, got %q", n.Outcome)
+	}
+	terms, err := gs.SignedTerms(n)
+	if err != nil || terms.PreMoneyValuation != n.AskingValuation {
+		t.Errorf("Signed terms should carry the negotiated valuation, got %+v (%v)", terms, err)
+	}
+
+	n, _ = gs.OpenTermSheetNegotiation(1, 50000)
+	n.CompetingOffers, n.Patience = 0, 4
+	squeeze := n.Proposal
+	squeeze.LiquidationPref = 2
+	squeeze.Participating = true
+	gs.ProposeTermSheet(n, squeeze)
+	if n.Counter == nil || n.Counter.Participating {
+		t.Fatalf("Participating 2x pref should draw a counter without participation, got outcome %q", n.Outcome)
+	}
+
+	predatory := n.Proposal
+	predatory.PreMoneyValuation = n.AskingValuation / 3
+	predatory.LiquidationPref = 3
+	gs.ProposeTermSheet(n, predatory)
+	if n.Outcome != "walked" {
+		t.Fatalf("Predatory terms should make the founder walk, got %q", n.Outcome)
+	}
+	if err := gs.MakeInvestment(1, 50000); err == nil {
+		t.Error("Should not be able to invest after the founder walked away")
+	}
+}
+
+func TestBoardGovernance(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	startup := gs.AvailableStartups[0]
+	amount := startup.Valuation / 5
+	if err := gs.MakeInvestmentWithTerms(0, amount, InvestmentTerms{Type: "Preferred Stock", HasBoardSeat: true, BoardSeatMultiplier: 1, LiquidationPref: 1}); err != nil {
+		t.Fatalf("MakeInvestmentWithTerms failed: %v", err)
+	}
+
+	board := gs.GetBoard(startup.Name)
+	if board.totalVotes()%2 == 0 {
+		t.Errorf("Board should have an odd number of votes, has %d", board.totalVotes())
+	}
+	if board.Directors[0].Role != "founder" {
+		t.Errorf("Founder should hold the first seat, got %s", board.Directors[0].Role)
+	}
+
+	hire := BoardVote{CompanyName: startup.Name, VoteType: "ceo_hire", Metadata: map[string]interface{}{}}
+	if lean := gs.directorSupport(board.Directors[0], hire); lean > 0.2 {
+		t.Errorf("Founder should oppose replacing themselves as CEO, lean %.2f", lean)
+	}
+
+	equity := gs.Portfolio.Investments[0].EquityPercent
+	pool := BoardVote{CompanyName: startup.Name, VoteType: "option_pool", Metadata: map[string]interface{}{"poolPercent": 0.10, "playerVotedForA": true}}
+	gs.ExecuteBoardVoteOutcome(pool, true)
+	if got := gs.Portfolio.Investments[0].EquityPercent; got >= equity {
+		t.Errorf("Option pool expansion should dilute the player, %.2f -> %.2f", equity, got)
+	}
+}
+
+func TestPortfolioConstructionAndMonteCarlo(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	if err := gs.MakeInvestment(0, gs.AvailableStartups[0].Valuation/5); err != nil {
+		t.Fatalf("MakeInvestment failed: %v", err)
+	}
+
+	if needs := gs.ProjectFollowOnNeeds(); len(needs) == 0 {
+		t.Error("Scheduled rounds at a portfolio company should project follow-on needs")
+	}
+
+	a := gs.SimulatePortfolio(500, rand.New(rand.NewSource(1)))
+	b := gs.SimulatePortfolio(500, rand.New(rand.NewSource(1)))
+	if a.P50 != b.P50 || a.Mean != b.Mean {
+		t.Error("Monte Carlo with the same seed should be reproducible")
+	}
+	if !(a.P10 <= a.P50 && a.P50 <= a.P90) {
+		t.Errorf("Percentiles out of order: %.2f %.2f %.2f", a.P10, a.P50, a.P90)
+	}
+
+	warnings := gs.PlanDivergence(a)
+	found := false
+	for _, w := range warnings {
+		if strings.Contains(w, "planned checks written") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("One check against the plan should warn about concentration, got %v", warnings)
+	}
+}
+
+func TestSecondaryOrderBook(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	if err := gs.MakeInvestment(0, 50000); err != nil {
+		t.Fatalf("MakeInvestment failed: %v", err)
+	}
+	company := gs.Portfolio.Investments[0].CompanyName
+	equity := gs.Portfolio.Investments[0].EquityPercent
+
+	// Buy a stake we missed from a rival's ask
+	missed := gs.AvailableStartups[1]
+	seller := gs.AIPlayers[0]
+	gs.postSecondaryOrder(SecondaryOrder{CompanyName: missed.Name, Side: "ask", PartyFirm: seller.Firm,
+		EquityPercent: 1, Price: 20000, ExpiresIn: 3})
+	if _, err := gs.BuySecondaryStake(gs.SecondaryOrderSeq); err != nil {
+		t.Fatalf("BuySecondaryStake failed: %v", err)
+	}
+	if gs.findInvestment(missed.Name) == nil {
+		t.Fatal("Buying an ask should add the company to the portfolio")
+	}
+
+	// Hitting a bid for half our stake pays the price less the secondary fee
+	cash := gs.Portfolio.Cash
+	gs.postSecondaryOrder(SecondaryOrder{CompanyName: company, Side: "bid", PartyFirm: seller.Firm,
+		EquityPercent: equity / 2, Price: 40000, ExpiresIn: 3})
+	if _, err := gs.SellToBid(gs.SecondaryOrderSeq); err != nil {
+		t.Fatalf("SellToBid failed: %v", err)
+	}
+	if got, want := gs.Portfolio.Cash-cash, int64(40000*(1-GetSecondaryMarketFee())); got != want {
+		t.Errorf("Expected $%d proceeds after fees, got $%d", want, got)
+	}
+	if inv := gs.findInvestment(company); inv == nil || inv.EquityPercent > equity/2+0.0001 {
+		t.Error("Selling into a bid should reduce the stake")
+	}
+
+	// Continuation vehicles only open near the end of the fund
+	if _, err := gs.QuoteContinuationVehicle(); err == nil {
+		t.Error("Continuation vehicle should not be available at the start of the fund")
+	}
+	gs.Portfolio.Turn = gs.Portfolio.MaxTurns - 6
+	gs.findInvestment(company).CurrentValuation *= 10
+	deal, err := gs.QuoteContinuationVehicle()
+	if err != nil || len(deal.Companies) == 0 || deal.Proceeds >= deal.Price {
+		t.Fatalf("Expected a continuation vehicle quote net of fees, got %+v (%v)", deal, err)
+	}
+}
+
+func TestCareerCapital(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	fundSize := gs.Portfolio.InitialFundSize
+
+	if _, err := gs.ApplyCareerCapital(CareerCapital{Path: "anchor", NetWorth: 50000, Commitment: 25000}); err == nil {
+		t.Error("Career paths should need a minimum commitment")
+	}
+
+	messages, err := gs.ApplyCareerCapital(CareerCapital{
+		Path: "anchor", NetWorth: 2000000, Commitment: 1000000, Network: []string{"Elad Gil", "Sequoia Capital"},
+	})
+	if err != nil {
+		t.Fatalf("ApplyCareerCapital failed: %v", err)
+	}
+	if gs.Portfolio.InitialFundSize != fundSize+1000000*(1+AnchorLPMatch) {
+		t.Errorf("Anchoring should grow the fund by the commitment plus the LP match, got $%d", gs.Portfolio.InitialFundSize)
+	}
+	intros := 0
+	for _, s := range gs.AvailableStartups {
+		if s.ReferredBy != "" {
+			intros++
+		}
+	}
+	if intros != 2 || len(messages) != 3 {
+		t.Errorf("Each network contact should make one warm intro, got %d intros", intros)
+	}
+
+	// Personal money tracks the fund's multiple
+	gs.Portfolio.NetWorth = gs.Portfolio.InitialFundSize * 2
+	if got := gs.CareerPersonalReturn(); got != 2000000 {
+		t.Errorf("A 2x fund should double the GP commitment, got $%d", got)
+	}
+
+	// Angels invest their own money with no LP-backed opportunity fund
+	angel := NewGame("TestPlayer", "TestPlayer Angel", MediumDifficulty, []string{})
+	if _, err := angel.ApplyCareerCapital(CareerCapital{Path: "angel", NetWorth: 400000, Commitment: 200000}); err != nil {
+		t.Fatalf("ApplyCareerCapital failed: %v", err)
+	}
+	if angel.Portfolio.Cash != 200000 || angel.Portfolio.OpportunityFund != 0 {
+		t.Errorf("Angel should have $200000 cash and no opportunity fund, got $%d and $%d", angel.Portfolio.Cash, angel.Portfolio.OpportunityFund)
+	}
+}
+
+func TestInvestmentRecords(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	if err := gs.MakeInvestment(0, 50000); err != nil {
+		t.Fatalf("MakeInvestment failed: %v", err)
+	}
+	inv := gs.Portfolio.Investments[0]
+
+	// Selling half on the secondary market realizes half the cost basis
+	gs.postSecondaryOrder(SecondaryOrder{CompanyName: inv.CompanyName, Side: "bid", PartyFirm: gs.AIPlayers[0].Firm,
+		EquityPercent: inv.EquityPercent / 2, Price: 40000, ExpiresIn: 3})
+	if _, err := gs.SellToBid(gs.SecondaryOrderSeq); err != nil {
+		t.Fatalf("SellToBid failed: %v", err)
+	}
+
+	records := gs.InvestmentRecords()
+	if len(records) != 2 {
+		t.Fatalf("Expected a realized and a held record, got %d", len(records))
+	}
+	sold, held := records[0], records[1]
+	if sold.Outcome != "secondary" || held.Outcome != "held" {
+		t.Errorf("Expected secondary then held outcomes, got %s and %s", sold.Outcome, held.Outcome)
+	}
+	if sold.AmountInvested+held.AmountInvested != inv.AmountInvested {
+		t.Errorf("Records should split the $%d cost basis, got $%d + $%d",
+			inv.AmountInvested, sold.AmountInvested, held.AmountInvested)
+	}
+	if sold.Category != inv.Category || sold.DDLevel == "" || sold.TermsType == "" {
+		t.Errorf("Record should carry sector, DD level and terms: %+v", sold)
+	}
+	if sold.ROI() <= 0 {
+		t.Errorf("Selling $25k of cost for $%d should show a gain, got %.1f%%", sold.ExitValue, sold.ROI())
+	}
+}
+
+func TestTurnSnapshots(t *testing.T) {
+	gs := NewGame("TestPlayer", "TestPlayer Capital", MediumDifficulty, []string{})
+	if err := gs.MakeInvestment(0, 50000); err != nil {
+		t.Fatalf("MakeInvestment failed: %v", err)
+	}
+	company := gs.Portfolio.Investments[0].CompanyName
+
+	for i := 0; i < 3; i++ {
+		gs.ProcessTurn()
+	}
+
+	// A baseline plus one snapshot per turn
+	if got := len(gs.NetWorthSeries()); got != 4 {
+		t.Fatalf("Expected 4 net worth points, got %d", got)
+	}
+	if last := gs.History[len(gs.History)-1]; last.NetWorth != gs.Portfolio.NetWorth {
+		t.Errorf("Last snapshot should match current net worth: %d vs %d", last.NetWorth, gs.Portfolio.NetWorth)
+	}
+	if got := len(gs.RivalSeries(gs.AIPlayers[0].Firm)); got != 4 {
+		t.Errorf("Expected rival series to cover every turn, got %d points", got)
+	}
+	if len(gs.ValuationSeries(company)) == 0 {
+		t.Error("Expected valuation history for a held company")
+	}
+	if _, ok := gs.HistorySeries()["valuation:"+company]; !ok {
+		t.Error("HistorySeries should include per-company valuations")
+	}
+}
+
+func TestHotSeatGame(t *testing.T) {
+	if _, err := NewHotSeatGame([]SeatSetup{{PlayerName: "Ada"}}, MediumDifficulty, 42); err == nil {
+		t.Error("A hot-seat game needs at least two players")
+	}
+	if _, err := NewHotSeatGame([]SeatSetup{{PlayerName: "Ada"}, {PlayerName: "ada"}}, MediumDifficulty, 42); err == nil {
+		t.Error("The same player can't take two seats")
+	}
+
+	gs, err := NewHotSeatGame([]SeatSetup{
+		{PlayerName: "Ada", Upgrades: []string{"fund_booster", "speed_mode"}},
+		{PlayerName: "Grace"},
+	}, MediumDifficulty, 42)
+	if err != nil {
+		t.Fatalf("NewHotSeatGame failed: %v", err)
+	}
+	if gs.PlayerName != "Ada" || gs.Portfolio.MaxTurns != MediumDifficulty.MaxTurns {
+		t.Errorf("Ada should start at the keyboard on the shared clock, got %s with %d turns", gs.PlayerName, gs.Portfolio.MaxTurns)
+	}
+
+	valuation := gs.AvailableStartups[0].Valuation
+	company := gs.AvailableStartups[0].Name
+	board := InvestmentTerms{Type: "Preferred Stock", HasBoardSeat: true}
+	if err := gs.MakeInvestmentWithTerms(0, valuation*15/100, board); err != nil {
+		t.Fatalf("Ada's investment failed: %v", err)
+	}
+
+	// Grace is bidding for what's left of the same round
+	gs.SwitchSeat(1)
+	if len(gs.Portfolio.Investments) != 0 {
+		t.Fatal("Grace shouldn't see Ada's portfolio")
+	}
+	if err := gs.MakeInvestmentWithTerms(0, valuation*10/100, InvestmentTerms{Type: "Preferred Stock"}); err == nil {
+		t.Error("Only 5% of the round is left, so a 10% check should be refused")
+	}
+	if err := gs.MakeInvestmentWithTerms(0, valuation*45/1000, board); err == nil {
+		t.Error("Ada already holds the board seat")
+	}
+	if err := gs.MakeInvestmentWithTerms(0, valuation*45/1000, InvestmentTerms{Type: "Preferred Stock"}); err != nil {
+		t.Errorf("Grace should get the rest of the round: %v", err)
+	}
+	if !gs.HasAnyBoardSeat(company) || gs.HasBoardSeat(company) {
+		t.Error("The board seat belongs to Ada, not Grace")
+	}
+
+	// The month moves on for everyone at once, with each player's news kept apart
+	if messages := gs.ProcessTurn(); len(messages) != 0 {
+		t.Errorf("Hot-seat news should go to inboxes, got %v", messages)
+	}
+	if gs.PlayerName != "Grace" {
+		t.Errorf("ProcessTurn should leave Grace at the keyboard, got %s", gs.PlayerName)
+	}
+	for _, seat := range gs.otherSeats() {
+		if seat.Portfolio.Turn != gs.Portfolio.Turn || len(seat.History) != len(gs.History) {
+			t.Errorf("%s is out of step with Grace", seat.PlayerName)
+		}
+	}
+
+	humans := 0
+	for _, entry := range gs.GetLeaderboard() {
+		if entry.IsHuman {
+			humans++
+		}
+	}
+	if humans != 2 {
+		t.Errorf("Expected both players on the leaderboard, got %d", humans)
+	}
+}
@@ -0,0 +1,231 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxSeats is the most players a hot-seat game can take
+const MaxSeats = 6
+
+// hotSeatRoundEquity is the share of each round the human funds compete for
+const hotSeatRoundEquity = 20.0
+
+// Seat is one human player's side of a hot-seat game. The player at the keyboard
+// lives in GameState's own fields; everyone else waits here until SwitchSeat.
+type Seat struct {
+	PlayerName              string
+	PlayerFirmName          string
+	Portfolio               Portfolio
+	PlayerUpgrades          []string
+	InsuranceUsed           bool
+	ProtectedCompany        string
+	PendingBoardVotes       []BoardVote
+	PlayerReputation        *VCReputation
+	ActiveValueAddActions   []ValueAddAction
+	PendingDDDecisions      []DDDecision
+	SecondaryMarketOffers   []SecondaryOffer
+	TermSheetWalkaways      []string
+	Boards                  []CompanyBoard
+	PortfolioPlan           PortfolioPlan
+	ContinuationVehicleDone bool
+	Career                  *CareerCapital
+	ExitedInvestments       []InvestmentRecord
+	History                 []TurnSnapshot
+
+	Inbox []string // News for this player, read when they next take the keyboard
+}
+
+// SeatSetup is what a player brings to a hot-seat game
+type SeatSetup struct {
+	PlayerName string
+	FirmName   string
+	Upgrades   []string
+}
+
+// NewHotSeatGame starts a game where 2-6 people share the same deals, market and
+// rivals, each running their own fund
+func NewHotSeatGame(players []SeatSetup, difficulty Difficulty, seed int64) (*GameState, error) {
+	if len(players) < 2 || len(players) > MaxSeats {
+		return nil, fmt.Errorf("hot-seat games take 2 to %d players", MaxSeats)
+	}
+	seen := map[string]bool{}
+	for _, p := range players {
+		key := strings.ToLower(strings.TrimSpace(p.PlayerName))
+		if key == "" {
+			return nil, fmt.Errorf("every player needs a name")
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%s is playing twice", p.PlayerName)
+		}
+		seen[key] = true
+	}
+
+	// Deal flow is shared, so nobody's upgrades or reputation get to shape it
+	gs := NewGameWithSeed(players[0].PlayerName, players[0].FirmName, difficulty, nil, nil, seed)
+
+	for _, p := range players {
+		upgrades := seatUpgrades(p.Upgrades)
+		firm := p.FirmName
+		if firm == "" {
+			firm = GenerateDefaultFirmName(p.PlayerName)
+		}
+		gs.Seats = append(gs.Seats, Seat{
+			PlayerName:     strings.TrimSpace(p.PlayerName),
+			PlayerFirmName: firm,
+			Portfolio:      newPortfolio(difficulty, upgrades),
+			PlayerUpgrades: upgrades,
+		})
+	}
+	gs.loadSeat(0)
+	return gs, nil
+}
+
+// seatUpgrades drops upgrades that change the game's length; everyone shares one clock
+func seatUpgrades(upgrades []string) []string {
+	kept := []string{}
+	for _, id := range upgrades {
+		if id != "speed_mode" && id != "endurance_mode" {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+// IsHotSeat reports whether several people are sharing this game
+func (gs *GameState) IsHotSeat() bool {
+	return len(gs.Seats) > 1
+}
+
+// SwitchSeat hands the game to another hot-seat player
+func (gs *GameState) SwitchSeat(i int) {
+	if !gs.IsHotSeat() || i < 0 || i >= len(gs.Seats) || i == gs.ActiveSeat {
+		return
+	}
+	gs.saveSeat()
+	gs.loadSeat(i)
+}
+
+// saveSeat parks the player at the keyboard back in their seat
+func (gs *GameState) saveSeat() {
+	seat := &gs.Seats[gs.ActiveSeat]
+	seat.PlayerName = gs.PlayerName
+	seat.PlayerFirmName = gs.PlayerFirmName
+	seat.Portfolio = gs.Portfolio
+	seat.PlayerUpgrades = gs.PlayerUpgrades
+	seat.InsuranceUsed = gs.InsuranceUsed
+	seat.ProtectedCompany = gs.ProtectedCompany
+	seat.PendingBoardVotes = gs.PendingBoardVotes
+	seat.PlayerReputation = gs.PlayerReputation
+	seat.ActiveValueAddActions = gs.ActiveValueAddActions
+	seat.PendingDDDecisions = gs.PendingDDDecisions
+	seat.SecondaryMarketOffers = gs.SecondaryMarketOffers
+	seat.TermSheetWalkaways = gs.TermSheetWalkaways
+	seat.Boards = gs.Boards
+	seat.PortfolioPlan = gs.PortfolioPlan
+	seat.ContinuationVehicleDone = gs.ContinuationVehicleDone
+	seat.Career = gs.Career
+	seat.ExitedInvestments = gs.ExitedInvestments
+	seat.History = gs.History
+}
+
+// loadSeat puts seat i's player at the keyboard
+func (gs *GameState) loadSeat(i int) {
+	seat := gs.Seats[i]
+	gs.ActiveSeat = i
+	gs.PlayerName = seat.PlayerName
+	gs.PlayerFirmName = seat.PlayerFirmName
+	gs.Portfolio = seat.Portfolio
+	gs.PlayerUpgrades = seat.PlayerUpgrades
+	gs.InsuranceUsed = seat.InsuranceUsed
+	gs.ProtectedCompany = seat.ProtectedCompany
+	gs.PendingBoardVotes = seat.PendingBoardVotes
+	gs.PlayerReputation = seat.PlayerReputation
+	gs.ActiveValueAddActions = seat.ActiveValueAddActions
+	gs.PendingDDDecisions = seat.PendingDDDecisions
+	gs.SecondaryMarketOffers = seat.SecondaryMarketOffers
+	gs.TermSheetWalkaways = seat.TermSheetWalkaways
+	gs.Boards = seat.Boards
+	gs.PortfolioPlan = seat.PortfolioPlan
+	gs.ContinuationVehicleDone = seat.ContinuationVehicleDone
+	gs.Career = seat.Career
+	gs.ExitedInvestments = seat.ExitedInvestments
+	gs.History = seat.History
+}
+
+// EachSeat runs fn for every player's fund. A single-player game just returns fn's
+// messages; in hot-seat each player's messages go to their own inbox so nobody
+// reads anyone else's news.
+func (gs *GameState) EachSeat(fn func() []string) []string {
+	if !gs.IsHotSeat() {
+		return fn()
+	}
+	active := gs.ActiveSeat
+	for i := range gs.Seats {
+		gs.SwitchSeat(i)
+		gs.Seats[i].Inbox = append(gs.Seats[i].Inbox, fn()...)
+	}
+	gs.SwitchSeat(active)
+	return nil
+}
+
+// asSeat runs fn for the player called name, routing messages like EachSeat
+func (gs *GameState) asSeat(name string, fn func() []string) []string {
+	if !gs.IsHotSeat() {
+		return fn()
+	}
+	active := gs.ActiveSeat
+	for i := range gs.Seats {
+		if gs.Seats[i].PlayerName == name {
+			gs.SwitchSeat(i)
+			gs.Seats[i].Inbox = append(gs.Seats[i].Inbox, fn()...)
+		}
+	}
+	gs.SwitchSeat(active)
+	return nil
+}
+
+// TakeInbox returns and clears the news waiting for the player at the keyboard
+func (gs *GameState) TakeInbox() []string {
+	if !gs.IsHotSeat() {
+		return nil
+	}
+	messages := gs.Seats[gs.ActiveSeat].Inbox
+	gs.Seats[gs.ActiveSeat].Inbox = nil
+	return messages
+}
+
+// otherSeats is every hot-seat player except the one at the keyboard
+func (gs *GameState) otherSeats() []*Seat {
+	others := []*Seat{}
+	for i := range gs.Seats {
+		if i != gs.ActiveSeat {
+			others = append(others, &gs.Seats[i])
+		}
+	}
+	return others
+}
+
+// checkSeatCompetition stops human funds from buying more of a round than exists
+// or sharing a board: the first player to a deal gets first pick
+func (gs *GameState) checkSeatCompetition(companyName string, equityPercent float64, terms InvestmentTerms) error {
+	taken := 0.0
+	for _, seat := range gs.otherSeats() {
+		for _, inv := range seat.Portfolio.Investments {
+			if inv.CompanyName != companyName {
+				continue
+			}
+			taken += inv.InitialEquity
+			if terms.HasBoardSeat && inv.Terms.HasBoardSeat {
+				return fmt.Errorf("%s already holds the investor board seat at %s", seat.PlayerFirmName, companyName)
+			}
+		}
+	}
+	if left := hotSeatRoundEquity - taken; equityPercent > left+0.001 {
+		if left <= 0 {
+			return fmt.Errorf("%s's round is fully allocated", companyName)
+		}
+		return fmt.Errorf("only %.1f%% of %s's round is left", left, companyName)
+	}
+	return nil
+}
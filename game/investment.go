@@ -2,7 +2,6 @@ package game
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 
@@ -146,6 +145,10 @@ func (gs *GameState) MakeInvestmentWithTerms(startupIndex int, amount int64, ter
 		}
 	}
 
+	if gs.founderWalkedAway(startup.Name) {
+		return fmt.Errorf("%s's founder walked away from your term sheet", startup.Name)
+	}
+
 	// Cap on the number of first-check investments this fund can make.
 	// Follow-ons and syndicates are NOT subject to this cap — only new bets.
 	// This mirrors real fund strategy of reserve > initial deployment.
@@ -176,7 +179,12 @@ func (gs *GameState) MakeInvestmentWithTerms(startupIndex int, amount int64, ter
 
 	// Calculate equity percentage based on investment amount and company valuation
 	// Only 20% of company is available for investment in this round
-	equityPercent := (float64(amount) / float64(startup.Valuation)) * 100.0
+	// A negotiated term sheet prices the round at its own pre-money
+	priceValuation := startup.Valuation
+	if terms.PreMoneyValuation > 0 {
+		priceValuation = terms.PreMoneyValuation
+	}
+	equityPercent := (float64(amount) / float64(priceValuation)) * 100.0
 
 	// Apply Seed Accelerator upgrade - first investment gets 25% equity bonus
 	isFirstInvestment := len(gs.Portfolio.Investments) == 0
@@ -217,6 +225,11 @@ func (gs *GameState) MakeInvestmentWithTerms(startupIndex int, amount int64, ter
 		equityPercent = maxEquityPercent
 	}
 
+	// Hot-seat players compete for the same round and the same board seat
+	if err := gs.checkSeatCompetition(startup.Name, equityPercent, terms); err != nil {
+		return err
+	}
+
 	investment := Investment{
 		CompanyName:      startup.Name,
 		AmountInvested:   amount,
@@ -242,6 +255,9 @@ func (gs *GameState) MakeInvestmentWithTerms(startupIndex int, amount int64, ter
 
 func (gs *GameState) GetFollowOnOpportunities() []FollowOnOpportunity {
 	opportunities := []FollowOnOpportunity{}
+	if gs.Modifier == ModifierNoFollowOns {
+		return opportunities
+	}
 
 	for _, event := range gs.FundingRoundQueue {
 		if event.ScheduledTurn == gs.Portfolio.Turn {
@@ -383,6 +399,9 @@ func (gs *GameState) MakeFollowOnInvestment(companyName string, amount int64) er
 	if amount <= 0 {
 		return fmt.Errorf("investment amount must be positive")
 	}
+	if gs.Modifier == ModifierNoFollowOns {
+		return fmt.Errorf("no follow-on investments in this challenge")
+	}
 
 	// Calculate total available capital: cash + follow-on reserve + opp fund (if qualified)
 	availableCash := gs.Portfolio.Cash + gs.Portfolio.FollowOnReserve
@@ -545,7 +564,7 @@ func (gs *GameState) GenerateSyndicateOpportunities(playerLevel int) {
 	}
 	
 	// Generate 2-4 syndicate opportunities from available startups
-	numOpportunities := 2 + rand.Intn(3) // 2-4 opportunities
+	numOpportunities := 2 + gs.rng.Intn(3) // 2-4 opportunities
 	
 	// Select random startups that aren't already in player's portfolio
 	availableForSyndicate := []int{}
@@ -565,7 +584,7 @@ func (gs *GameState) GenerateSyndicateOpportunities(playerLevel int) {
 	
 	// Shuffle and take first N
 	if len(availableForSyndicate) > numOpportunities {
-		rand.Shuffle(len(availableForSyndicate), func(i, j int) {
+		gs.rng.Shuffle(len(availableForSyndicate), func(i, j int) {
 			availableForSyndicate[i], availableForSyndicate[j] = availableForSyndicate[j], availableForSyndicate[i]
 		})
 		availableForSyndicate = availableForSyndicate[:numOpportunities]
@@ -576,15 +595,15 @@ func (gs *GameState) GenerateSyndicateOpportunities(playerLevel int) {
 		startup := gs.AvailableStartups[startupIdx]
 		
 		// Pick a random AI investor to lead
-		leadInvestorIdx := rand.Intn(len(gs.AIPlayers))
+		leadInvestorIdx := gs.rng.Intn(len(gs.AIPlayers))
 		leadInvestor := gs.AIPlayers[leadInvestorIdx]
 		
 		// Calculate round size (typically 1.5-3x company valuation for seed rounds)
-		roundMultiplier := 1.5 + rand.Float64()*1.5 // 1.5x to 3x
+		roundMultiplier := 1.5 + gs.rng.Float64()*1.5 // 1.5x to 3x
 		totalRoundSize := int64(float64(startup.Valuation) * roundMultiplier)
 		
 		// Player can invest 20-40% of the round
-		playerSharePercent := 0.20 + rand.Float64()*0.20 // 20-40%
+		playerSharePercent := 0.20 + gs.rng.Float64()*0.20 // 20-40%
 		yourMaxShare := int64(float64(totalRoundSize) * playerSharePercent)
 		yourMinShare := int64(25000) // $25k minimum
 		
@@ -615,7 +634,7 @@ func (gs *GameState) GenerateSyndicateOpportunities(playerLevel int) {
 			fmt.Sprintf("Co-investment opportunity with %s on %s", leadInvestor.Firm, startup.Name),
 			fmt.Sprintf("%s invites you to join their deal on %s", leadInvestor.Name, startup.Name),
 		}
-		description := descriptions[rand.Intn(len(descriptions))]
+		description := descriptions[gs.rng.Intn(len(descriptions))]
 		
 		opportunity := SyndicateOpportunity{
 			CompanyName:      startup.Name,
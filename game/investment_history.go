@@ -0,0 +1,69 @@
+package game
+
+// InvestmentRecord is the outcome of one position, kept for post-game analytics
+type InvestmentRecord struct {
+	CompanyName       string
+	Category          string
+	TermsType         string
+	DDLevel           string
+	Outcome           string // "acquired", "secondary", "continuation", "held", "written_off"
+	AmountInvested    int64
+	EntryValuation    int64
+	ExitValuation     int64
+	ExitValue         int64 // What the fund received (or the stake is worth if still held)
+	MonthsHeld        int
+	RelationshipScore float64
+}
+
+// ROI is the record's return as a percentage of the amount invested
+func (r InvestmentRecord) ROI() float64 {
+	if r.AmountInvested <= 0 {
+		return 0
+	}
+	return float64(r.ExitValue-r.AmountInvested) / float64(r.AmountInvested) * 100
+}
+
+// recordExit logs a realized position before it leaves (or shrinks in) the portfolio.
+// amount is the slice of the cost basis that was sold.
+func (gs *GameState) recordExit(inv Investment, amount, proceeds int64, outcome string) {
+	gs.ExitedInvestments = append(gs.ExitedInvestments, newInvestmentRecord(inv, amount, proceeds, outcome))
+}
+
+func newInvestmentRecord(inv Investment, amount, value int64, outcome string) InvestmentRecord {
+	ddLevel := inv.DDLevel
+	if ddLevel == "" {
+		ddLevel = "none"
+	}
+	termsType := inv.Terms.Type
+	if termsType == "" {
+		termsType = "Common"
+	}
+	return InvestmentRecord{
+		CompanyName:       inv.CompanyName,
+		Category:          inv.Category,
+		TermsType:         termsType,
+		DDLevel:           ddLevel,
+		Outcome:           outcome,
+		AmountInvested:    amount,
+		EntryValuation:    inv.InitialValuation,
+		ExitValuation:     inv.CurrentValuation,
+		ExitValue:         value,
+		MonthsHeld:        inv.MonthsHeld,
+		RelationshipScore: inv.RelationshipScore,
+	}
+}
+
+// InvestmentRecords returns every position of the game: realized exits plus what's
+// still held, marked at its current valuation
+func (gs *GameState) InvestmentRecords() []InvestmentRecord {
+	records := append([]InvestmentRecord{}, gs.ExitedInvestments...)
+	for _, inv := range gs.Portfolio.Investments {
+		value := int64(float64(inv.CurrentValuation) * inv.EquityPercent / 100)
+		outcome := "held"
+		if inv.CurrentValuation <= 0 {
+			outcome = "written_off"
+		}
+		records = append(records, newInvestmentRecord(inv, inv.AmountInvested, value, outcome))
+	}
+	return records
+}
@@ -88,18 +88,18 @@ func InitializeMarketCycle(difficulty string) *MarketCycle {
 }
 
 // AdvanceMarketCycle potentially changes the market cycle
-func AdvanceMarketCycle(currentCycle *MarketCycle, turn int) *MarketCycle {
+func AdvanceMarketCycle(rng *rand.Rand, currentCycle *MarketCycle, turn int) *MarketCycle {
 	// Check if current cycle has ended
 	if turn < currentCycle.StartTurn+currentCycle.Duration {
 		return currentCycle // Still in current cycle
 	}
 	
 	// Generate new cycle
-	return generateNextCycle(currentCycle, turn)
+	return generateNextCycle(rng, currentCycle, turn)
 }
 
 // generateNextCycle creates the next market cycle
-func generateNextCycle(previousCycle *MarketCycle, turn int) *MarketCycle {
+func generateNextCycle(rng *rand.Rand, previousCycle *MarketCycle, turn int) *MarketCycle {
 	// Cycles tend to revert to normal, with some randomness
 	cycles := []struct {
 		name       string
@@ -119,7 +119,7 @@ func generateNextCycle(previousCycle *MarketCycle, turn int) *MarketCycle {
 	}
 	
 	// Adjust probabilities based on previous cycle (reversion to mean)
-	roll := rand.Float64()
+	roll := rng.Float64()
 	cumProb := 0.0
 	
 	// If coming from extreme, more likely to normalize
@@ -169,9 +169,9 @@ func generateNextCycle(previousCycle *MarketCycle, turn int) *MarketCycle {
 }
 
 // GenerateEconomicEvent creates a random economic event
-func GenerateEconomicEvent(turn int) *EconomicEvent {
+func GenerateEconomicEvent(rng *rand.Rand, turn int) *EconomicEvent {
 	// 15% chance per turn to generate an event
-	if rand.Float64() > 0.15 {
+	if rng.Float64() > 0.15 {
 		return nil
 	}
 	
@@ -291,7 +291,7 @@ func GenerateEconomicEvent(turn int) *EconomicEvent {
 	}
 	
 	// Select event based on probabilities
-	roll := rand.Float64()
+	roll := rng.Float64()
 	cumProb := 0.0
 	
 	for _, evt := range events {
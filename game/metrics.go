@@ -1,13 +1,8 @@
 package game
 
-import (
-	"math/rand"
-)
-
-
 func (gs *GameState) UpdateCompanyFinancials(startup *Startup) {
 	// Apply growth rate to revenue (with some randomness)
-	growthVariance := (rand.Float64()*0.4 - 0.2) // -20% to +20% variance
+	growthVariance := (gs.rng.Float64()*0.4 - 0.2) * gs.volatilityScale() // -20% to +20% variance
 	actualGrowth := startup.RevenueGrowthRate + growthVariance
 
 	// Update revenue based on growth
@@ -65,7 +60,7 @@ func (gs *GameState) UpdateCompanyFinancials(startup *Startup) {
 	newValuation := int64(float64(annualRevenue) * revenueMultiple)
 
 	// Smooth valuation changes (max 20% per month)
-	maxChange := float64(startup.Valuation) * 0.20
+	maxChange := float64(startup.Valuation) * 0.20 * gs.volatilityScale()
 	valuationChange := newValuation - startup.Valuation
 	if valuationChange > int64(maxChange) {
 		newValuation = startup.Valuation + int64(maxChange)
@@ -122,8 +117,22 @@ func (gs *GameState) GetLeaderboard() []PlayerScore {
 		NetWorth: gs.Portfolio.NetWorth,
 		ROI:      playerROI,
 		IsPlayer: true,
+		IsHuman:  true,
 	})
 
+	// Add the other hot-seat players - same calculation
+	for _, seat := range gs.otherSeats() {
+		seatCapital := seat.Portfolio.InitialFundSize + seat.Portfolio.FollowOnReserve
+		seatROI := ((float64(seat.Portfolio.NetWorth) - float64(seatCapital)) / float64(seatCapital)) * 100.0
+		scores = append(scores, PlayerScore{
+			Name:     seat.PlayerName,
+			Firm:     seat.PlayerFirmName,
+			NetWorth: seat.Portfolio.NetWorth,
+			ROI:      seatROI,
+			IsHuman:  true,
+		})
+	}
+
 	// Add AI players - same calculation
 	for _, ai := range gs.AIPlayers {
 		aiTotalCapital := ai.Portfolio.InitialFundSize + ai.Portfolio.FollowOnReserve
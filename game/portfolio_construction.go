@@ -0,0 +1,267 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// PortfolioPlan is the fund's construction plan: how many checks, how big, and what to hold back
+type PortfolioPlan struct {
+	TargetChecks int
+	ReserveRatio float64          // Share of total capital held back for follow-ons
+	CheckByStage map[string]int64 // Planned initial check per stage
+	MaxPerSector int              // Concentration limit per sector
+	Customized   bool             // Player has edited the default plan
+}
+
+// FollowOnNeed is a projected follow-on for a portfolio company
+type FollowOnNeed struct {
+	CompanyName   string
+	RoundName     string
+	Turn          int
+	RaiseAmount   int64
+	ProRataAmount int64 // What it costs to hold our ownership
+}
+
+// MonteCarloResult summarizes simulated outcomes for the current portfolio
+type MonteCarloResult struct {
+	Runs          int
+	Multiples     []float64 // Sorted fund multiples (TVPI), one per run
+	P10           float64
+	P50           float64
+	P90           float64
+	Mean          float64
+	ProbLoss      float64 // Share of runs returning less than the fund
+	ProbTripled   float64 // Share of runs returning 3x or better
+	ExpectedValue int64   // Mean ending portfolio value
+}
+
+// totalCapital is everything the fund can deploy
+func (gs *GameState) totalCapital() int64 {
+	return gs.Portfolio.InitialFundSize + gs.Portfolio.FollowOnReserve
+}
+
+// DefaultPortfolioPlan builds a plan from the fund's size and difficulty caps
+func (gs *GameState) DefaultPortfolioPlan() PortfolioPlan {
+	target := gs.Difficulty.MaxInitialInvestments
+	if target <= 0 {
+		target = 15
+	}
+	reserveRatio := 0.5
+	initialCapital := float64(gs.totalCapital()) * (1 - reserveRatio)
+	avgCheck := int64(initialCapital / float64(target))
+
+	return PortfolioPlan{
+		TargetChecks: target,
+		ReserveRatio: reserveRatio,
+		CheckByStage: map[string]int64{
+			"Pre-Seed": avgCheck * 3 / 4,
+			"Seed":     avgCheck * 5 / 4,
+		},
+		MaxPerSector: int(math.Max(2, math.Ceil(float64(target)/4))),
+	}
+}
+
+// GetPortfolioPlan returns the player's plan, creating the default one on first use
+func (gs *GameState) GetPortfolioPlan() *PortfolioPlan {
+	if gs.PortfolioPlan.TargetChecks == 0 {
+		gs.PortfolioPlan = gs.DefaultPortfolioPlan()
+	}
+	return &gs.PortfolioPlan
+}
+
+// AdjustPlan changes the target check count and reserve ratio, resizing planned checks to fit
+func (gs *GameState) AdjustPlan(checkDelta int, reserveDelta float64) {
+	plan := gs.GetPortfolioPlan()
+	plan.TargetChecks += checkDelta
+	if plan.TargetChecks < 1 {
+		plan.TargetChecks = 1
+	}
+	plan.ReserveRatio = math.Max(0, math.Min(0.8, plan.ReserveRatio+reserveDelta))
+
+	avgCheck := int64(float64(gs.totalCapital()) * (1 - plan.ReserveRatio) / float64(plan.TargetChecks))
+	plan.CheckByStage["Pre-Seed"] = avgCheck * 3 / 4
+	plan.CheckByStage["Seed"] = avgCheck * 5 / 4
+	plan.MaxPerSector = int(math.Max(2, math.Ceil(float64(plan.TargetChecks)/4)))
+	plan.Customized = true
+}
+
+// PlannedCheckSize is the plan's initial check for a stage
+func (gs *GameState) PlannedCheckSize(stage string) int64 {
+	plan := gs.GetPortfolioPlan()
+	if check, ok := plan.CheckByStage[stage]; ok {
+		return check
+	}
+	return plan.CheckByStage["Pre-Seed"]
+}
+
+// ProjectFollowOnNeeds lists upcoming rounds at portfolio companies and the pro-rata cost of each
+func (gs *GameState) ProjectFollowOnNeeds() []FollowOnNeed {
+	needs := []FollowOnNeed{}
+	for _, round := range gs.FundingRoundQueue {
+		if round.ScheduledTurn <= gs.Portfolio.Turn {
+			continue
+		}
+		for _, inv := range gs.Portfolio.Investments {
+			if inv.CompanyName != round.CompanyName {
+				continue
+			}
+			need := FollowOnNeed{
+				CompanyName: round.CompanyName,
+				RoundName:   round.RoundName,
+				Turn:        round.ScheduledTurn,
+				RaiseAmount: round.RaiseAmount,
+			}
+			if inv.Terms.HasProRataRights {
+				need.ProRataAmount = int64(float64(round.RaiseAmount) * inv.EquityPercent / 100)
+			}
+			needs = append(needs, need)
+		}
+	}
+	sort.Slice(needs, func(i, j int) bool { return needs[i].Turn < needs[j].Turn })
+	return needs
+}
+
+// SimulatePortfolio runs a Monte Carlo over the current portfolio using PredictROI's
+// range for each company and its risk score as the chance of a write-off
+func (gs *GameState) SimulatePortfolio(runs int, rng *rand.Rand) MonteCarloResult {
+	result := MonteCarloResult{Runs: runs}
+	capital := float64(gs.totalCapital())
+	if runs <= 0 || capital <= 0 {
+		return result
+	}
+
+	type position struct {
+		invested   float64
+		worst      float64
+		projected  float64
+		best       float64
+		failChance float64
+	}
+	positions := []position{}
+	for _, inv := range gs.Portfolio.Investments {
+		proj := gs.PredictROI(inv)
+		failChance := 0.3
+		for _, s := range gs.AvailableStartups {
+			if s.Name == inv.CompanyName {
+				failChance = s.RiskScore * 0.6
+			}
+		}
+		positions = append(positions, position{
+			invested:   float64(inv.AmountInvested),
+			worst:      math.Max(0, 1+proj.WorstCaseROI/100),
+			projected:  math.Max(0, 1+proj.ProjectedROI/100),
+			best:       math.Max(0, 1+proj.BestCaseROI/100),
+			failChance: failChance,
+		})
+	}
+
+	uninvested := float64(gs.Portfolio.Cash + gs.Portfolio.FollowOnReserve)
+	var totalValue float64
+	for r := 0; r < runs; r++ {
+		value := uninvested
+		for _, p := range positions {
+			if rng.Float64() < p.failChance {
+				continue // Written off
+			}
+			value += p.invested * triangular(rng, p.worst, p.projected, p.best)
+		}
+		multiple := value / capital
+		result.Multiples = append(result.Multiples, multiple)
+		totalValue += value
+		if multiple < 1 {
+			result.ProbLoss++
+		}
+		if multiple >= 3 {
+			result.ProbTripled++
+		}
+	}
+
+	sort.Float64s(result.Multiples)
+	result.P10 = result.Multiples[runs/10]
+	result.P50 = result.Multiples[runs/2]
+	result.P90 = result.Multiples[runs*9/10]
+	result.Mean = totalValue / float64(runs) / capital
+	result.ExpectedValue = int64(totalValue / float64(runs))
+	result.ProbLoss /= float64(runs)
+	result.ProbTripled /= float64(runs)
+	return result
+}
+
+// triangular samples a triangular distribution between low and high peaking at mode
+func triangular(rng *rand.Rand, low, mode, high float64) float64 {
+	if high <= low {
+		return mode
+	}
+	mode = math.Max(low, math.Min(high, mode))
+	u := rng.Float64()
+	cut := (mode - low) / (high - low)
+	if u < cut {
+		return low + math.Sqrt(u*(high-low)*(mode-low))
+	}
+	return high - math.Sqrt((1-u)*(high-low)*(high-mode))
+}
+
+// PlanDivergence warns where the portfolio has drifted from the construction plan
+func (gs *GameState) PlanDivergence(sim MonteCarloResult) []string {
+	plan := gs.GetPortfolioPlan()
+	warnings := []string{}
+	investments := gs.Portfolio.Investments
+
+	if len(investments) < plan.TargetChecks/2 {
+		warnings = append(warnings, fmt.Sprintf("📉 Only %d of %d planned checks written - the portfolio is too concentrated to absorb failures",
+			len(investments), plan.TargetChecks))
+	}
+
+	// Reserves vs. projected pro-rata needs
+	var needed int64
+	for _, need := range gs.ProjectFollowOnNeeds() {
+		needed += need.ProRataAmount
+	}
+	plannedReserves := int64(float64(gs.totalCapital()) * plan.ReserveRatio)
+	available := gs.Portfolio.Cash + gs.Portfolio.FollowOnReserve
+	if needed > available {
+		warnings = append(warnings, fmt.Sprintf("⚠️  Projected pro-rata needs ($%s) exceed available capital ($%s)",
+			formatCurrency(needed), formatCurrency(available)))
+	} else if available < plannedReserves/2 && len(investments) > 0 {
+		warnings = append(warnings, fmt.Sprintf("⚠️  Reserves are at $%s vs $%s planned - you've deployed reserves as first checks",
+			formatCurrency(available), formatCurrency(plannedReserves)))
+	}
+
+	// Check sizes vs. plan
+	if len(investments) > 0 {
+		var total int64
+		for _, inv := range investments {
+			total += inv.AmountInvested
+		}
+		avg := total / int64(len(investments))
+		planned := (plan.CheckByStage["Pre-Seed"] + plan.CheckByStage["Seed"]) / 2
+		if planned > 0 && (avg > planned*3/2 || avg < planned/2) {
+			warnings = append(warnings, fmt.Sprintf("📏 Average check is $%s vs $%s planned", formatCurrency(avg), formatCurrency(planned)))
+		}
+	}
+
+	// Sector concentration
+	sectors := map[string]int{}
+	for _, inv := range investments {
+		sectors[inv.Category]++
+	}
+	sectorNames := make([]string, 0, len(sectors))
+	for sector := range sectors {
+		sectorNames = append(sectorNames, sector)
+	}
+	sort.Strings(sectorNames)
+	for _, sector := range sectorNames {
+		if sectors[sector] > plan.MaxPerSector {
+			warnings = append(warnings, fmt.Sprintf("🎯 %d checks in %s (plan allows %d)", sectors[sector], sector, plan.MaxPerSector))
+		}
+	}
+
+	if sim.Runs > 0 && sim.P50 < 1 {
+		warnings = append(warnings, fmt.Sprintf("🎲 Median simulated outcome is %.2fx - more likely than not to lose LP money", sim.P50))
+	}
+
+	return warnings
+}
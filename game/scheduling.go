@@ -1,19 +1,14 @@
 package game
 
-import (
-	"math/rand"
-)
-
-
 func (gs *GameState) ScheduleFundingRounds() {
 	gs.FundingRoundQueue = []FundingRoundEvent{}
 
 	// Schedule funding rounds with realistic amounts
 	for _, startup := range gs.AvailableStartups {
 		// Seed round (3-9 months) - raise $2M-$5M
-		seedTurn := 3 + rand.Intn(7)
+		seedTurn := 3 + gs.rng.Intn(7)
 		if seedTurn < gs.Portfolio.MaxTurns {
-			seedAmount := int64(2000000 + rand.Intn(3000000)) // $2M-$5M
+			seedAmount := int64(2000000 + gs.rng.Intn(3000000)) // $2M-$5M
 			gs.FundingRoundQueue = append(gs.FundingRoundQueue, FundingRoundEvent{
 				CompanyName:   startup.Name,
 				RoundName:     "Seed",
@@ -23,9 +18,9 @@ func (gs *GameState) ScheduleFundingRounds() {
 		}
 
 		// Series A (12-24 months) - raise $10M-$20M
-		seriesATurn := 12 + rand.Intn(13)
+		seriesATurn := 12 + gs.rng.Intn(13)
 		if seriesATurn < gs.Portfolio.MaxTurns {
-			seriesAAmount := int64(10000000 + rand.Intn(10000000)) // $10M-$20M
+			seriesAAmount := int64(10000000 + gs.rng.Intn(10000000)) // $10M-$20M
 			gs.FundingRoundQueue = append(gs.FundingRoundQueue, FundingRoundEvent{
 				CompanyName:   startup.Name,
 				RoundName:     "Series A",
@@ -35,9 +30,9 @@ func (gs *GameState) ScheduleFundingRounds() {
 		}
 
 		// Series B (30-48 months) - raise $30M-$50M
-		seriesBTurn := 30 + rand.Intn(19)
+		seriesBTurn := 30 + gs.rng.Intn(19)
 		if seriesBTurn < gs.Portfolio.MaxTurns {
-			seriesBAmount := int64(30000000 + rand.Intn(20000000)) // $30M-$50M
+			seriesBAmount := int64(30000000 + gs.rng.Intn(20000000)) // $30M-$50M
 			gs.FundingRoundQueue = append(gs.FundingRoundQueue, FundingRoundEvent{
 				CompanyName:   startup.Name,
 				RoundName:     "Series B",
@@ -47,10 +42,10 @@ func (gs *GameState) ScheduleFundingRounds() {
 		}
 
 		// Series C (48-60 months) - raise $50M-$100M, only for top performers
-		if rand.Float64() < 0.3 { // 30% of companies
-			seriesCTurn := 48 + rand.Intn(13)
+		if gs.rng.Float64() < 0.3 { // 30% of companies
+			seriesCTurn := 48 + gs.rng.Intn(13)
 			if seriesCTurn < gs.Portfolio.MaxTurns {
-				seriesCAmount := int64(50000000 + rand.Intn(50000000)) // $50M-$100M
+				seriesCAmount := int64(50000000 + gs.rng.Intn(50000000)) // $50M-$100M
 				gs.FundingRoundQueue = append(gs.FundingRoundQueue, FundingRoundEvent{
 					CompanyName:   startup.Name,
 					RoundName:     "Series C",
@@ -61,14 +56,14 @@ func (gs *GameState) ScheduleFundingRounds() {
 		}
 
 		// 20% chance of a down round occurring (usually Series A or B)
-		if rand.Float64() < 0.2 {
-			downRoundTurn := 20 + rand.Intn(30) // Months 20-50
+		if gs.rng.Float64() < 0.2 {
+			downRoundTurn := 20 + gs.rng.Intn(30) // Months 20-50
 			if downRoundTurn < gs.Portfolio.MaxTurns {
 				downRoundName := "Series A (Down)"
-				if rand.Float64() < 0.5 {
+				if gs.rng.Float64() < 0.5 {
 					downRoundName = "Series B (Down)"
 				}
-				downAmount := int64(5000000 + rand.Intn(15000000)) // $5M-$20M
+				downAmount := int64(5000000 + gs.rng.Intn(15000000)) // $5M-$20M
 				gs.FundingRoundQueue = append(gs.FundingRoundQueue, FundingRoundEvent{
 					CompanyName:   startup.Name,
 					RoundName:     downRoundName,
@@ -87,16 +82,16 @@ func (gs *GameState) ScheduleAcquisitions() {
 
 	// 40% of companies get acquisition offers
 	for _, startup := range gs.AvailableStartups {
-		if rand.Float64() < 0.4 {
+		if gs.rng.Float64() < 0.4 {
 			// Acquisitions happen between months 24-60
-			acqTurn := 24 + rand.Intn(37)
+			acqTurn := 24 + gs.rng.Intn(37)
 			if acqTurn < gs.Portfolio.MaxTurns {
 				// Multiple ranges from 3x to 6x EBITDA (4x average)
-				multiple := 3.0 + rand.Float64()*3.0
+				multiple := 3.0 + gs.rng.Float64()*3.0
 
 				// Due diligence quality
 				dueDiligence := "normal"
-				roll := rand.Float64()
+				roll := gs.rng.Float64()
 				if roll < 0.15 { // 15% bad due diligence
 					dueDiligence = "bad"
 					multiple *= 0.6 // Offer falls through or gets cut 40%
@@ -137,14 +132,24 @@ func (gs *GameState) ScheduleDramaticEvents() {
 	}
 
 	for _, startup := range gs.AvailableStartups {
-		if rand.Float64() < eventChance {
+		// Hidden problems (fraud, IP disputes, shaky founders) tend to surface eventually
+		traitEvent := hiddenTraitEvent(startup.Hidden)
+		chance := eventChance
+		if traitEvent != "" {
+			chance += 0.35
+		}
+
+		if gs.rng.Float64() < chance {
 			// Events happen between months 6-55
-			eventTurn := 6 + rand.Intn(50)
+			eventTurn := 6 + gs.rng.Intn(50)
 			if eventTurn < gs.Portfolio.MaxTurns {
-				eventType := eventTypes[rand.Intn(len(eventTypes))]
+				eventType := eventTypes[gs.rng.Intn(len(eventTypes))]
+				if traitEvent != "" {
+					eventType = traitEvent
+				}
 
 				// Determine severity (difficulty affects this)
-				severityRoll := rand.Float64()
+				severityRoll := gs.rng.Float64()
 				severity := "minor"
 				impactPercent := 0.85 // 15% drop
 
@@ -2,7 +2,6 @@ package game
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // SecondaryOffer represents an offer to buy a stake from the player
@@ -50,7 +49,7 @@ func (gs *GameState) GenerateSecondaryOffers() []SecondaryOffer {
 		}
 
 		// 10% chance to get an offer
-		if rand.Float64() > 0.10 {
+		if gs.rng.Float64() > 0.10 {
 			continue
 		}
 
@@ -59,7 +58,7 @@ func (gs *GameState) GenerateSecondaryOffers() []SecondaryOffer {
 			continue
 		}
 
-		buyer := gs.AIPlayers[rand.Intn(len(gs.AIPlayers))]
+		buyer := gs.AIPlayers[gs.rng.Intn(len(gs.AIPlayers))]
 
 		// Calculate offer
 		currentStakeValue := int64(float64(inv.CurrentValuation) * inv.EquityPercent / 100.0)
@@ -141,6 +140,9 @@ func (gs *GameState) AcceptSecondaryOffer(offerIndex int) error {
 		return fmt.Errorf("investment not found")
 	}
 
+	inv := gs.Portfolio.Investments[invIdx]
+	gs.recordExit(inv, inv.AmountInvested, offer.OfferAmount, "secondary")
+
 	// Remove the investment from portfolio
 	gs.Portfolio.Investments = append(
 		gs.Portfolio.Investments[:invIdx],
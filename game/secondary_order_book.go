@@ -0,0 +1,459 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// SecondaryOrder is a resting bid or ask for a stake in a private company
+type SecondaryOrder struct {
+	ID            int
+	CompanyName   string
+	Side          string // "bid" (wants to buy) or "ask" (wants to sell)
+	PartyName     string
+	PartyFirm     string
+	EquityPercent float64 // Stake size on offer or wanted
+	Price         int64   // Total price for the stake
+	FairValue     int64   // What the market thinks the stake is worth
+	ExpiresIn     int     // Turns until the order is pulled
+	IsPlayer      bool    // Posted by the player's fund
+}
+
+// ContinuationVehicleDeal is a GP-led secondary: winners move into a new vehicle
+// funded by secondary buyers, LPs get cash, and the fund rolls a slice forward
+type ContinuationVehicleDeal struct {
+	Companies []string
+	NAV       int64   // Value of the stakes being moved
+	Price     int64   // What the secondary buyers pay before fees
+	Proceeds  int64   // Cash to the fund after fees
+	RollOver  float64 // Share of each stake the fund keeps
+}
+
+// Continuation vehicles roll forward this share of each stake
+const continuationRollOver = 0.20
+
+// currentMarketCycle returns the market cycle, starting one from the difficulty on first use
+func (gs *GameState) currentMarketCycle() *MarketCycle {
+	if gs.MarketCycle == nil {
+		gs.MarketCycle = InitializeMarketCycle(strings.ToLower(gs.Difficulty.Name))
+	}
+	return gs.MarketCycle
+}
+
+// SecondaryFairValue prices a stake from the company's metrics and the market cycle
+func (gs *GameState) SecondaryFairValue(startup *Startup, equityPercent float64) int64 {
+	if startup.Valuation <= 0 || equityPercent <= 0 {
+		return 0
+	}
+	mult := 0.85 // Illiquidity discount
+
+	// Metrics: growth and profitability earn a premium, burn and risk a discount
+	mult += math.Max(-0.15, math.Min(0.15, startup.RevenueGrowthRate))
+	if startup.NetIncome > 0 {
+		mult += 0.05
+	} else if startup.MonthlyRevenue > 0 && -startup.NetIncome > startup.MonthlyRevenue*2 {
+		mult -= 0.05
+	}
+	mult += (startup.GrowthPotential - 0.5) * 0.2
+	mult -= (startup.RiskScore - 0.5) * 0.2
+
+	mult *= gs.currentMarketCycle().ValuationMult
+	mult = math.Max(0.3, math.Min(1.5, mult))
+
+	return int64(float64(startup.Valuation) * equityPercent / 100 * mult)
+}
+
+func (gs *GameState) findStartup(companyName string) *Startup {
+	for i := range gs.AvailableStartups {
+		if gs.AvailableStartups[i].Name == companyName {
+			return &gs.AvailableStartups[i]
+		}
+	}
+	return nil
+}
+
+func (gs *GameState) findInvestment(companyName string) *Investment {
+	for i := range gs.Portfolio.Investments {
+		if gs.Portfolio.Investments[i].CompanyName == companyName {
+			return &gs.Portfolio.Investments[i]
+		}
+	}
+	return nil
+}
+
+func (gs *GameState) postSecondaryOrder(order SecondaryOrder) {
+	gs.SecondaryOrderSeq++
+	order.ID = gs.SecondaryOrderSeq
+	gs.SecondaryOrders = append(gs.SecondaryOrders, order)
+}
+
+// ProcessSecondaryOrderBook runs a month of the secondary market: the cycle moves,
+// buyers take up the player's listings, stale orders are pulled and new ones posted
+func (gs *GameState) ProcessSecondaryOrderBook() []string {
+	messages := []string{}
+
+	previous := gs.currentMarketCycle().Name
+	gs.MarketCycle = AdvanceMarketCycle(gs.rng, gs.MarketCycle, gs.Portfolio.Turn)
+	if gs.MarketCycle.Name != previous {
+		messages = append(messages, fmt.Sprintf("📈 %s: %s - secondary prices follow", gs.MarketCycle.Name, gs.MarketCycle.Description))
+	}
+
+	remaining := []SecondaryOrder{}
+	for _, order := range gs.SecondaryOrders {
+		startup := gs.findStartup(order.CompanyName)
+		if startup == nil || startup.Valuation <= 0 {
+			continue
+		}
+		order.FairValue = gs.SecondaryFairValue(startup, order.EquityPercent)
+
+		if order.IsPlayer && order.Side == "ask" && len(gs.AIPlayers) > 0 {
+			// Cheaper listings fill faster; hot markets fill everything faster
+			premium := float64(order.Price)/math.Max(1, float64(order.FairValue)) - 1
+			fillChance := (0.35 - premium) * gs.currentMarketCycle().FundingEase
+			if gs.rng.Float64() < fillChance {
+				buyer := gs.AIPlayers[gs.rng.Intn(len(gs.AIPlayers))]
+				seller := order.PartyName
+				order.PartyName, order.PartyFirm = buyer.Name, buyer.Firm
+				messages = append(messages, gs.asSeat(seller, func() []string {
+					proceeds, err := gs.sellStake(order, buyer.Firm)
+					if err != nil {
+						return nil
+					}
+					return []string{fmt.Sprintf("💱 %s bought your %.2f%% of %s - $%s after fees",
+						buyer.Firm, order.EquityPercent, order.CompanyName, formatCurrency(proceeds))}
+				})...)
+				continue
+			}
+		}
+
+		order.ExpiresIn--
+		if order.ExpiresIn <= 0 {
+			if order.IsPlayer {
+				messages = append(messages, gs.asSeat(order.PartyName, func() []string {
+					return []string{fmt.Sprintf("Your listing for %s expired unsold", order.CompanyName)}
+				})...)
+			}
+			continue
+		}
+		remaining = append(remaining, order)
+	}
+	gs.SecondaryOrders = remaining
+
+	gs.generateAIOrders()
+	return messages
+}
+
+// generateAIOrders has rival funds bid for hot names and offload stakes they hold
+func (gs *GameState) generateAIOrders() {
+	if len(gs.AIPlayers) == 0 || len(gs.AvailableStartups) == 0 {
+		return
+	}
+	aiOrders := 0
+	for _, order := range gs.SecondaryOrders {
+		if !order.IsPlayer {
+			aiOrders++
+		}
+	}
+	cycle := gs.currentMarketCycle()
+
+	for attempts := 0; aiOrders < 10 && attempts < 4; attempts++ {
+		ai := gs.AIPlayers[gs.rng.Intn(len(gs.AIPlayers))]
+
+		// Asks come from stakes the AI actually holds; bear markets shake more loose
+		if len(ai.Portfolio.Investments) > 0 && gs.rng.Float64() < 0.5/cycle.FundingEase {
+			inv := ai.Portfolio.Investments[gs.rng.Intn(len(ai.Portfolio.Investments))]
+			startup := gs.findStartup(inv.CompanyName)
+			if startup == nil || startup.Valuation <= 0 || inv.EquityPercent <= 0 {
+				continue
+			}
+			equity := inv.EquityPercent * (0.25 + gs.rng.Float64()*0.75)
+			fair := gs.SecondaryFairValue(startup, equity)
+			gs.postSecondaryOrder(SecondaryOrder{
+				CompanyName:   inv.CompanyName,
+				Side:          "ask",
+				PartyName:     ai.Name,
+				PartyFirm:     ai.Firm,
+				EquityPercent: equity,
+				Price:         int64(float64(fair) * (0.95 + gs.rng.Float64()*0.20)),
+				FairValue:     fair,
+				ExpiresIn:     3 + gs.rng.Intn(3),
+			})
+			aiOrders++
+			continue
+		}
+
+		// Bids chase growth; aggressive funds pay up
+		startup := &gs.AvailableStartups[gs.rng.Intn(len(gs.AvailableStartups))]
+		if startup.Valuation <= 0 || startup.GrowthPotential < 0.4 {
+			continue
+		}
+		equity := 0.5 + gs.rng.Float64()*2.5
+		fair := gs.SecondaryFairValue(startup, equity)
+		bidMult := 0.80 + gs.rng.Float64()*0.15
+		if ai.Strategy == "aggressive" {
+			bidMult += 0.05
+		}
+		gs.postSecondaryOrder(SecondaryOrder{
+			CompanyName:   startup.Name,
+			Side:          "bid",
+			PartyName:     ai.Name,
+			PartyFirm:     ai.Firm,
+			EquityPercent: equity,
+			Price:         int64(float64(fair) * bidMult),
+			FairValue:     fair,
+			ExpiresIn:     3 + gs.rng.Intn(3),
+		})
+		aiOrders++
+	}
+}
+
+// BuySecondaryStake lifts an ask, buying into a company whether or not we already own it
+func (gs *GameState) BuySecondaryStake(orderID int) (string, error) {
+	idx := gs.secondaryOrderIndex(orderID)
+	if idx < 0 {
+		return "", fmt.Errorf("order is no longer on the book")
+	}
+	order := gs.SecondaryOrders[idx]
+	if order.Side != "ask" || order.IsPlayer {
+		return "", fmt.Errorf("you can only buy from another fund's ask")
+	}
+	if order.Price > gs.Portfolio.Cash {
+		return "", fmt.Errorf("insufficient funds (need $%s)", formatCurrency(order.Price))
+	}
+	startup := gs.findStartup(order.CompanyName)
+	if startup == nil {
+		return "", fmt.Errorf("company not found")
+	}
+
+	gs.Portfolio.Cash -= order.Price
+	if inv := gs.findInvestment(order.CompanyName); inv != nil {
+		inv.AmountInvested += order.Price
+		inv.EquityPercent += order.EquityPercent
+	} else {
+		gs.Portfolio.Investments = append(gs.Portfolio.Investments, Investment{
+			CompanyName:       startup.Name,
+			AmountInvested:    order.Price,
+			EquityPercent:     order.EquityPercent,
+			InitialEquity:     order.EquityPercent,
+			InitialValuation:  startup.Valuation,
+			CurrentValuation:  startup.Valuation,
+			Category:          startup.Category,
+			Terms:             InvestmentTerms{Type: "Common (Secondary)"},
+			FounderName:       gs.FounderNameFor(startup.Name),
+			RelationshipScore: 50,
+			LastInteraction:   gs.Portfolio.Turn,
+		})
+	}
+	gs.transferAIStake(order.PartyFirm, order.CompanyName, -order.EquityPercent, -order.Price)
+
+	gs.SecondaryOrders = append(gs.SecondaryOrders[:idx], gs.SecondaryOrders[idx+1:]...)
+	gs.updateNetWorth()
+	return fmt.Sprintf("✓ Bought %.2f%% of %s from %s for $%s",
+		order.EquityPercent, order.CompanyName, order.PartyFirm, formatCurrency(order.Price)), nil
+}
+
+// SellToBid hits a bid with (up to) the stake it asks for
+func (gs *GameState) SellToBid(orderID int) (string, error) {
+	idx := gs.secondaryOrderIndex(orderID)
+	if idx < 0 {
+		return "", fmt.Errorf("order is no longer on the book")
+	}
+	order := gs.SecondaryOrders[idx]
+	if order.Side != "bid" {
+		return "", fmt.Errorf("that order is an ask, not a bid")
+	}
+	inv := gs.findInvestment(order.CompanyName)
+	if inv == nil {
+		return "", fmt.Errorf("you don't own any %s", order.CompanyName)
+	}
+	if inv.EquityPercent < order.EquityPercent {
+		// Fill what we have at the bid's price per point
+		order.Price = int64(float64(order.Price) * inv.EquityPercent / order.EquityPercent)
+		order.EquityPercent = inv.EquityPercent
+	}
+
+	proceeds, err := gs.sellStake(order, order.PartyFirm)
+	if err != nil {
+		return "", err
+	}
+	gs.SecondaryOrders = append(gs.SecondaryOrders[:idx], gs.SecondaryOrders[idx+1:]...)
+	return fmt.Sprintf("✓ Sold %.2f%% of %s to %s for $%s after %.0f%% fees",
+		order.EquityPercent, order.CompanyName, order.PartyFirm, formatCurrency(proceeds), GetSecondaryMarketFee()*100), nil
+}
+
+// ListPosition posts an ask for part of a portfolio stake at a premium (or discount) to fair value
+func (gs *GameState) ListPosition(companyName string, sharePercent float64, premium float64) error {
+	inv := gs.findInvestment(companyName)
+	if inv == nil {
+		return fmt.Errorf("you don't own any %s", companyName)
+	}
+	if sharePercent <= 0 || sharePercent > 1 {
+		return fmt.Errorf("list between 1%% and 100%% of the stake")
+	}
+	for _, order := range gs.SecondaryOrders {
+		if gs.IsOwnOrder(order) && order.CompanyName == companyName {
+			return fmt.Errorf("%s is already listed", companyName)
+		}
+	}
+	startup := gs.findStartup(companyName)
+	if startup == nil || startup.Valuation <= 0 {
+		return fmt.Errorf("no buyers for a failed company")
+	}
+
+	equity := inv.EquityPercent * sharePercent
+	fair := gs.SecondaryFairValue(startup, equity)
+	gs.postSecondaryOrder(SecondaryOrder{
+		CompanyName:   companyName,
+		Side:          "ask",
+		PartyName:     gs.PlayerName,
+		PartyFirm:     gs.PlayerFirmName,
+		EquityPercent: equity,
+		Price:         int64(float64(fair) * (1 + premium)),
+		FairValue:     fair,
+		ExpiresIn:     6,
+		IsPlayer:      true,
+	})
+	return nil
+}
+
+// CancelListing pulls one of the player's asks
+func (gs *GameState) CancelListing(orderID int) error {
+	idx := gs.secondaryOrderIndex(orderID)
+	if idx < 0 || !gs.IsOwnOrder(gs.SecondaryOrders[idx]) {
+		return fmt.Errorf("no listing to cancel")
+	}
+	gs.SecondaryOrders = append(gs.SecondaryOrders[:idx], gs.SecondaryOrders[idx+1:]...)
+	return nil
+}
+
+// sellStake moves equity from the player to an AI fund and books the proceeds net of fees
+func (gs *GameState) sellStake(order SecondaryOrder, buyerFirm string) (int64, error) {
+	inv := gs.findInvestment(order.CompanyName)
+	if inv == nil || inv.EquityPercent <= 0 {
+		return 0, fmt.Errorf("you don't own any %s", order.CompanyName)
+	}
+	equity := math.Min(order.EquityPercent, inv.EquityPercent)
+	share := equity / inv.EquityPercent
+	proceeds := int64(float64(order.Price) * (1 - GetSecondaryMarketFee()))
+
+	gs.recordExit(*inv, int64(float64(inv.AmountInvested)*share), proceeds, "secondary")
+	inv.AmountInvested -= int64(float64(inv.AmountInvested) * share)
+	inv.EquityPercent -= equity
+	gs.Portfolio.Cash += proceeds
+	gs.transferAIStake(buyerFirm, order.CompanyName, equity, order.Price)
+
+	if inv.EquityPercent <= 0.0001 {
+		for i := range gs.Portfolio.Investments {
+			if gs.Portfolio.Investments[i].CompanyName == order.CompanyName {
+				gs.Portfolio.Investments = append(gs.Portfolio.Investments[:i], gs.Portfolio.Investments[i+1:]...)
+				break
+			}
+		}
+	}
+	gs.updateNetWorth()
+	return proceeds, nil
+}
+
+// transferAIStake adds (or, with negative amounts, removes) equity from an AI fund's portfolio
+func (gs *GameState) transferAIStake(firm, companyName string, equity float64, amount int64) {
+	for i := range gs.AIPlayers {
+		ai := &gs.AIPlayers[i]
+		if ai.Firm != firm {
+			continue
+		}
+		ai.Portfolio.Cash -= amount
+		for j := range ai.Portfolio.Investments {
+			inv := &ai.Portfolio.Investments[j]
+			if inv.CompanyName == companyName {
+				inv.EquityPercent = math.Max(0, inv.EquityPercent+equity)
+				inv.AmountInvested = int64(math.Max(0, float64(inv.AmountInvested+amount)))
+				return
+			}
+		}
+		if equity > 0 {
+			startup := gs.findStartup(companyName)
+			if startup == nil {
+				return
+			}
+			ai.Portfolio.Investments = append(ai.Portfolio.Investments, Investment{
+				CompanyName:      companyName,
+				AmountInvested:   amount,
+				EquityPercent:    equity,
+				InitialEquity:    equity,
+				InitialValuation: startup.Valuation,
+				CurrentValuation: startup.Valuation,
+				Category:         startup.Category,
+			})
+		}
+		return
+	}
+}
+
+// IsOwnOrder reports whether the player at the keyboard posted the order
+func (gs *GameState) IsOwnOrder(order SecondaryOrder) bool {
+	return order.IsPlayer && order.PartyName == gs.PlayerName
+}
+
+func (gs *GameState) secondaryOrderIndex(orderID int) int {
+	for i, order := range gs.SecondaryOrders {
+		if order.ID == orderID {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContinuationVehicleAvailable reports whether the fund is close enough to its end for a GP-led deal
+func (gs *GameState) ContinuationVehicleAvailable() bool {
+	return !gs.ContinuationVehicleDone && gs.Portfolio.Turn >= gs.Portfolio.MaxTurns-12
+}
+
+// QuoteContinuationVehicle prices moving every winner (stake worth more than it cost) into a continuation vehicle
+func (gs *GameState) QuoteContinuationVehicle() (ContinuationVehicleDeal, error) {
+	deal := ContinuationVehicleDeal{RollOver: continuationRollOver}
+	if !gs.ContinuationVehicleAvailable() {
+		return deal, fmt.Errorf("continuation vehicles open in the fund's final 12 months")
+	}
+	for _, inv := range gs.Portfolio.Investments {
+		startup := gs.findStartup(inv.CompanyName)
+		if startup == nil {
+			continue
+		}
+		value := int64(float64(inv.CurrentValuation) * inv.EquityPercent / 100)
+		if value <= inv.AmountInvested {
+			continue
+		}
+		sold := inv.EquityPercent * (1 - continuationRollOver)
+		deal.Companies = append(deal.Companies, inv.CompanyName)
+		deal.NAV += int64(float64(value) * (1 - continuationRollOver))
+		// Lead secondary buyers price near NAV; the cycle decides how near
+		deal.Price += int64(math.Max(float64(gs.SecondaryFairValue(startup, sold)), float64(value)*(1-continuationRollOver)*0.85))
+	}
+	if len(deal.Companies) == 0 {
+		return deal, fmt.Errorf("no winners to move into a continuation vehicle")
+	}
+	deal.Proceeds = int64(float64(deal.Price) * (1 - GetSecondaryMarketFee()))
+	return deal, nil
+}
+
+// ExecuteContinuationVehicle sells the quoted stakes to the vehicle, keeping the roll-over slice
+func (gs *GameState) ExecuteContinuationVehicle() (string, error) {
+	deal, err := gs.QuoteContinuationVehicle()
+	if err != nil {
+		return "", err
+	}
+	for _, name := range deal.Companies {
+		inv := gs.findInvestment(name)
+		sold := float64(inv.CurrentValuation) * inv.EquityPercent / 100 * (1 - continuationRollOver)
+		gs.recordExit(*inv, int64(float64(inv.AmountInvested)*(1-continuationRollOver)),
+			int64(sold/float64(deal.NAV)*float64(deal.Proceeds)), "continuation")
+		inv.AmountInvested = int64(float64(inv.AmountInvested) * continuationRollOver)
+		inv.EquityPercent *= continuationRollOver
+	}
+	gs.Portfolio.Cash += deal.Proceeds
+	gs.ContinuationVehicleDone = true
+	gs.updateNetWorth()
+	return fmt.Sprintf("🔁 Continuation vehicle closed: %d companies, $%s to LPs, %.0f%% rolled forward",
+		len(deal.Companies), formatCurrency(deal.Proceeds), continuationRollOver*100), nil
+}
@@ -0,0 +1,269 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// FounderProfile describes the founding CEO of a generated startup
+type FounderProfile struct {
+	Name            string
+	Background      string // "ex-FAANG engineer", "serial founder", ...
+	YearsExperience int
+	PriorExits      int
+	Technical       bool
+}
+
+// HiddenTraits are facts about a startup that only due diligence can uncover
+type HiddenTraits struct {
+	FounderIntegrity      float64 // 0-1, low = likely to mislead investors
+	ReportedChurn         float64 // Monthly churn the founders claim
+	RealChurn             float64 // Actual monthly churn
+	CustomerConcentration float64 // Share of revenue from the top customer
+	IPIssues              bool    // Unclear IP assignment or pending claims
+	FraudRisk             float64 // 0-1, chance the numbers are cooked
+}
+
+// sectorTemplate describes how startups in a sector look
+type sectorTemplate struct {
+	Category    string
+	Prefixes    []string
+	Suffixes    []string
+	Products    []string
+	Customers   []string
+	PriceRange  [2]int // Sale price per unit
+	MarginRange [2]int // Percent margin per unit
+}
+
+var sectorTemplates = []sectorTemplate{
+	{"AI/ML", []string{"Neur", "Cogni", "Synth", "Vector", "Tensor"}, []string{"ly", "a", "iq", "Labs", "AI"},
+		[]string{"AI copilots", "model monitoring", "document extraction", "voice agents"},
+		[]string{"support teams", "insurers", "law firms", "developers"}, [2]int{200, 4000}, [2]int{50, 85}},
+	{"FinTech", []string{"Ledger", "Coin", "Vault", "Pay", "Clear"}, []string{"ly", "stack", "wise", "Pilot", "Base"},
+		[]string{"spend management", "embedded payments", "treasury automation", "payroll"},
+		[]string{"SMBs", "freelancers", "CFOs", "marketplaces"}, [2]int{50, 2000}, [2]int{30, 75}},
+	{"HealthTech", []string{"Vita", "Care", "Pulse", "Medi", "Thera"}, []string{"ly", "Path", "Loop", "Health", "io"},
+		[]string{"remote monitoring", "clinical scheduling", "prior authorization", "virtual therapy"},
+		[]string{"clinics", "hospitals", "employers", "patients"}, [2]int{80, 3000}, [2]int{35, 70}},
+	{"Security", []string{"Shield", "Cipher", "Sentinel", "Aegis", "Lock"}, []string{"ly", "Ops", "Guard", "Wall", "X"},
+		[]string{"identity threat detection", "secrets scanning", "cloud posture management", "phishing defense"},
+		[]string{"CISOs", "DevOps teams", "banks", "mid-market IT"}, [2]int{500, 8000}, [2]int{60, 85}},
+	{"CloudTech", []string{"Nimbus", "Stack", "Edge", "Kube", "Flux"}, []string{"ly", "io", "Grid", "Works", "Cloud"},
+		[]string{"cost optimization", "serverless databases", "observability", "CI pipelines"},
+		[]string{"platform teams", "startups", "SREs", "data engineers"}, [2]int{100, 5000}, [2]int{55, 85}},
+	{"EdTech", []string{"Learn", "Quill", "Tutor", "Brain", "Class"}, []string{"ly", "Hub", "Path", "Spark", "ify"},
+		[]string{"AI tutoring", "skills assessments", "cohort courses", "classroom tools"},
+		[]string{"teachers", "students", "HR teams", "bootcamps"}, [2]int{20, 600}, [2]int{40, 80}},
+	{"ClimateTech", []string{"Terra", "Carbon", "Volt", "Sol", "Green"}, []string{"ly", "Grid", "Cycle", "Works", "Loop"},
+		[]string{"carbon accounting", "battery analytics", "grid forecasting", "EV charging software"},
+		[]string{"utilities", "manufacturers", "fleets", "property managers"}, [2]int{300, 6000}, [2]int{20, 60}},
+	{"Robotics", []string{"Servo", "Mech", "Auto", "Robo", "Kine"}, []string{"ly", "Works", "Dynamics", "Bot", "Motion"},
+		[]string{"warehouse picking robots", "inspection drones", "kitchen automation", "cobots"},
+		[]string{"warehouses", "restaurants", "factories", "energy companies"}, [2]int{2000, 20000}, [2]int{15, 45}},
+	{"Logistics", []string{"Freight", "Route", "Cargo", "Dock", "Haul"}, []string{"ly", "Flow", "Link", "Hub", "Pilot"},
+		[]string{"freight matching", "last-mile routing", "customs automation", "yard management"},
+		[]string{"shippers", "carriers", "3PLs", "importers"}, [2]int{100, 3000}, [2]int{15, 50}},
+	{"Gaming", []string{"Pixel", "Quest", "Arcade", "Loot", "Level"}, []string{"ly", "Forge", "Studios", "Play", "Verse"},
+		[]string{"multiplayer backends", "creator tools", "mobile puzzle games", "anti-cheat"},
+		[]string{"indie studios", "players", "streamers", "publishers"}, [2]int{5, 300}, [2]int{50, 85}},
+	{"BioTech", []string{"Gene", "Cell", "Protea", "Bio", "Helix"}, []string{"ly", "Bio", "Therapeutics", "Labs", "gen"},
+		[]string{"protein design", "lab automation", "diagnostic assays", "cell therapy tooling"},
+		[]string{"pharma", "research labs", "CROs", "hospitals"}, [2]int{1000, 15000}, [2]int{30, 75}},
+	{"Consumer Goods", []string{"Bloom", "Hearth", "Kind", "Fresh", "Nest"}, []string{"ly", "Co", "Goods", "Supply", "Box"},
+		[]string{"DTC skincare", "sustainable cookware", "pet wellness", "functional snacks"},
+		[]string{"millennials", "parents", "pet owners", "athletes"}, [2]int{15, 200}, [2]int{20, 60}},
+	{"LegalTech", []string{"Brief", "Clause", "Docket", "Counsel", "Lex"}, []string{"ly", "Hub", "AI", "Desk", "Base"},
+		[]string{"contract review", "e-discovery", "matter management", "compliance tracking"},
+		[]string{"law firms", "in-house counsel", "startups", "insurers"}, [2]int{200, 5000}, [2]int{55, 85}},
+	{"SpaceTech", []string{"Orbit", "Astro", "Stellar", "Lunar", "Apogee"}, []string{"ly", "Space", "Works", "Systems", "Labs"},
+		[]string{"satellite imagery analytics", "launch scheduling", "in-orbit servicing", "ground station software"},
+		[]string{"governments", "insurers", "agriculture firms", "telecoms"}, [2]int{5000, 50000}, [2]int{20, 60}},
+	{"AgriTech", []string{"Harvest", "Field", "Crop", "Soil", "Agri"}, []string{"ly", "Sense", "Works", "Grow", "Labs"},
+		[]string{"precision irrigation", "crop yield forecasting", "vertical farming", "livestock monitoring"},
+		[]string{"farmers", "co-ops", "food brands", "greenhouses"}, [2]int{100, 4000}, [2]int{20, 55}},
+}
+
+var founderBackgrounds = []string{
+	"ex-FAANG engineer", "serial founder", "PhD researcher", "industry operator",
+	"former consultant", "college dropout", "ex-YC founder", "former product lead",
+}
+
+// StartupGenerator procedurally creates VC-mode deal flow from a seed
+type StartupGenerator struct {
+	rng        *rand.Rand
+	difficulty Difficulty
+	usedNames  map[string]bool
+}
+
+// NewStartupGenerator creates a generator; the same seed and difficulty produce the same startups
+func NewStartupGenerator(seed int64, difficulty Difficulty) *StartupGenerator {
+	return &StartupGenerator{
+		rng:        rand.New(rand.NewSource(seed)),
+		difficulty: difficulty,
+		usedNames:  make(map[string]bool),
+	}
+}
+
+// Reserve marks names (e.g. curated startups) so generated companies don't collide
+func (g *StartupGenerator) Reserve(names ...string) {
+	for _, name := range names {
+		g.usedNames[strings.ToLower(name)] = true
+	}
+}
+
+func (g *StartupGenerator) between(r [2]int) int {
+	if r[1] <= r[0] {
+		return r[0]
+	}
+	return r[0] + g.rng.Intn(r[1]-r[0]+1)
+}
+
+func (g *StartupGenerator) pick(options []string) string {
+	return options[g.rng.Intn(len(options))]
+}
+
+// RollTier picks a quality tier, shifted toward better deals on easier difficulties
+func (g *StartupGenerator) RollTier() int {
+	bias := g.difficulty.StartupQualityBias
+	roll := g.rng.Float64()
+	switch {
+	case roll < 0.25+bias:
+		return 1
+	case roll < 0.70+bias/2:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func (g *StartupGenerator) name(t sectorTemplate) string {
+	for attempt := 0; attempt < 20; attempt++ {
+		prefix, suffix := g.pick(t.Prefixes), g.pick(t.Suffixes)
+		name := prefix + suffix // "Ledgerly", "ShieldOps"
+		if len(suffix) >= 6 {
+			name = prefix + " " + suffix // "Helix Therapeutics"
+		}
+		if !g.usedNames[strings.ToLower(name)] {
+			g.usedNames[strings.ToLower(name)] = true
+			return name
+		}
+	}
+	name := fmt.Sprintf("%s %d", g.pick(t.Prefixes), 100+g.rng.Intn(900))
+	g.usedNames[strings.ToLower(name)] = true
+	return name
+}
+
+func (g *StartupGenerator) founder(tier int) FounderProfile {
+	profile := FounderProfile{
+		Name:            g.pick(founderFirstNames) + " " + g.pick(founderLastNames),
+		Background:      g.pick(founderBackgrounds),
+		YearsExperience: 2 + g.rng.Intn(15),
+		Technical:       g.rng.Float64() < 0.55,
+	}
+	if profile.Background == "serial founder" || profile.Background == "ex-YC founder" || tier == 1 {
+		profile.PriorExits = g.rng.Intn(3)
+	}
+	return profile
+}
+
+func (g *StartupGenerator) hiddenTraits(tier int) HiddenTraits {
+	// Harder difficulties hide more skeletons
+	severity := 0.1*float64(tier-1) - g.difficulty.StartupQualityBias
+	traits := HiddenTraits{
+		FounderIntegrity:      clampUnit(0.95 - severity - g.rng.Float64()*0.3),
+		ReportedChurn:         0.01 + g.rng.Float64()*0.03,
+		CustomerConcentration: clampUnit(0.05 + g.rng.Float64()*0.3 + severity),
+		IPIssues:              g.rng.Float64() < 0.05+severity/2,
+		FraudRisk:             clampUnit(g.rng.Float64()*0.1 + severity/3),
+	}
+	traits.RealChurn = traits.ReportedChurn * (1 + g.rng.Float64()*severity*4)
+	return traits
+}
+
+func clampUnit(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+// Generate creates one startup whose metrics match the requested quality tier (1=hot, 3=struggling)
+func (g *StartupGenerator) Generate(tier int) Startup {
+	if tier < 1 || tier > 3 {
+		tier = 2
+	}
+	t := sectorTemplates[g.rng.Intn(len(sectorTemplates))]
+
+	// Unit economics: better tiers sit at the top of the sector's margin range
+	marginLow, marginHigh := t.MarginRange[0], t.MarginRange[1]
+	span := marginHigh - marginLow
+	var margin int
+	switch tier {
+	case 1:
+		margin = g.between([2]int{marginLow + span*2/3, marginHigh + 5})
+	case 2:
+		margin = g.between([2]int{marginLow + span/4, marginLow + span*3/4})
+	default:
+		margin = g.between([2]int{marginLow - 5, marginLow + span/3})
+	}
+	if margin < 5 {
+		margin = 5
+	}
+	if margin > 95 {
+		margin = 95
+	}
+	price := g.between(t.PriceRange)
+	cost := price * (100 - margin) / 100
+
+	var sales, activation, burn [2]int
+	switch tier {
+	case 1:
+		sales, activation, burn = [2]int{300, 800}, [2]int{110, 200}, [2]int{1, 3}
+	case 2:
+		sales, activation, burn = [2]int{50, 300}, [2]int{40, 120}, [2]int{4, 15}
+	default:
+		sales, activation, burn = [2]int{5, 60}, [2]int{5, 60}, [2]int{12, 45}
+	}
+	monthlySales := g.between(sales)
+
+	stage := "Pre-Seed"
+	valuation := int64(250000 + g.rng.Intn(500000))
+	if g.rng.Float64() < 0.4 {
+		stage = "Seed"
+		valuation = int64(600000 + g.rng.Intn(400000))
+	}
+
+	name := g.name(t)
+	product := g.pick(t.Products)
+	return Startup{
+		Name:                   name,
+		Description:            fmt.Sprintf("%s for %s", strings.ToUpper(product[:1])+product[1:], g.pick(t.Customers)),
+		Category:               t.Category,
+		Valuation:              valuation,
+		GrossBurnRate:          g.between(burn),
+		MonthlyActivationRate:  g.between(activation),
+		MonthlyWebsiteVisitors: monthlySales * (20 + g.rng.Intn(60)),
+		MonthlySales:           monthlySales,
+		Cost:                   cost,
+		SalePrice:              price,
+		PercentMargin:          margin,
+		QualityTier:            tier,
+		Stage:                  stage,
+		Generated:              true,
+		Founder:                g.founder(tier),
+		Hidden:                 g.hiddenTraits(tier),
+	}
+}
+
+// GenerateBatch creates count startups with tiers rolled for the difficulty
+func (g *StartupGenerator) GenerateBatch(count int) []Startup {
+	startups := make([]Startup, 0, count)
+	for i := 0; i < count; i++ {
+		startups = append(startups, g.Generate(g.RollTier()))
+	}
+	return startups
+}
@@ -0,0 +1,279 @@
+package game
+
+import (
+	"fmt"
+	"math"
+)
+
+// TermSheetProposal is a custom term sheet the fund puts in front of a founder
+type TermSheetProposal struct {
+	Amount               int64
+	PreMoneyValuation    int64
+	LiquidationPref      float64 // 1x-3x
+	Participating        bool    // Takes preference AND shares in common proceeds
+	BoardSeat            bool
+	ProRata              bool
+	InfoRights           bool
+	ProtectiveProvisions bool // Veto over new rounds, sales and debt
+}
+
+// TermSheetNegotiation tracks back-and-forth with one founder
+type TermSheetNegotiation struct {
+	StartupIndex    int
+	CompanyName     string
+	AskingValuation int64 // The founder's anchor
+	CompetingOffers int   // Other funds circling the deal
+	Patience        int   // Proposals the founder will entertain before walking
+	Rounds          int
+	Proposal        TermSheetProposal
+	Counter         *TermSheetProposal // Founder's latest counter, if any
+	Outcome         string             // "", "accepted", "walked"
+}
+
+// Aggressiveness scores how investor-friendly a proposal is (0 = founder-friendly, 1+ = predatory)
+func (p TermSheetProposal) Aggressiveness(askingValuation int64) float64 {
+	score := 0.0
+	if askingValuation > 0 && p.PreMoneyValuation < askingValuation {
+		score += float64(askingValuation-p.PreMoneyValuation) / float64(askingValuation) * 2
+	}
+	if p.LiquidationPref > 1 {
+		score += (p.LiquidationPref - 1) * 0.35
+	}
+	if p.Participating {
+		score += 0.25
+	}
+	if p.ProtectiveProvisions {
+		score += 0.10
+	}
+	if p.BoardSeat {
+		score += 0.05
+	}
+	if p.ProRata {
+		score += 0.02
+	}
+	return score
+}
+
+// IsAggressive reports whether founders would complain about these terms to their friends
+func (t InvestmentTerms) IsAggressive() bool {
+	return t.FounderFriction >= 0.5
+}
+
+// founderRelationshipAverage is the fund's standing with its current founders
+func (gs *GameState) founderRelationshipAverage() float64 {
+	total, count := 0.0, 0
+	for _, inv := range gs.Portfolio.Investments {
+		if inv.FounderName != "" {
+			total += inv.RelationshipScore
+			count++
+		}
+	}
+	if count == 0 {
+		return 60.0
+	}
+	return total / float64(count)
+}
+
+// FounderReferralScore is how founders talk about the fund: relationships,
+// dragged down by every predatory term sheet signed
+func (gs *GameState) FounderReferralScore() float64 {
+	score := gs.founderRelationshipAverage()
+	for _, inv := range gs.Portfolio.Investments {
+		if inv.Terms.IsAggressive() {
+			score -= 5
+		}
+	}
+	return score
+}
+
+// OpenTermSheetNegotiation starts a negotiation with a founder from a standard preferred deal
+func (gs *GameState) OpenTermSheetNegotiation(startupIndex int, amount int64) (*TermSheetNegotiation, error) {
+	if startupIndex < 0 || startupIndex >= len(gs.AvailableStartups) {
+		return nil, fmt.Errorf("invalid startup index")
+	}
+	startup := &gs.AvailableStartups[startupIndex]
+	if gs.founderWalkedAway(startup.Name) {
+		return nil, fmt.Errorf("%s's founder walked away from your last term sheet", startup.Name)
+	}
+
+	// Hot companies have other term sheets on the table
+	competing := 0
+	if startup.QualityTier == 1 || startup.GrowthPotential > 0.7 {
+		competing += 1 + gs.rng.Intn(2)
+	}
+	if startup.GrowthPotential > 0.5 && gs.rng.Float64() < 0.5 {
+		competing++
+	}
+
+	return &TermSheetNegotiation{
+		StartupIndex:    startupIndex,
+		CompanyName:     startup.Name,
+		AskingValuation: startup.Valuation,
+		CompetingOffers: competing,
+		Patience:        4 - competing,
+		Proposal: TermSheetProposal{
+			Amount:            amount,
+			PreMoneyValuation: startup.Valuation,
+			LiquidationPref:   1.0,
+			BoardSeat:         amount >= 100000,
+			ProRata:           true,
+			InfoRights:        true,
+		},
+	}, nil
+}
+
+// founderTolerance is how much aggressiveness a founder will swallow from this fund
+func (gs *GameState) founderTolerance(n *TermSheetNegotiation) float64 {
+	tolerance := 0.30
+
+	// A strong reputation buys leeway; founders know what the brand is worth
+	if gs.PlayerReputation != nil {
+		tolerance += (gs.PlayerReputation.GetAggregateReputation() - 50) / 200
+	}
+
+	// Founders check references with the fund's portfolio
+	tolerance += (gs.FounderReferralScore() - 60) / 200
+
+	// Competing term sheets make founders picky
+	tolerance -= float64(n.CompetingOffers) * 0.10
+
+	return math.Max(0.05, tolerance)
+}
+
+// ProposeTermSheet sends a proposal; the founder accepts, counters or walks away
+func (gs *GameState) ProposeTermSheet(n *TermSheetNegotiation, p TermSheetProposal) string {
+	if n.Outcome != "" {
+		return "Negotiation is already over"
+	}
+
+	n.Rounds++
+	n.Proposal = p
+	n.Counter = nil
+
+	aggression := p.Aggressiveness(n.AskingValuation)
+	tolerance := gs.founderTolerance(n)
+
+	if aggression <= tolerance {
+		n.Outcome = "accepted"
+		return fmt.Sprintf("🤝 %s's founder accepted your term sheet", n.CompanyName)
+	}
+
+	if aggression > tolerance+0.6 || n.Rounds >= n.Patience {
+		n.Outcome = "walked"
+		gs.TermSheetWalkaways = append(gs.TermSheetWalkaways, n.CompanyName)
+		if n.CompetingOffers > 0 {
+			return fmt.Sprintf("🚪 %s's founder walked and signed with another fund", n.CompanyName)
+		}
+		return fmt.Sprintf("🚪 %s's founder walked away from the table", n.CompanyName)
+	}
+
+	counter := gs.founderCounter(n, p, tolerance)
+	n.Counter = &counter
+	return fmt.Sprintf("↩️  %s's founder countered at $%s pre-money, %.1fx pref",
+		n.CompanyName, formatCurrency(counter.PreMoneyValuation), counter.LiquidationPref)
+}
+
+// founderCounter strips the most founder-hostile terms until the sheet fits the founder's tolerance
+func (gs *GameState) founderCounter(n *TermSheetNegotiation, p TermSheetProposal, tolerance float64) TermSheetProposal {
+	counter := p
+	if counter.Participating {
+		counter.Participating = false
+	}
+	if counter.LiquidationPref > 1 && counter.Aggressiveness(n.AskingValuation) > tolerance {
+		counter.LiquidationPref = 1.0
+	}
+	if counter.ProtectiveProvisions && counter.Aggressiveness(n.AskingValuation) > tolerance {
+		counter.ProtectiveProvisions = false
+	}
+	if counter.PreMoneyValuation < n.AskingValuation {
+		counter.PreMoneyValuation = (counter.PreMoneyValuation + n.AskingValuation) / 2
+	}
+	return counter
+}
+
+// AcceptCounter signs the founder's counter-proposal
+func (n *TermSheetNegotiation) AcceptCounter() error {
+	if n.Counter == nil {
+		return fmt.Errorf("no counter-proposal to accept")
+	}
+	n.Proposal = *n.Counter
+	n.Counter = nil
+	n.Outcome = "accepted"
+	return nil
+}
+
+// SignedTerms converts an accepted proposal into investment terms
+func (gs *GameState) SignedTerms(n *TermSheetNegotiation) (InvestmentTerms, error) {
+	if n.Outcome != "accepted" {
+		return InvestmentTerms{}, fmt.Errorf("term sheet hasn't been accepted")
+	}
+	p := n.Proposal
+
+	boardSeatMultiplier := 1
+	for _, upgradeID := range gs.PlayerUpgrades {
+		if upgradeID == "double_board_seat" {
+			boardSeatMultiplier = 2
+			break
+		}
+	}
+
+	return InvestmentTerms{
+		Type:                 "Preferred Stock (Negotiated)",
+		HasProRataRights:     p.ProRata,
+		HasInfoRights:        p.InfoRights,
+		HasBoardSeat:         p.BoardSeat,
+		BoardSeatMultiplier:  boardSeatMultiplier,
+		LiquidationPref:      p.LiquidationPref,
+		HasAntiDilution:      true,
+		Participating:        p.Participating,
+		ProtectiveProvisions: p.ProtectiveProvisions,
+		PreMoneyValuation:    p.PreMoneyValuation,
+		FounderFriction:      p.Aggressiveness(n.AskingValuation),
+	}, nil
+}
+
+func (gs *GameState) founderWalkedAway(companyName string) bool {
+	for _, name := range gs.TermSheetWalkaways {
+		if name == companyName {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessFounderReferrals lets founders talk: once a year, how the fund treats its
+// founders makes the deals still in market better or worse
+func (gs *GameState) ProcessFounderReferrals() []string {
+	messages := []string{}
+	if len(gs.Portfolio.Investments) == 0 || gs.Portfolio.Turn%12 != 0 {
+		return messages
+	}
+
+	score := gs.FounderReferralScore()
+	bonus := GetFounderReferralBonus(score)
+	if bonus == 0 {
+		return messages
+	}
+
+	for i := range gs.AvailableStartups {
+		if !gs.hasInvestmentIn(gs.AvailableStartups[i].Name) {
+			CalculateFounderReferralEffect(score, &gs.AvailableStartups[i])
+		}
+	}
+
+	if bonus > 0 {
+		messages = append(messages, "📣 Your founders are referring their best friends to you - deal flow improving")
+	} else {
+		messages = append(messages, "📣 Founders are warning each other about your term sheets - deal flow suffering")
+	}
+	return messages
+}
+
+func (gs *GameState) hasInvestmentIn(companyName string) bool {
+	for _, inv := range gs.Portfolio.Investments {
+		if inv.CompanyName == companyName {
+			return true
+		}
+	}
+	return false
+}
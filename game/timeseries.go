@@ -0,0 +1,87 @@
+package game
+
+// TurnSnapshot is the fund's key numbers at the end of one turn
+type TurnSnapshot struct {
+	Turn          int
+	NetWorth      int64
+	Cash          int64
+	RivalNetWorth map[string]int64 // By AI firm
+	Valuations    map[string]int64 // By portfolio company
+}
+
+// RecordSnapshot captures this turn's numbers for charts and post-game history
+func (gs *GameState) RecordSnapshot() {
+	snap := TurnSnapshot{
+		Turn:          gs.Portfolio.Turn,
+		NetWorth:      gs.Portfolio.NetWorth,
+		Cash:          gs.Portfolio.Cash,
+		RivalNetWorth: map[string]int64{},
+		Valuations:    map[string]int64{},
+	}
+	for _, ai := range gs.AIPlayers {
+		snap.RivalNetWorth[ai.Firm] = ai.Portfolio.NetWorth
+	}
+	for _, inv := range gs.Portfolio.Investments {
+		snap.Valuations[inv.CompanyName] = inv.CurrentValuation
+	}
+	gs.History = append(gs.History, snap)
+}
+
+// NetWorthSeries is the player's net worth turn by turn
+func (gs *GameState) NetWorthSeries() []int64 {
+	series := make([]int64, len(gs.History))
+	for i, snap := range gs.History {
+		series[i] = snap.NetWorth
+	}
+	return series
+}
+
+// CashSeries is the fund's cash turn by turn
+func (gs *GameState) CashSeries() []int64 {
+	series := make([]int64, len(gs.History))
+	for i, snap := range gs.History {
+		series[i] = snap.Cash
+	}
+	return series
+}
+
+// RivalSeries is an AI fund's net worth turn by turn
+func (gs *GameState) RivalSeries(firm string) []int64 {
+	series := make([]int64, len(gs.History))
+	for i, snap := range gs.History {
+		series[i] = snap.RivalNetWorth[firm]
+	}
+	return series
+}
+
+// ValuationSeries is a portfolio company's valuation from the turn we first held it
+func (gs *GameState) ValuationSeries(company string) []int64 {
+	series := []int64{}
+	for _, snap := range gs.History {
+		if v, ok := snap.Valuations[company]; ok {
+			series = append(series, v)
+		}
+	}
+	return series
+}
+
+// HistorySeries returns every recorded series by name, for saving with the game
+func (gs *GameState) HistorySeries() map[string][]int64 {
+	series := map[string][]int64{
+		"net_worth": gs.NetWorthSeries(),
+		"cash":      gs.CashSeries(),
+	}
+	for _, ai := range gs.AIPlayers {
+		series["rival:"+ai.Firm] = gs.RivalSeries(ai.Firm)
+	}
+	seen := map[string]bool{}
+	for _, snap := range gs.History {
+		for company := range snap.Valuations {
+			if !seen[company] {
+				seen[company] = true
+				series["valuation:"+company] = gs.ValuationSeries(company)
+			}
+		}
+	}
+	return series
+}
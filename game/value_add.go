@@ -2,7 +2,6 @@ package game
 
 import (
 	"fmt"
-	"math/rand"
 )
 
 // ValueAddAction represents operational support provided to portfolio companies
@@ -179,7 +178,7 @@ func (gs *GameState) ProvideValueAdd(companyName string, actionTypeID string) er
 	// Check if can provide
 	can, reason := gs.CanProvideValueAdd(companyName, *actionType)
 	if !can {
-		return fmt.Errorf(reason)
+		return fmt.Errorf("%s", reason)
 	}
 
 	// Find the investment
@@ -195,12 +194,12 @@ func (gs *GameState) ProvideValueAdd(companyName string, actionTypeID string) er
 
 	// Calculate actual values within ranges
 	relationshipIncrease := actionType.MinRelationship +
-		rand.Float64()*(actionType.MaxRelationship-actionType.MinRelationship)
+		gs.rng.Float64()*(actionType.MaxRelationship-actionType.MinRelationship)
 
 	valuationBoost := 0.0
 	if actionType.MaxValBoost > 0 {
 		valuationBoost = actionType.MinValBoost +
-			rand.Float64()*(actionType.MaxValBoost-actionType.MinValBoost)
+			gs.rng.Float64()*(actionType.MaxValBoost-actionType.MinValBoost)
 	}
 
 	// Create the action
@@ -0,0 +1,207 @@
+package investors
+
+import (
+	"math"
+	"strings"
+)
+
+// Persona is a venture firm that shows up in both game modes: as an AI rival in
+// VC mode and as a prospective investor in Founder mode
+type Persona struct {
+	Name          string // Partner who sits on boards
+	Firm          string
+	Strategy      string  // "conservative", "aggressive", "balanced", "early_stage", "mega_fund", "seed_focused", "enterprise_focused", "deep_tech", "consumer_focused"
+	RiskTolerance float64 // 0-1
+	Thesis        string
+	Stages        []string // Rounds the firm leads
+	Sectors       []string // Preferred sectors (empty = generalist)
+}
+
+// Roster is every firm in the shared investor model
+var Roster = []Persona{
+	{
+		Name:          "CARL",
+		Firm:          "Sterling & Cooper",
+		Strategy:      "conservative",
+		RiskTolerance: 0.3,
+		Thesis:        "Capital-efficient businesses with a path to profit",
+		Stages:        []string{"Seed", "Series A", "Series B"},
+	},
+	{
+		Name:          "Sarah Chen",
+		Firm:          "Accel Partners",
+		Strategy:      "aggressive",
+		RiskTolerance: 0.8,
+		Thesis:        "Category leaders growing faster than anyone else",
+		Stages:        []string{"Series A", "Series B"},
+	},
+	{
+		Name:          "Marcus Williams",
+		Firm:          "Sequoia Capital",
+		Strategy:      "balanced",
+		RiskTolerance: 0.5,
+		Thesis:        "Legendary companies, from idea to IPO",
+		Stages:        []string{"Seed", "Series A", "Series B", "Series C"},
+	},
+	{
+		Name:          "Alex Rodriguez",
+		Firm:          "Tiger Global",
+		Strategy:      "aggressive",
+		RiskTolerance: 0.85, // Very aggressive growth investor
+		Thesis:        "Fast growth at scale, priced to win the deal",
+		Stages:        []string{"Series B", "Series C", "Series D"},
+	},
+	{
+		Name:          "Jessica Park",
+		Firm:          "Y Combinator",
+		Strategy:      "early_stage",
+		RiskTolerance: 0.6, // Early-stage specialist, moderate risk
+		Thesis:        "Make something people want",
+		Stages:        []string{"Pre-Seed", "Seed"},
+	},
+	{
+		Name:          "Raj Patel",
+		Firm:          "SoftBank Vision Fund",
+		Strategy:      "mega_fund",
+		RiskTolerance: 0.75, // Mega-fund, high valuations
+		Thesis:        "Blitzscale the winner of every market",
+		Stages:        []string{"Series C", "Series D"},
+	},
+	{
+		Name:          "David Kim",
+		Firm:          "Andreessen Horowitz",
+		Strategy:      "balanced",
+		RiskTolerance: 0.55, // Balanced approach, tech-focused
+		Thesis:        "Software is eating the world",
+		Stages:        []string{"Seed", "Series A", "Series B", "Series C"},
+		Sectors:       []string{"SaaS", "FinTech", "DeepTech", "HealthTech"},
+	},
+	{
+		Name:          "Lisa Thompson",
+		Firm:          "Benchmark Capital",
+		Strategy:      "conservative",
+		RiskTolerance: 0.35, // Conservative, quality-focused
+		Thesis:        "A few great companies, with a partner on every board",
+		Stages:        []string{"Series A"},
+	},
+	{
+		Name:          "Michael Chen",
+		Firm:          "First Round Capital",
+		Strategy:      "seed_focused",
+		RiskTolerance: 0.65, // Seed-stage specialist, higher risk tolerance
+		Thesis:        "First institutional check for exceptional founders",
+		Stages:        []string{"Pre-Seed", "Seed"},
+	},
+	{
+		Name:          "Emily Rodriguez",
+		Firm:          "Greylock Partners",
+		Strategy:      "enterprise_focused",
+		RiskTolerance: 0.45, // Enterprise SaaS focus, moderate risk
+		Thesis:        "Enterprise software and the systems governments run on",
+		Stages:        []string{"Seed", "Series A", "Series B"},
+		Sectors:       []string{"SaaS", "GovTech"},
+	},
+	{
+		Name:          "James Wilson",
+		Firm:          "Index Ventures",
+		Strategy:      "deep_tech",
+		RiskTolerance: 0.7, // Deep tech focus, high risk/high reward
+		Thesis:        "Hard technology with long roads and big moats",
+		Stages:        []string{"Seed", "Series A", "Series B"},
+		Sectors:       []string{"DeepTech", "Hardware", "CleanTech", "HealthTech"},
+	},
+	{
+		Name:          "Sophie Martin",
+		Firm:          "Lightspeed Venture Partners",
+		Strategy:      "consumer_focused",
+		RiskTolerance: 0.6, // Consumer products focus, moderate-high risk
+		Thesis:        "Products that consumers love and tell their friends about",
+		Stages:        []string{"Seed", "Series A", "Series B"},
+		Sectors:       []string{"SaaS", "Advertising", "Consumer", "FinTech"},
+	},
+}
+
+// ByFirm looks a persona up by firm name
+func ByFirm(firm string) (Persona, bool) {
+	for _, p := range Roster {
+		if p.Firm == firm {
+			return p, true
+		}
+	}
+	return Persona{}, false
+}
+
+// ThesisFit scores how well a company matches the firm's thesis (0-1)
+func (p Persona) ThesisFit(sector, round string) float64 {
+	fit := 0.15
+	for _, stage := range p.Stages {
+		if stage == round {
+			fit = 0.5
+			break
+		}
+	}
+	if len(p.Sectors) == 0 {
+		return fit + 0.3
+	}
+	for _, s := range p.Sectors {
+		if strings.EqualFold(s, sector) {
+			return fit + 0.5
+		}
+	}
+	return fit
+}
+
+// BoardPatience is how long the firm's director tolerates a miss before pushing (0-1)
+func BoardPatience(strategy string) float64 {
+	switch strategy {
+	case "conservative", "mega_fund":
+		return 0.3
+	case "aggressive":
+		return 0.4
+	case "early_stage", "seed_focused", "deep_tech":
+		return 0.7
+	default:
+		return 0.5
+	}
+}
+
+// ExitBias shifts a director's support for selling the company (+ = takes the money)
+func ExitBias(strategy string) float64 {
+	switch strategy {
+	case "aggressive", "mega_fund":
+		return -0.15 // Swinging for a bigger outcome
+	case "conservative":
+		return 0.1
+	}
+	return 0
+}
+
+// SpendingBias shifts a director's support for bigger budgets and burn
+func SpendingBias(strategy string) float64 {
+	switch strategy {
+	case "conservative":
+		return -0.15
+	case "aggressive", "mega_fund":
+		return 0.1
+	}
+	return 0
+}
+
+// FollowOnWillingness is the chance the firm takes its pro-rata in the next round.
+// performance runs from 0 (struggling) to 1 (breakout).
+func FollowOnWillingness(strategy string, riskTolerance, performance float64) float64 {
+	chance := 0.2 + performance*0.6 + (riskTolerance-0.5)*0.3
+	switch strategy {
+	case "conservative":
+		if performance < 0.5 {
+			chance -= 0.2 // Doesn't throw good money after bad
+		}
+	case "mega_fund", "aggressive":
+		if performance > 0.6 {
+			chance += 0.15 // Doubles down on winners
+		}
+	case "early_stage", "seed_focused":
+		chance -= 0.1 // Reserves are thin at the seed funds
+	}
+	return math.Max(0.05, math.Min(0.95, chance))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -13,6 +14,9 @@ const (
 	// Production URLs for Vercel deployment
 	DefaultAPIEndpoint        = "https://unicorn-green.vercel.app/api/submit-score"
 	DefaultFounderAPIEndpoint = "https://unicorn-green.vercel.app/api/submit-founder-score"
+	DefaultTournamentEndpoint = "https://unicorn-green.vercel.app/api/get-tournament-results"
+	DefaultDailyAPIEndpoint   = "https://unicorn-green.vercel.app/api/submit-daily-score"
+	DefaultDailyEndpoint      = "https://unicorn-green.vercel.app/api/get-daily-leaderboard"
 )
 
 // ScoreSubmission represents a VC mode score to be submitted to the global leaderboard
@@ -23,6 +27,38 @@ type ScoreSubmission struct {
 	SuccessfulExits int     `json:"successful_exits"`
 	TurnsPlayed     int     `json:"turns_played"`
 	Difficulty      string  `json:"difficulty"`
+	TournamentID    string  `json:"tournament_id,omitempty"`    // Set when the game was a tournament round
+	TournamentRound int     `json:"tournament_round,omitempty"` // 1 for the first round
+}
+
+// TournamentScore is a round result another player submitted under a tournament ID
+type TournamentScore struct {
+	PlayerName      string    `json:"player_name"`
+	Round           int       `json:"round"`
+	FinalNetWorth   int64     `json:"final_net_worth"`
+	ROI             float64   `json:"roi"`
+	SuccessfulExits int       `json:"successful_exits"`
+	PlayedAt        time.Time `json:"played_at"`
+}
+
+// DailyScoreSubmission is a finished daily challenge for the daily leaderboard
+type DailyScoreSubmission struct {
+	PlayerName string  `json:"player_name"`
+	Date       string  `json:"date"` // YYYY-MM-DD
+	Mode       string  `json:"mode"`
+	Modifier   string  `json:"modifier"`
+	Score      int64   `json:"score"` // Net worth in VC games, exit payout in founder games
+	ROI        float64 `json:"roi"`
+}
+
+// DailyScore is a score on the daily leaderboard
+type DailyScore struct {
+	PlayerName string    `json:"player_name"`
+	Mode       string    `json:"mode"`
+	Modifier   string    `json:"modifier"`
+	Score      int64     `json:"score"`
+	ROI        float64   `json:"roi"`
+	PlayedAt   time.Time `json:"played_at"`
 }
 
 // FounderScoreSubmission represents a Founder mode score to be submitted to the global leaderboard
@@ -152,6 +188,121 @@ func SubmitFounderScore(submission FounderScoreSubmission, apiURL string) error
 	return nil
 }
 
+// GetTournamentScores fetches every round result submitted under a tournament ID
+func GetTournamentScores(tournamentID, apiURL string) ([]TournamentScore, error) {
+	if apiURL == "" {
+		apiURL = DefaultTournamentEndpoint
+	}
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(apiURL + "?tournament_id=" + url.QueryEscape(tournamentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var result struct {
+		Scores []TournamentScore `json:"scores"`
+		Error  string            `json:"error"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("API error: %s", result.Error)
+	}
+
+	return result.Scores, nil
+}
+
+// SubmitDailyScore submits a finished daily challenge to the daily leaderboard
+func SubmitDailyScore(submission DailyScoreSubmission, apiURL string) error {
+	if apiURL == "" {
+		apiURL = DefaultDailyAPIEndpoint
+	}
+
+	jsonData, err := json.Marshal(submission)
+	if err != nil {
+		return fmt.Errorf("failed to encode submission: %v", err)
+	}
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	req, err := http.NewRequest("POST", apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Unicorn-Game/1.0")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return fmt.Errorf("failed to parse response: %v", err)
+	}
+	if !apiResp.Success {
+		return fmt.Errorf("API error: %s", apiResp.Message)
+	}
+
+	return nil
+}
+
+// GetDailyScores fetches the daily leaderboard for a date (YYYY-MM-DD), best first
+func GetDailyScores(date, apiURL string) ([]DailyScore, error) {
+	if apiURL == "" {
+		apiURL = DefaultDailyEndpoint
+	}
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(apiURL + "?date=" + url.QueryEscape(date))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var result struct {
+		Scores []DailyScore `json:"scores"`
+		Error  string       `json:"error"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("API error: %s", result.Error)
+	}
+
+	return result.Scores, nil
+}
+
 // IsAPIAvailable checks if the leaderboard API is reachable
 func IsAPIAvailable(apiURL string) bool {
 	if apiURL == "" {
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/game"
+	"github.com/jamesacampbell/unicorn/netplay"
+	"github.com/jamesacampbell/unicorn/tui"
+)
+
+const joinUsage = `usage: unicorn join <host[:port]> [name]
+
+  name defaults to the active local profile`
+
+// runHostCommand handles `unicorn host`: run a game for remote players
+func runHostCommand(args []string) error {
+	flags := flag.NewFlagSet("host", flag.ContinueOnError)
+	port := flags.Int("port", netplay.DefaultPort, "port to listen on")
+	players := flags.Int("players", 2, fmt.Sprintf("players to wait for (2-%d)", game.MaxSeats))
+	difficulty := flags.String("difficulty", "medium", "easy, medium, hard or expert")
+	timer := flags.Duration("timer", 2*time.Minute, "turn timer, e.g. 90s; 0 waits for everyone")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var level game.Difficulty
+	for _, d := range []game.Difficulty{game.EasyDifficulty, game.MediumDifficulty, game.HardDifficulty, game.ExpertDifficulty} {
+		if strings.EqualFold(d.Name, *difficulty) {
+			level = d
+		}
+	}
+	if level.Name == "" {
+		return fmt.Errorf("unknown difficulty: %s", *difficulty)
+	}
+
+	srv, err := netplay.NewServer(netplay.Config{
+		Players:    *players,
+		Difficulty: level,
+		TurnTimer:  *timer,
+		Seed:       time.Now().UnixNano(),
+		Logf: func(format string, args ...interface{}) {
+			fmt.Printf(format+"\n", args...)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf(":%d", *port)
+	errs := make(chan error, 1)
+	go func() { errs <- srv.ListenAndServe(addr) }()
+	fmt.Printf("🦄 Hosting a %d-player %s game on port %d\n", *players, level.Name, *port)
+	fmt.Printf("   Players join with: unicorn join <this machine>:%d <name>\n", *port)
+
+	select {
+	case err := <-errs:
+		return err
+	case <-srv.Done():
+	}
+
+	fmt.Println("\n🏆 Final standings")
+	for i, entry := range srv.Standings() {
+		who := "🤖"
+		if entry.IsHuman {
+			who = "👤"
+		}
+		fmt.Printf("  %s %d. %-20s %-26s $%d (%.0f%%)\n", who, i+1, entry.Name, entry.Firm, entry.NetWorth, entry.ROI)
+	}
+
+	// Give clients a moment to draw the final standings before hanging up
+	time.Sleep(3 * time.Second)
+	return srv.Close()
+}
+
+// runJoinCommand handles `unicorn join`: play in someone else's hosted game
+func runJoinCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("%s", joinUsage)
+	}
+	addr := args[0]
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, fmt.Sprint(netplay.DefaultPort))
+	}
+
+	name := ""
+	if len(args) > 1 {
+		name = args[1]
+	} else {
+		store, err := database.NewSQLiteStore(database.DefaultPath())
+		if err != nil {
+			return err
+		}
+		if profiles, err := store.ListProfiles(); err == nil && len(profiles) > 0 {
+			name = profiles[0].Name
+		}
+		store.Close()
+	}
+	if name == "" {
+		return fmt.Errorf("no local profile yet - pass a name: %s", joinUsage)
+	}
+
+	client, err := netplay.Dial(addr, name, "")
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return tui.RunNetClient(client)
+}
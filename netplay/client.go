@@ -0,0 +1,200 @@
+package netplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// Reconnect policy when the host drops out from under a client
+const (
+	reconnectAttempts = 10
+	reconnectDelay    = 2 * time.Second
+	dialTimeout       = 5 * time.Second
+)
+
+// Event is news from the host for the client's UI
+type Event struct {
+	View   View   // Latest view, set on every state update
+	Status string // Connection news such as reconnect attempts; empty once connected
+	Err    string // The host refused an action
+	Closed bool   // The client gave up on the host
+}
+
+// Client is one player's connection to a host
+type Client struct {
+	addr string
+	name string
+	firm string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	enc    *json.Encoder
+	view   View
+	closed bool
+
+	events chan Event
+	retry  time.Duration
+}
+
+// Dial joins the game hosted at addr as name. A player who drops out can Dial
+// again with the same name to get their seat back.
+func Dial(addr, name, firm string) (*Client, error) {
+	c := &Client{
+		addr:   addr,
+		name:   name,
+		firm:   firm,
+		events: make(chan Event, 64),
+		retry:  reconnectDelay,
+	}
+	dec, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	go c.readLoop(dec)
+	return c, nil
+}
+
+// Events delivers state updates, refused actions and connection news
+func (c *Client) Events() <-chan Event {
+	return c.events
+}
+
+// View is the client's latest copy of the game
+func (c *Client) View() View {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.view
+}
+
+// Send asks the host to carry out an action
+func (c *Client) Send(a Action) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.enc == nil {
+		return fmt.Errorf("not connected to %s", c.addr)
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := c.enc.Encode(Message{Type: MsgAction, Action: &a}); err != nil {
+		return fmt.Errorf("failed to send action: %v", err)
+	}
+	return nil
+}
+
+// Close leaves the game; the seat stays open on the host for a reconnect
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// connect dials the host, says hello and waits for the first view
+func (c *Client) connect() (*json.Decoder, error) {
+	conn, err := net.DialTimeout("tcp", c.addr, dialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %v", c.addr, err)
+	}
+	enc := json.NewEncoder(conn)
+	dec := json.NewDecoder(bufio.NewReader(conn))
+
+	if err := enc.Encode(Message{Type: MsgHello, Version: ProtocolVersion, Name: c.name, Firm: c.firm}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join %s: %v", c.addr, err)
+	}
+	var first Message
+	conn.SetReadDeadline(time.Now().Add(helloTimeout))
+	if err := dec.Decode(&first); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join %s: %v", c.addr, err)
+	}
+	conn.SetReadDeadline(time.Time{})
+	if first.Type == MsgError {
+		conn.Close()
+		return nil, fmt.Errorf("%s", first.Error)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		conn.Close()
+		return nil, fmt.Errorf("client closed")
+	}
+	c.conn = conn
+	c.enc = enc
+	if err := c.apply(first); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return dec, nil
+}
+
+// apply folds a state message into the view; callers hold mu
+func (c *Client) apply(msg Message) error {
+	if msg.Full {
+		c.view = View{}
+	}
+	return Apply(&c.view, msg.Diff)
+}
+
+func (c *Client) readLoop(dec *json.Decoder) {
+	c.emit(Event{View: c.View()})
+	for {
+		var msg Message
+		if err := dec.Decode(&msg); err != nil {
+			if dec = c.reconnect(); dec == nil {
+				close(c.events)
+				return
+			}
+			continue
+		}
+
+		switch msg.Type {
+		case MsgState:
+			c.mu.Lock()
+			err := c.apply(msg)
+			view := c.view
+			c.mu.Unlock()
+			if err != nil {
+				c.emit(Event{View: view, Err: err.Error()})
+				continue
+			}
+			c.emit(Event{View: view})
+		case MsgError:
+			c.emit(Event{View: c.View(), Err: msg.Error})
+		}
+	}
+}
+
+// reconnect retries the host until it answers or we run out of attempts
+func (c *Client) reconnect() *json.Decoder {
+	c.mu.Lock()
+	closed := c.closed
+	c.enc = nil
+	c.mu.Unlock()
+	if closed {
+		return nil
+	}
+
+	for attempt := 1; attempt <= reconnectAttempts; attempt++ {
+		c.emit(Event{View: c.View(), Status: fmt.Sprintf("Connection lost - reconnecting (%d/%d)...", attempt, reconnectAttempts)})
+		time.Sleep(c.retry)
+		dec, err := c.connect()
+		if err == nil {
+			c.emit(Event{View: c.View()})
+			return dec
+		}
+	}
+	c.emit(Event{View: c.View(), Closed: true, Status: fmt.Sprintf("Lost the host at %s", c.addr)})
+	return nil
+}
+
+func (c *Client) emit(e Event) {
+	c.events <- e
+}
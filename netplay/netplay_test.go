@@ -0,0 +1,130 @@
+package netplay
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/game"
+)
+
+func startHost(t *testing.T, cfg Config) (*Server, string) {
+	t.Helper()
+	srv, err := NewServer(cfg)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go srv.Serve(l)
+	t.Cleanup(func() { srv.Close() })
+	return srv, l.Addr().String()
+}
+
+func join(t *testing.T, addr, name string) *Client {
+	t.Helper()
+	c, err := Dial(addr, name, "")
+	if err != nil {
+		t.Fatalf("%s failed to join: %v", name, err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// waitFor reads events until ok accepts one
+func waitFor(t *testing.T, c *Client, what string, ok func(Event) bool) Event {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e := <-c.Events():
+			if ok(e) {
+				return e
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %s", what)
+		}
+	}
+}
+
+func phase(name string) func(Event) bool {
+	return func(e Event) bool { return e.View.Phase == name }
+}
+
+func TestHostAndClients(t *testing.T) {
+	_, addr := startHost(t, Config{Players: 2, Difficulty: game.EasyDifficulty, Seed: 7})
+
+	ada := join(t, addr, "Ada")
+	waitFor(t, ada, "the lobby", func(e Event) bool { return e.View.Phase == PhaseLobby && len(e.View.Seats) == 1 })
+	if _, err := Dial(addr, "ada", ""); err == nil || !strings.Contains(err.Error(), "already connected") {
+		t.Errorf("expected a second Ada to be refused, got %v", err)
+	}
+
+	grace := join(t, addr, "Grace")
+	waitFor(t, ada, "the invest phase", phase(PhaseInvest))
+	waitFor(t, grace, "the invest phase", phase(PhaseInvest))
+	if _, err := Dial(addr, "Linus", ""); err == nil || !strings.Contains(err.Error(), "already started") {
+		t.Errorf("expected a latecomer to be refused, got %v", err)
+	}
+
+	// Ada gets a quote, then takes the board seat
+	target := grace.View().Startups[0]
+	ada.Send(Action{Kind: ActionQuote, Company: target.Name, Amount: 100000})
+	waitFor(t, ada, "a quote", func(e Event) bool { return e.View.Quote != nil && len(e.View.Quote.Terms) > 0 })
+	if !ada.View().Quote.Terms[0].HasBoardSeat {
+		t.Fatalf("expected preferred terms with a board seat for $100k")
+	}
+	ada.Send(Action{Kind: ActionInvest, Company: target.Name, Amount: 100000, Index: 0})
+	waitFor(t, ada, "Ada's investment", func(e Event) bool { return len(e.View.Investments) == 1 })
+
+	// Grace competes for the same seat and is turned away; her view never shows Ada's fund
+	grace.Send(Action{Kind: ActionInvest, Company: target.Name, Amount: 100000, Index: 0})
+	refused := waitFor(t, grace, "a refusal", func(e Event) bool { return e.Err != "" })
+	if !strings.Contains(refused.Err, "board seat") {
+		t.Errorf("expected Grace to lose the board seat, got %q", refused.Err)
+	}
+	if n := len(grace.View().Investments); n != 0 {
+		t.Errorf("Grace should see only her own fund, saw %d investments", n)
+	}
+
+	ada.Send(Action{Kind: ActionDone})
+	grace.Send(Action{Kind: ActionDone})
+	waitFor(t, ada, "month 2", func(e Event) bool { return e.View.Phase == PhaseTurn && e.View.Month == 2 })
+
+	// Grace drops out and comes back to the same seat
+	grace.Close()
+	waitFor(t, ada, "Grace to disconnect", func(e Event) bool { return len(e.View.Seats) == 2 && !e.View.Seats[1].Connected })
+	back := join(t, addr, "grace")
+	waitFor(t, back, "Grace's seat back", func(e Event) bool {
+		return e.View.Phase == PhaseTurn && e.View.Month == 2 && e.View.PlayerName == "Grace"
+	})
+}
+
+func TestTurnTimer(t *testing.T) {
+	_, addr := startHost(t, Config{Players: 2, Difficulty: game.EasyDifficulty, Seed: 7, TurnTimer: 100 * time.Millisecond})
+
+	ada := join(t, addr, "Ada")
+	join(t, addr, "Grace")
+
+	// Nobody ends their turn, so the timer moves the game along
+	waitFor(t, ada, "the timer to run the first month", func(e Event) bool { return e.View.Phase == PhaseTurn && e.View.Month >= 2 })
+	if ada.View().Deadline.IsZero() {
+		t.Errorf("expected the view to carry the turn deadline")
+	}
+}
+
+func TestStalledClientIsDropped(t *testing.T) {
+	host, client := net.Pipe()
+	defer client.Close()
+	p := &peer{conn: host, out: make(chan Message, 1)}
+
+	// Nothing drains the queue, so the second update overflows it
+	p.queue(Message{Type: MsgState})
+	p.queue(Message{Type: MsgState})
+	if _, err := host.Write([]byte("{}")); err == nil {
+		t.Errorf("expected a client that fell behind to be disconnected")
+	}
+}
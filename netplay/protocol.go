@@ -0,0 +1,192 @@
+// Package netplay runs a VC game over the network: one host process owns the
+// GameState and remote players send it actions over TCP, one JSON message per line.
+package netplay
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/game"
+)
+
+// ProtocolVersion changes whenever host and client stop understanding each other
+const ProtocolVersion = 1
+
+// DefaultPort is where `unicorn host` listens unless told otherwise
+const DefaultPort = 7777
+
+// Message types
+const (
+	MsgHello  = "hello"  // client → host: join or rejoin as Name
+	MsgAction = "action" // client → host: do something in the game
+	MsgState  = "state"  // host → client: what changed in your view
+	MsgError  = "error"  // host → client: an action or join was refused
+)
+
+// Action kinds
+const (
+	ActionQuote    = "quote"     // Ask for term sheets on Company at Amount
+	ActionInvest   = "invest"    // Invest Amount in Company on term sheet Index
+	ActionFollowOn = "follow_on" // Follow on in Company with Amount
+	ActionVote     = "vote"      // Vote on Company's board vote of type Detail
+	ActionDD       = "dd"        // Close (Approve) or walk from diligence decision Index
+	ActionDone     = "done"      // Finished investing, or finished this month
+)
+
+// Game phases
+const (
+	PhaseLobby  = "lobby"
+	PhaseInvest = "invest"
+	PhaseTurn   = "turn"
+	PhaseOver   = "over"
+)
+
+// Message is one line on the wire
+type Message struct {
+	Type    string                     `json:"type"`
+	Version int                        `json:"version,omitempty"`
+	Name    string                     `json:"name,omitempty"`
+	Firm    string                     `json:"firm,omitempty"`
+	Action  *Action                    `json:"action,omitempty"`
+	Full    bool                       `json:"full,omitempty"` // Diff holds the whole view
+	Diff    map[string]json.RawMessage `json:"diff,omitempty"`
+	Error   string                     `json:"error,omitempty"`
+}
+
+// Action is something a player asks the host to do
+type Action struct {
+	Kind    string `json:"kind"`
+	Company string `json:"company,omitempty"`
+	Amount  int64  `json:"amount,omitempty"`
+	Index   int    `json:"index,omitempty"`
+	Approve bool   `json:"approve,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// View is everything one player is allowed to see. Other players' funds only
+// show up as standings.
+type View struct {
+	Phase     string     `json:"phase"`
+	Month     int        `json:"month"`
+	MaxMonths int        `json:"max_months"`
+	Deadline  time.Time  `json:"deadline"` // Zero when the host runs without a turn timer
+	Seats     []SeatView `json:"seats"`
+	Needed    int        `json:"needed"` // Players the host is waiting for before starting
+
+	PlayerName  string            `json:"player_name"`
+	FirmName    string            `json:"firm_name"`
+	Cash        int64             `json:"cash"`
+	NetWorth    int64             `json:"net_worth"`
+	FundSize    int64             `json:"fund_size"`
+	Investments []game.Investment `json:"investments"`
+
+	Startups    []StartupView              `json:"startups"`
+	Quote       *Quote                     `json:"quote"`
+	FollowOns   []game.FollowOnOpportunity `json:"follow_ons"`
+	Votes       []VoteView                 `json:"votes"`
+	DDDecisions []DDView                   `json:"dd_decisions"`
+	News        []string                   `json:"news"`
+	Leaderboard []game.PlayerScore         `json:"leaderboard"`
+}
+
+// SeatView is a player at the table and whether the host is still waiting on them
+type SeatView struct {
+	Name      string `json:"name"`
+	Firm      string `json:"firm"`
+	Connected bool   `json:"connected"`
+	Done      bool   `json:"done"`
+}
+
+// StartupView is a company open for investment
+type StartupView struct {
+	Name            string  `json:"name"`
+	Category        string  `json:"category"`
+	Description     string  `json:"description"`
+	Valuation       int64   `json:"valuation"`
+	RiskScore       float64 `json:"risk"`
+	GrowthPotential float64 `json:"growth"`
+}
+
+// Quote is the term sheets on offer for a proposed check
+type Quote struct {
+	Company string                 `json:"company"`
+	Amount  int64                  `json:"amount"`
+	Terms   []game.InvestmentTerms `json:"terms"`
+}
+
+// VoteView is a board vote waiting on the player
+type VoteView struct {
+	CompanyName  string `json:"company"`
+	VoteType     string `json:"type"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	OptionA      string `json:"option_a"`
+	OptionB      string `json:"option_b"`
+	ConsequenceA string `json:"consequence_a"`
+	ConsequenceB string `json:"consequence_b"`
+}
+
+// DDView is a deal in diligence waiting for a close-or-walk decision
+type DDView struct {
+	CompanyName    string           `json:"company"`
+	Amount         int64            `json:"amount"`
+	Findings       []game.DDFinding `json:"findings"`
+	RoundCloseTurn int              `json:"round_close"`
+}
+
+// Diff returns the top-level view fields that differ between prev and next
+func Diff(prev, next View) (map[string]json.RawMessage, error) {
+	before, err := fields(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := fields(next)
+	if err != nil {
+		return nil, err
+	}
+	return changed(before, after), nil
+}
+
+func changed(before, after map[string]json.RawMessage) map[string]json.RawMessage {
+	diff := map[string]json.RawMessage{}
+	for k, v := range after {
+		if string(before[k]) != string(v) {
+			diff[k] = v
+		}
+	}
+	return diff
+}
+
+// Apply folds a diff from the host into the client's copy of the view
+func Apply(view *View, diff map[string]json.RawMessage) error {
+	merged, err := fields(*view)
+	if err != nil {
+		return err
+	}
+	for k, v := range diff {
+		merged[k] = v
+	}
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return fmt.Errorf("failed to apply state diff: %v", err)
+	}
+	var next View
+	if err := json.Unmarshal(data, &next); err != nil {
+		return fmt.Errorf("failed to apply state diff: %v", err)
+	}
+	*view = next
+	return nil
+}
+
+func fields(v View) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode view: %v", err)
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to encode view: %v", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,582 @@
+package netplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/game"
+)
+
+// helloTimeout is how long a new connection gets to say who it is
+const helloTimeout = 10 * time.Second
+
+// writeTimeout stops one stalled client from holding up the table
+const writeTimeout = 5 * time.Second
+
+// sendQueue is how many updates a client can fall behind before it's dropped
+const sendQueue = 64
+
+// Config is how the host sets up a game
+type Config struct {
+	Players    int // Seats to fill before the game starts (2-6)
+	Difficulty game.Difficulty
+	TurnTimer  time.Duration // 0 waits for every player, however long they take
+	Seed       int64
+	Logf       func(format string, args ...interface{}) // Host console, optional
+}
+
+// Server owns the authoritative GameState and the players connected to it
+type Server struct {
+	cfg Config
+
+	mu       sync.Mutex
+	gs       *game.GameState
+	players  []*player
+	phase    string
+	deadline time.Time
+	round    int // Bumped every phase so a stale timer can't end the next one
+	timer    *time.Timer
+	listener net.Listener
+	done     chan struct{}
+}
+
+// player is one seat at the table, connected or not
+type player struct {
+	name   string
+	firm   string
+	seat   int
+	conn   *peer // nil while disconnected
+	synced bool  // Client holds sent and only needs diffs
+	sent   map[string]json.RawMessage
+	done   bool
+	news   []string
+	quote  *Quote
+}
+
+// peer is one TCP connection
+type peer struct {
+	conn net.Conn
+	enc  *json.Encoder
+	out  chan Message // Written by write, outside the server lock
+}
+
+func (p *peer) send(msg Message) error {
+	p.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return p.enc.Encode(msg)
+}
+
+// queue hands msg to the writer without blocking. A client too far behind is
+// dropped and gets a full sync when it reconnects.
+func (p *peer) queue(msg Message) {
+	select {
+	case p.out <- msg:
+	default:
+		p.conn.Close()
+	}
+}
+
+// write sends queued messages in order until out is closed. A failed write drops
+// the connection so the client reconnects and gets a full sync.
+func (p *peer) write() {
+	for msg := range p.out {
+		if err := p.send(msg); err != nil {
+			p.conn.Close()
+			return
+		}
+	}
+}
+
+// NewServer creates a host for cfg.Players players
+func NewServer(cfg Config) (*Server, error) {
+	if cfg.Players < 2 || cfg.Players > game.MaxSeats {
+		return nil, fmt.Errorf("networked games take 2 to %d players", game.MaxSeats)
+	}
+	if cfg.Difficulty.Name == "" {
+		cfg.Difficulty = game.MediumDifficulty
+	}
+	if cfg.Logf == nil {
+		cfg.Logf = func(string, ...interface{}) {}
+	}
+	return &Server{cfg: cfg, phase: PhaseLobby, done: make(chan struct{})}, nil
+}
+
+// ListenAndServe accepts players on addr until Close
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", addr, err)
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts players on l until Close
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	s.listener = l
+	s.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection: %v", err)
+		}
+		go s.handle(conn)
+	}
+}
+
+// Close stops accepting players and drops everyone connected
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	for _, p := range s.players {
+		if p.conn != nil {
+			p.conn.conn.Close()
+		}
+	}
+	if s.listener != nil {
+		return s.listener.Close()
+	}
+	return nil
+}
+
+// Done is closed once the last month has been played
+func (s *Server) Done() <-chan struct{} {
+	return s.done
+}
+
+// Standings ranks every fund, human and AI
+func (s *Server) Standings() []game.PlayerScore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.gs == nil {
+		return nil
+	}
+	return s.gs.GetLeaderboard()
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	p := &peer{conn: conn, enc: json.NewEncoder(conn), out: make(chan Message, sendQueue)}
+	dec := json.NewDecoder(bufio.NewReader(conn))
+
+	var hello Message
+	conn.SetReadDeadline(time.Now().Add(helloTimeout))
+	if err := dec.Decode(&hello); err != nil || hello.Type != MsgHello {
+		p.send(Message{Type: MsgError, Error: "expected a hello"})
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+	if hello.Version != ProtocolVersion {
+		p.send(Message{Type: MsgError, Error: fmt.Sprintf("host speaks protocol %d, client speaks %d", ProtocolVersion, hello.Version)})
+		return
+	}
+
+	pl, err := s.join(hello.Name, hello.Firm, p)
+	if err != nil {
+		p.send(Message{Type: MsgError, Error: err.Error()})
+		return
+	}
+	// Once seated, everything goes through the writer so messages stay in order
+	go p.write()
+	defer close(p.out)
+	defer s.leave(pl, p)
+
+	for {
+		var msg Message
+		if err := dec.Decode(&msg); err != nil {
+			return
+		}
+		if msg.Type != MsgAction || msg.Action == nil {
+			continue
+		}
+		if err := s.act(pl, *msg.Action); err != nil {
+			s.mu.Lock()
+			if pl.conn == p {
+				p.queue(Message{Type: MsgError, Error: err.Error()})
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// join seats a new player, or puts a returning one back in their seat
+func (s *Server) join(name, firm string, conn *peer) (*player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("every player needs a name")
+	}
+
+	for _, p := range s.players {
+		if !strings.EqualFold(p.name, name) {
+			continue
+		}
+		if p.conn != nil {
+			return nil, fmt.Errorf("%s is already connected", p.name)
+		}
+		p.conn = conn
+		p.synced = false
+		s.cfg.Logf("🔌 %s reconnected", p.name)
+		s.broadcast()
+		return p, nil
+	}
+
+	if s.phase != PhaseLobby {
+		return nil, fmt.Errorf("the game has already started")
+	}
+	if firm == "" {
+		firm = game.GenerateDefaultFirmName(name)
+	}
+	p := &player{name: name, firm: firm, conn: conn}
+	s.players = append(s.players, p)
+	s.cfg.Logf("👋 %s joined (%d/%d)", name, len(s.players), s.cfg.Players)
+
+	if len(s.players) == s.cfg.Players {
+		if err := s.start(); err != nil {
+			s.players = s.players[:len(s.players)-1]
+			return nil, err
+		}
+	}
+	s.broadcast()
+	return p, nil
+}
+
+// leave marks a player disconnected; their seat waits for them once the game is on
+func (s *Server) leave(p *player, conn *peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p.conn != conn {
+		return
+	}
+	p.conn = nil
+	if s.phase == PhaseLobby {
+		for i, q := range s.players {
+			if q == p {
+				s.players = append(s.players[:i], s.players[i+1:]...)
+				break
+			}
+		}
+	}
+	s.cfg.Logf("💤 %s disconnected", p.name)
+	s.broadcast()
+}
+
+func (s *Server) start() error {
+	setups := make([]game.SeatSetup, len(s.players))
+	for i, p := range s.players {
+		setups[i] = game.SeatSetup{PlayerName: p.name, FirmName: p.firm}
+		p.seat = i
+	}
+	gs, err := game.NewHotSeatGame(setups, s.cfg.Difficulty, s.cfg.Seed)
+	if err != nil {
+		return err
+	}
+	s.gs = gs
+	s.cfg.Logf("🚀 Game on: %d players, %s difficulty", len(s.players), s.cfg.Difficulty.Name)
+	s.startPhase(PhaseInvest)
+	return nil
+}
+
+// startPhase resets everyone's done flag and arms the turn timer
+func (s *Server) startPhase(phase string) {
+	s.phase = phase
+	s.round++
+	for _, p := range s.players {
+		p.done = false
+		p.quote = nil
+	}
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.deadline = time.Time{}
+	if s.cfg.TurnTimer > 0 && phase != PhaseOver {
+		s.deadline = time.Now().Add(s.cfg.TurnTimer)
+		round := s.round
+		s.timer = time.AfterFunc(s.cfg.TurnTimer, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.round == round {
+				s.cfg.Logf("⏰ Time's up for month %d", s.gs.Portfolio.Turn)
+				s.endPhase()
+				s.broadcast()
+			}
+		})
+	}
+}
+
+// endPhase moves the game on once everyone is done or the timer runs out
+func (s *Server) endPhase() {
+	if s.phase == PhaseInvest {
+		s.gs.AIPlayerMakeInvestments()
+	}
+
+	s.gs.ProcessTurn()
+	s.gs.EachSeat(s.gs.ProcessPortfolioMonth)
+	for _, p := range s.players {
+		s.gs.SwitchSeat(p.seat)
+		p.news = s.gs.TakeInbox()
+	}
+
+	if s.gs.IsGameOver() {
+		s.startPhase(PhaseOver)
+		s.cfg.Logf("🏁 Game over")
+		close(s.done)
+		return
+	}
+	s.startPhase(PhaseTurn)
+}
+
+// act carries out one player's action against the shared game
+func (s *Server) act(p *player, a Action) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.phase == PhaseLobby || s.phase == PhaseOver {
+		return fmt.Errorf("there's nothing to do right now")
+	}
+	if p.done && a.Kind != ActionDone {
+		return fmt.Errorf("you've already ended your turn")
+	}
+	gs := s.gs
+	gs.SwitchSeat(p.seat)
+
+	var err error
+	switch a.Kind {
+	case ActionQuote, ActionInvest:
+		err = s.invest(p, a)
+	case ActionFollowOn:
+		if s.phase != PhaseTurn {
+			return fmt.Errorf("follow-ons open once the game is under way")
+		}
+		if err = gs.MakeFollowOnInvestment(a.Company, a.Amount); err == nil {
+			p.news = append(p.news, fmt.Sprintf("✓ Followed on in %s with $%s", a.Company, formatMoney(a.Amount)))
+		}
+	case ActionVote:
+		err = s.vote(p, a)
+	case ActionDD:
+		var result string
+		if result, err = gs.ResolveDDDecision(a.Index, a.Approve); err == nil {
+			p.news = append(p.news, result)
+		}
+	case ActionDone:
+		if votes := gs.GetPendingBoardVotes(); len(votes) > 0 && s.phase == PhaseTurn {
+			return fmt.Errorf("the %s board is waiting for your vote", votes[0].CompanyName)
+		}
+		p.done = true
+		if s.allDone() {
+			s.endPhase()
+		}
+	default:
+		return fmt.Errorf("unknown action %q", a.Kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Votes and secondary trades can land news in other players' inboxes
+	if s.gs.IsHotSeat() && s.phase != PhaseOver {
+		for _, q := range s.players {
+			gs.SwitchSeat(q.seat)
+			q.news = append(q.news, gs.TakeInbox()...)
+		}
+	}
+	s.broadcast()
+	return nil
+}
+
+func (s *Server) invest(p *player, a Action) error {
+	gs := s.gs
+	if s.phase != PhaseInvest {
+		return fmt.Errorf("new investments are only made before the first month")
+	}
+	idx := -1
+	for i, startup := range gs.AvailableStartups {
+		if startup.Name == a.Company {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("no startup called %s", a.Company)
+	}
+	options := gs.GenerateTermOptions(&gs.AvailableStartups[idx], a.Amount)
+
+	if a.Kind == ActionQuote {
+		p.quote = &Quote{Company: a.Company, Amount: a.Amount, Terms: options}
+		return nil
+	}
+
+	if a.Index < 0 || a.Index >= len(options) {
+		return fmt.Errorf("no such term sheet")
+	}
+	terms := options[a.Index]
+	if err := gs.MakeInvestmentWithTerms(idx, a.Amount, terms); err != nil {
+		return err
+	}
+	inv := &gs.Portfolio.Investments[len(gs.Portfolio.Investments)-1]
+	inv.FounderName = gs.FounderNameFor(inv.CompanyName)
+	inv.RelationshipScore = gs.CalculateInitialRelationship(terms, false, a.Amount)
+	inv.LastInteraction = gs.Portfolio.Turn
+	p.quote = nil
+	p.news = append(p.news, fmt.Sprintf("✓ Invested $%s in %s (%s)", formatMoney(a.Amount), a.Company, terms.Type))
+	return nil
+}
+
+func (s *Server) vote(p *player, a Action) error {
+	gs := s.gs
+	for i, v := range gs.PendingBoardVotes {
+		if v.CompanyName != a.Company || v.VoteType != a.Detail {
+			continue
+		}
+		choice := "B"
+		if a.Approve {
+			choice = "A"
+		}
+		result, passed, err := gs.ProcessBoardVote(i, choice)
+		if err != nil {
+			return err
+		}
+		p.news = append(p.news, result)
+		p.news = append(p.news, gs.ExecuteBoardVoteOutcome(v, passed)...)
+		return nil
+	}
+	return fmt.Errorf("no %s vote pending at %s", a.Detail, a.Company)
+}
+
+func (s *Server) allDone() bool {
+	for _, p := range s.players {
+		if !p.done {
+			return false
+		}
+	}
+	return true
+}
+
+// broadcast queues every connected player what changed in their view. The writes
+// happen on each peer's writer, so a stalled client never holds the lock.
+func (s *Server) broadcast() {
+	for _, p := range s.players {
+		if p.conn == nil {
+			continue
+		}
+		// Encode now: the view shares slices with the game, which keeps moving
+		view, err := fields(s.view(p))
+		if err != nil {
+			s.cfg.Logf("❌ %v", err)
+			continue
+		}
+		if !p.synced {
+			p.sent = nil
+		}
+		diff := changed(p.sent, view)
+		if len(diff) == 0 && p.synced {
+			continue
+		}
+		p.conn.queue(Message{Type: MsgState, Full: !p.synced, Diff: diff})
+		p.sent = view
+		p.synced = true
+	}
+}
+
+// view is what p is allowed to see of the game
+func (s *Server) view(p *player) View {
+	v := View{
+		Phase:      s.phase,
+		Deadline:   s.deadline,
+		Needed:     s.cfg.Players,
+		PlayerName: p.name,
+		FirmName:   p.firm,
+		News:       p.news,
+	}
+	for _, q := range s.players {
+		v.Seats = append(v.Seats, SeatView{Name: q.name, Firm: q.firm, Connected: q.conn != nil, Done: q.done})
+	}
+	if s.gs == nil {
+		return v
+	}
+
+	gs := s.gs
+	gs.SwitchSeat(p.seat)
+	v.Month = gs.Portfolio.Turn
+	v.MaxMonths = gs.Portfolio.MaxTurns
+	v.Cash = gs.Portfolio.Cash
+	v.NetWorth = gs.Portfolio.NetWorth
+	v.FundSize = gs.Portfolio.InitialFundSize
+	v.Investments = gs.Portfolio.Investments
+	v.Quote = p.quote
+	v.Leaderboard = gs.GetLeaderboard()
+
+	if s.phase == PhaseInvest {
+		owned := map[string]bool{}
+		for _, inv := range gs.Portfolio.Investments {
+			owned[inv.CompanyName] = true
+		}
+		for _, startup := range gs.AvailableStartups {
+			if owned[startup.Name] {
+				continue
+			}
+			v.Startups = append(v.Startups, StartupView{
+				Name:            startup.Name,
+				Category:        startup.Category,
+				Description:     startup.Description,
+				Valuation:       startup.Valuation,
+				RiskScore:       startup.RiskScore,
+				GrowthPotential: startup.GrowthPotential,
+			})
+		}
+	}
+	if s.phase == PhaseTurn {
+		v.FollowOns = gs.GetFollowOnOpportunities()
+		for _, vote := range gs.GetPendingBoardVotes() {
+			v.Votes = append(v.Votes, VoteView{
+				CompanyName:  vote.CompanyName,
+				VoteType:     vote.VoteType,
+				Title:        vote.Title,
+				Description:  vote.Description,
+				OptionA:      vote.OptionA,
+				OptionB:      vote.OptionB,
+				ConsequenceA: vote.ConsequenceA,
+				ConsequenceB: vote.ConsequenceB,
+			})
+		}
+		for _, dd := range gs.PendingDDDecisions {
+			v.DDDecisions = append(v.DDDecisions, DDView{
+				CompanyName:    dd.CompanyName,
+				Amount:         dd.InvestmentAmount,
+				Findings:       dd.Findings,
+				RoundCloseTurn: dd.RoundCloseTurn,
+			})
+		}
+	}
+	return v
+}
+
+// formatMoney formats whole dollars with thousands separators
+func formatMoney(amount int64) string {
+	if amount < 0 {
+		return "-" + formatMoney(-amount)
+	}
+	s := fmt.Sprintf("%d", amount)
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteRune(',')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,405 @@
+// Package profile moves a player's progress between machines: a signed, versioned
+// archive that can be merged into another database, plus CSV exports for spreadsheets.
+package profile
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+)
+
+// ArchiveVersion is the archive format this build writes. Older versions import fine;
+// newer ones are refused.
+const ArchiveVersion = 1
+
+// signingKey catches corrupted or hand-edited archives. It ships with the game, so
+// it proves an archive came from unicorn, not who exported it.
+var signingKey = []byte("unicorn-profile-archive-v1")
+
+// allRows asks the store for every row instead of the top N
+const allRows = -1
+
+// Archive is everything we know about one player
+type Archive struct {
+	Version      int                          `json:"version"`
+	ExportedAt   time.Time                    `json:"exported_at"`
+	PlayerName   string                       `json:"player_name"`
+	Profile      database.PlayerProfile       `json:"profile"`
+	Achievements []string                     `json:"achievements"`
+	Upgrades     []string                     `json:"upgrades"`
+	Progress     []database.ProgressInfo      `json:"progress"`
+	Reputation   *database.VCReputation       `json:"reputation,omitempty"`
+	Career       database.Career              `json:"career"`
+	Network      []string                     `json:"network"`
+	Scores       []database.GameScore         `json:"scores"`
+	Investments  []database.InvestmentHistory `json:"investments"`
+}
+
+// MergeReport says what an import changed
+type MergeReport struct {
+	ProfileUpdated    bool
+	AchievementsAdded int
+	UpgradesAdded     int
+	ProgressUpdated   int
+	ReputationUpdated bool
+	CareerUpdated     bool
+	ScoresAdded       int
+	InvestmentsAdded  int
+}
+
+// Build collects a player's data from the store
+func Build(store database.Store, playerName string) (*Archive, error) {
+	local, err := store.GetProfile(playerName)
+	if err != nil {
+		return nil, err
+	}
+	if local == nil {
+		return nil, fmt.Errorf("no profile named %s", playerName)
+	}
+
+	a := &Archive{
+		Version:    ArchiveVersion,
+		ExportedAt: time.Now(),
+		PlayerName: playerName,
+	}
+
+	profile, err := store.GetPlayerProfile(playerName)
+	if err != nil {
+		return nil, err
+	}
+	a.Profile = *profile
+
+	if a.Achievements, err = store.GetPlayerAchievements(playerName); err != nil {
+		return nil, err
+	}
+	if a.Upgrades, err = store.GetPlayerUpgrades(playerName); err != nil {
+		return nil, err
+	}
+	progress, err := store.GetAllProgress(playerName)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range progress {
+		a.Progress = append(a.Progress, info)
+	}
+	if store.HasVCReputation(playerName) {
+		if a.Reputation, err = store.GetVCReputation(playerName); err != nil {
+			return nil, err
+		}
+	}
+	career, err := store.GetCareer(playerName)
+	if err != nil {
+		return nil, err
+	}
+	a.Career = *career
+	if a.Network, err = store.GetNetworkContacts(playerName); err != nil {
+		return nil, err
+	}
+	if a.Scores, err = store.GetTopScoresByPlayer(playerName, allRows); err != nil {
+		return nil, err
+	}
+	if a.Investments, err = store.GetInvestmentHistory(playerName); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Write saves the archive as a ZIP: the signed profile.json plus games.csv and
+// investments.csv for spreadsheets
+func (a *Archive) Write(w io.Writer) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode profile: %v", err)
+	}
+
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{"profile.json", func(w io.Writer) error { _, err := w.Write(data); return err }},
+		{"profile.sig", func(w io.Writer) error { _, err := io.WriteString(w, sign(data)); return err }},
+		{"games.csv", func(w io.Writer) error { return WriteGamesCSV(w, a.Scores) }},
+		{"investments.csv", func(w io.Writer) error { return WriteInvestmentsCSV(w, a.Investments) }},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %v", f.name, err)
+		}
+		if err := f.write(fw); err != nil {
+			return fmt.Errorf("failed to write %s: %v", f.name, err)
+		}
+	}
+	return zw.Close()
+}
+
+// WriteFile saves the archive to path
+func (a *Archive) WriteFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", path, err)
+	}
+	if err := a.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadFile opens an exported archive, checking its signature and version
+func ReadFile(path string) (*Archive, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a profile archive: %v", path, err)
+	}
+
+	data, err := readZipFile(zr, "profile.json")
+	if err != nil {
+		return nil, err
+	}
+	sig, err := readZipFile(zr, "profile.sig")
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(sign(data)), bytes.TrimSpace(sig)) {
+		return nil, fmt.Errorf("profile archive signature doesn't match - the file was modified or corrupted")
+	}
+
+	var a Archive
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, fmt.Errorf("failed to decode profile: %v", err)
+	}
+	if a.Version > ArchiveVersion {
+		return nil, fmt.Errorf("profile archive v%d is newer than this build supports (v%d) - please upgrade unicorn",
+			a.Version, ArchiveVersion)
+	}
+	return &a, nil
+}
+
+func readZipFile(zr *zip.Reader, name string) ([]byte, error) {
+	f, err := zr.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("profile archive is missing %s", name)
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func sign(data []byte) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Merge folds an archive into the store under playerName (the archive's own name if
+// empty). Nothing already earned is lost: achievements and upgrades are unioned, and
+// for XP, progress, reputation and career the further-along copy wins. Games and
+// investments this store hasn't seen are added. The merge is one transaction, so a
+// failed import changes nothing.
+func (a *Archive) Merge(store database.Store, playerName string) (*MergeReport, error) {
+	if playerName == "" {
+		playerName = a.PlayerName
+	}
+	var report *MergeReport
+	err := store.InTransaction(func(tx database.Store) error {
+		var err error
+		report, err = a.merge(tx, playerName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (a *Archive) merge(store database.Store, playerName string) (*MergeReport, error) {
+	report := &MergeReport{}
+
+	// Local profile, so the imported player shows up in the profile picker
+	local, err := store.GetProfile(playerName)
+	if err != nil {
+		return nil, err
+	}
+	if local == nil {
+		if _, err := store.CreateProfile(playerName); err != nil {
+			return nil, err
+		}
+	}
+
+	// Profile: keep whichever copy has more XP
+	current, err := store.GetPlayerProfile(playerName)
+	if err != nil {
+		return nil, err
+	}
+	if a.Profile.Level > current.Level ||
+		(a.Profile.Level == current.Level && a.Profile.ExperiencePoints > current.ExperiencePoints) {
+		imported := a.Profile
+		imported.PlayerName = playerName
+		if err := store.SavePlayerProfile(&imported); err != nil {
+			return nil, err
+		}
+		report.ProfileUpdated = true
+	}
+
+	// Achievements and upgrades: union
+	have, err := store.GetPlayerAchievements(playerName)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range a.Achievements {
+		if !contains(have, id) {
+			if err := store.UnlockAchievement(playerName, id); err != nil {
+				return nil, err
+			}
+			report.AchievementsAdded++
+		}
+	}
+	owned, err := store.GetPlayerUpgrades(playerName)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range a.Upgrades {
+		if !contains(owned, id) {
+			if err := store.PurchaseUpgrade(playerName, id); err != nil {
+				return nil, err
+			}
+			report.UpgradesAdded++
+		}
+	}
+
+	// Progress: keep the higher count
+	progress, err := store.GetAllProgress(playerName)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range a.Progress {
+		if info.CurrentProgress > progress[info.AchievementID].CurrentProgress {
+			if err := store.UpdateAchievementProgress(playerName, info.AchievementID, info.CurrentProgress, info.MaxProgress); err != nil {
+				return nil, err
+			}
+			report.ProgressUpdated++
+		}
+	}
+
+	// Reputation: keep the one built from more games
+	if a.Reputation != nil {
+		rep, err := store.GetVCReputation(playerName)
+		if err != nil {
+			return nil, err
+		}
+		if !store.HasVCReputation(playerName) || a.Reputation.TotalGamesPlayed > rep.TotalGamesPlayed {
+			imported := *a.Reputation
+			imported.PlayerName = playerName
+			if err := store.SaveVCReputation(&imported); err != nil {
+				return nil, err
+			}
+			report.ReputationUpdated = true
+		}
+	}
+
+	// Career: keep the richer one, logged as a career event so the history adds up
+	career, err := store.GetCareer(playerName)
+	if err != nil {
+		return nil, err
+	}
+	if a.Career.NetWorth > career.NetWorth {
+		career.FounderExits = max(career.FounderExits, a.Career.FounderExits)
+		career.FundsAnchored = max(career.FundsAnchored, a.Career.FundsAnchored)
+		career.AngelGames = max(career.AngelGames, a.Career.AngelGames)
+		if err := store.RecordCareerEvent(career, "import", "Imported career", a.Career.NetWorth-career.NetWorth); err != nil {
+			return nil, err
+		}
+		report.CareerUpdated = true
+	}
+	if len(a.Network) > 0 {
+		if err := store.AddNetworkContacts(playerName, "imported", a.Network); err != nil {
+			return nil, err
+		}
+	}
+
+	// Scores: add games this store hasn't seen
+	existing, err := store.GetTopScoresByPlayer(playerName, allRows)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, s := range existing {
+		seen[scoreKey(s)] = true
+	}
+	for _, s := range a.Scores {
+		if seen[scoreKey(s)] {
+			continue
+		}
+		s.PlayerName = playerName
+		if err := store.SaveGameScore(s); err != nil {
+			return nil, err
+		}
+		seen[scoreKey(s)] = true
+		report.ScoresAdded++
+	}
+
+	// Investments: add positions this store hasn't seen, grouped back into their games.
+	// The archive carries positions, not game totals, so only the positions come back.
+	history, err := store.GetInvestmentHistory(playerName)
+	if err != nil {
+		return nil, err
+	}
+	seen = map[string]bool{}
+	for _, inv := range history {
+		seen[investmentKey(inv)] = true
+	}
+	var gameIDs []int64
+	games := map[int64][]database.InvestmentHistory{}
+	for _, inv := range a.Investments {
+		if seen[investmentKey(inv)] {
+			continue
+		}
+		if _, ok := games[inv.GameID]; !ok {
+			gameIDs = append(gameIDs, inv.GameID)
+		}
+		games[inv.GameID] = append(games[inv.GameID], inv)
+	}
+	for _, id := range gameIDs {
+		investments := games[id]
+		game := database.GameHistory{PlayerName: playerName, GameMode: "vc", PlayedAt: investments[0].PlayedAt}
+		if err := store.SaveGameHistory(game, investments); err != nil {
+			return nil, err
+		}
+		report.InvestmentsAdded += len(investments)
+	}
+
+	return report, nil
+}
+
+// scoreKey identifies a game across databases, where row IDs differ
+func scoreKey(s database.GameScore) string {
+	return fmt.Sprintf("%d|%d|%s", s.PlayedAt.Unix(), s.FinalNetWorth, s.Difficulty)
+}
+
+// investmentKey identifies a position across databases: its game's time and company
+func investmentKey(inv database.InvestmentHistory) string {
+	return fmt.Sprintf("%d|%s", inv.PlayedAt.Unix(), inv.CompanyName)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
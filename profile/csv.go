@@ -0,0 +1,97 @@
+package profile
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+)
+
+// WriteGamesCSV writes one row per finished game
+func WriteGamesCSV(w io.Writer, scores []database.GameScore) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"played_at", "mode", "difficulty", "final_net_worth", "roi", "successful_exits", "turns_played"})
+	for _, s := range scores {
+		cw.Write([]string{
+			s.PlayedAt.Format(time.RFC3339),
+			s.Mode,
+			s.Difficulty,
+			strconv.FormatInt(s.FinalNetWorth, 10),
+			strconv.FormatFloat(s.ROI, 'f', 2, 64),
+			strconv.Itoa(s.SuccessfulExits),
+			strconv.Itoa(s.TurnsPlayed),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteInvestmentsCSV writes one row per position taken in a recorded VC game
+func WriteInvestmentsCSV(w io.Writer, investments []database.InvestmentHistory) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"game_id", "played_at", "company", "sector", "terms", "dd_level", "outcome",
+		"amount_invested", "entry_valuation", "exit_valuation", "exit_value", "months_held",
+		"relationship_score", "roi"})
+	for _, inv := range investments {
+		cw.Write([]string{
+			strconv.FormatInt(inv.GameID, 10),
+			inv.PlayedAt.Format(time.RFC3339),
+			inv.CompanyName,
+			inv.Sector,
+			inv.TermsType,
+			inv.DDLevel,
+			inv.Outcome,
+			strconv.FormatInt(inv.AmountInvested, 10),
+			strconv.FormatInt(inv.EntryValuation, 10),
+			strconv.FormatInt(inv.ExitValuation, 10),
+			strconv.FormatInt(inv.ExitValue, 10),
+			strconv.Itoa(inv.MonthsHeld),
+			strconv.FormatFloat(inv.RelationshipScore, 'f', 1, 64),
+			strconv.FormatFloat(inv.ROI(), 'f', 2, 64),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ExportCSV writes <player>-games.csv and <player>-investments.csv into dir and
+// returns their paths
+func ExportCSV(store database.Store, playerName, dir string) ([]string, error) {
+	scores, err := store.GetTopScoresByPlayer(playerName, allRows)
+	if err != nil {
+		return nil, err
+	}
+	investments, err := store.GetInvestmentHistory(playerName)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		path  string
+		write func(io.Writer) error
+	}{
+		{filepath.Join(dir, playerName+"-games.csv"), func(w io.Writer) error { return WriteGamesCSV(w, scores) }},
+		{filepath.Join(dir, playerName+"-investments.csv"), func(w io.Writer) error { return WriteInvestmentsCSV(w, investments) }},
+	}
+	var paths []string
+	for _, f := range files {
+		out, err := os.Create(f.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %v", f.path, err)
+		}
+		if err := f.write(out); err != nil {
+			out.Close()
+			return nil, fmt.Errorf("failed to write %s: %v", f.path, err)
+		}
+		if err := out.Close(); err != nil {
+			return nil, err
+		}
+		paths = append(paths, f.path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,144 @@
+package profile
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+)
+
+func TestExportImportMerge(t *testing.T) {
+	home := database.NewMemoryStore()
+	if _, err := Build(home, "Alice"); err == nil {
+		t.Error("Exporting a profile that doesn't exist should fail")
+	}
+	home.CreateProfile("Alice")
+	home.AddExperience("Alice", 2000)
+	home.UnlockAchievement("Alice", "first_win")
+	home.PurchaseUpgrade("Alice", "extra_cash")
+	played := time.Now().Add(-time.Hour)
+	home.SaveGameScore(database.GameScore{PlayerName: "Alice", FinalNetWorth: 5000000, Difficulty: "Easy", PlayedAt: played})
+
+	archive, err := Build(home, "Alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "alice.zip")
+	if err := archive.WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// The laptop already has a shorter history with a different achievement and the same game
+	laptop := database.NewMemoryStore()
+	laptop.AddExperience("Alice", 100)
+	laptop.UnlockAchievement("Alice", "speed_run")
+	laptop.SaveGameScore(database.GameScore{PlayerName: "Alice", FinalNetWorth: 5000000, Difficulty: "Easy", PlayedAt: played})
+
+	imported, err := ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := imported.Merge(laptop, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.ProfileUpdated || report.AchievementsAdded != 1 || report.UpgradesAdded != 1 || report.ScoresAdded != 0 {
+		t.Errorf("Unexpected merge report: %+v", report)
+	}
+	want, _ := home.GetPlayerProfile("Alice")
+	got, _ := laptop.GetPlayerProfile("Alice")
+	if got.Level != want.Level || got.ExperiencePoints != want.ExperiencePoints {
+		t.Errorf("Expected the higher-XP profile (level %d), got level %d", want.Level, got.Level)
+	}
+	if achs, _ := laptop.GetPlayerAchievements("Alice"); len(achs) != 2 {
+		t.Errorf("Expected achievements to be unioned, got %v", achs)
+	}
+
+	// Editing profile.json without re-signing is caught
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited := filepath.Join(t.TempDir(), "edited.zip")
+	out, _ := os.Create(edited)
+	zw := zip.NewWriter(out)
+	for _, f := range zr.File {
+		r, _ := f.Open()
+		data, _ := io.ReadAll(r)
+		r.Close()
+		if f.Name == "profile.json" {
+			data = bytes.Replace(data, []byte(`"Level": `), []byte(`"Level": 9`), 1)
+		}
+		w, _ := zw.Create(f.Name)
+		w.Write(data)
+	}
+	zr.Close()
+	zw.Close()
+	out.Close()
+	if _, err := ReadFile(edited); err == nil {
+		t.Error("ReadFile should reject an archive whose profile was edited")
+	}
+}
+
+func TestMergeSQLiteDedupesScores(t *testing.T) {
+	open := func(name string) *database.SQLiteStore {
+		s, err := database.NewSQLiteStore(filepath.Join(t.TempDir(), name))
+		if err != nil {
+			t.Fatalf("NewSQLiteStore failed: %v", err)
+		}
+		t.Cleanup(func() { s.Close() })
+		return s
+	}
+	home, laptop := open("home.db"), open("laptop.db")
+
+	played := time.Now().Add(-time.Hour).Truncate(time.Second)
+	game := database.GameScore{PlayerName: "Alice", FinalNetWorth: 5000000, Difficulty: "Easy", PlayedAt: played}
+	home.CreateProfile("Alice")
+	home.SaveGameScore(game)
+	home.SaveGameScore(database.GameScore{PlayerName: "Alice", FinalNetWorth: 7000000, Difficulty: "Hard", PlayedAt: played.Add(time.Minute)})
+	laptop.CreateProfile("Alice")
+	laptop.SaveGameScore(game)
+
+	// The shared game's positions are on both machines; the second game's only at home
+	shared := []database.InvestmentHistory{{CompanyName: "Acme", AmountInvested: 100000, ExitValue: 300000}}
+	home.SaveGameHistory(database.GameHistory{PlayerName: "Alice", GameMode: "vc", PlayedAt: played}, shared)
+	home.SaveGameHistory(database.GameHistory{PlayerName: "Alice", GameMode: "vc", PlayedAt: played.Add(time.Minute)},
+		[]database.InvestmentHistory{{CompanyName: "Globex", AmountInvested: 50000}, {CompanyName: "Initech", AmountInvested: 75000}})
+	laptop.SaveGameHistory(database.GameHistory{PlayerName: "Alice", GameMode: "vc", PlayedAt: played}, shared)
+
+	archive, err := Build(home, "Alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "alice.zip")
+	if err := archive.WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The game both machines played survives the trip through SQLite and isn't doubled
+	for i, want := range []int{1, 0} {
+		report, err := imported.Merge(laptop, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.ScoresAdded != want || report.InvestmentsAdded != 2*want {
+			t.Errorf("Merge %d: expected %d new games and %d investments, got %+v", i+1, want, 2*want, report)
+		}
+	}
+	if scores, _ := laptop.GetTopScoresByPlayer("Alice", 10); len(scores) != 2 {
+		t.Errorf("Expected 2 games on the laptop, got %d", len(scores))
+	}
+	history, _ := laptop.GetInvestmentHistory("Alice")
+	if len(history) != 3 || history[0].GameID != history[1].GameID {
+		t.Errorf("Expected the imported game's 2 positions to land in one game, got %+v", history)
+	}
+}
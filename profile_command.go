@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/profile"
+)
+
+const profileUsage = `usage: unicorn profile <command>
+
+  export <name> [file]   write a signed archive of a player's progress (default: <name>-profile.zip)
+  import <file> [name]   merge an archive into this machine (optionally under another name)
+  csv <name> [dir]       write the player's games and investments as CSV (default: current dir)`
+
+// runProfileCommand handles `unicorn profile ...` export and import
+func runProfileCommand(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("%s", profileUsage)
+	}
+
+	store, err := database.NewSQLiteStore(database.DefaultPath())
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	switch args[0] {
+	case "export":
+		name := args[1]
+		dest := name + "-profile.zip"
+		if len(args) > 2 {
+			dest = args[2]
+		}
+		archive, err := profile.Build(store, name)
+		if err != nil {
+			return err
+		}
+		if err := archive.WriteFile(dest); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Exported %s (level %d, %d games, %d achievements) to %s\n",
+			name, archive.Profile.Level, len(archive.Scores), len(archive.Achievements), dest)
+
+	case "import":
+		archive, err := profile.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		name := archive.PlayerName
+		if len(args) > 2 {
+			name = args[2]
+		}
+		report, err := archive.Merge(store, name)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ Imported %s from %s\n", name, args[1])
+		if report.ProfileUpdated {
+			fmt.Printf("  Level %d (imported XP was higher)\n", archive.Profile.Level)
+		}
+		fmt.Printf("  %d games, %d investments, %d achievements, %d upgrades added\n",
+			report.ScoresAdded, report.InvestmentsAdded, report.AchievementsAdded, report.UpgradesAdded)
+		if report.ReputationUpdated {
+			fmt.Println("  VC reputation replaced with the imported one")
+		}
+		if report.CareerUpdated {
+			fmt.Printf("  Career net worth raised to $%d\n", archive.Career.NetWorth)
+		}
+
+	case "csv":
+		dir := "."
+		if len(args) > 2 {
+			dir = args[2]
+		}
+		paths, err := profile.ExportCSV(store, args[1], dir)
+		if err != nil {
+			return err
+		}
+		for _, p := range paths {
+			fmt.Printf("✓ Wrote %s\n", p)
+		}
+
+	default:
+		return fmt.Errorf("unknown profile command: %s\n\n%s", args[0], profileUsage)
+	}
+	return nil
+}
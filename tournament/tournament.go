@@ -0,0 +1,361 @@
+// Package tournament runs organizer-defined tournaments: every participant plays
+// the same seeded rounds under the same rules, and the results roll up into standings.
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/game"
+	"github.com/jamesacampbell/unicorn/upgrades"
+)
+
+// PlacementPoints is what finishing 1st, 2nd, 3rd... in a round is worth
+var PlacementPoints = []int{10, 8, 6, 5, 4, 3, 2, 1}
+
+// Tournament is the definition an organizer shares with participants
+type Tournament struct {
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Players int     `json:"players,omitempty"` // Field size; a cut waits until everyone still in has played the round
+	Rounds  []Round `json:"rounds"`
+}
+
+// Round is one seeded VC game that every participant plays
+type Round struct {
+	Name       string   `json:"name,omitempty"`
+	Seed       int64    `json:"seed"`
+	Difficulty string   `json:"difficulty"`
+	Upgrades   []string `json:"upgrades,omitempty"` // Upgrades players may bring if they own them; none when empty
+	Cut        int      `json:"cut,omitempty"`      // Only the top Cut players in the standings after this round play on
+}
+
+// Standing is a player's place in the tournament
+type Standing struct {
+	PlayerName    string
+	Points        int
+	Wins          int
+	RoundsPlayed  int
+	TotalNetWorth int64
+	BestROI       float64
+	Eliminated    bool // Missed a cut
+}
+
+// Parse reads and validates a tournament definition
+func Parse(data []byte) (*Tournament, error) {
+	var t Tournament
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse tournament: %v", err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ReadFile loads a tournament definition shared by an organizer
+func ReadFile(path string) (*Tournament, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tournament: %v", err)
+	}
+	return Parse(data)
+}
+
+// WriteFile saves the definition for sharing
+func (t *Tournament) WriteFile(path string) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode tournament: %v", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write tournament: %v", err)
+	}
+	return nil
+}
+
+// Validate checks the definition is playable
+func (t *Tournament) Validate() error {
+	if strings.TrimSpace(t.ID) == "" {
+		return fmt.Errorf("tournament needs an id")
+	}
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("tournament needs a name")
+	}
+	if len(t.Rounds) == 0 {
+		return fmt.Errorf("tournament %s has no rounds", t.ID)
+	}
+	for i, r := range t.Rounds {
+		if _, err := ParseDifficulty(r.Difficulty); err != nil {
+			return fmt.Errorf("round %d: %v", i+1, err)
+		}
+		for _, id := range r.Upgrades {
+			if _, ok := upgrades.AllUpgrades[id]; !ok {
+				return fmt.Errorf("round %d: unknown upgrade: %s", i+1, id)
+			}
+		}
+		if r.Cut < 0 {
+			return fmt.Errorf("round %d: cut can't be negative", i+1)
+		}
+		if r.Cut > 0 && t.Players == 0 {
+			return fmt.Errorf("round %d: a cut needs the number of players", i+1)
+		}
+	}
+	if t.Players < 0 {
+		return fmt.Errorf("players can't be negative")
+	}
+	return nil
+}
+
+// Save stores the definition locally so its rounds can be played from the menu
+func (t *Tournament) Save(store database.TournamentStore) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("failed to encode tournament: %v", err)
+	}
+	return store.SaveTournament(database.Tournament{ID: t.ID, Name: t.Name, Definition: string(data)})
+}
+
+// Load reads a tournament saved with Save
+func Load(store database.TournamentStore, id string) (*Tournament, error) {
+	saved, err := store.GetTournament(id)
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil {
+		return nil, fmt.Errorf("no tournament with id %s", id)
+	}
+	return Parse([]byte(saved.Definition))
+}
+
+// ParseDifficulty looks a difficulty up by name
+func ParseDifficulty(name string) (game.Difficulty, error) {
+	for _, d := range []game.Difficulty{game.EasyDifficulty, game.MediumDifficulty, game.HardDifficulty, game.ExpertDifficulty} {
+		if strings.EqualFold(d.Name, name) {
+			return d, nil
+		}
+	}
+	return game.Difficulty{}, fmt.Errorf("unknown difficulty: %s", name)
+}
+
+// RoundName is the round's display name
+func (t *Tournament) RoundName(round int) string {
+	if name := t.Rounds[round-1].Name; name != "" {
+		return name
+	}
+	return fmt.Sprintf("Round %d", round)
+}
+
+// NewGame starts round (1 for the first) for a player. Everyone gets the same
+// seed and difficulty, only the allowed upgrades they own, and no reputation bonus.
+func (t *Tournament) NewGame(round int, playerName, firmName string, owned []string) (*game.GameState, error) {
+	if round < 1 || round > len(t.Rounds) {
+		return nil, fmt.Errorf("%s has no round %d", t.Name, round)
+	}
+	r := t.Rounds[round-1]
+	difficulty, err := ParseDifficulty(r.Difficulty)
+	if err != nil {
+		return nil, err
+	}
+	var allowed []string
+	for _, id := range upgrades.FilterUpgradeIDsForGameMode(owned, "vc") {
+		if upgrades.IsOwned(id, r.Upgrades) {
+			allowed = append(allowed, id)
+		}
+	}
+	return game.NewGameWithSeed(playerName, firmName, difficulty, allowed, nil, r.Seed), nil
+}
+
+// NextRound is the round a player plays next, or an error saying why they can't:
+// they've finished, or they missed a cut.
+func (t *Tournament) NextRound(results []database.TournamentResult, playerName string) (int, error) {
+	played := 0
+	for _, r := range results {
+		if playerKey(r.PlayerName) == playerKey(playerName) && r.Round > played {
+			played = r.Round
+		}
+	}
+	if played >= len(t.Rounds) {
+		return 0, fmt.Errorf("%s has played every round of %s", playerName, t.Name)
+	}
+	for round := 1; round <= played; round++ {
+		cut := t.Rounds[round-1].Cut
+		if cut == 0 {
+			continue
+		}
+		if !t.complete(results, round) {
+			return 0, fmt.Errorf("%s is waiting for everyone to finish %s before the cut", playerName, t.RoundName(round))
+		}
+		for i, s := range rank(upTo(results, round)) {
+			if playerKey(s.PlayerName) == playerKey(playerName) && i >= cut {
+				return 0, fmt.Errorf("%s missed the cut after %s", playerName, t.RoundName(round))
+			}
+		}
+	}
+	return played + 1, nil
+}
+
+// Standings ranks players by placement points across rounds. Ties go to round
+// wins, then total net worth, then best single-round ROI.
+func (t *Tournament) Standings(results []database.TournamentResult) []Standing {
+	standings := rank(results)
+	for round, r := range t.Rounds {
+		if r.Cut == 0 || round+1 == len(t.Rounds) || !t.complete(results, round+1) {
+			continue
+		}
+		for i, s := range rank(upTo(results, round+1)) {
+			if i < r.Cut {
+				continue
+			}
+			for j := range standings {
+				if playerKey(standings[j].PlayerName) == playerKey(s.PlayerName) {
+					standings[j].Eliminated = true
+				}
+			}
+		}
+	}
+	return standings
+}
+
+// rank orders players by points and tiebreakers
+func rank(results []database.TournamentResult) []Standing {
+	byPlayer := map[string]*Standing{}
+	var order []*Standing
+	rounds := map[int][]database.TournamentResult{}
+	for _, r := range results {
+		rounds[r.Round] = append(rounds[r.Round], r)
+		s, ok := byPlayer[playerKey(r.PlayerName)]
+		if !ok {
+			s = &Standing{PlayerName: r.PlayerName, BestROI: r.ROI}
+			byPlayer[playerKey(r.PlayerName)] = s
+			order = append(order, s)
+		}
+		s.RoundsPlayed++
+		s.TotalNetWorth += r.FinalNetWorth
+		if r.ROI > s.BestROI {
+			s.BestROI = r.ROI
+		}
+	}
+
+	for _, field := range rounds {
+		sort.SliceStable(field, func(i, j int) bool { return field[i].FinalNetWorth > field[j].FinalNetWorth })
+		place := 0
+		for i, r := range field {
+			// Equal net worth shares the better place
+			if i == 0 || r.FinalNetWorth != field[i-1].FinalNetWorth {
+				place = i
+			}
+			s := byPlayer[playerKey(r.PlayerName)]
+			if place < len(PlacementPoints) {
+				s.Points += PlacementPoints[place]
+			}
+			if place == 0 {
+				s.Wins++
+			}
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.TotalNetWorth != b.TotalNetWorth {
+			return a.TotalNetWorth > b.TotalNetWorth
+		}
+		if a.BestROI != b.BestROI {
+			return a.BestROI > b.BestROI
+		}
+		return a.PlayerName < b.PlayerName
+	})
+
+	standings := make([]Standing, len(order))
+	for i, s := range order {
+		standings[i] = *s
+	}
+	return standings
+}
+
+// field is how many players are still in for round, after earlier cuts
+func (t *Tournament) field(round int) int {
+	n := t.Players
+	for _, r := range t.Rounds[:round-1] {
+		if r.Cut > 0 && r.Cut < n {
+			n = r.Cut
+		}
+	}
+	return n
+}
+
+// complete reports whether everyone still in has finished round, so its cut
+// no longer depends on who happened to finish first
+func (t *Tournament) complete(results []database.TournamentResult, round int) bool {
+	finished := map[string]bool{}
+	for _, r := range results {
+		if r.Round == round {
+			finished[playerKey(r.PlayerName)] = true
+		}
+	}
+	return len(finished) >= t.field(round)
+}
+
+// upTo keeps the results from the first n rounds
+func upTo(results []database.TournamentResult, n int) []database.TournamentResult {
+	var kept []database.TournamentResult
+	for _, r := range results {
+		if r.Round <= n {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// playerKey is how results are matched to a player: names ignore case and spacing
+func playerKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Merge combines local results with ones fetched from the leaderboard, keeping
+// the first result for each player and round
+func Merge(local, remote []database.TournamentResult) []database.TournamentResult {
+	seen := map[string]bool{}
+	var merged []database.TournamentResult
+	for _, r := range append(append([]database.TournamentResult{}, local...), remote...) {
+		key := fmt.Sprintf("%d/%s", r.Round, playerKey(r.PlayerName))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// New creates a tournament of rounds games at one difficulty. Round seeds are
+// drawn from seed, so the same arguments always make the same tournament. A cut
+// keeps the top cut of players for the second half of the rounds.
+func New(id, name string, rounds int, difficulty string, allowed []string, players, cut int, seed int64) (*Tournament, error) {
+	rng := rand.New(rand.NewSource(seed))
+	t := &Tournament{ID: id, Name: name, Players: players}
+	for i := 0; i < rounds; i++ {
+		t.Rounds = append(t.Rounds, Round{Seed: rng.Int63(), Difficulty: difficulty, Upgrades: allowed})
+	}
+	if cut > 0 {
+		if rounds < 2 {
+			return nil, fmt.Errorf("a cut needs at least 2 rounds")
+		}
+		t.Rounds[rounds/2-1].Cut = cut
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,105 @@
+package tournament
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jamesacampbell/unicorn/database"
+)
+
+func result(round int, player string, netWorth int64, roi float64) database.TournamentResult {
+	return database.TournamentResult{TournamentID: "spring", Round: round, PlayerName: player, FinalNetWorth: netWorth, ROI: roi}
+}
+
+func TestTournament(t *testing.T) {
+	tour, err := Parse([]byte(`{
+		"id": "spring",
+		"name": "Spring Invitational",
+		"players": 3,
+		"rounds": [
+			{"seed": 11, "difficulty": "medium", "cut": 2},
+			{"seed": 22, "difficulty": "Hard"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if _, err := Parse([]byte(`{"id": "x", "name": "X", "rounds": [{"seed": 1, "difficulty": "insane"}]}`)); err == nil {
+		t.Error("Parse should refuse an unknown difficulty")
+	}
+
+	// Everyone plays the same deals, whatever upgrades they own
+	a, _ := tour.NewGame(1, "Ada", "Ada Capital", []string{"extended_game"})
+	b, _ := tour.NewGame(1, "Grace", "Grace Ventures", nil)
+	if a.AvailableStartups[0].Name != b.AvailableStartups[0].Name {
+		t.Errorf("Round 1 should deal the same startups, got %s and %s", a.AvailableStartups[0].Name, b.AvailableStartups[0].Name)
+	}
+	if len(a.PlayerUpgrades) != 0 {
+		t.Errorf("Round 1 allows no upgrades, Ada kept %v", a.PlayerUpgrades)
+	}
+
+	if _, err := Parse([]byte(`{"id": "x", "name": "X", "rounds": [{"seed": 1, "difficulty": "easy", "cut": 1}, {"seed": 2, "difficulty": "easy"}]}`)); err == nil {
+		t.Error("Parse should refuse a cut without a field size")
+	}
+
+	// Nobody is cut while Linus still has round 1 to play, however it's going
+	early := []database.TournamentResult{result(1, "Ada", 5_000_000, 150), result(1, "Grace", 4_000_000, 100)}
+	for _, s := range tour.Standings(append(early, result(2, "Ada", 1, 0))) {
+		if s.Eliminated {
+			t.Errorf("%s was cut before round 1 was complete", s.PlayerName)
+		}
+	}
+	if _, err := tour.NextRound(early, "Ada"); err == nil || !strings.Contains(err.Error(), "waiting") {
+		t.Errorf("Ada should wait for round 1 to finish, got %v", err)
+	}
+
+	// Ada and Grace tie on points; Grace's win breaks it. Linus misses the cut.
+	results := []database.TournamentResult{
+		result(1, "Ada", 5_000_000, 150),
+		result(1, "Grace", 4_000_000, 100),
+		result(1, "Linus", 1_000_000, -50),
+		result(2, "Grace", 9_000_000, 300),
+		result(2, "Ada", 3_000_000, 50),
+	}
+	standings := tour.Standings(results)
+	var order []string
+	for _, s := range standings {
+		order = append(order, s.PlayerName)
+	}
+	if strings.Join(order, ",") != "Grace,Ada,Linus" {
+		t.Fatalf("Expected Grace,Ada,Linus, got %v", order)
+	}
+	if standings[0].Points != 18 || standings[0].Wins != 1 || standings[1].Points != 18 {
+		t.Errorf("Unexpected points: %+v", standings[:2])
+	}
+	if !standings[2].Eliminated {
+		t.Error("Linus should be marked as cut")
+	}
+
+	if _, err := tour.NextRound(results, "Linus"); err == nil || !strings.Contains(err.Error(), "cut") {
+		t.Errorf("Linus shouldn't get round 2, got %v", err)
+	}
+	if _, err := tour.NextRound(results, "Ada"); err == nil {
+		t.Error("Ada has played every round")
+	}
+	if _, err := tour.NextRound(results, "linus"); err == nil || !strings.Contains(err.Error(), "cut") {
+		t.Errorf("Names match whatever their case, so linus is cut too, got %v", err)
+	}
+	if got := tour.Standings(append(results, result(3, "grace", 1, 0))); len(got) != 3 {
+		t.Errorf("grace and Grace should share one standing, got %d players", len(got))
+	}
+	if round, err := tour.NextRound(results, "Margaret"); err != nil || round != 1 {
+		t.Errorf("A newcomer starts at round 1, got %d, %v", round, err)
+	}
+
+	made, err := New("x", "X", 4, "easy", nil, 8, 4, 1)
+	if err != nil || made.Rounds[1].Cut != 4 || made.Players != 8 {
+		t.Errorf("Expected a cut to 4 after round 2, got %+v, %v", made, err)
+	}
+
+	// Results fetched from the leaderboard don't double count local ones
+	merged := Merge(results, []database.TournamentResult{result(1, "ada", 1, 0), result(1, "Margaret", 2_000_000, 20)})
+	if len(merged) != len(results)+1 {
+		t.Errorf("Expected one new result after merging, got %d", len(merged)-len(results))
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/leaderboard"
+	"github.com/jamesacampbell/unicorn/tournament"
+)
+
+const tournamentUsage = `usage: unicorn tournament <command>
+
+  new <id> <name> [flags]    create a tournament and write <id>.json to share with players
+      -rounds 3 -difficulty medium -upgrades id,id -seed N
+      -players N -cut N      keep the top N for the second half once all players finish
+  add <file>                 load a tournament someone shared with you
+  list                       show the tournaments on this machine
+  standings <id> [-global]   rank everyone who has played (-global adds leaderboard results)
+
+Rounds are played from the game menu: VC setup → Tournament.`
+
+// runTournamentCommand handles `unicorn tournament ...`
+func runTournamentCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("%s", tournamentUsage)
+	}
+
+	store, err := database.NewSQLiteStore(database.DefaultPath())
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	switch args[0] {
+	case "new":
+		if len(args) < 3 {
+			return fmt.Errorf("%s", tournamentUsage)
+		}
+		flags := flag.NewFlagSet("tournament new", flag.ContinueOnError)
+		rounds := flags.Int("rounds", 3, "number of rounds")
+		difficulty := flags.String("difficulty", "medium", "easy, medium, hard or expert")
+		allowed := flags.String("upgrades", "", "comma-separated upgrade IDs players may bring (default none)")
+		players := flags.Int("players", 0, "number of players; needed for a cut")
+		cut := flags.Int("cut", 0, "players kept for the second half of the rounds (default no cut)")
+		seed := flags.Int64("seed", time.Now().UnixNano(), "seed the round seeds are drawn from")
+		if err := flags.Parse(args[3:]); err != nil {
+			return err
+		}
+		var ids []string
+		if *allowed != "" {
+			ids = strings.Split(*allowed, ",")
+		}
+		t, err := tournament.New(args[1], args[2], *rounds, *difficulty, ids, *players, *cut, *seed)
+		if err != nil {
+			return err
+		}
+		if err := t.Save(store); err != nil {
+			return err
+		}
+		dest := t.ID + ".json"
+		if err := t.WriteFile(dest); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Created %s (%d rounds, %s) and wrote %s\n", t.Name, len(t.Rounds), *difficulty, dest)
+		fmt.Printf("  Players load it with: unicorn tournament add %s\n", dest)
+
+	case "add":
+		if len(args) < 2 {
+			return fmt.Errorf("%s", tournamentUsage)
+		}
+		t, err := tournament.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		if err := t.Save(store); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Added %s (%d rounds)\n", t.Name, len(t.Rounds))
+
+	case "list":
+		saved, err := store.ListTournaments()
+		if err != nil {
+			return err
+		}
+		if len(saved) == 0 {
+			fmt.Println("No tournaments yet - create one with `unicorn tournament new` or add a shared one")
+		}
+		for _, s := range saved {
+			t, err := tournament.Parse([]byte(s.Definition))
+			if err != nil {
+				fmt.Printf("  %-20s %s (unreadable: %v)\n", s.ID, s.Name, err)
+				continue
+			}
+			fmt.Printf("  %-20s %s - %d rounds\n", t.ID, t.Name, len(t.Rounds))
+		}
+
+	case "standings":
+		flags := flag.NewFlagSet("tournament standings", flag.ContinueOnError)
+		global := flags.Bool("global", false, "include results submitted to the online leaderboard")
+		if len(args) < 2 {
+			return fmt.Errorf("%s", tournamentUsage)
+		}
+		if err := flags.Parse(args[2:]); err != nil {
+			return err
+		}
+		t, err := tournament.Load(store, args[1])
+		if err != nil {
+			return err
+		}
+		results, err := store.GetTournamentResults(t.ID)
+		if err != nil {
+			return err
+		}
+		if *global {
+			scores, err := leaderboard.GetTournamentScores(t.ID, "")
+			if err != nil {
+				return err
+			}
+			var remote []database.TournamentResult
+			for _, s := range scores {
+				remote = append(remote, database.TournamentResult{
+					TournamentID:    t.ID,
+					Round:           s.Round,
+					PlayerName:      s.PlayerName,
+					FinalNetWorth:   s.FinalNetWorth,
+					ROI:             s.ROI,
+					SuccessfulExits: s.SuccessfulExits,
+					PlayedAt:        s.PlayedAt,
+				})
+			}
+			results = tournament.Merge(results, remote)
+		}
+
+		fmt.Printf("🏆 %s standings\n", t.Name)
+		standings := t.Standings(results)
+		if len(standings) == 0 {
+			fmt.Println("  Nobody has finished a round yet")
+		}
+		for i, s := range standings {
+			note := ""
+			if s.Eliminated {
+				note = " (cut)"
+			}
+			fmt.Printf("  %d. %-20s %3d pts  %d wins  %d/%d rounds  $%d%s\n",
+				i+1, s.PlayerName, s.Points, s.Wins, s.RoundsPlayed, len(t.Rounds), s.TotalNetWorth, note)
+		}
+
+	default:
+		return fmt.Errorf("unknown tournament command: %s\n\n%s", args[0], tournamentUsage)
+	}
+	return nil
+}
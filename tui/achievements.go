@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jamesacampbell/unicorn/achievements"
@@ -18,6 +17,7 @@ import (
 type AchievementsScreen struct {
 	width            int
 	height           int
+	store            database.Store
 	playerName       string
 	currentMode      string // "vc", "founder", or "" (all)
 	unlockedAchs     []string
@@ -30,33 +30,12 @@ type AchievementsScreen struct {
 	// Chain view
 	chainIDs      []string
 	selectedChain int
-
-	// Name entry
-	needsName bool
-	nameInput textinput.Model
 }
 
 // NewAchievementsScreen creates a new achievements screen
-func NewAchievementsScreen(width, height int, playerName, mode string) *AchievementsScreen {
-	// If no player name, show name input first
-	if playerName == "" {
-		nameInput := textinput.New()
-		nameInput.Placeholder = "Enter your name"
-		nameInput.Focus()
-		nameInput.CharLimit = 30
-		nameInput.Width = 30
-
-		return &AchievementsScreen{
-			width:       width,
-			height:      height,
-			needsName:   true,
-			nameInput:   nameInput,
-			currentMode: mode,
-		}
-	}
-
+func NewAchievementsScreen(width, height int, store database.Store, playerName, mode string) *AchievementsScreen {
 	// Load unlocked achievements
-	unlocked, _ := database.GetPlayerAchievements(playerName)
+	unlocked, _ := store.GetPlayerAchievements(playerName)
 
 	// Build unlocked map for quick lookup
 	unlockedMap := make(map[string]bool)
@@ -83,6 +62,7 @@ func NewAchievementsScreen(width, height int, playerName, mode string) *Achievem
 	return &AchievementsScreen{
 		width:        width,
 		height:       height,
+		store:        store,
 		playerName:   playerName,
 		unlockedAchs: unlocked,
 		unlockedMap:  unlockedMap,
@@ -103,34 +83,11 @@ func matchesMode(achMode, filterMode string) bool {
 
 // Init initializes the achievements screen
 func (s *AchievementsScreen) Init() tea.Cmd {
-	if s.needsName {
-		return textinput.Blink
-	}
 	return nil
 }
 
 // Update handles achievements input
 func (s *AchievementsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
-	// Handle name input state
-	if s.needsName {
-		switch msg := msg.(type) {
-		case tea.KeyMsg:
-			switch {
-			case key.Matches(msg, keys.Global.Back):
-				return s, PopScreen()
-			case msg.Type == tea.KeyEnter:
-				name := strings.TrimSpace(s.nameInput.Value())
-				if name != "" {
-					return NewAchievementsScreen(s.width, s.height, name, s.currentMode), textinput.Blink
-				}
-			}
-		}
-
-		var cmd tea.Cmd
-		s.nameInput, cmd = s.nameInput.Update(msg)
-		return s, cmd
-	}
-
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
@@ -158,11 +115,6 @@ func (s *AchievementsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 
 // View renders achievements
 func (s *AchievementsScreen) View() string {
-	// Name input screen
-	if s.needsName {
-		return s.renderNameInput()
-	}
-
 	// Chains view is separate
 	if s.categories[s.selectedCategory] == "Chains" {
 		return s.renderChainsView()
@@ -297,52 +249,6 @@ func (s *AchievementsScreen) View() string {
 	return b.String()
 }
 
-func (s *AchievementsScreen) renderNameInput() string {
-	var b strings.Builder
-
-	// Header
-	headerStyle := lipgloss.NewStyle().
-		Foreground(styles.Black).
-		Background(styles.Gold).
-		Bold(true).
-		Width(70).
-		Align(lipgloss.Center)
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🏆 ACHIEVEMENTS 🏆")))
-	b.WriteString("\n\n")
-
-	// Explanation
-	infoBox := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.Yellow).
-		Padding(1, 2).
-		Width(50)
-
-	info := "Enter your player name to view your achievements."
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(infoBox.Render(info)))
-	b.WriteString("\n\n")
-
-	// Name input
-	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(titleStyle.Render("PLAYER NAME")))
-	b.WriteString("\n")
-
-	inputBox := lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
-		BorderForeground(styles.Cyan).
-		Padding(0, 1).
-		Width(35)
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(inputBox.Render(s.nameInput.View())))
-	b.WriteString("\n\n")
-
-	// Help
-	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("enter confirm • esc back"))
-
-	return b.String()
-}
-
 func (s *AchievementsScreen) renderChainsView() string {
 	var b strings.Builder
 
@@ -385,7 +291,7 @@ func (s *AchievementsScreen) renderChainsView() string {
 		chainsContent.WriteString("No achievement chains available yet.\n")
 	} else {
 		for _, chainID := range s.chainIDs {
-			unlocked, total := achievements.GetChainProgress(s.playerName, chainID)
+			unlocked, total := achievements.GetChainProgress(s.store, s.playerName, chainID)
 			chainAchs := achievements.GetAchievementsByChain(chainID)
 
 			// Chain header
@@ -422,7 +328,7 @@ func (s *AchievementsScreen) renderChainsView() string {
 				if s.unlockedMap[ach.ID] {
 					achStyle := lipgloss.NewStyle().Foreground(styles.Green)
 					chainsContent.WriteString(achStyle.Render(fmt.Sprintf("%s✓ %s %s (+%d)", prefix, ach.Icon, ach.Name, ach.Points)))
-				} else if achievements.CheckAchievementChain(s.playerName, ach.ID) {
+				} else if achievements.CheckAchievementChain(s.store, s.playerName, ach.ID) {
 					achStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
 					chainsContent.WriteString(achStyle.Render(fmt.Sprintf("%s○ %s %s (Available)", prefix, ach.Icon, ach.Name)))
 				} else {
@@ -6,55 +6,55 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jamesacampbell/unicorn/analytics"
 	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/tui/components"
 	"github.com/jamesacampbell/unicorn/tui/keys"
 	"github.com/jamesacampbell/unicorn/tui/styles"
 )
 
 // AnalyticsScreen shows analytics dashboard
 type AnalyticsScreen struct {
-	width       int
-	height      int
-	playerName  string
-	needsName   bool
-	nameInput   textinput.Model
-	stats       *database.PlayerStats
-	trendReport *analytics.TrendReport
-	monthlyData []*analytics.MonthlyReport
-	selectedTab int
-	tabs        []string
+	width          int
+	height         int
+	store          database.Store
+	playerName     string
+	stats          *database.PlayerStats
+	trendReport    *analytics.TrendReport
+	monthlyData    []*analytics.MonthlyReport
+	topInvestments []analytics.InvestmentSummary
+	sectors        []analytics.SectorPerformance
+	termsBreakdown []analytics.PerformanceBreakdown
+	ddBreakdown    []analytics.PerformanceBreakdown
+	lastVCSeries   map[string][]int64
+	selectedTab    int
+	tabs           []string
 }
 
 // NewAnalyticsScreen creates a new analytics screen
-func NewAnalyticsScreen(width, height int) *AnalyticsScreen {
-	ti := textinput.New()
-	ti.Placeholder = "Enter player name"
-	ti.CharLimit = 30
-	ti.Width = 30
-	ti.Focus()
-
-	return &AnalyticsScreen{
-		width:     width,
-		height:    height,
-		needsName: true,
-		nameInput: ti,
-		tabs:      []string{"Overview", "Heatmap", "Difficulty"},
+func NewAnalyticsScreen(width, height int, store database.Store, playerName string) *AnalyticsScreen {
+	s := &AnalyticsScreen{
+		width:      width,
+		height:     height,
+		store:      store,
+		playerName: playerName,
+		tabs:       []string{"Overview", "Heatmap", "Difficulty", "Investments", "Breakdown"},
 	}
+	s.loadData()
+	return s
 }
 
 func (s *AnalyticsScreen) loadData() {
 	// Load player stats
-	stats, err := database.GetPlayerStats(s.playerName)
+	stats, err := s.store.GetPlayerStats(s.playerName)
 	if err == nil && stats.TotalGames > 0 {
 		s.stats = stats
 	}
 
 	// Load trend report
-	trendReport, err := analytics.GenerateTrendAnalysis(s.playerName, 30)
+	trendReport, err := analytics.GenerateTrendAnalysis(s.store, s.playerName, 30)
 	if err == nil {
 		s.trendReport = trendReport
 	}
@@ -63,44 +63,27 @@ func (s *AnalyticsScreen) loadData() {
 	now := time.Now()
 	for i := 5; i >= 0; i-- {
 		month := now.AddDate(0, -i, 0)
-		monthReport, err := analytics.GetMonthlyStats(s.playerName, month.Year(), int(month.Month()))
+		monthReport, err := analytics.GetMonthlyStats(s.store, s.playerName, month.Year(), int(month.Month()))
 		if err == nil && monthReport != nil {
 			s.monthlyData = append(s.monthlyData, monthReport)
 		}
 	}
+
+	// Load per-investment analytics
+	s.topInvestments, _ = analytics.GetTopInvestments(s.store, s.playerName, 10)
+	s.sectors, _ = analytics.GetSectorPerformance(s.store, s.playerName)
+	s.termsBreakdown, _ = analytics.GetTermsBreakdown(s.store, s.playerName)
+	s.ddBreakdown, _ = analytics.GetDDLevelBreakdown(s.store, s.playerName)
+	s.lastVCSeries, _ = s.store.GetLatestGameSeries(s.playerName, "vc")
 }
 
 // Init initializes the analytics screen
 func (s *AnalyticsScreen) Init() tea.Cmd {
-	if s.needsName {
-		return textinput.Blink
-	}
 	return nil
 }
 
 // Update handles analytics input
 func (s *AnalyticsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
-	if s.needsName {
-		switch msg := msg.(type) {
-		case tea.KeyMsg:
-			switch msg.String() {
-			case "enter":
-				name := strings.TrimSpace(s.nameInput.Value())
-				if name != "" {
-					s.playerName = name
-					s.needsName = false
-					s.loadData()
-				}
-				return s, nil
-			case "esc":
-				return s, PopScreen()
-			}
-		}
-		var cmd tea.Cmd
-		s.nameInput, cmd = s.nameInput.Update(msg)
-		return s, cmd
-	}
-
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
@@ -121,10 +104,6 @@ func (s *AnalyticsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 
 // View renders analytics
 func (s *AnalyticsScreen) View() string {
-	if s.needsName {
-		return s.renderNameInput()
-	}
-
 	var b strings.Builder
 
 	// Header
@@ -161,6 +140,10 @@ func (s *AnalyticsScreen) View() string {
 		b.WriteString(s.renderHeatmap())
 	case "Difficulty":
 		b.WriteString(s.renderDifficultyBreakdown())
+	case "Investments":
+		b.WriteString(s.renderTopInvestments())
+	case "Breakdown":
+		b.WriteString(s.renderInvestmentBreakdown())
 	}
 
 	// Help
@@ -170,39 +153,6 @@ func (s *AnalyticsScreen) View() string {
 	return b.String()
 }
 
-func (s *AnalyticsScreen) renderNameInput() string {
-	var b strings.Builder
-
-	headerStyle := lipgloss.NewStyle().
-		Foreground(styles.Black).
-		Background(styles.Cyan).
-		Bold(true).
-		Width(70).
-		Align(lipgloss.Center)
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("📊 ANALYTICS DASHBOARD 📊")))
-	b.WriteString("\n\n")
-
-	boxStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.Cyan).
-		Padding(1, 2).
-		Width(40)
-
-	var content strings.Builder
-	content.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true).Render("Enter Player Name"))
-	content.WriteString("\n\n")
-	content.WriteString(s.nameInput.View())
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(boxStyle.Render(content.String())))
-	b.WriteString("\n\n")
-
-	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("enter submit • esc back"))
-
-	return b.String()
-}
-
 func (s *AnalyticsScreen) renderOverview() string {
 	var b strings.Builder
 
@@ -270,6 +220,13 @@ func (s *AnalyticsScreen) renderOverview() string {
 				content.WriteString("\n")
 			}
 		}
+
+		if netWorth := s.lastVCSeries["net_worth"]; len(netWorth) > 1 {
+			content.WriteString(labelStyle.Render("Last VC Game:  "))
+			content.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Render(
+				components.Sparkline(components.FloatSeries(netWorth), 40)))
+			content.WriteString("\n")
+		}
 	}
 
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(contentBox.Render(content.String())))
@@ -408,7 +365,7 @@ func (s *AnalyticsScreen) renderDifficultyBreakdown() string {
 	content.WriteString("\n")
 
 	for _, diff := range difficulties {
-		scores, err := database.GetTopScoresByNetWorth(1000, diff)
+		scores, err := s.store.GetTopScoresByNetWorth(1000, diff)
 		if err != nil {
 			continue
 		}
@@ -448,3 +405,108 @@ func (s *AnalyticsScreen) renderDifficultyBreakdown() string {
 
 	return b.String()
 }
+
+func (s *AnalyticsScreen) renderTopInvestments() string {
+	var b strings.Builder
+
+	contentBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(1, 2).
+		Width(75)
+
+	var content strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Green).Bold(true)
+
+	content.WriteString(titleStyle.Render("🏆 TOP INVESTMENTS (All Games)"))
+	content.WriteString("\n\n")
+
+	if len(s.topInvestments) == 0 {
+		content.WriteString("No investment history yet. Finish a VC game to start tracking.\n")
+	} else {
+		headerStyle := lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+		content.WriteString(headerStyle.Render(fmt.Sprintf("%-18s %-11s %-12s %9s %8s %5s", "COMPANY", "SECTOR", "OUTCOME", "IN", "ROI", "MO")))
+		content.WriteString("\n")
+		content.WriteString(strings.Repeat("─", 68))
+		content.WriteString("\n")
+
+		for _, inv := range s.topInvestments {
+			roiStyle := lipgloss.NewStyle().Foreground(styles.Green)
+			if inv.ROI < 0 {
+				roiStyle = roiStyle.Foreground(styles.Red)
+			}
+			content.WriteString(fmt.Sprintf("%-18s %-11s %-12s %9s ",
+				truncate(inv.CompanyName, 18), truncate(inv.Sector, 11), inv.Outcome,
+				"$"+formatCompactMoney(inv.AmountInvested)))
+			content.WriteString(roiStyle.Render(fmt.Sprintf("%7.0f%%", inv.ROI)))
+			content.WriteString(fmt.Sprintf(" %5d", inv.MonthsHeld))
+			content.WriteString("\n")
+		}
+	}
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(contentBox.Render(content.String())))
+	b.WriteString("\n\n")
+
+	return b.String()
+}
+
+func (s *AnalyticsScreen) renderInvestmentBreakdown() string {
+	var b strings.Builder
+
+	contentBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Magenta).
+		Padding(1, 2).
+		Width(70)
+
+	var content strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Magenta).Bold(true)
+	headerStyle := lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+
+	content.WriteString(titleStyle.Render("🔍 WHAT WORKS FOR YOU"))
+	content.WriteString("\n\n")
+
+	if len(s.sectors) == 0 {
+		content.WriteString("No investment history yet. Finish a VC game to start tracking.\n")
+	} else {
+		content.WriteString(headerStyle.Render(fmt.Sprintf("%-14s %6s %9s %9s %10s", "SECTOR", "DEALS", "AVG ROI", "BEST", "INVESTED")))
+		content.WriteString("\n")
+		for _, sp := range s.sectors {
+			content.WriteString(fmt.Sprintf("%-14s %6d ", truncate(sp.SectorName, 14), sp.InvestmentCount))
+			content.WriteString(roiCell(sp.AvgROI))
+			content.WriteString(fmt.Sprintf(" %8.0f%% %10s\n", sp.BestROI, "$"+formatCompactMoney(sp.TotalInvested)))
+		}
+		content.WriteString("\n")
+
+		for _, section := range []struct {
+			title string
+			rows  []analytics.PerformanceBreakdown
+		}{
+			{"TERMS", s.termsBreakdown},
+			{"DUE DILIGENCE", s.ddBreakdown},
+		} {
+			content.WriteString(headerStyle.Render(fmt.Sprintf("%-14s %6s %9s %9s %10s", section.title, "DEALS", "AVG ROI", "WIN RATE", "RETURNED")))
+			content.WriteString("\n")
+			for _, pb := range section.rows {
+				content.WriteString(fmt.Sprintf("%-14s %6d ", truncate(pb.Label, 14), pb.InvestmentCount))
+				content.WriteString(roiCell(pb.AvgROI))
+				content.WriteString(fmt.Sprintf(" %8.0f%% %10s\n", pb.WinRate, "$"+formatCompactMoney(pb.TotalReturned)))
+			}
+			content.WriteString("\n")
+		}
+	}
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(contentBox.Render(content.String())))
+	b.WriteString("\n\n")
+
+	return b.String()
+}
+
+// roiCell renders an average ROI, green when positive and red when negative
+func roiCell(roi float64) string {
+	style := lipgloss.NewStyle().Foreground(styles.Green)
+	if roi < 0 {
+		style = style.Foreground(styles.Red)
+	}
+	return style.Render(fmt.Sprintf("%8.0f%%", roi))
+}
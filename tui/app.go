@@ -1,15 +1,14 @@
 package tui
 
 import (
-	"os"
-	"path/filepath"
-
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/daily"
 	"github.com/jamesacampbell/unicorn/database"
 	"github.com/jamesacampbell/unicorn/founder"
 	"github.com/jamesacampbell/unicorn/game"
+	"github.com/jamesacampbell/unicorn/tournament"
 	"github.com/jamesacampbell/unicorn/tui/styles"
 )
 
@@ -34,6 +33,12 @@ const (
 	ScreenAnalytics
 	ScreenReputation
 	ScreenHelp
+	ScreenProfiles
+	ScreenSettings
+	ScreenHotSeatSetup
+	ScreenHotSeatResults
+	ScreenTournament
+	ScreenDaily
 )
 
 // Global key bindings
@@ -100,6 +105,30 @@ type GameData struct {
 	PlayerUpgrades []string
 	AutoMode       bool
 	CurrentMode    string // "vc" or "founder"
+	Store          database.Store
+	Profile        *database.Profile // Active local profile; nil until one is picked
+
+	// Set while the game is a tournament round
+	Tournament      *tournament.Tournament
+	TournamentRound int
+
+	// Set while the game is the daily challenge
+	Daily *daily.Challenge
+}
+
+// SetProfile makes p the active profile: its name goes on every game and score,
+// and its theme is applied
+func (g *GameData) SetProfile(p *database.Profile) {
+	g.Profile = p
+	g.PlayerName = p.Name
+	g.AutoMode = p.AutoMode
+	styles.ApplyTheme(p.Theme)
+	g.Store.UseProfile(p.Name)
+}
+
+// SubmitScores reports whether finished games go to the global leaderboard
+func (g *GameData) SubmitScores() bool {
+	return g.Profile == nil || g.Profile.SubmitScores
 }
 
 // ScreenModel interface for all screen models
@@ -135,19 +164,30 @@ type App struct {
 	analytics    ScreenModel
 	reputation   ScreenModel
 	help         ScreenModel
+	profiles     ScreenModel
+	settings     ScreenModel
+	hotSeatSetup   ScreenModel
+	hotSeatResults ScreenModel
+	tournament     ScreenModel
+	daily          ScreenModel
 
 	quitting bool
 	showHelp bool
 }
 
-// NewApp creates a new application instance
-func NewApp() *App {
+// NewApp creates a new application instance backed by store
+func NewApp(store database.Store) *App {
 	app := &App{
 		width:         80,
 		height:        24,
 		currentScreen: ScreenSplash,
 		screenStack:   []Screen{},
-		gameData:      &GameData{},
+		gameData:      &GameData{Store: store},
+	}
+
+	// Pick up where the last player left off
+	if profiles, err := store.ListProfiles(); err == nil && len(profiles) > 0 {
+		app.gameData.SetProfile(&profiles[0])
 	}
 
 	// Initialize screens - they will be created lazily
@@ -156,18 +196,6 @@ func NewApp() *App {
 
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
-	// Get user config directory (~/.config/unicorn on Linux, ~/Library/Application Support/unicorn on macOS)
-	configDir, err := os.UserConfigDir()
-	if err != nil {
-		configDir = os.Getenv("HOME")
-	}
-	unicornDir := filepath.Join(configDir, "unicorn")
-
-	// Create config directory if it doesn't exist
-	os.MkdirAll(unicornDir, 0755)
-	dbPath := filepath.Join(unicornDir, "unicorn_scores.db")
-	database.InitDB(dbPath)
-
 	// Start with splash screen
 	a.splash = NewSplashScreen(a.width, a.height)
 	return a.splash.Init()
@@ -180,7 +208,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		// Global quit handler
-		if key.Matches(msg, appKeys.Quit) && a.currentScreen != ScreenFounderGame && a.currentScreen != ScreenFounderResults {
+		if key.Matches(msg, appKeys.Quit) && a.currentScreen != ScreenFounderGame && a.currentScreen != ScreenFounderResults && a.currentScreen != ScreenProfiles && a.currentScreen != ScreenHotSeatSetup {
 			a.quitting = true
 			return a, tea.Quit
 		}
@@ -304,6 +332,40 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.help, cmd = a.help.Update(msg)
 			cmds = append(cmds, cmd)
 		}
+	case ScreenProfiles:
+		if a.profiles != nil {
+			a.profiles, cmd = a.profiles.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case ScreenSettings:
+		if a.settings != nil {
+			a.settings, cmd = a.settings.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case ScreenHotSeatSetup:
+		if a.hotSeatSetup != nil {
+			a.hotSeatSetup, cmd = a.hotSeatSetup.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case ScreenHotSeatResults:
+		if a.hotSeatResults != nil {
+			a.hotSeatResults, cmd = a.hotSeatResults.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case ScreenTournament:
+		if a.tournament != nil {
+			a.tournament, cmd = a.tournament.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case ScreenDaily:
+		if a.daily != nil {
+			a.daily, cmd = a.daily.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 	}
 
 	return a, tea.Batch(cmds...)
@@ -385,6 +447,30 @@ func (a *App) View() string {
 		if a.help != nil {
 			content = a.help.View()
 		}
+	case ScreenProfiles:
+		if a.profiles != nil {
+			content = a.profiles.View()
+		}
+	case ScreenSettings:
+		if a.settings != nil {
+			content = a.settings.View()
+		}
+	case ScreenHotSeatSetup:
+		if a.hotSeatSetup != nil {
+			content = a.hotSeatSetup.View()
+		}
+	case ScreenHotSeatResults:
+		if a.hotSeatResults != nil {
+			content = a.hotSeatResults.View()
+		}
+	case ScreenTournament:
+		if a.tournament != nil {
+			content = a.tournament.View()
+		}
+	case ScreenDaily:
+		if a.daily != nil {
+			content = a.daily.View()
+		}
 	default:
 		content = "Loading..."
 	}
@@ -398,6 +484,10 @@ func (a *App) View() string {
 
 // switchScreen handles screen transitions
 func (a *App) switchScreen(screen Screen, data interface{}) (tea.Model, tea.Cmd) {
+	// Everything past the menu is filed under a profile, so make sure there is one
+	if screen == ScreenMainMenu && a.gameData.Profile == nil {
+		screen = ScreenProfiles
+	}
 	a.currentScreen = screen
 	var cmd tea.Cmd
 
@@ -439,36 +529,60 @@ func (a *App) switchScreen(screen Screen, data interface{}) (tea.Model, tea.Cmd)
 		cmd = a.founderResults.Init()
 
 	case ScreenLeaderboard:
-		a.leaderboard = NewLeaderboardScreen(a.width, a.height)
+		a.leaderboard = NewLeaderboardScreen(a.width, a.height, a.gameData.Store)
 		cmd = a.leaderboard.Init()
 
 	case ScreenAchievements:
-		a.achievements = NewAchievementsScreen(a.width, a.height, a.gameData.PlayerName, a.gameData.CurrentMode)
+		a.achievements = NewAchievementsScreen(a.width, a.height, a.gameData.Store, a.gameData.PlayerName, a.gameData.CurrentMode)
 		cmd = a.achievements.Init()
 
 	case ScreenUpgrades:
-		a.upgrades = NewUpgradesScreen(a.width, a.height, a.gameData.PlayerName, a.gameData.CurrentMode)
+		a.upgrades = NewUpgradesScreen(a.width, a.height, a.gameData.Store, a.gameData.PlayerName, a.gameData.CurrentMode)
 		cmd = a.upgrades.Init()
 
 	case ScreenStats:
-		a.stats = NewStatsScreen(a.width, a.height)
+		a.stats = NewStatsScreen(a.width, a.height, a.gameData.Store, a.gameData.PlayerName)
 		cmd = a.stats.Init()
 
 	case ScreenProgression:
-		a.progression = NewProgressionScreen(a.width, a.height, a.gameData.PlayerName)
+		a.progression = NewProgressionScreen(a.width, a.height, a.gameData.Store, a.gameData.PlayerName)
 		cmd = a.progression.Init()
 
 	case ScreenAnalytics:
-		a.analytics = NewAnalyticsScreen(a.width, a.height)
+		a.analytics = NewAnalyticsScreen(a.width, a.height, a.gameData.Store, a.gameData.PlayerName)
 		cmd = a.analytics.Init()
 
 	case ScreenReputation:
-		a.reputation = NewReputationScreen(a.width, a.height, a.gameData.PlayerName)
+		a.reputation = NewReputationScreen(a.width, a.height, a.gameData.Store, a.gameData.PlayerName)
 		cmd = a.reputation.Init()
 
 	case ScreenHelp:
 		a.help = NewHelpScreen(a.width, a.height)
 		cmd = a.help.Init()
+
+	case ScreenProfiles:
+		a.profiles = NewProfilesScreen(a.width, a.height, a.gameData)
+		cmd = a.profiles.Init()
+
+	case ScreenSettings:
+		a.settings = NewSettingsScreen(a.width, a.height, a.gameData)
+		cmd = a.settings.Init()
+
+	case ScreenHotSeatSetup:
+		a.hotSeatSetup = NewHotSeatSetupScreen(a.width, a.height, a.gameData)
+		cmd = a.hotSeatSetup.Init()
+
+	case ScreenHotSeatResults:
+		a.hotSeatResults = NewHotSeatResultsScreen(a.width, a.height, a.gameData)
+		cmd = a.hotSeatResults.Init()
+
+	case ScreenTournament:
+		a.tournament = NewTournamentScreen(a.width, a.height, a.gameData)
+		cmd = a.tournament.Init()
+
+	case ScreenDaily:
+		a.daily = NewDailyScreen(a.width, a.height, a.gameData)
+		cmd = a.daily.Init()
 	}
 
 	return a, cmd
@@ -476,17 +590,20 @@ func (a *App) switchScreen(screen Screen, data interface{}) (tea.Model, tea.Cmd)
 
 // Run starts the Bubble Tea program
 func Run() error {
+	// Open the database before the UI so a schema from a newer build stops us cold
+	store, err := database.NewSQLiteStore(database.DefaultPath())
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
 	p := tea.NewProgram(
-		NewApp(),
+		NewApp(store),
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
 
-	_, err := p.Run()
-
-	// Clean up database on exit
-	database.CloseDB()
-
+	_, err = p.Run()
 	return err
 }
 
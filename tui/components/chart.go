@@ -0,0 +1,205 @@
+package components
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders the last width values as a one-line bar chart
+func Sparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	lo, hi := seriesRange(values)
+
+	var b strings.Builder
+	for _, v := range values {
+		level := len(sparkLevels) / 2
+		if hi > lo {
+			level = int((v - lo) / (hi - lo) * float64(len(sparkLevels)-1))
+		}
+		b.WriteRune(sparkLevels[level])
+	}
+	return b.String()
+}
+
+// FloatSeries converts an integer series (money, counts) for charting
+func FloatSeries(values []int64) []float64 {
+	out := make([]float64, len(values))
+	for i, v := range values {
+		out[i] = float64(v)
+	}
+	return out
+}
+
+// ChartSeries is one line on a LineChart
+type ChartSeries struct {
+	Name   string
+	Values []float64
+	Color  lipgloss.Color
+}
+
+// LineChart plots one or more series on a shared y-axis
+type LineChart struct {
+	Title   string
+	Series  []ChartSeries
+	Width   int
+	Height  int
+	FormatY func(float64) string // Y-axis label formatter
+}
+
+// NewLineChart creates an empty line chart
+func NewLineChart(title string, width, height int) *LineChart {
+	return &LineChart{
+		Title:   title,
+		Width:   width,
+		Height:  height,
+		FormatY: formatAxis,
+	}
+}
+
+// AddSeries adds a line to the chart. Earlier series draw on top of later ones.
+func (c *LineChart) AddSeries(name string, values []float64, color lipgloss.Color) {
+	c.Series = append(c.Series, ChartSeries{Name: name, Values: values, Color: color})
+}
+
+// View renders the chart
+func (c *LineChart) View() string {
+	var all []float64
+	for _, s := range c.Series {
+		all = append(all, s.Values...)
+	}
+	if len(all) < 2 || c.Height < 2 {
+		return lipgloss.NewStyle().Foreground(styles.Gray).Render("Not enough history to chart yet")
+	}
+	lo, hi := seriesRange(all)
+
+	labelWidth := 8
+	plotWidth := c.Width - labelWidth - 2
+	if plotWidth < 10 {
+		plotWidth = 10
+	}
+
+	// Grid of series indexes (-1 = empty); paint back to front so the first series wins
+	grid := make([][]int, c.Height)
+	for row := range grid {
+		grid[row] = make([]int, plotWidth)
+		for col := range grid[row] {
+			grid[row][col] = -1
+		}
+	}
+	for si := len(c.Series) - 1; si >= 0; si-- {
+		values := c.Series[si].Values
+		if len(values) == 0 {
+			continue
+		}
+		for col := 0; col < plotWidth; col++ {
+			row := c.rowFor(sampleAt(values, col, plotWidth), lo, hi)
+			grid[row][col] = si
+		}
+	}
+
+	var b strings.Builder
+	if c.Title != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true).Render(c.Title))
+		b.WriteString("\n")
+	}
+	axisStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+	for row := c.Height - 1; row >= 0; row-- {
+		label := ""
+		switch row {
+		case c.Height - 1:
+			label = c.FormatY(hi)
+		case 0:
+			label = c.FormatY(lo)
+		case (c.Height - 1) / 2:
+			label = c.FormatY((hi + lo) / 2)
+		}
+		b.WriteString(axisStyle.Render(padLeft(label, labelWidth) + " ┤"))
+		for col := 0; col < plotWidth; col++ {
+			si := grid[row][col]
+			if si < 0 {
+				b.WriteString(" ")
+				continue
+			}
+			b.WriteString(lipgloss.NewStyle().Foreground(c.Series[si].Color).Render("•"))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(axisStyle.Render(strings.Repeat(" ", labelWidth) + " └" + strings.Repeat("─", plotWidth)))
+
+	// Legend
+	if len(c.Series) > 1 {
+		b.WriteString("\n")
+		var legend []string
+		for _, s := range c.Series {
+			legend = append(legend, lipgloss.NewStyle().Foreground(s.Color).Render("• "+s.Name))
+		}
+		b.WriteString(strings.Repeat(" ", labelWidth+2) + strings.Join(legend, "  "))
+	}
+	return b.String()
+}
+
+func (c *LineChart) rowFor(v, lo, hi float64) int {
+	if hi <= lo {
+		return c.Height / 2
+	}
+	row := int(math.Round((v - lo) / (hi - lo) * float64(c.Height-1)))
+	return int(math.Max(0, math.Min(float64(c.Height-1), float64(row))))
+}
+
+// sampleAt stretches a series across the plot width, interpolating between points
+func sampleAt(values []float64, col, width int) float64 {
+	if len(values) == 1 || width <= 1 {
+		return values[len(values)-1]
+	}
+	pos := float64(col) / float64(width-1) * float64(len(values)-1)
+	i := int(pos)
+	if i >= len(values)-1 {
+		return values[len(values)-1]
+	}
+	frac := pos - float64(i)
+	return values[i] + (values[i+1]-values[i])*frac
+}
+
+func seriesRange(values []float64) (float64, float64) {
+	lo, hi := values[0], values[0]
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	return lo, hi
+}
+
+func formatAxis(v float64) string {
+	abs := math.Abs(v)
+	switch {
+	case abs >= 1e9:
+		return trimFloat(v/1e9) + "B"
+	case abs >= 1e6:
+		return trimFloat(v/1e6) + "M"
+	case abs >= 1e3:
+		return trimFloat(v/1e3) + "K"
+	}
+	return trimFloat(v)
+}
+
+func trimFloat(v float64) string {
+	return strings.TrimSuffix(strconv.FormatFloat(v, 'f', 1, 64), ".0")
+}
+
+func padLeft(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat(" ", width-len(s)) + s
+}
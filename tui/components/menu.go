@@ -145,6 +145,13 @@ func (m *Menu) SelectedIndex() int {
 	return m.cursor
 }
 
+// SetCursor moves the cursor, e.g. to keep position after rebuilding a menu
+func (m *Menu) SetCursor(index int) {
+	if index >= 0 && index < len(m.items) {
+		m.cursor = index
+	}
+}
+
 // View renders the menu
 func (m *Menu) View() string {
 	var b strings.Builder
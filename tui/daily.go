@@ -0,0 +1,350 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/daily"
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/game"
+	"github.com/jamesacampbell/unicorn/leaderboard"
+	"github.com/jamesacampbell/unicorn/tui/components"
+	"github.com/jamesacampbell/unicorn/tui/keys"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+// dailyScoresMsg carries the global daily leaderboard once it's fetched
+type dailyScoresMsg struct {
+	scores []leaderboard.DailyScore
+	err    error
+}
+
+// DailyScreen shows today's challenge, its leaderboards and the player's streak
+type DailyScreen struct {
+	width    int
+	height   int
+	gameData *GameData
+
+	challenge daily.Challenge
+	attempt   *database.DailyResult // The active profile's attempt today, nil if not played
+	local     []database.DailyResult
+	menu      *components.Menu
+
+	global      []leaderboard.DailyScore
+	showGlobal  bool
+	fetching    bool
+	globalError string
+
+	err string
+}
+
+// NewDailyScreen creates a new daily challenge screen
+func NewDailyScreen(width, height int, gameData *GameData) *DailyScreen {
+	s := &DailyScreen{
+		width:     width,
+		height:    height,
+		gameData:  gameData,
+		challenge: daily.Today(),
+	}
+	s.refresh()
+	return s
+}
+
+func (s *DailyScreen) refresh() {
+	store := s.gameData.Store
+	s.local, _ = store.GetDailyLeaderboard(s.challenge.Date, 10)
+	s.attempt = nil
+	if s.gameData.PlayerName != "" {
+		s.attempt, _ = store.GetDailyResult(s.challenge.Date, s.gameData.PlayerName)
+		if p, err := store.GetProfile(s.gameData.PlayerName); err == nil && p != nil {
+			s.gameData.Profile = p
+		}
+	}
+
+	var items []components.MenuItem
+	if s.attempt == nil && s.gameData.PlayerName != "" {
+		items = append(items, components.MenuItem{
+			ID:          "play",
+			Title:       fmt.Sprintf("Play Today's %s", s.challenge.Title()),
+			Description: "One attempt - quitting part way still uses it up",
+			Icon:        "▶️",
+		})
+	}
+	items = append(items,
+		components.MenuItem{
+			ID:          "global",
+			Title:       "Global Leaderboard",
+			Description: "Today's scores from everyone who submitted",
+			Icon:        "🌐",
+		},
+		components.MenuItem{
+			ID:          "back",
+			Title:       "Back",
+			Description: "Game modes",
+			Icon:        "↩️",
+		},
+	)
+	s.menu = components.NewMenu("DAILY CHALLENGE", items)
+	s.menu.SetSize(60, 8)
+	s.menu.SetHideHelp(true)
+}
+
+// Init initializes the daily challenge screen
+func (s *DailyScreen) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles daily challenge screen input
+func (s *DailyScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, keys.Global.Back) {
+			if s.showGlobal {
+				s.showGlobal = false
+				return s, nil
+			}
+			return s, SwitchTo(ScreenVCSetup)
+		}
+
+	case dailyScoresMsg:
+		s.fetching = false
+		s.global = msg.scores
+		s.globalError = ""
+		if msg.err != nil {
+			s.globalError = msg.err.Error()
+		}
+		return s, nil
+
+	case components.MenuSelectedMsg:
+		switch msg.ID {
+		case "play":
+			return s, s.start()
+		case "global":
+			s.showGlobal = !s.showGlobal
+			if s.showGlobal && s.global == nil && !s.fetching {
+				s.fetching = true
+				return s, fetchDailyScores(s.challenge.Date)
+			}
+		case "back":
+			return s, SwitchTo(ScreenVCSetup)
+		}
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	s.menu, cmd = s.menu.Update(msg)
+	return s, cmd
+}
+
+func fetchDailyScores(date string) tea.Cmd {
+	return func() tea.Msg {
+		scores, err := leaderboard.GetDailyScores(date, "")
+		return dailyScoresMsg{scores: scores, err: err}
+	}
+}
+
+// start builds today's game and uses up the day's attempt
+func (s *DailyScreen) start() tea.Cmd {
+	c := s.challenge
+	name := s.gameData.PlayerName
+	s.err = ""
+
+	switch c.Mode {
+	case "founder":
+		fs, err := c.NewFounderGame(name)
+		if err != nil {
+			s.err = err.Error()
+			return nil
+		}
+		if err := s.gameData.Store.StartDailyChallenge(c.Result(name)); err != nil {
+			s.err = err.Error()
+			return nil
+		}
+		s.gameData.FounderState = fs
+		s.gameData.CurrentMode = "founder"
+		s.gameData.Tournament = nil
+		s.gameData.Daily = &c
+		return SwitchTo(ScreenFounderGame)
+
+	default:
+		gs, err := c.NewVCGame(name, game.GenerateDefaultFirmName(name))
+		if err != nil {
+			s.err = err.Error()
+			return nil
+		}
+		if err := s.gameData.Store.StartDailyChallenge(c.Result(name)); err != nil {
+			s.err = err.Error()
+			return nil
+		}
+		s.gameData.GameState = gs
+		s.gameData.FirmName = gs.PlayerFirmName
+		s.gameData.Difficulty = gs.Difficulty
+		s.gameData.CurrentMode = "vc"
+		s.gameData.Tournament = nil
+		s.gameData.Daily = &c
+		return SwitchTo(ScreenVCInvest)
+	}
+}
+
+// finishDaily records the daily challenge just played and sends it to the daily
+// leaderboard. It returns the player's streak and a line for the results screen.
+func (g *GameData) finishDaily(playerName string, score int64, roi float64) (int, string) {
+	c := g.Daily
+	if c == nil {
+		return 0, ""
+	}
+	streak, err := g.Store.FinishDailyChallenge(c.Date, playerName, score, roi)
+	if err != nil {
+		return 0, "✗ " + err.Error()
+	}
+	if g.SubmitScores() && leaderboard.IsAPIAvailable("") {
+		_ = leaderboard.SubmitDailyScore(leaderboard.DailyScoreSubmission{
+			PlayerName: playerName,
+			Date:       c.Date,
+			Mode:       c.Mode,
+			Modifier:   c.Modifier,
+			Score:      score,
+			ROI:        roi,
+		}, "")
+	}
+	return streak, fmt.Sprintf("📅 Daily challenge %s recorded • streak: %d day(s)", c.Date, streak)
+}
+
+// View renders the daily challenge screen
+func (s *DailyScreen) View() string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Cyan).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	b.WriteString(center.Render(headerStyle.Render("📅 DAILY CHALLENGE 📅")))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Cyan).
+		Padding(1, 2)
+
+	b.WriteString(center.Render(box.Render(s.renderChallenge())))
+	b.WriteString("\n\n")
+	if s.showGlobal {
+		b.WriteString(center.Render(box.Render(s.renderGlobal())))
+	} else {
+		b.WriteString(center.Render(box.Render(s.renderLocal())))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(center.Render(box.Render(s.menu.View())))
+	b.WriteString("\n\n")
+
+	if s.err != "" {
+		errStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(errStyle.Render("✗ " + s.err))
+		b.WriteString("\n\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("↑/↓ navigate • enter select • esc back"))
+
+	return b.String()
+}
+
+func (s *DailyScreen) renderChallenge() string {
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s • %s", s.challenge.Date, s.challenge.Title())))
+	b.WriteString("\n")
+	b.WriteString(s.challenge.Description())
+	b.WriteString("\n\n")
+
+	mode := "VC Investor • Medium • no upgrades"
+	if s.challenge.Mode == "founder" {
+		mode = "Startup Founder • no upgrades"
+	}
+	b.WriteString(labelStyle.Render("Mode:   ") + mode)
+	b.WriteString("\n")
+
+	if p := s.gameData.Profile; p != nil {
+		b.WriteString(labelStyle.Render("Streak: ") + fmt.Sprintf("%d day(s) • best %d", p.ActiveDailyStreak(s.challenge.Date), p.BestDailyStreak))
+		b.WriteString("\n")
+	}
+
+	switch {
+	case s.gameData.PlayerName == "":
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Yellow).Render("Pick a profile to play the daily challenge"))
+	case s.attempt == nil:
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Green).Render("Your attempt is waiting"))
+	case s.attempt.Finished:
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Render(
+			fmt.Sprintf("Played today: $%s (%.0f%% ROI) - come back tomorrow", formatCompactMoney(s.attempt.Score), s.attempt.ROI)))
+	default:
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Gray).Render("Today's attempt was left unfinished - come back tomorrow"))
+	}
+	return b.String()
+}
+
+func (s *DailyScreen) renderLocal() string {
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
+	b.WriteString(titleStyle.Render("TODAY ON THIS MACHINE"))
+	b.WriteString("\n\n")
+
+	if len(s.local) == 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Gray).Render("Nobody here has finished today's challenge yet"))
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf("%-4s %-20s %10s %8s\n", "#", "Player", "Score", "ROI"))
+	for i, r := range s.local {
+		line := fmt.Sprintf("%-4d %-20s %10s %7.0f%%", i+1, truncate(r.PlayerName, 20), "$"+formatCompactMoney(r.Score), r.ROI)
+		style := lipgloss.NewStyle().Foreground(styles.White)
+		if r.PlayerName == s.gameData.PlayerName {
+			style = style.Foreground(styles.Cyan).Bold(true)
+		}
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (s *DailyScreen) renderGlobal() string {
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
+	b.WriteString(titleStyle.Render("TODAY WORLDWIDE"))
+	b.WriteString("\n\n")
+
+	gray := lipgloss.NewStyle().Foreground(styles.Gray)
+	switch {
+	case s.fetching:
+		b.WriteString(gray.Render("Fetching scores..."))
+		return b.String()
+	case s.globalError != "":
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Red).Render("Couldn't reach the leaderboard: " + s.globalError))
+		return b.String()
+	case len(s.global) == 0:
+		b.WriteString(gray.Render("No scores submitted for today yet"))
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf("%-4s %-20s %10s %8s\n", "#", "Player", "Score", "ROI"))
+	for i, r := range s.global {
+		if i == 10 {
+			break
+		}
+		line := fmt.Sprintf("%-4d %-20s %10s %7.0f%%", i+1, truncate(r.PlayerName, 20), "$"+formatCompactMoney(r.Score), r.ROI)
+		style := lipgloss.NewStyle().Foreground(styles.White)
+		if r.PlayerName == s.gameData.PlayerName {
+			style = style.Foreground(styles.Cyan).Bold(true)
+		}
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
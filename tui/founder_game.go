@@ -2,7 +2,6 @@ package tui
 
 import (
 	"fmt"
-	"math/rand"
 	"strconv"
 	"strings"
 
@@ -25,9 +24,11 @@ const (
 	FounderViewHiring
 	FounderViewHiringMarket
 	FounderViewFiring
+	FounderViewPeople
 	FounderViewMarketing
 	FounderViewFunding
-	FounderViewFundingTerms
+	FounderViewFundraise
+	FounderViewTermSheet
 	FounderViewPartnership
 	FounderViewAffiliate
 	FounderViewCompetitors
@@ -54,6 +55,7 @@ const (
 	FounderViewEconomy
 	FounderViewSuccession
 	FounderViewSalesPipeline
+	FounderViewDeal
 	// New features for parity
 	FounderViewStrategicOpportunity
 	FounderViewContentMarketing
@@ -71,6 +73,7 @@ const (
 	FounderViewConfirmQuit
 	FounderViewEngineerRealloc
 	FounderViewCapTable
+	FounderViewTenderOffer
 	FounderViewExecOffer
 )
 
@@ -88,14 +91,17 @@ type FounderGameScreen struct {
 	actionsMenu      *components.Menu
 	hiringMenu       *components.Menu
 	firingMenu       *components.Menu
+	peopleMenu       *components.Menu
 	partnershipMenu  *components.Menu
 	fundingMenu      *components.Menu
-	fundingTerms     []founder.TermSheetOption
+	fundraiseMenu    *components.Menu
+	termSheetMenu    *components.Menu
 	exitMenu         *components.Menu
 	competitorMenu   *components.Menu
 	expansionMenu    *components.Menu
 	pivotMenu        *components.Menu
 	boardMenu        *components.Menu
+	tenderOfferMenu  *components.Menu
 	buybackMenu      *components.Menu
 	competitorAction *components.Menu
 
@@ -108,7 +114,7 @@ type FounderGameScreen struct {
 	selectedRole          founder.EmployeeRole
 	selectedIsExec        bool
 	selectedRoundName     string
-	selectedTermIndex     int
+	selectedTermSheet     int
 	selectedExitType      string
 	selectedCompetitorIdx int
 	selectedBuybackRound  string
@@ -131,6 +137,9 @@ type FounderGameScreen struct {
 	prCrisisMenu     *components.Menu
 	economyMenu      *components.Menu
 	successionMenu   *components.Menu
+	pipelineMenu     *components.Menu
+	dealMenu         *components.Menu
+	selectedDealID   int
 
 	// Advanced inputs
 	pricingInput    textinput.Model
@@ -317,6 +326,7 @@ func (s *FounderGameScreen) rebuildActionsMenu() {
 		{ID: "header_team", Title: "── TEAM & OPERATIONS ──", Disabled: true, Icon: ""},
 		{ID: "hiring", Title: "Hire Team Member", Description: "Engineers, Sales, CS, Marketing, C-Suite", Icon: "💼"},
 		{ID: "firing", Title: "Let Go Team Member", Description: "Reduce headcount to cut costs", Icon: "⚠️"},
+		{ID: "people", Title: "Manage People", Description: "Promotions, managers, crunch time", Icon: "🧑‍🤝‍🧑"},
 		{ID: "marketing", Title: "Spend on Marketing", Description: "Acquire customers with ad spend", Icon: "📣"},
 
 		// Funding & Equity
@@ -599,7 +609,7 @@ func (s *FounderGameScreen) rebuildHiringMenu() {
 	}
 
 	items := []components.MenuItem{
-		{ID: "header_ic", Title: "── INDIVIDUAL CONTRIBUTORS ($100k/yr) ──", Disabled: true},
+		{ID: "header_ic", Title: "── INDIVIDUAL CONTRIBUTORS (pay by level & market) ──", Disabled: true},
 		{ID: "engineer", Title: "Engineer", Description: "Builds product, reduces churn", Icon: "👨‍💻"},
 		{ID: "sales", Title: "Sales Rep", Description: "Increases customer acquisition", Icon: "📞"},
 		{ID: "cs", Title: "Customer Success", Description: "Reduces churn rate", Icon: "🤝"},
@@ -620,6 +630,170 @@ func (s *FounderGameScreen) rebuildHiringMenu() {
 	s.hiringMenu.SetHideHelp(true)
 }
 
+func (s *FounderGameScreen) rebuildPeopleMenu() {
+	fg := s.gameData.FounderState
+
+	items := []components.MenuItem{}
+	if fg.CrunchMode {
+		items = append(items, components.MenuItem{
+			ID: "crunch_off", Title: "End Crunch Time", Description: "Let the team recover from burnout", Icon: "🛌",
+		})
+	} else {
+		items = append(items, components.MenuItem{
+			ID: "crunch_on", Title: "Call Crunch Time", Description: "+25% output, burnout builds every month", Icon: "🔥",
+		})
+	}
+
+	candidates := fg.PromotionCandidates()
+	if len(candidates) > 5 {
+		candidates = candidates[:5]
+	}
+	for _, e := range candidates {
+		items = append(items, components.MenuItem{
+			ID:    fmt.Sprintf("promote_%s|%s", e.Role, e.Name),
+			Title: fmt.Sprintf("Promote %s", e.Name),
+			Description: fmt.Sprintf("%s %s → %s • perf %.2f • +$%s/yr", founder.LevelTitle(e.Level), e.Role,
+				founder.LevelTitle(e.Level+1), e.Performance,
+				formatCompactMoney(founder.SalaryBand(e.Role, e.Level+1, e.AssignedMarket)-founder.SalaryBand(e.Role, e.Level, e.AssignedMarket))),
+			Icon: "⬆️",
+		})
+	}
+
+	for _, role := range []founder.EmployeeRole{founder.RoleEngineer, founder.RoleSales, founder.RoleCustomerSuccess, founder.RoleMarketing} {
+		ics, capacity := fg.ManagementCoverage(role)
+		candidate := fg.ManagerCandidate(role)
+		if candidate == nil || ics < capacity-2 {
+			continue
+		}
+		items = append(items, components.MenuItem{
+			ID:          fmt.Sprintf("manager_%s|%s", role, candidate.Name),
+			Title:       fmt.Sprintf("Make %s a %s Manager", candidate.Name, role),
+			Description: fmt.Sprintf("%d ICs, room for %d • manager costs +15%%", ics, capacity),
+			Icon:        "🧭",
+		})
+	}
+
+	items = append(items, components.MenuItem{
+		ID: "cancel", Title: "Back", Icon: "←",
+	})
+
+	s.peopleMenu = components.NewMenu("PEOPLE", items)
+	s.peopleMenu.SetSize(65, 16)
+	s.peopleMenu.SetHideHelp(true)
+}
+
+func (s *FounderGameScreen) handlePeopleSelection(id string) (ScreenModel, tea.Cmd) {
+	fg := s.gameData.FounderState
+
+	switch id {
+	case "cancel":
+		s.view = FounderViewActions
+		return s, nil
+	case "crunch_on":
+		fg.SetCrunchMode(true)
+		s.inputMessage = "🔥 Crunch time: output up 25%, burnout will build until you call it off"
+		s.rebuildPeopleMenu()
+		return s, nil
+	case "crunch_off":
+		fg.SetCrunchMode(false)
+		s.inputMessage = "🛌 Crunch is over — burnout will ease off over the next few months"
+		s.rebuildPeopleMenu()
+		return s, nil
+	}
+
+	action, target, ok := strings.Cut(id, "_")
+	if !ok {
+		return s, nil
+	}
+	roleName, name, ok := strings.Cut(target, "|")
+	if !ok {
+		return s, nil
+	}
+	role := founder.EmployeeRole(roleName)
+
+	var err error
+	switch action {
+	case "promote":
+		err = fg.PromoteEmployee(role, name)
+		if err == nil {
+			s.inputMessage = fmt.Sprintf("⬆️ Promoted %s", name)
+		}
+	case "manager":
+		err = fg.MakeManager(role, name)
+		if err == nil {
+			s.inputMessage = fmt.Sprintf("🧭 %s now manages part of the %s team", name, role)
+		}
+	}
+	if err != nil {
+		s.inputMessage = fmt.Sprintf("❌ %v", err)
+	}
+	s.rebuildPeopleMenu()
+	return s, nil
+}
+
+func (s *FounderGameScreen) renderPeople() string {
+	fg := s.gameData.FounderState
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Green).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🧑‍🤝‍🧑 PEOPLE")))
+	b.WriteString("\n\n")
+
+	infoStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
+	crunch := "off"
+	if fg.CrunchMode {
+		crunch = fmt.Sprintf("ON since month %d", fg.CrunchStartMonth)
+	}
+	b.WriteString(infoStyle.Render(fmt.Sprintf("Culture: %.0f%% | Avg Morale: %.0f%% | Avg Burnout: %.0f%% | Crunch: %s",
+		fg.Culture*100, fg.AverageMorale()*100, fg.AverageBurnout()*100, crunch)))
+	b.WriteString("\n")
+
+	var coverage []string
+	for _, role := range []founder.EmployeeRole{founder.RoleEngineer, founder.RoleSales, founder.RoleCustomerSuccess, founder.RoleMarketing} {
+		ics, capacity := fg.ManagementCoverage(role)
+		if ics == 0 {
+			continue
+		}
+		mark := "✓"
+		if fg.NeedsManager(role) {
+			mark = "⚠️"
+		}
+		coverage = append(coverage, fmt.Sprintf("%s %d/%d %s", role, ics, capacity, mark))
+	}
+	if len(coverage) > 0 {
+		b.WriteString(infoStyle.Render("Management: " + strings.Join(coverage, " | ")))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if s.inputMessage != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Width(s.width).Align(lipgloss.Center).Render(s.inputMessage))
+		b.WriteString("\n\n")
+	}
+
+	menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	menuBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(1, 2)
+
+	if s.peopleMenu != nil {
+		b.WriteString(menuContainer.Render(menuBox.Render(s.peopleMenu.View())))
+	}
+	b.WriteString("\n\n")
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("esc back • enter select • see Team Roster for individual morale"))
+
+	return b.String()
+}
+
 func (s *FounderGameScreen) rebuildFiringMenu() {
 	fg := s.gameData.FounderState
 
@@ -674,6 +848,18 @@ func (s *FounderGameScreen) rebuildFundingMenu() {
 
 	items := []components.MenuItem{}
 
+	if fg.ActiveFundraise != nil {
+		p := fg.ActiveFundraise
+		items = append(items, components.MenuItem{
+			ID:          "process",
+			Title:       fmt.Sprintf("Continue %s Process", p.RoundName),
+			Description: fmt.Sprintf("%d term sheet(s), closes by month %d", len(p.TermSheets), p.Deadline),
+			Icon:        "💼",
+		})
+		// Only one raise at a time
+		hasSeed, hasSeriesA, hasSeriesB = true, true, true
+	}
+
 	if !hasSeed {
 		items = append(items, components.MenuItem{
 			ID: "seed", Title: "Seed Round ($2-5M)", Description: "Early stage funding", Icon: "🌱",
@@ -821,6 +1007,12 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, nil
 			}
 
+		case FounderViewPeople:
+			if key.Matches(msg, keys.Global.Back) {
+				s.view = FounderViewActions
+				return s, nil
+			}
+
 		case FounderViewMarketing:
 			switch {
 			case key.Matches(msg, keys.Global.Back):
@@ -837,16 +1029,17 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, nil
 			}
 
-		case FounderViewFundingTerms:
+		case FounderViewFundraise:
 			if key.Matches(msg, keys.Global.Back) {
-				s.view = FounderViewFunding
+				s.view = FounderViewActions
 				return s, nil
 			}
-			// Handle number keys for term selection
-			keyStr := msg.String()
-			if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '4' {
-				num := int(keyStr[0] - '0')
-				return s.handleTermSelection(num)
+
+		case FounderViewTermSheet:
+			if key.Matches(msg, keys.Global.Back) {
+				s.rebuildFundraiseMenu()
+				s.view = FounderViewFundraise
+				return s, nil
 			}
 
 		case FounderViewPartnership:
@@ -895,6 +1088,13 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, nil
 			}
 
+		case FounderViewTenderOffer:
+			if key.Matches(msg, keys.Global.Back) {
+				s.rebuildBoardMenu()
+				s.view = FounderViewBoard
+				return s, nil
+			}
+
 		case FounderViewBoardAction:
 			if key.Matches(msg, keys.Global.Back) {
 				s.rebuildBoardMenu()
@@ -1026,6 +1226,14 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, nil
 			}
 
+		case FounderViewDeal:
+			if key.Matches(msg, keys.Global.Back) {
+				s.inputMessage = ""
+				s.rebuildPipelineMenu()
+				s.view = FounderViewSalesPipeline
+				return s, nil
+			}
+
 		// New feature views
 		case FounderViewStrategicOpportunity:
 			if key.Matches(msg, keys.Global.Back) {
@@ -1146,8 +1354,14 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 			return s.handleExecOfferSelection(msg.ID)
 		case FounderViewFiring:
 			return s.handleFiringSelection(msg.ID)
+		case FounderViewPeople:
+			return s.handlePeopleSelection(msg.ID)
 		case FounderViewFunding:
 			return s.handleFundingSelection(msg.ID)
+		case FounderViewFundraise:
+			return s.handleFundraiseSelection(msg.ID)
+		case FounderViewTermSheet:
+			return s.handleTermSheetSelection(msg.ID)
 		case FounderViewPartnership:
 			return s.handlePartnershipSelection(msg.ID)
 		case FounderViewExit:
@@ -1160,6 +1374,8 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 			return s.handlePivotSelection(msg.ID)
 		case FounderViewBoard:
 			return s.handleBoardSelection(msg.ID)
+		case FounderViewTenderOffer:
+			return s.handleTenderOfferSelection(msg.ID)
 		case FounderViewBuyback:
 			return s.handleBuybackSelection(msg.ID)
 		case FounderViewRoadmap:
@@ -1180,6 +1396,10 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 			return s.handleEconomySelection(msg.ID)
 		case FounderViewSuccession:
 			return s.handleSuccessionSelection(msg.ID)
+		case FounderViewSalesPipeline:
+			return s.handlePipelineSelection(msg.ID)
+		case FounderViewDeal:
+			return s.handleDealSelection(msg.ID)
 		// New feature handlers
 		case FounderViewStrategicOpportunity:
 			return s.handleStrategicOpportunitySelection(msg.ID)
@@ -1230,8 +1450,20 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		}
 	case FounderViewFiring:
 		s.firingMenu, cmd = s.firingMenu.Update(msg)
+	case FounderViewPeople:
+		if s.peopleMenu != nil {
+			s.peopleMenu, cmd = s.peopleMenu.Update(msg)
+		}
 	case FounderViewFunding:
 		s.fundingMenu, cmd = s.fundingMenu.Update(msg)
+	case FounderViewFundraise:
+		if s.fundraiseMenu != nil {
+			s.fundraiseMenu, cmd = s.fundraiseMenu.Update(msg)
+		}
+	case FounderViewTermSheet:
+		if s.termSheetMenu != nil {
+			s.termSheetMenu, cmd = s.termSheetMenu.Update(msg)
+		}
 	case FounderViewMarketing:
 		s.marketingInput, cmd = s.marketingInput.Update(msg)
 	case FounderViewAffiliate:
@@ -1256,6 +1488,10 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		if s.boardMenu != nil {
 			s.boardMenu, cmd = s.boardMenu.Update(msg)
 		}
+	case FounderViewTenderOffer:
+		if s.tenderOfferMenu != nil {
+			s.tenderOfferMenu, cmd = s.tenderOfferMenu.Update(msg)
+		}
 	case FounderViewBoardAction:
 		s.equityPoolInput, cmd = s.equityPoolInput.Update(msg)
 	case FounderViewBuyback:
@@ -1300,6 +1536,14 @@ func (s *FounderGameScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		if s.successionMenu != nil {
 			s.successionMenu, cmd = s.successionMenu.Update(msg)
 		}
+	case FounderViewSalesPipeline:
+		if s.pipelineMenu != nil {
+			s.pipelineMenu, cmd = s.pipelineMenu.Update(msg)
+		}
+	case FounderViewDeal:
+		if s.dealMenu != nil {
+			s.dealMenu, cmd = s.dealMenu.Update(msg)
+		}
 	// New feature menu updates
 	case FounderViewStrategicOpportunity:
 		if s.strategicOpportunityMenu != nil {
@@ -1394,6 +1638,12 @@ func (s *FounderGameScreen) handleAction(id string) (ScreenModel, tea.Cmd) {
 		s.view = FounderViewFiring
 		return s, nil
 
+	case "people":
+		s.inputMessage = ""
+		s.rebuildPeopleMenu()
+		s.view = FounderViewPeople
+		return s, nil
+
 	case "marketing":
 		s.marketingInput.SetValue("")
 		s.marketingInput.Focus()
@@ -1556,6 +1806,7 @@ func (s *FounderGameScreen) handleAction(id string) (ScreenModel, tea.Cmd) {
 		return s, nil
 
 	case "pipeline":
+		s.rebuildPipelineMenu()
 		s.view = FounderViewSalesPipeline
 		return s, nil
 
@@ -1676,9 +1927,11 @@ func (s *FounderGameScreen) handleHiringSelection(id string) (ScreenModel, tea.C
 	if err != nil {
 		s.turnMessages = []string{fmt.Sprintf("❌ Error: %v", err)}
 	} else {
+		team := *fg.TeamForRole(role)
+		hire := team[len(team)-1]
 		s.turnMessages = []string{
-			fmt.Sprintf("✓ Hired a new %s!", role),
-			fmt.Sprintf("   Cost: $100k/year"),
+			fmt.Sprintf("✓ Hired %s (%s %s)!", hire.Name, founder.LevelTitle(hire.Level), role),
+			fmt.Sprintf("   Cost: $%s/year", formatCompactMoney(hire.MonthlyCost*12)),
 			fmt.Sprintf("   New runway: %d months", fg.CashRunwayMonths),
 		}
 	}
@@ -1839,9 +2092,11 @@ func (s *FounderGameScreen) handleMarketSelection(num int) (ScreenModel, tea.Cmd
 	if err != nil {
 		s.turnMessages = []string{fmt.Sprintf("❌ Error: %v", err)}
 	} else {
+		team := *fg.TeamForRole(s.selectedRole)
+		hire := team[len(team)-1]
 		s.turnMessages = []string{
-			fmt.Sprintf("✓ Hired a new %s!", s.selectedRole),
-			fmt.Sprintf("   Assigned to: %s", market),
+			fmt.Sprintf("✓ Hired %s (%s %s)!", hire.Name, founder.LevelTitle(hire.Level), s.selectedRole),
+			fmt.Sprintf("   Assigned to: %s at $%s/year", market, formatCompactMoney(hire.MonthlyCost*12)),
 			fmt.Sprintf("   New runway: %d months", fg.CashRunwayMonths),
 		}
 	}
@@ -1937,6 +2192,13 @@ func (s *FounderGameScreen) handleFundingSelection(id string) (ScreenModel, tea.
 		return s, nil
 	}
 
+	if id == "process" {
+		s.inputMessage = ""
+		s.rebuildFundraiseMenu()
+		s.view = FounderViewFundraise
+		return s, nil
+	}
+
 	var roundName string
 	switch id {
 	case "seed":
@@ -1950,50 +2212,188 @@ func (s *FounderGameScreen) handleFundingSelection(id string) (ScreenModel, tea.
 	}
 
 	s.selectedRoundName = roundName
-	s.fundingTerms = fg.GenerateTermSheetOptions(roundName)
-
-	if len(s.fundingTerms) == 0 {
-		s.turnMessages = []string{"❌ Unable to generate term sheets!"}
+	process, err := fg.StartFundraise(roundName)
+	if err != nil {
+		s.turnMessages = []string{fmt.Sprintf("❌ Can't start fundraise: %v", err)}
 		s.view = FounderViewMain
 		return s, nil
 	}
 
-	s.view = FounderViewFundingTerms
+	s.inputMessage = fmt.Sprintf("💼 Kicked off %s fundraise: %d investors on your target list, %d months to close",
+		roundName, len(process.Prospects), process.Deadline-process.StartMonth)
+	s.rebuildFundraiseMenu()
+	s.view = FounderViewFundraise
 	return s, nil
 }
 
-func (s *FounderGameScreen) handleTermSelection(num int) (ScreenModel, tea.Cmd) {
+func (s *FounderGameScreen) rebuildFundraiseMenu() {
 	fg := s.gameData.FounderState
+	p := fg.ActiveFundraise
 
-	if num < 1 || num > len(s.fundingTerms) {
+	items := []components.MenuItem{}
+	if p != nil {
+		for i, sheet := range p.TermSheets {
+			items = append(items, components.MenuItem{
+				ID:          fmt.Sprintf("sheet_%d", i),
+				Title:       fmt.Sprintf("Term Sheet: %s", sheet.Investor),
+				Description: fmt.Sprintf("$%s at $%s pre • expires month %d", formatCompactMoney(sheet.Amount), formatCompactMoney(sheet.PreValuation), sheet.ExpiresMonth),
+				Icon:        "📄",
+			})
+		}
+		slotsLeft := p.MaxMeetings - p.MeetingsThisMonth
+		for i, prospect := range p.Prospects {
+			if prospect.Stage == "passed" || prospect.Stage == "term_sheet" {
+				continue
+			}
+			items = append(items, components.MenuItem{
+				ID:          fmt.Sprintf("meet_%d", i),
+				Title:       fmt.Sprintf("Meet %s", prospect.Name),
+				Description: prospectDescription(prospect),
+				Icon:        "☕",
+				Disabled:    slotsLeft <= 0,
+			})
+		}
+		items = append(items, components.MenuItem{
+			ID: "abandon", Title: "Walk Away From Raise", Description: "End the process without closing", Icon: "🚪",
+		})
+	}
+
+	items = append(items, components.MenuItem{
+		ID: "cancel", Title: "Back", Icon: "←",
+	})
+
+	s.fundraiseMenu = components.NewMenu("FUNDRAISING", items)
+	s.fundraiseMenu.SetSize(65, 18)
+	s.fundraiseMenu.SetHideHelp(true)
+}
+
+func prospectDescription(prospect founder.InvestorProspect) string {
+	desc := fmt.Sprintf("%s • interest %.0f%%", strings.ReplaceAll(prospect.Stage, "_", " "), prospect.Interest*100)
+	if prospect.Strategy != "" {
+		desc += fmt.Sprintf(" • %s • thesis fit %.0f%%", strings.ReplaceAll(prospect.Strategy, "_", " "), prospect.ThesisFit*100)
+	}
+	return desc
+}
+
+func (s *FounderGameScreen) handleFundraiseSelection(id string) (ScreenModel, tea.Cmd) {
+	fg := s.gameData.FounderState
+
+	switch id {
+	case "cancel":
+		s.view = FounderViewActions
+		return s, nil
+	case "abandon":
+		if err := fg.AbandonFundraise(); err != nil {
+			s.turnMessages = []string{fmt.Sprintf("❌ %v", err)}
+		} else {
+			s.turnMessages = []string{fmt.Sprintf("🚪 Walked away from the %s process", s.selectedRoundName)}
+		}
+		s.view = FounderViewMain
 		return s, nil
 	}
 
-	selectedSheet := s.fundingTerms[num-1]
-	success := fg.RaiseFundingWithTerms(s.selectedRoundName, selectedSheet)
+	if strings.HasPrefix(id, "meet_") {
+		idx, _ := strconv.Atoi(strings.TrimPrefix(id, "meet_"))
+		result, err := fg.TakeInvestorMeeting(idx)
+		if err != nil {
+			s.inputMessage = fmt.Sprintf("❌ %v", err)
+		} else {
+			s.inputMessage = result
+		}
+		s.rebuildFundraiseMenu()
+		return s, nil
+	}
 
-	if !success {
-		s.turnMessages = []string{"❌ Failed to raise funding!"}
-	} else {
+	if strings.HasPrefix(id, "sheet_") {
+		idx, _ := strconv.Atoi(strings.TrimPrefix(id, "sheet_"))
+		if fg.ActiveFundraise != nil && idx >= 0 && idx < len(fg.ActiveFundraise.TermSheets) {
+			s.selectedTermSheet = idx
+			s.rebuildTermSheetMenu()
+			s.view = FounderViewTermSheet
+		}
+		return s, nil
+	}
+
+	return s, nil
+}
+
+func (s *FounderGameScreen) rebuildTermSheetMenu() {
+	fg := s.gameData.FounderState
+	sheet := fg.ActiveFundraise.TermSheets[s.selectedTermSheet]
+
+	items := []components.MenuItem{
+		{ID: "accept", Title: "Accept & Close Round", Description: fmt.Sprintf("Take $%s from %s", formatCompactMoney(sheet.Amount), sheet.Investor), Icon: "✅"},
+		{ID: "valuation", Title: "Push for Higher Valuation", Description: "More leverage with competing term sheets", Icon: "📈"},
+	}
+	if sheet.BoardSeats > 0 {
+		items = append(items, components.MenuItem{
+			ID: "board_seat", Title: "Ask for One Fewer Board Seat", Description: fmt.Sprintf("Currently %d seat(s)", sheet.BoardSeats), Icon: "🪑",
+		})
+	}
+	if sheet.ProRata {
+		items = append(items, components.MenuItem{
+			ID: "pro_rata", Title: "Strike Pro-Rata Rights", Description: "Keep room for new investors later", Icon: "✂️",
+		})
+	}
+	items = append(items, components.MenuItem{
+		ID: "cancel", Title: "Back", Icon: "←",
+	})
+
+	s.termSheetMenu = components.NewMenu("TERM SHEET", items)
+	s.termSheetMenu.SetSize(60, 12)
+	s.termSheetMenu.SetHideHelp(true)
+}
+
+func (s *FounderGameScreen) handleTermSheetSelection(id string) (ScreenModel, tea.Cmd) {
+	fg := s.gameData.FounderState
+
+	switch id {
+	case "cancel":
+		s.rebuildFundraiseMenu()
+		s.view = FounderViewFundraise
+		return s, nil
+
+	case "accept":
+		round, err := fg.AcceptTermSheet(s.selectedTermSheet)
+		if err != nil {
+			s.turnMessages = []string{fmt.Sprintf("❌ Failed to close round: %v", err)}
+			s.view = FounderViewMain
+			return s, nil
+		}
 		runway := fmt.Sprintf("%d months", fg.CashRunwayMonths)
 		if fg.CashRunwayMonths < 0 {
 			runway = "∞ (profitable!)"
 		}
 		s.turnMessages = []string{
-			fmt.Sprintf("✓ Successfully raised %s!", s.selectedRoundName),
-			fmt.Sprintf("   Amount: $%s", formatCompactMoney(selectedSheet.Amount)),
-			fmt.Sprintf("   Valuation: $%s", formatCompactMoney(selectedSheet.PostValuation)),
-			fmt.Sprintf("   Equity Given: %.1f%%", selectedSheet.Equity),
+			fmt.Sprintf("✓ Successfully closed %s led by %s!", round.RoundName, round.Investors[0]),
+			fmt.Sprintf("   Amount: $%s", formatCompactMoney(round.Amount)),
+			fmt.Sprintf("   Valuation: $%s post-money", formatCompactMoney(round.Valuation+round.Amount)),
+			fmt.Sprintf("   Equity Given: %.1f%%", round.EquityGiven),
 			fmt.Sprintf("   Your equity: %.1f%%", 100.0-fg.EquityGivenAway-fg.EquityPool),
 			fmt.Sprintf("   New runway: %s", runway),
 		}
-		if selectedSheet.BoardSeatsOffered > 0 {
-			s.turnMessages = append(s.turnMessages,
-				fmt.Sprintf("   Board seats granted: %d", selectedSheet.BoardSeatsOffered))
+		s.view = FounderViewMain
+		return s, nil
+
+	case "valuation", "board_seat", "pro_rata":
+		investor := fg.ActiveFundraise.TermSheets[s.selectedTermSheet].Investor
+		result, err := fg.NegotiateTermSheet(s.selectedTermSheet, id)
+		if err != nil {
+			s.inputMessage = fmt.Sprintf("❌ %v", err)
+		} else {
+			s.inputMessage = result
 		}
+		// The investor may have pulled their sheet
+		if s.selectedTermSheet >= len(fg.ActiveFundraise.TermSheets) ||
+			fg.ActiveFundraise.TermSheets[s.selectedTermSheet].Investor != investor {
+			s.rebuildFundraiseMenu()
+			s.view = FounderViewFundraise
+			return s, nil
+		}
+		s.rebuildTermSheetMenu()
+		return s, nil
 	}
 
-	s.view = FounderViewMain
 	return s, nil
 }
 
@@ -2360,6 +2760,15 @@ func (s *FounderGameScreen) rebuildBoardMenu() {
 		{ID: "add_advisor", Title: "Add Advisor", Description: "0.25-1% equity, optional board seat", Icon: "🧠"},
 	}
 
+	tenderDesc := "Let employees sell vested shares to investors"
+	canTender, tenderReason := fg.CanRunTenderOffer()
+	if !canTender {
+		tenderDesc = tenderReason
+	}
+	items = append(items, components.MenuItem{
+		ID: "tender_offer", Title: "Run Tender Offer", Description: tenderDesc, Icon: "🤝", Disabled: !canTender,
+	})
+
 	// Check for advisors who can be promoted to chairman
 	hasActiveAdvisor := false
 	hasChairman := false
@@ -2484,6 +2893,12 @@ func (s *FounderGameScreen) handleBoardSelection(id string) (ScreenModel, tea.Cm
 		s.view = FounderViewBoardAction
 		return s, textinput.Blink
 
+	case "tender_offer":
+		s.inputMessage = ""
+		s.rebuildTenderOfferMenu()
+		s.view = FounderViewTenderOffer
+		return s, nil
+
 	case "add_advisor":
 		// Show expertise selection
 		s.rebuildAdvisorExpertiseMenu()
@@ -2762,12 +3177,28 @@ func (s *FounderGameScreen) rebuildRoadmapMenu() {
 		{ID: "start", Title: "Start New Feature", Description: "Assign engineers to build features", Icon: "🚀"},
 	}
 
+	backlog := fg.GetFeatureBacklog()
+	items = append(items, components.MenuItem{
+		ID: "backlog", Title: "Feature Request Backlog", Description: fmt.Sprintf("%d features requested by customers and prospects", len(backlog)), Icon: "📣",
+	})
+
 	inProgress := fg.GetInProgressFeatures()
 	if len(inProgress) > 0 {
 		items = append(items, components.MenuItem{
 			ID: "reallocate", Title: "Reallocate Engineers", Description: "Adjust team assignments", Icon: "👥",
 		})
 	}
+	for _, f := range inProgress {
+		if f.Rushed {
+			continue
+		}
+		items = append(items, components.MenuItem{
+			ID:          "rush_" + f.Name,
+			Title:       fmt.Sprintf("Rush %s", f.Name),
+			Description: "Ship ~40% faster; adds tech debt and bug risk",
+			Icon:        "⚡",
+		})
+	}
 
 	items = append(items, components.MenuItem{
 		ID: "cancel", Title: "Back", Icon: "←",
@@ -2794,7 +3225,14 @@ func (s *FounderGameScreen) handleRoadmapSelection(id string) (ScreenModel, tea.
 		if len(inProgress) > 0 {
 			msgs = append(msgs, "🔨 IN PROGRESS:")
 			for _, f := range inProgress {
-				msgs = append(msgs, fmt.Sprintf("  • %s (%d%% done, %d engineers)", f.Name, f.DevelopmentProgress, f.AllocatedEngineers))
+				line := fmt.Sprintf("  • %s (%d%% done, %d engineers, ETA month %d)", f.Name, f.DevelopmentProgress, f.AllocatedEngineers, f.ExpectedMonth)
+				if f.SlipMonths > 0 {
+					line += fmt.Sprintf(" — slipped %d mo", f.SlipMonths)
+				}
+				if f.Rushed {
+					line += " ⚡ rushed"
+				}
+				msgs = append(msgs, line)
 			}
 		}
 
@@ -2818,6 +3256,30 @@ func (s *FounderGameScreen) handleRoadmapSelection(id string) (ScreenModel, tea.
 		s.view = FounderViewRoadmapStart
 		return s, nil
 
+	case "backlog":
+		backlog := fg.GetFeatureBacklog()
+		if len(backlog) == 0 {
+			s.turnMessages = []string{"📣 No feature requests yet — talk to customers and run discovery on deals"}
+		} else {
+			msgs := []string{"📣 FEATURE REQUEST BACKLOG (by priority):"}
+			for i, req := range backlog {
+				if i >= 8 {
+					break
+				}
+				line := fmt.Sprintf("  %d. %s — %d asks, %d lost deals ($%s/mo)", i+1, req.FeatureName, req.Requests, req.LostDeals, formatCompactMoney(req.LostMRR))
+				if req.BlockedDeals > 0 {
+					line += fmt.Sprintf(", %d open deals waiting", req.BlockedDeals)
+				}
+				if missing := fg.MissingPrerequisites(req.FeatureName); len(missing) > 0 {
+					line += fmt.Sprintf(" [needs %s]", strings.Join(missing, ", "))
+				}
+				msgs = append(msgs, line)
+			}
+			s.turnMessages = msgs
+		}
+		s.view = FounderViewMain
+		return s, nil
+
 	case "reallocate":
 		s.rebuildEngineerReallocMenu()
 		if s.reallocMenu == nil {
@@ -2829,6 +3291,20 @@ func (s *FounderGameScreen) handleRoadmapSelection(id string) (ScreenModel, tea.
 		return s, nil
 	}
 
+	if strings.HasPrefix(id, "rush_") {
+		name := strings.TrimPrefix(id, "rush_")
+		if err := fg.RushFeature(name); err != nil {
+			s.turnMessages = []string{fmt.Sprintf("❌ Error: %v", err)}
+		} else {
+			s.turnMessages = []string{
+				fmt.Sprintf("⚡ Rushing %s", name),
+				"   Ships faster, but expect bugs for a few months after launch",
+			}
+		}
+		s.view = FounderViewMain
+		return s, nil
+	}
+
 	return s, nil
 }
 
@@ -3613,6 +4089,178 @@ func (s *FounderGameScreen) handleSuccessionSelection(id string) (ScreenModel, t
 	return s, nil
 }
 
+// Sales Pipeline
+func (s *FounderGameScreen) rebuildPipelineMenu() {
+	fg := s.gameData.FounderState
+
+	var items []components.MenuItem
+	if fg.SalesPipeline != nil {
+		stages := []struct{ id, title string }{
+			{"negotiation", "Negotiation"},
+			{"demo", "Demo / POC"},
+			{"qualified", "Qualified"},
+			{"lead", "Lead"},
+		}
+		for _, stage := range stages {
+			var deals []founder.Deal
+			for _, deal := range fg.SalesPipeline.ActiveDeals {
+				if deal.Stage == stage.id {
+					deals = append(deals, deal)
+				}
+			}
+			if len(deals) == 0 {
+				continue
+			}
+			items = append(items, components.MenuItem{
+				ID:       "stage_" + stage.id,
+				Title:    fmt.Sprintf("── %s (%d) ──", stage.title, len(deals)),
+				Disabled: true,
+			})
+			for _, deal := range deals {
+				desc := fmt.Sprintf("%s • %.0f%%", deal.Segment, deal.CloseProbability*100)
+				if deal.AssignedSalesRep != "" {
+					desc += " • " + deal.AssignedSalesRep
+				} else {
+					desc += " • unassigned"
+				}
+				if deal.DiscoveryDone && deal.Competitor != "" {
+					desc += " • vs " + deal.Competitor
+				}
+				if deal.POCStatus == "running" {
+					desc += " • POC running"
+				} else if deal.SecurityReview == "required" || deal.SecurityReview == "in_progress" {
+					desc += " • security review"
+				}
+				items = append(items, components.MenuItem{
+					ID:          fmt.Sprintf("deal_%d", deal.ID),
+					Title:       fmt.Sprintf("%s — $%s/mo", deal.CompanyName, formatCompactMoney(deal.DealSize)),
+					Description: desc,
+					Icon:        "💼",
+				})
+			}
+		}
+	}
+
+	items = append(items, components.MenuItem{
+		ID: "cancel", Title: "Back", Icon: "←",
+	})
+
+	s.pipelineMenu = components.NewMenu("DEALS", items)
+	s.pipelineMenu.SetSize(65, 18)
+	s.pipelineMenu.SetHideHelp(true)
+}
+
+func (s *FounderGameScreen) handlePipelineSelection(id string) (ScreenModel, tea.Cmd) {
+	if id == "cancel" {
+		s.view = FounderViewActions
+		return s, nil
+	}
+
+	if strings.HasPrefix(id, "deal_") {
+		dealID, err := strconv.Atoi(strings.TrimPrefix(id, "deal_"))
+		if err != nil {
+			return s, nil
+		}
+		s.selectedDealID = dealID
+		s.inputMessage = ""
+		s.rebuildDealMenu()
+		s.view = FounderViewDeal
+	}
+
+	return s, nil
+}
+
+func (s *FounderGameScreen) rebuildDealMenu() {
+	fg := s.gameData.FounderState
+
+	deal, ok := fg.GetDeal(s.selectedDealID)
+	if !ok {
+		s.dealMenu = components.NewMenu("DEAL", []components.MenuItem{{ID: "cancel", Title: "Back", Icon: "←"}})
+		s.dealMenu.SetHideHelp(true)
+		return
+	}
+
+	early := deal.Stage == "lead" || deal.Stage == "qualified"
+	late := deal.Stage == "demo" || deal.Stage == "negotiation"
+
+	items := []components.MenuItem{
+		{
+			ID:          "discovery",
+			Title:       "Run Discovery",
+			Description: "Uncover requirements and competitors (+10% close)",
+			Icon:        "🔎",
+			Disabled:    deal.DiscoveryDone || !early,
+		},
+		{
+			ID:          "poc",
+			Title:       "Start Proof of Concept",
+			Description: "$10-20k (2x enterprise), 1-2 months",
+			Icon:        "🧪",
+			Disabled:    deal.POCStatus != "" || !late,
+		},
+		{
+			ID:          "security",
+			Title:       "Complete Security Review",
+			Description: "$5k questionnaire and audit",
+			Icon:        "🛡️",
+			Disabled:    deal.SecurityReview != "required" && deal.SecurityReview != "failed",
+		},
+		{
+			ID:          "discount_10",
+			Title:       "Offer 10% Discount",
+			Description: "Smaller deal, higher close probability",
+			Icon:        "🏷️",
+			Disabled:    !late || deal.Discount+0.10 > 0.30,
+		},
+		{
+			ID:          "discount_20",
+			Title:       "Offer 20% Discount",
+			Description: "Smaller deal, higher close probability",
+			Icon:        "🏷️",
+			Disabled:    !late || deal.Discount+0.20 > 0.30,
+		},
+		{ID: "cancel", Title: "Back", Icon: "←"},
+	}
+
+	s.dealMenu = components.NewMenu(strings.ToUpper(deal.CompanyName), items)
+	s.dealMenu.SetSize(55, 12)
+	s.dealMenu.SetHideHelp(true)
+}
+
+func (s *FounderGameScreen) handleDealSelection(id string) (ScreenModel, tea.Cmd) {
+	fg := s.gameData.FounderState
+
+	var result string
+	var err error
+	switch id {
+	case "cancel":
+		s.inputMessage = ""
+		s.rebuildPipelineMenu()
+		s.view = FounderViewSalesPipeline
+		return s, nil
+	case "discovery":
+		result, err = fg.RunDiscovery(s.selectedDealID)
+	case "poc":
+		result, err = fg.StartPOC(s.selectedDealID)
+	case "security":
+		result, err = fg.StartSecurityReview(s.selectedDealID)
+	case "discount_10":
+		result, err = fg.OfferDiscount(s.selectedDealID, 0.10)
+	case "discount_20":
+		result, err = fg.OfferDiscount(s.selectedDealID, 0.20)
+	default:
+		return s, nil
+	}
+
+	if err != nil {
+		s.inputMessage = fmt.Sprintf("❌ %v", err)
+	} else {
+		s.inputMessage = result
+	}
+	s.rebuildDealMenu()
+	return s, nil
+}
+
 // ============================================================================
 // NEW FEATURE MENUS AND HANDLERS
 // ============================================================================
@@ -3799,7 +4447,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 		if chairman != nil {
 			delegateName = "Chairman " + chairman.Name
 			// Chairman's network occasionally gives a bonus
-			if rand.Float64() < 0.30 {
+			if fg.Rand().Float64() < 0.30 {
 				benefitMultiplier = 0.85 // Chairman's network came through
 			}
 		}
@@ -3841,7 +4489,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 	// Apply actual effects based on type
 	switch opp.Type {
 	case "press":
-		newCustomers := scaleCustomers(5 + rand.Intn(15))
+		newCustomers := scaleCustomers(5 + fg.Rand().Intn(15))
 		if newCustomers < 1 {
 			newCustomers = 1
 		}
@@ -3857,8 +4505,8 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 
 	case "enterprise_pilot":
 		successChance := 0.80 * benefitMultiplier // Delegation reduces success rate
-		if rand.Float64() < successChance {
-			dealMRR := scaleMRR((50000 + rand.Int63n(150000)) / 12)
+		if fg.Rand().Float64() < successChance {
+			dealMRR := scaleMRR((50000 + fg.Rand().Int63n(150000)) / 12)
 			fg.Customers += 1
 			fg.DirectCustomers += 1
 			fg.DirectMRR += dealMRR
@@ -3870,8 +4518,8 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 		}
 
 	case "bridge_round":
-		amount := 200000 + rand.Int63n(500000)
-		equity := 3.0 + rand.Float64()*5.0
+		amount := 200000 + fg.Rand().Int63n(500000)
+		equity := 3.0 + fg.Rand().Float64()*5.0
 		fg.Cash += amount
 		fg.EquityGivenAway += equity
 		fg.CalculateRunway()
@@ -3881,7 +4529,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 			fmt.Sprintf("   New runway: %d months", fg.CashRunwayMonths))
 
 	case "conference":
-		newCustomers := scaleCustomers(3 + rand.Intn(8))
+		newCustomers := scaleCustomers(3 + fg.Rand().Intn(8))
 		if newCustomers < 1 {
 			newCustomers = 1
 		}
@@ -3903,6 +4551,9 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 			MonthlyCost: 200000 / 12,
 			Impact:      impactMult,
 			MonthHired:  fg.Turn,
+			Level:       4,
+			Morale:      0.9,
+			Performance: 1.0,
 		}
 		fg.Team.Engineers = append(fg.Team.Engineers, eng)
 		fg.CalculateTeamCost()
@@ -3912,7 +4563,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 			fmt.Sprintf("   Salary: $200k/yr | New runway: %d months", fg.CashRunwayMonths))
 
 	case "competitor_distress":
-		newCustomers := scaleCustomers(15 + rand.Intn(25))
+		newCustomers := scaleCustomers(15 + fg.Rand().Intn(25))
 		if newCustomers < 1 {
 			newCustomers = 1
 		}
@@ -3930,7 +4581,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 		msgs = append(msgs, fmt.Sprintf("   +%d customers acquired (+$%s/mo MRR)", newCustomers, formatCompactMoney(newMRR)))
 
 	case "api_integration":
-		newCustomers := scaleCustomers(10 + rand.Intn(20))
+		newCustomers := scaleCustomers(10 + fg.Rand().Intn(20))
 		if newCustomers < 1 {
 			newCustomers = 1
 		}
@@ -3945,7 +4596,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 			fmt.Sprintf("   +%.1f%% ongoing monthly growth", growthBoost*100))
 
 	case "govt_contract":
-		contractMRR := scaleMRR(int64(20000 + rand.Intn(80000)))
+		contractMRR := scaleMRR(int64(20000 + fg.Rand().Intn(80000)))
 		fg.Customers += 1
 		fg.DirectCustomers += 1
 		fg.DirectMRR += contractMRR
@@ -3954,7 +4605,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 			"   3-year guaranteed revenue")
 
 	case "influencer":
-		newCustomers := scaleCustomers(8 + rand.Intn(20))
+		newCustomers := scaleCustomers(8 + fg.Rand().Intn(20))
 		if newCustomers < 1 {
 			newCustomers = 1
 		}
@@ -3974,7 +4625,7 @@ func (s *FounderGameScreen) handleStrategicOpportunitySelection(id string) (Scre
 		msgs = append(msgs, fmt.Sprintf("   Patent granted — competitors' market share reduced %.0f%%", (1.0-reduction)*100))
 
 	case "university_partnership":
-		newCustomers := scaleCustomers(5 + rand.Intn(10))
+		newCustomers := scaleCustomers(5 + fg.Rand().Intn(10))
 		if newCustomers < 1 {
 			newCustomers = 1
 		}
@@ -4708,12 +5359,16 @@ func (s *FounderGameScreen) View() string {
 		return s.renderHiringMarket()
 	case FounderViewFiring:
 		return s.renderFiring()
+	case FounderViewPeople:
+		return s.renderPeople()
 	case FounderViewMarketing:
 		return s.renderMarketing()
 	case FounderViewFunding:
 		return s.renderFunding()
-	case FounderViewFundingTerms:
-		return s.renderFundingTerms()
+	case FounderViewFundraise:
+		return s.renderFundraise()
+	case FounderViewTermSheet:
+		return s.renderTermSheet()
 	case FounderViewPartnership:
 		return s.renderPartnership()
 	case FounderViewAffiliate:
@@ -4728,6 +5383,8 @@ func (s *FounderGameScreen) View() string {
 		return s.renderBoard()
 	case FounderViewBoardAction:
 		return s.renderExpandPool()
+	case FounderViewTenderOffer:
+		return s.renderTenderOffer()
 	case FounderViewBuyback:
 		return s.renderBuyback()
 	case FounderViewBuybackConfirm:
@@ -4767,6 +5424,8 @@ func (s *FounderGameScreen) View() string {
 		return s.renderSuccession()
 	case FounderViewSalesPipeline:
 		return s.renderSalesPipeline()
+	case FounderViewDeal:
+		return s.renderDeal()
 	// New feature views
 	case FounderViewStrategicOpportunity:
 		return s.renderStrategicOpportunity()
@@ -5016,6 +5675,22 @@ func (s *FounderGameScreen) renderMetricsPanel() string {
 	b.WriteString(fmt.Sprintf("%.0f%%", fg.ProductMaturity*100))
 	b.WriteString("\n")
 
+	// Trends since founding
+	if len(fg.History) > 1 {
+		series := fg.HistorySeries()
+		trendStyle := lipgloss.NewStyle().Foreground(styles.Cyan)
+		b.WriteString("\n")
+		for _, t := range []struct{ label, key string }{
+			{"MRR     ", "mrr"},
+			{"Burn    ", "net_burn"},
+			{"Runway  ", "runway"},
+		} {
+			b.WriteString(labelStyle.Render(t.label))
+			b.WriteString(trendStyle.Render(components.Sparkline(components.FloatSeries(series[t.key]), 20)))
+			b.WriteString("\n")
+		}
+	}
+
 	return panelStyle.Render(b.String())
 }
 
@@ -5491,7 +6166,7 @@ func (s *FounderGameScreen) renderFunding() string {
 	return b.String()
 }
 
-func (s *FounderGameScreen) renderFundingTerms() string {
+func (s *FounderGameScreen) renderFundraise() string {
 	fg := s.gameData.FounderState
 	var b strings.Builder
 
@@ -5502,18 +6177,67 @@ func (s *FounderGameScreen) renderFundingTerms() string {
 		Width(60).
 		Align(lipgloss.Center)
 
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render(fmt.Sprintf("📄 %s TERM SHEETS", strings.ToUpper(s.selectedRoundName)))))
+	title := "💼 FUNDRAISING"
+	if fg.ActiveFundraise != nil {
+		title = fmt.Sprintf("💼 %s FUNDRAISE", strings.ToUpper(fg.ActiveFundraise.RoundName))
+	}
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render(title)))
 	b.WriteString("\n\n")
 
-	currentEquity := 100.0 - fg.EquityGivenAway - fg.EquityPool
 	infoStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(infoStyle.Render(fmt.Sprintf("Your Current Equity: %.2f%%", currentEquity)))
-	if currentEquity >= 50.0 {
-		b.WriteString(lipgloss.NewStyle().Foreground(styles.Green).Width(s.width).Align(lipgloss.Center).Render(" ✓ Majority control"))
+	if p := fg.ActiveFundraise; p != nil {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("Target: $%s | Closes by month %d | Meetings left this month: %d/%d",
+			formatCompactMoney(p.TargetAmount), p.Deadline, p.MaxMeetings-p.MeetingsThisMonth, p.MaxMeetings)))
+		b.WriteString("\n")
+		b.WriteString(infoStyle.Render(fmt.Sprintf("Readiness: %.0f/100 | Rule of 40: %.0f | Burn Multiple: %.1fx | FOMO: %.0f%%",
+			fg.FundraiseMetricsScore()*100, fg.CalculateRuleOf40(), fg.CalculateBurnMultiple(), p.Momentum*100)))
+		b.WriteString("\n\n")
+	}
+
+	if s.inputMessage != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Width(s.width).Align(lipgloss.Center).Render(s.inputMessage))
+		b.WriteString("\n\n")
+	}
+
+	menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	menuBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(1, 2)
+
+	if s.fundraiseMenu != nil {
+		b.WriteString(menuContainer.Render(menuBox.Render(s.fundraiseMenu.View())))
 	}
 	b.WriteString("\n\n")
 
-	// Term sheets
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("esc back • enter select • meetings reset each month"))
+
+	return b.String()
+}
+
+func (s *FounderGameScreen) renderTermSheet() string {
+	fg := s.gameData.FounderState
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Green).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("📄 TERM SHEET")))
+	b.WriteString("\n\n")
+
+	if fg.ActiveFundraise == nil || s.selectedTermSheet >= len(fg.ActiveFundraise.TermSheets) {
+		return b.String()
+	}
+	sheet := fg.ActiveFundraise.TermSheets[s.selectedTermSheet]
+
+	currentEquity := 100.0 - fg.EquityGivenAway - fg.EquityPool
+	newEquity := currentEquity - sheet.Equity
+
 	termsBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(styles.Green).
@@ -5521,25 +6245,39 @@ func (s *FounderGameScreen) renderFundingTerms() string {
 		Width(65)
 
 	var terms strings.Builder
-	for i, sheet := range s.fundingTerms {
-		newEquity := currentEquity - sheet.Equity
-		terms.WriteString(fmt.Sprintf("%d. %s\n", i+1, sheet.Terms))
-		terms.WriteString(fmt.Sprintf("   Amount: $%s | Valuation: $%s\n", formatCompactMoney(sheet.Amount), formatCompactMoney(sheet.PostValuation)))
-		terms.WriteString(fmt.Sprintf("   Equity: %.1f%% | Your equity after: %.1f%%", sheet.Equity, newEquity))
-		if sheet.BoardSeatsOffered > 0 {
-			terms.WriteString(fmt.Sprintf(" | Board seats: %d", sheet.BoardSeatsOffered))
-		}
-		if newEquity < 50.0 && currentEquity >= 50.0 {
-			terms.WriteString(" ⚠️ LOSES CONTROL")
-		}
-		terms.WriteString("\n\n")
-	}
+	terms.WriteString(fmt.Sprintf("Lead Investor: %s (%s)\n", sheet.Investor, sheet.Terms))
+	terms.WriteString(fmt.Sprintf("Amount: $%s\n", formatCompactMoney(sheet.Amount)))
+	terms.WriteString(fmt.Sprintf("Pre-money: $%s | Post-money: $%s\n", formatCompactMoney(sheet.PreValuation), formatCompactMoney(sheet.PostValuation)))
+	terms.WriteString(fmt.Sprintf("Equity: %.1f%% | Your equity after: %.1f%%", sheet.Equity, newEquity))
+	if newEquity < 50.0 && currentEquity >= 50.0 {
+		terms.WriteString(" ⚠️ LOSES CONTROL")
+	}
+	terms.WriteString("\n")
+	terms.WriteString(fmt.Sprintf("Board seats: %d | Pro-rata: %v\n", sheet.BoardSeats, sheet.ProRata))
+	terms.WriteString(fmt.Sprintf("Liquidation preference: %s\n", founder.DescribePreference(sheet.Terms)))
+	terms.WriteString(fmt.Sprintf("Competing term sheets: %d | Expires: month %d", len(fg.ActiveFundraise.TermSheets)-1, sheet.ExpiresMonth))
 
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(termsBox.Render(terms.String())))
 	b.WriteString("\n\n")
 
+	if s.inputMessage != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Width(s.width).Align(lipgloss.Center).Render(s.inputMessage))
+		b.WriteString("\n\n")
+	}
+
+	menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	menuBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(1, 2)
+
+	if s.termSheetMenu != nil {
+		b.WriteString(menuContainer.Render(menuBox.Render(s.termSheetMenu.View())))
+	}
+	b.WriteString("\n\n")
+
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("1-4 select term sheet • esc back"))
+	b.WriteString(helpStyle.Render("esc back • enter select"))
 
 	return b.String()
 }
@@ -5651,7 +6389,13 @@ func (s *FounderGameScreen) renderCompetitors() string {
 		comp := fg.Competitors[s.selectedCompetitorIdx]
 		infoStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
 		b.WriteString(infoStyle.Render(fmt.Sprintf("Handling: %s (Threat: %s)", comp.Name, comp.Threat)))
-		b.WriteString("\n\n")
+		b.WriteString("\n")
+		if len(comp.Features) > 0 {
+			featStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+			b.WriteString(featStyle.Render(fmt.Sprintf("Shipped: %s", strings.Join(comp.Features, ", "))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
 
 		menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
 		menuBox := lipgloss.NewStyle().
@@ -5683,6 +6427,12 @@ func (s *FounderGameScreen) renderCompetitors() string {
 			threatStyle := lipgloss.NewStyle().Foreground(threatColor)
 			comps.WriteString(fmt.Sprintf("%d. %s\n", activeNum, c.Name))
 			comps.WriteString(fmt.Sprintf("   Threat: %s | Share: %.1f%%\n", threatStyle.Render(c.Threat), c.MarketShare*100))
+			if c.Stage != "" {
+				comps.WriteString(fmt.Sprintf("   %s | Raised $%s | %d staff | Price %.0f%% of ours\n",
+					c.Stage, formatCompactMoney(c.TotalFunding), c.Headcount, c.PriceIndex*100))
+				comps.WriteString(fmt.Sprintf("   Strength %.2f vs our %.2f | Head-to-head: %dW-%dL\n",
+					founder.CompetitorStrength(c), fg.OurCompetitiveStrength(), c.DealsLost, c.DealsWon))
+			}
 			comps.WriteString(fmt.Sprintf("   Strategy: %s\n\n", c.Strategy))
 		}
 
@@ -5784,6 +6534,9 @@ func (s *FounderGameScreen) renderTeamRoster() string {
 			eqInfo := ""
 			if e.Equity > 0 {
 				eqInfo = fmt.Sprintf(" %.2f%%eq", e.Equity)
+				if fg.IsUnderwater(e) {
+					eqInfo += " (underwater)"
+				}
 			}
 
 			// Market assignment
@@ -5795,8 +6548,18 @@ func (s *FounderGameScreen) renderTeamRoster() string {
 			// Salary
 			salary := fmt.Sprintf("$%s/mo", formatCompactMoney(e.MonthlyCost))
 
-			team.WriteString(fmt.Sprintf("  • %s %.1fx %s%s%s%s\n",
-				truncate(e.Name, 15), e.Impact, salary, eqInfo, vestInfo, marketInfo))
+			// Level, morale and burnout
+			levelInfo := founder.LevelTitle(e.Level)
+			if e.IsManager {
+				levelInfo += " Mgr"
+			}
+			moodInfo := fmt.Sprintf(" 😊%.0f%%", e.Morale*100)
+			if e.Burnout > 0.5 {
+				moodInfo += fmt.Sprintf(" 🔥%.0f%%", e.Burnout*100)
+			}
+
+			team.WriteString(fmt.Sprintf("  • %s %s %.1fx %s%s%s%s%s\n",
+				truncate(e.Name, 15), levelInfo, fg.EffectiveImpact(e), salary, moodInfo, eqInfo, vestInfo, marketInfo))
 		}
 		team.WriteString("\n")
 	}
@@ -5806,6 +6569,19 @@ func (s *FounderGameScreen) renderTeamRoster() string {
 	renderEmployeeList(fg.Team.CustomerSuccess, "Customer Success")
 	renderEmployeeList(fg.Team.Marketing, "Marketing")
 
+	if len(fg.FormerEmployees) > 0 {
+		team.WriteString(fmt.Sprintf("Former Employees: %d\n", len(fg.FormerEmployees)))
+		for _, fe := range fg.FormerEmployees {
+			status := fmt.Sprintf("exercise by month %d", fe.ExerciseDeadline)
+			if fe.Exercised {
+				status = "exercised"
+			}
+			team.WriteString(fmt.Sprintf("  • %s %.2f%%eq @ $%.2f strike [%s]\n",
+				truncate(fe.Name, 15), fe.Equity, fe.StrikePrice, status))
+		}
+		team.WriteString("\n")
+	}
+
 	if len(fg.Team.Executives) > 0 {
 		team.WriteString(fmt.Sprintf("Executives: %d\n", len(fg.Team.Executives)))
 		for _, e := range fg.Team.Executives {
@@ -5816,6 +6592,9 @@ func (s *FounderGameScreen) renderTeamRoster() string {
 			eqInfo := ""
 			if e.Equity > 0 {
 				eqInfo = fmt.Sprintf(" %.2f%%eq", e.Equity)
+				if fg.IsUnderwater(e) {
+					eqInfo += " (underwater)"
+				}
 			}
 			team.WriteString(fmt.Sprintf("  • %s (%s) %.1fx $%s/mo%s%s\n",
 				e.Name, e.Role, e.Impact, formatCompactMoney(e.MonthlyCost), eqInfo, vestInfo))
@@ -6042,13 +6821,19 @@ func (s *FounderGameScreen) renderFinancials() string {
 		fin.WriteString("\n")
 		totalRaised := int64(0)
 		for _, r := range fg.FundingRounds {
-			fin.WriteString(fmt.Sprintf("  %s: $%s (%.1f%% equity, %s terms)\n",
-				r.RoundName, formatCompactMoney(r.Amount), r.EquityGiven, r.Terms))
+			fin.WriteString(fmt.Sprintf("  %s: $%s (%.1f%% equity, %s pref)\n",
+				r.RoundName, formatCompactMoney(r.Amount), r.EquityGiven, r.PreferenceSummary()))
 			totalRaised += r.Amount
 		}
 		fin.WriteString(fmt.Sprintf("  Total Raised: $%s\n", formatCompactMoney(totalRaised)))
 	}
 
+	// MRR against burn over time
+	if len(fg.History) > 1 {
+		fin.WriteString("\n")
+		fin.WriteString(founderMRRChart(fg, 62, 6).View())
+	}
+
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(finBox.Render(fin.String())))
 	b.WriteString("\n\n")
 
@@ -6095,6 +6880,9 @@ func (s *FounderGameScreen) renderCapTable() string {
 	content.WriteString("   ")
 	content.WriteString(labelStyle.Render("Funding Rounds: "))
 	content.WriteString(valStyle.Render(fmt.Sprintf("%d", len(fg.FundingRounds))))
+	content.WriteString("   ")
+	content.WriteString(labelStyle.Render("409A: "))
+	content.WriteString(valStyle.Render(fmt.Sprintf("$%.2f/share (month %d)", fg.CommonSharePrice(), fg.Last409AMonth)))
 	content.WriteString("\n\n")
 
 	// Column header
@@ -6155,6 +6943,14 @@ func (s *FounderGameScreen) renderCapTable() string {
 			style = advisorStyle
 			icon = "🧠"
 			detail = fmt.Sprintf("advisor, month %d", entry.MonthGranted)
+		case "former_employee":
+			style = dimStyle
+			icon = "🚪"
+			detail = "former employee, vested"
+		case "secondary":
+			style = investorStyle
+			icon = "🤝"
+			detail = fmt.Sprintf("tender offer, month %d", entry.MonthGranted)
 		default:
 			style = dimStyle
 			icon = "📄"
@@ -6207,6 +7003,95 @@ func (s *FounderGameScreen) renderCapTable() string {
 	return b.String()
 }
 
+func (s *FounderGameScreen) rebuildTenderOfferMenu() {
+	items := []components.MenuItem{
+		{ID: "founder_0", Title: "Employees Only", Description: "You keep your whole stake", Icon: "👷"},
+		{ID: "founder_5", Title: "Sell 5% of Your Stake", Description: "Modest personal liquidity", Icon: "💵"},
+		{ID: "founder_10", Title: "Sell 10% of Your Stake", Description: "Meaningful liquidity, board won't mind", Icon: "💰"},
+		{ID: "founder_20", Title: "Sell 20% of Your Stake", Description: "Board will question your commitment", Icon: "⚠️"},
+		{ID: "cancel", Title: "Back", Icon: "←"},
+	}
+
+	s.tenderOfferMenu = components.NewMenu("TENDER OFFER", items)
+	s.tenderOfferMenu.SetSize(60, 12)
+	s.tenderOfferMenu.SetHideHelp(true)
+}
+
+func (s *FounderGameScreen) handleTenderOfferSelection(id string) (ScreenModel, tea.Cmd) {
+	fg := s.gameData.FounderState
+
+	if id == "cancel" {
+		s.rebuildBoardMenu()
+		s.view = FounderViewBoard
+		return s, nil
+	}
+
+	pct, err := strconv.ParseFloat(strings.TrimPrefix(id, "founder_"), 64)
+	if err != nil {
+		return s, nil
+	}
+
+	offer, err := fg.RunTenderOffer(pct)
+	if err != nil {
+		s.turnMessages = []string{fmt.Sprintf("❌ Tender offer failed: %v", err)}
+		s.view = FounderViewMain
+		return s, nil
+	}
+
+	s.turnMessages = []string{
+		fmt.Sprintf("✓ Tender offer closed with %s at $%.2f/share", offer.Buyer, offer.PricePerShare),
+		fmt.Sprintf("   %d employees sold %.2f%% for $%s", offer.Participants, offer.EmployeeEquitySold, formatCompactMoney(offer.EmployeeProceeds)),
+	}
+	if offer.FounderEquitySold > 0 {
+		s.turnMessages = append(s.turnMessages,
+			fmt.Sprintf("   You sold %.2f%% for $%s (personal, not company cash)", offer.FounderEquitySold, formatCompactMoney(offer.FounderProceeds)))
+	}
+	s.turnMessages = append(s.turnMessages, "   📈 Team morale up — attrition lower for the next year")
+	if pct > 10 {
+		s.turnMessages = append(s.turnMessages, "   ⚠️  Board pressure increased")
+	}
+	s.view = FounderViewMain
+	return s, nil
+}
+
+func (s *FounderGameScreen) renderTenderOffer() string {
+	fg := s.gameData.FounderState
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Green).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🤝 TENDER OFFER")))
+	b.WriteString("\n\n")
+
+	infoStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(infoStyle.Render(fmt.Sprintf("Last preferred price: $%.2f/share | 409A common: $%.2f/share",
+		fg.PreferredSharePrice(), fg.CommonSharePrice())))
+	b.WriteString("\n")
+	b.WriteString(infoStyle.Render("Buyers pay 80-95% of the preferred price • employees sell 10-25% of vested shares"))
+	b.WriteString("\n\n")
+
+	menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	menuBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(1, 2)
+
+	if s.tenderOfferMenu != nil {
+		b.WriteString(menuContainer.Render(menuBox.Render(s.tenderOfferMenu.View())))
+	}
+	b.WriteString("\n\n")
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("esc back • enter select"))
+
+	return b.String()
+}
+
 func (s *FounderGameScreen) renderExit() string {
 	var b strings.Builder
 
@@ -6268,15 +7153,15 @@ func (s *FounderGameScreen) renderConfirmExit() string {
 		Padding(1, 2).
 		Width(60)
 
-	// At exit, unallocated equity pool cancels — only allocated equity counts
-	founderEquity := 100.0 - fg.EquityGivenAway - fg.EquityAllocated
-	payout := int64(float64(selectedExit.Valuation) * founderEquity / 100.0)
+	// Preferences only apply to a sale; at IPO or secondary everything converts
+	wf := fg.CalculateWaterfall(selectedExit.Valuation, s.selectedExitType != "acquisition")
 
 	var confirm strings.Builder
 	confirm.WriteString(fmt.Sprintf("Exit Type: %s\n", strings.ToUpper(s.selectedExitType)))
 	confirm.WriteString(fmt.Sprintf("Valuation: $%s\n", formatCompactMoney(selectedExit.Valuation)))
-	confirm.WriteString(fmt.Sprintf("Your Equity: %.1f%%\n", founderEquity))
-	confirm.WriteString(fmt.Sprintf("Your Payout: $%s\n\n", formatCompactMoney(payout)))
+	confirm.WriteString(fmt.Sprintf("Your Payout: $%s\n\n", formatCompactMoney(selectedExit.FounderPayout)))
+	confirm.WriteString(renderWaterfallBreakdown(wf))
+	confirm.WriteString("\n")
 	confirm.WriteString(selectedExit.Description)
 	confirm.WriteString("\n\nThis action is PERMANENT. Are you sure?")
 
@@ -6350,7 +7235,11 @@ func (s *FounderGameScreen) renderRoadmapStart() string {
 
 	var features strings.Builder
 	for i, f := range s.roadmapFeatures {
-		features.WriteString(fmt.Sprintf("%d. %s\n", i+1, f.Name))
+		features.WriteString(fmt.Sprintf("%d. %s", i+1, f.Name))
+		if demand := fg.FeatureDemand(f.Name); demand > 0 {
+			features.WriteString(fmt.Sprintf("  📣 %d requests", demand))
+		}
+		features.WriteString("\n")
 		features.WriteString(fmt.Sprintf("   Category: %s | Cost: $%s | Engineer-months: %d\n\n", f.Category, formatCompactMoney(f.Cost), f.EngineerMonths))
 	}
 
@@ -6358,6 +7247,13 @@ func (s *FounderGameScreen) renderRoadmapStart() string {
 		features.WriteString("No features available to start")
 	}
 
+	if locked := fg.GetLockedFeatures(); len(locked) > 0 {
+		features.WriteString("\n🔒 Locked:\n")
+		for _, f := range locked {
+			features.WriteString(fmt.Sprintf("   %s (needs %s)\n", f.Name, strings.Join(fg.MissingPrerequisites(f.Name), ", ")))
+		}
+	}
+
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(featureBox.Render(features.String())))
 	b.WriteString("\n\n")
 
@@ -6631,22 +7527,29 @@ func (s *FounderGameScreen) renderSalesPipeline() string {
 
 	if fg.SalesPipeline != nil {
 		metrics := fg.GetPipelineMetrics()
-		if totalDeals, ok := metrics["total_deals"].(int); ok {
-			pipe.WriteString(fmt.Sprintf("Active Deals: %d\n", totalDeals))
+		if activeDeals, ok := metrics["activeDeals"].(int); ok {
+			pipe.WriteString(fmt.Sprintf("Active Deals: %d\n", activeDeals))
+		}
+		if totalValue, ok := metrics["pipelineValue"].(int64); ok {
+			pipe.WriteString(fmt.Sprintf("Pipeline Value: $%s/mo", formatCompactMoney(totalValue)))
 		}
-		if totalValue, ok := metrics["total_value"].(int64); ok {
-			pipe.WriteString(fmt.Sprintf("Total Pipeline Value: $%s\n", formatCompactMoney(totalValue)))
+		if weighted, ok := metrics["weightedValue"].(int64); ok {
+			pipe.WriteString(fmt.Sprintf(" (weighted $%s/mo)\n", formatCompactMoney(weighted)))
 		}
-		if avgDeal, ok := metrics["avg_deal_size"].(int64); ok {
-			pipe.WriteString(fmt.Sprintf("Avg Deal Size: $%s\n", formatCompactMoney(avgDeal)))
+		if avgDeal, ok := metrics["avgDealSize"].(int64); ok {
+			pipe.WriteString(fmt.Sprintf("Avg Deal Size: $%s/mo\n", formatCompactMoney(avgDeal)))
 		}
-		if closeRate, ok := metrics["close_rate"].(float64); ok {
-			pipe.WriteString(fmt.Sprintf("Close Rate: %.0f%%\n", closeRate*100))
+		if winRate, ok := metrics["winRate"].(float64); ok {
+			pipe.WriteString(fmt.Sprintf("Win Rate: %.0f%%\n", winRate*100))
 		}
 
-		pipe.WriteString("\nDeals by Stage:\n")
-		for _, deal := range fg.SalesPipeline.ActiveDeals {
-			pipe.WriteString(fmt.Sprintf("  • %s: $%s (%s)\n", deal.CompanyName, formatCompactMoney(deal.DealSize), deal.Stage))
+		pipe.WriteString("\nReps (deals / capacity, quota this quarter):\n")
+		if len(fg.Team.Sales) == 0 {
+			pipe.WriteString(fmt.Sprintf("  %s: %d deals (no sales team yet)\n", fg.FounderName, fg.DealsForRep(fg.FounderName+" (Founder)")))
+		}
+		for _, rep := range fg.Team.Sales {
+			pipe.WriteString(fmt.Sprintf("  %-18s %2d/%-2d  %3.0f%% of quota\n",
+				truncate(rep.Name, 18), fg.DealsForRep(rep.Name), founder.RepCapacity(rep), fg.QuotaAttainment(rep)*100))
 		}
 	} else {
 		pipe.WriteString("No active sales pipeline")
@@ -6655,8 +7558,121 @@ func (s *FounderGameScreen) renderSalesPipeline() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(pipeBox.Render(pipe.String())))
 	b.WriteString("\n\n")
 
+	if s.pipelineMenu != nil {
+		menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+		menuBox := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(styles.Green).
+			Padding(1, 2)
+		b.WriteString(menuContainer.Render(menuBox.Render(s.pipelineMenu.View())))
+		b.WriteString("\n\n")
+	}
+
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("esc back"))
+	b.WriteString(helpStyle.Render("esc back • enter work deal"))
+
+	return b.String()
+}
+
+func (s *FounderGameScreen) renderDeal() string {
+	fg := s.gameData.FounderState
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Green).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("💼 DEAL DESK")))
+	b.WriteString("\n\n")
+
+	dealBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(1, 2).
+		Width(65)
+
+	var info strings.Builder
+	deal, ok := fg.GetDeal(s.selectedDealID)
+	if !ok {
+		info.WriteString("This deal has closed.")
+	} else {
+		info.WriteString(fmt.Sprintf("%s (%s, %s)\n", deal.CompanyName, deal.Segment, deal.Vertical))
+		info.WriteString(fmt.Sprintf("Stage: %s  •  %d days in stage\n", deal.Stage, deal.DaysInStage))
+		info.WriteString(fmt.Sprintf("Deal Size: $%s/mo", formatCompactMoney(deal.DealSize)))
+		if deal.Discount > 0 {
+			info.WriteString(fmt.Sprintf(" (%.0f%% off $%s list)", deal.Discount*100, formatCompactMoney(deal.ListPrice)))
+		}
+		info.WriteString("\n")
+		info.WriteString(fmt.Sprintf("Close Probability: %.0f%%\n", deal.CloseProbability*100))
+
+		owner := deal.AssignedSalesRep
+		if owner == "" {
+			owner = "unassigned (team at capacity)"
+		}
+		info.WriteString(fmt.Sprintf("Owner: %s\n", owner))
+		for _, rep := range fg.Team.Sales {
+			if rep.Name == deal.AssignedSalesRep {
+				info.WriteString(fmt.Sprintf("  %d/%d deals, %.0f%% of quota this quarter\n",
+					fg.DealsForRep(rep.Name), founder.RepCapacity(rep), fg.QuotaAttainment(rep)*100))
+			}
+		}
+
+		info.WriteString("\n")
+		if !deal.DiscoveryDone {
+			info.WriteString(lipgloss.NewStyle().Foreground(styles.Gray).Render("Run discovery to learn requirements and competition") + "\n")
+		} else {
+			if len(deal.RequiredFeatures) > 0 {
+				missing := fg.MissingFeatures(deal)
+				info.WriteString(fmt.Sprintf("Needs: %s\n", strings.Join(deal.RequiredFeatures, ", ")))
+				if len(missing) > 0 {
+					info.WriteString(lipgloss.NewStyle().Foreground(styles.Red).Render(fmt.Sprintf("  ⚠️  Not shipped: %s", strings.Join(missing, ", "))) + "\n")
+				} else {
+					info.WriteString(lipgloss.NewStyle().Foreground(styles.Green).Render("  ✓ All required features shipped") + "\n")
+				}
+			}
+			if deal.Competitor != "" {
+				if card := fg.GetBattleCard(deal.Competitor); card != nil {
+					info.WriteString(fmt.Sprintf("Competing with %s — battle card ready (+%.0f%%)\n", deal.Competitor, card.WinRateBonus*100))
+				} else {
+					info.WriteString(lipgloss.NewStyle().Foreground(styles.Yellow).Render(fmt.Sprintf("Competing with %s — no battle card (commission intel)", deal.Competitor)) + "\n")
+				}
+			}
+		}
+		if deal.POCStatus != "" {
+			info.WriteString(fmt.Sprintf("POC: %s", deal.POCStatus))
+			if deal.POCStatus == "running" {
+				info.WriteString(fmt.Sprintf(" (%d months left)", deal.POCMonthsLeft))
+			}
+			info.WriteString("\n")
+		}
+		if deal.SecurityReview != "" {
+			info.WriteString(fmt.Sprintf("Security Review: %s\n", strings.ReplaceAll(deal.SecurityReview, "_", " ")))
+		}
+	}
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(dealBox.Render(info.String())))
+	b.WriteString("\n\n")
+
+	if s.inputMessage != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Width(s.width).Align(lipgloss.Center).Render(s.inputMessage))
+		b.WriteString("\n\n")
+	}
+
+	if s.dealMenu != nil {
+		menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+		menuBox := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(styles.Green).
+			Padding(1, 2)
+		b.WriteString(menuContainer.Render(menuBox.Render(s.dealMenu.View())))
+		b.WriteString("\n\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("esc back • enter select"))
 
 	return b.String()
 }
@@ -7640,25 +8656,17 @@ func (s *FounderGameScreen) handleAcquisitionSelection(id string) (ScreenModel,
 		return s, nil
 	}
 
-	// At exit, unallocated equity pool cancels — only allocated equity counts
-	founderEquity := 100.0 - fg.EquityGivenAway - fg.EquityAllocated
-	founderPayout := int64(float64(offer.OfferAmount) * founderEquity / 100.0)
-
 	switch id {
 	case "accept", "forced":
-		fg.Cash = founderPayout
-		fg.HasExited = true
-		fg.ExitType = "acquisition"
-		fg.ExitValuation = offer.OfferAmount
-		fg.ExitMonth = fg.Turn
-		fg.Turn = fg.MaxTurns + 1 // End game
+		wf := fg.AcceptAcquisitionOffer(offer)
 
 		if offer.IsCompetitor {
 			s.turnMessages = append(s.turnMessages, fmt.Sprintf("⚠️ %s acquired your company for $%s!", offer.Acquirer, formatCompactMoney(offer.OfferAmount)))
 		} else {
 			s.turnMessages = append(s.turnMessages, fmt.Sprintf("🎉 Acquisition complete! Sold to %s for $%s", offer.Acquirer, formatCompactMoney(offer.OfferAmount)))
 		}
-		s.turnMessages = append(s.turnMessages, fmt.Sprintf("💰 Your payout: $%s (%.1f%% equity)", formatCompactMoney(founderPayout), founderEquity))
+		s.turnMessages = append(s.turnMessages, fmt.Sprintf("💰 Your payout: $%s after $%s in liquidation preferences",
+			formatCompactMoney(wf.FounderPayout), formatCompactMoney(wf.PreferenceTotal)))
 
 	case "decline":
 		s.turnMessages = append(s.turnMessages, fmt.Sprintf("✓ Declined acquisition offer from %s", offer.Acquirer))
@@ -7725,38 +8733,11 @@ func (s *FounderGameScreen) renderAcquisitionOffer() string {
 	details.WriteString(headerRow.Render("── PAYOUT BREAKDOWN ──"))
 	details.WriteString("\n\n")
 
-	// At exit, unallocated equity pool cancels — only allocated equity counts
-	founderEquity := 100.0 - fg.EquityGivenAway - fg.EquityAllocated
-	founderPayout := int64(float64(offer.OfferAmount) * founderEquity / 100.0)
-
-	founderLine := lipgloss.NewStyle().Foreground(styles.Green)
-	details.WriteString(founderLine.Render(fmt.Sprintf("%-30s %6.1f%%  $%s", "You (Founder)", founderEquity, formatCompactMoney(founderPayout))))
-	details.WriteString("\n")
-
-	for _, entry := range fg.CapTable {
-		payout := int64(float64(offer.OfferAmount) * entry.Equity / 100.0)
-		label := entry.Name
-		switch entry.Type {
-		case "executive":
-			label += " (Exec)"
-		case "employee":
-			label += " (Emp)"
-		case "advisor":
-			label += " (Adv)"
-		}
-		details.WriteString(fmt.Sprintf("%-30s %6.1f%%  $%s\n",
-			truncate(label, 30), entry.Equity, formatCompactMoney(payout)))
-	}
+	wf := fg.CalculateWaterfall(offer.OfferAmount, false)
+	details.WriteString(renderWaterfallBreakdown(wf))
 
-	unallocatedPool := fg.EquityPool - fg.EquityAllocated
-	if unallocatedPool < 0 {
-		unallocatedPool = 0
-	}
-	if unallocatedPool > 0 {
-		poolStyle := lipgloss.NewStyle().Foreground(styles.Gray)
-		details.WriteString(poolStyle.Render(fmt.Sprintf("%-30s %6.1f%%  (cancelled at exit)", "Unallocated Pool", unallocatedPool)))
-		details.WriteString("\n")
-	}
+	// Control is still decided by voting equity, not by the waterfall
+	founderEquity := 100.0 - fg.EquityGivenAway - fg.EquityAllocated
 
 	// Forced acceptance warning
 	if founderEquity < 50.0 {
@@ -8020,3 +9001,46 @@ func (s *FounderGameScreen) renderEngineerRealloc() string {
 
 	return b.String()
 }
+
+// renderWaterfallBreakdown lists what each holder receives from an exit
+func renderWaterfallBreakdown(wf founder.Waterfall) string {
+	var b strings.Builder
+
+	headerRow := lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+	b.WriteString(headerRow.Render(fmt.Sprintf("%-28s %6s  %8s  %8s", "Holder", "Own", "Pref", "Total")))
+	b.WriteString("\n")
+
+	for _, line := range wf.Lines {
+		lineStyle := lipgloss.NewStyle()
+		switch line.Class {
+		case "founder":
+			lineStyle = lineStyle.Foreground(styles.Green)
+		case "investor":
+			lineStyle = lineStyle.Foreground(styles.Yellow)
+		}
+		pref := "-"
+		if line.Preference > 0 {
+			pref = "$" + formatCompactMoney(line.Preference)
+		}
+		b.WriteString(lineStyle.Render(fmt.Sprintf("%-28s %5.1f%%  %8s  $%s",
+			truncate(line.Name, 28), line.Ownership, pref, formatCompactMoney(line.Total))))
+		b.WriteString("\n")
+	}
+
+	if wf.PreferenceTotal > 0 {
+		dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+		b.WriteString(dimStyle.Render(fmt.Sprintf("Liquidation preferences paid first: $%s", formatCompactMoney(wf.PreferenceTotal))))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// founderMRRChart plots MRR against net burn month by month
+func founderMRRChart(fg *founder.FounderState, width, height int) *components.LineChart {
+	series := fg.HistorySeries()
+	chart := components.NewLineChart("📈 MRR VS BURN", width, height)
+	chart.AddSeries("MRR", components.FloatSeries(series["mrr"]), styles.Green)
+	chart.AddSeries("Net burn", components.FloatSeries(series["net_burn"]), styles.Red)
+	return chart
+}
@@ -51,6 +51,10 @@ type FounderResultsScreen struct {
 	// Score saved
 	scoreSaved bool
 
+	// Daily challenge recorded, and the streak it left
+	dailyNote   string
+	dailyStreak int
+
 	// Leaderboard submission
 	leaderboardSubmitted bool
 	leaderboardError     string
@@ -71,18 +75,16 @@ func NewFounderResultsScreen(width, height int, gameData *GameData) *FounderResu
 	var founderPayout int64
 	if fs.HasExited {
 		switch fs.ExitType {
-		case "ipo":
-			founderPayout = int64(float64(fs.ExitValuation) * founderEquity * 0.20 / 100.0)
-		case "acquisition":
-			founderPayout = int64(float64(fs.ExitValuation) * founderEquity / 100.0)
-		case "secondary":
-			founderPayout = int64(float64(fs.ExitValuation) * founderEquity * 0.5 / 100.0)
+		case "ipo", "acquisition", "secondary":
+			founderPayout = fs.FounderExitPayout()
 		default:
 			founderPayout = fs.Cash + int64(float64(valuation)*founderEquity/100.0)
 		}
 	} else {
 		founderPayout = fs.Cash + int64(float64(valuation)*founderEquity/100.0)
 	}
+	// Shares sold in tender offers were already taken off the table
+	founderPayout += fs.FounderLiquidity
 
 	// Calculate ROI
 	initialCash := int64(500000)
@@ -164,30 +166,53 @@ func (s *FounderResultsScreen) Init() tea.Cmd {
 		actualTurns = fs.ExitMonth
 	}
 
-	// Save score to database
-	score := database.GameScore{
-		PlayerName:      fs.FounderName,
-		FinalNetWorth:   s.founderPayout,
-		ROI:             s.roi,
-		SuccessfulExits: successfulExits,
-		TurnsPlayed:     actualTurns,
-		Difficulty:      "Founder",
-		Mode:            "founder",
-		PlayedAt:        time.Now(),
+	// Daily challenges only count on the daily board, so the funding winter's
+	// harder rules stay out of local scores and charts
+	if s.gameData.Daily == nil {
+		score := database.GameScore{
+			PlayerName:      fs.FounderName,
+			FinalNetWorth:   s.founderPayout,
+			ROI:             s.roi,
+			SuccessfulExits: successfulExits,
+			TurnsPlayed:     actualTurns,
+			Difficulty:      "Founder",
+			Mode:            "founder",
+			PlayedAt:        time.Now(),
+		}
+
+		err := s.gameData.Store.SaveGameScore(score)
+		if err == nil {
+			s.scoreSaved = true
+		}
+		_ = s.gameData.Store.SaveGameSeries(fs.FounderName, "founder", fs.HistorySeries())
 	}
 
-	err := database.SaveGameScore(score)
-	if err == nil {
-		s.scoreSaved = true
+	// The daily challenge goes to its own leaderboard and extends the streak
+	if s.gameData.Daily != nil {
+		s.dailyStreak, s.dailyNote = s.gameData.finishDaily(fs.FounderName, s.founderPayout, s.roi)
 	}
 
-	// Auto-submit to global leaderboard (silent, skips on API unavailable)
-	if leaderboard.IsAPIAvailable("") {
+	// Auto-submit to global leaderboard (silent, skips on API unavailable or opted out)
+	if s.gameData.Daily == nil && s.gameData.SubmitScores() && leaderboard.IsAPIAvailable("") {
 		s.submitToGlobalLeaderboard()
 	}
 
+	// Founder proceeds and relationships carry into the player's career
+	if career, err := s.gameData.Store.GetCareer(fs.FounderName); err == nil {
+		// Only cash in hand counts: exit proceeds plus anything sold in tender offers
+		event := fmt.Sprintf("Founded %s", fs.CompanyName)
+		proceeds := fs.FounderLiquidity
+		if fs.HasExited {
+			career.FounderExits++
+			event = fmt.Sprintf("Exited %s (%s)", fs.CompanyName, fs.ExitType)
+			proceeds += fs.FounderExitPayout()
+		}
+		_ = s.gameData.Store.RecordCareerEvent(career, "founder", event, proceeds)
+		_ = s.gameData.Store.AddNetworkContacts(fs.FounderName, fs.CompanyName, fs.CareerNetwork())
+	}
+
 	// Get profile before XP is added
-	s.profileBefore, _ = database.GetPlayerProfile(fs.FounderName)
+	s.profileBefore, _ = s.gameData.Store.GetPlayerProfile(fs.FounderName)
 	if s.profileBefore != nil {
 		s.oldLevel = s.profileBefore.Level
 	} else {
@@ -202,9 +227,9 @@ func (s *FounderResultsScreen) Init() tea.Cmd {
 
 	// Add XP to player
 	var levelUpErr error
-	s.leveledUp, s.newLevel, s.levelUpPoints, levelUpErr = database.AddExperience(fs.FounderName, s.totalXP)
+	s.leveledUp, s.newLevel, s.levelUpPoints, levelUpErr = s.gameData.Store.AddExperience(fs.FounderName, s.totalXP)
 	if levelUpErr == nil {
-		s.profileAfter, _ = database.GetPlayerProfile(fs.FounderName)
+		s.profileAfter, _ = s.gameData.Store.GetPlayerProfile(fs.FounderName)
 	}
 
 	// Initialize animated counters for the results display
@@ -338,8 +363,8 @@ func (s *FounderResultsScreen) checkAchievements() {
 	}
 
 	// Get player stats
-	playerStats, _ := database.GetPlayerStats(fs.FounderName)
-	winStreak, _ := database.GetWinStreak(fs.FounderName)
+	playerStats, _ := s.gameData.Store.GetPlayerStats(fs.FounderName)
+	winStreak, _ := s.gameData.Store.GetWinStreak(fs.FounderName)
 
 	// Calculate actual turns for achievements
 	achievementTurns := fs.Turn
@@ -382,12 +407,13 @@ func (s *FounderResultsScreen) checkAchievements() {
 		TotalGames:                  playerStats.TotalGames,
 		TotalWins:                   int(playerStats.WinRate * float64(playerStats.TotalGames) / 100.0),
 		WinStreak:                   winStreak,
+		DailyStreak:                 s.dailyStreak,
 		BestNetWorth:                playerStats.BestNetWorth,
 		TotalExits:                  playerStats.TotalExits,
 	}
 
 	// Get previously unlocked achievements
-	previouslyUnlocked, _ := database.GetPlayerAchievements(fs.FounderName)
+	previouslyUnlocked, _ := s.gameData.Store.GetPlayerAchievements(fs.FounderName)
 
 	// Check for new achievements
 	newUnlocks := achievements.CheckAchievements(gameStats, previouslyUnlocked)
@@ -395,7 +421,7 @@ func (s *FounderResultsScreen) checkAchievements() {
 	for _, ach := range newUnlocks {
 		s.newAchievements = append(s.newAchievements, ach.Name)
 		s.newAchievementObjs = append(s.newAchievementObjs, ach)
-		database.UnlockAchievement(fs.FounderName, ach.ID)
+		s.gameData.Store.UnlockAchievement(fs.FounderName, ach.ID)
 	}
 }
 
@@ -628,9 +654,10 @@ func (s *FounderResultsScreen) renderResults() string {
 		payoutStyle := lipgloss.NewStyle().Foreground(styles.Green).Bold(true)
 		switch fs.ExitType {
 		case "ipo":
-			immediateVal := int64(float64(fs.ExitValuation) * s.founderEquity * 0.20 / 100.0)
-			remainingVal := int64(float64(fs.ExitValuation) * s.founderEquity * 0.80 / 100.0)
-			results.WriteString(payoutStyle.Render(fmt.Sprintf("Immediate Liquidation (20%%): $%s", formatCompactMoney(immediateVal))))
+			stake := fs.ExitWaterfall().FounderPayout
+			immediateVal := int64(float64(stake) * founder.IPOSellFraction)
+			remainingVal := stake - immediateVal
+			results.WriteString(payoutStyle.Render(fmt.Sprintf("Immediate Liquidation (%.0f%%): $%s", founder.IPOSellFraction*100, formatCompactMoney(immediateVal))))
 			results.WriteString("\n")
 			results.WriteString(fmt.Sprintf("Remaining Equity Value: $%s", formatCompactMoney(remainingVal)))
 			results.WriteString("\n")
@@ -638,9 +665,10 @@ func (s *FounderResultsScreen) renderResults() string {
 		case "acquisition":
 			results.WriteString(payoutStyle.Render(fmt.Sprintf("Your Payout: $%s", formatCompactMoney(s.founderPayout))))
 		case "secondary":
-			soldVal := int64(float64(fs.ExitValuation) * s.founderEquity * 0.5 / 100.0)
-			remainVal := int64(float64(fs.ExitValuation) * s.founderEquity * 0.5 / 100.0)
-			results.WriteString(fmt.Sprintf("Sold (50%% of stake): $%s", formatCompactMoney(soldVal)))
+			stake := fs.ExitWaterfall().FounderPayout
+			soldVal := int64(float64(stake) * founder.SecondarySellFraction)
+			remainVal := stake - soldVal
+			results.WriteString(fmt.Sprintf("Sold (%.0f%% of stake): $%s", founder.SecondarySellFraction*100, formatCompactMoney(soldVal)))
 			results.WriteString("\n")
 			results.WriteString(fmt.Sprintf("Remaining Equity: $%s", formatCompactMoney(remainVal)))
 			results.WriteString("\n")
@@ -705,46 +733,14 @@ func (s *FounderResultsScreen) renderResults() string {
 		}
 		results.WriteString("\n\n")
 
-		// Use exit valuation if exited, otherwise computed valuation
-		valForPayout := s.valuation
+		// Exits run through the liquidation waterfall; otherwise value at current valuation
+		var wf founder.Waterfall
 		if fs.HasExited && fs.ExitValuation > 0 {
-			valForPayout = fs.ExitValuation
-		}
-
-		founderPayStyle := lipgloss.NewStyle().Foreground(styles.Green)
-		founderVal := int64(float64(valForPayout) * s.founderEquity / 100.0)
-		results.WriteString(founderPayStyle.Render(fmt.Sprintf("%-28s %6.1f%%  $%s",
-			"You (Founder)", s.founderEquity, formatCompactMoney(founderVal))))
-		results.WriteString("\n")
-
-		// Investors
-		investorStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
-		for _, round := range fs.FundingRounds {
-			investorName := round.RoundName
-			if len(round.Investors) > 0 {
-				investorName = round.Investors[0]
-			}
-			investorVal := int64(float64(valForPayout) * round.EquityGiven / 100.0)
-			results.WriteString(investorStyle.Render(fmt.Sprintf("%-28s %6.1f%%  $%s",
-				truncate(investorName+" ("+round.RoundName+")", 28), round.EquityGiven, formatCompactMoney(investorVal))))
-			results.WriteString("\n")
-		}
-
-		// Employees, execs, advisors from cap table
-		for _, entry := range fs.CapTable {
-			entryVal := int64(float64(valForPayout) * entry.Equity / 100.0)
-			label := entry.Name
-			switch entry.Type {
-			case "executive":
-				label += " (Exec)"
-			case "employee":
-				label += " (Emp)"
-			case "advisor":
-				label += " (Adv)"
-			}
-			results.WriteString(fmt.Sprintf("%-28s %6.2f%%  $%s\n",
-				truncate(label, 28), entry.Equity, formatCompactMoney(entryVal)))
+			wf = fs.ExitWaterfall()
+		} else {
+			wf = fs.CalculateWaterfall(s.valuation, true)
 		}
+		results.WriteString(renderWaterfallBreakdown(wf))
 
 		// Unallocated pool
 		unallocatedPool := fs.EquityPool - fs.EquityAllocated
@@ -757,6 +753,12 @@ func (s *FounderResultsScreen) renderResults() string {
 				"Unallocated Pool", unallocatedPool)))
 			results.WriteString("\n")
 		}
+
+		if fs.FounderLiquidity > 0 {
+			results.WriteString(lipgloss.NewStyle().Foreground(styles.Green).Render(fmt.Sprintf("%-28s $%s  (sold in %d tender offer(s))",
+				"Secondary Proceeds", formatCompactMoney(fs.FounderLiquidity), len(fs.TenderOffers))))
+			results.WriteString("\n")
+		}
 	}
 
 	results.WriteString("\n\n")
@@ -775,6 +777,11 @@ func (s *FounderResultsScreen) renderResults() string {
 		b.WriteString(savedStyle.Render("✓ Score saved to leaderboard"))
 		b.WriteString("\n\n")
 	}
+	if s.dailyNote != "" {
+		noteStyle := lipgloss.NewStyle().Foreground(styles.Cyan).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(noteStyle.Render(s.dailyNote))
+		b.WriteString("\n\n")
+	}
 
 	// Help
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
@@ -952,7 +959,13 @@ func (s *FounderResultsScreen) renderLeaderboard() string {
 		durationMonths = fs.ExitMonth
 	}
 	content.WriteString(fmt.Sprintf("Duration:    %d months\n", durationMonths))
-	content.WriteString(fmt.Sprintf("Peak MRR:    $%s\n", formatCompactMoney(fs.MRR)))
+	peakMRR := fs.MRR
+	for _, snap := range fs.History {
+		if snap.MRR > peakMRR {
+			peakMRR = snap.MRR
+		}
+	}
+	content.WriteString(fmt.Sprintf("Peak MRR:    $%s\n", formatCompactMoney(peakMRR)))
 	content.WriteString(fmt.Sprintf("Total Customers: %d (ever: %d)\n", fs.Customers, fs.TotalCustomersEver))
 
 	totalRaised := int64(0)
@@ -975,6 +988,16 @@ func (s *FounderResultsScreen) renderLeaderboard() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(summaryBox.Render(content.String())))
 	b.WriteString("\n\n")
 
+	// The company's trajectory
+	if len(fs.History) > 1 {
+		chartBox := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(styles.Cyan).
+			Padding(0, 1)
+		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(chartBox.Render(founderMRRChart(fs, 66, 8).View())))
+		b.WriteString("\n\n")
+	}
+
 	// Global leaderboard prompt
 	if !s.leaderboardSubmitted {
 		promptStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
@@ -1040,17 +1063,11 @@ func (s *FounderResultsScreen) submitToGlobalLeaderboard() {
 
 	var founderPayout int64
 	if fs.HasExited {
-		switch fs.ExitType {
-		case "ipo":
-			founderPayout = int64(float64(fs.ExitValuation) * founderEquity * 0.20 / 100.0)
-		case "acquisition":
-			founderPayout = int64(float64(fs.ExitValuation) * founderEquity / 100.0)
-		case "secondary":
-			founderPayout = int64(float64(fs.ExitValuation) * founderEquity * 0.5 / 100.0)
-		}
+		founderPayout = fs.FounderExitPayout()
 	} else {
 		founderPayout = int64(float64(valuation) * founderEquity / 100.0)
 	}
+	founderPayout += fs.FounderLiquidity
 
 	maxARR := fs.MRR * 12
 
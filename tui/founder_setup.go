@@ -20,8 +20,7 @@ import (
 type FounderSetupStep int
 
 const (
-	FounderStepName FounderSetupStep = iota
-	FounderStepCompany
+	FounderStepCompany FounderSetupStep = iota
 	FounderStepCategory
 	FounderStepDifficulty
 	FounderStepReady
@@ -35,7 +34,6 @@ type FounderSetupScreen struct {
 	step     FounderSetupStep
 
 	// Inputs
-	nameInput      textinput.Model
 	companyInput   textinput.Model
 	categoryMenu   *components.Menu
 	difficultyMenu *components.Menu
@@ -54,18 +52,12 @@ type FounderSetupScreen struct {
 
 // NewFounderSetupScreen creates a new founder setup screen
 func NewFounderSetupScreen(width, height int, gameData *GameData) *FounderSetupScreen {
-	// Name input
-	nameInput := textinput.New()
-	nameInput.Placeholder = "Enter your name"
-	nameInput.Focus()
-	nameInput.CharLimit = 30
-	nameInput.Width = 30
-
 	// Company input
 	companyInput := textinput.New()
 	companyInput.Placeholder = "e.g., Acme Inc."
 	companyInput.CharLimit = 40
 	companyInput.Width = 30
+	companyInput.Focus()
 
 	// Category menu
 	categoryItems := []components.MenuItem{
@@ -84,7 +76,7 @@ func NewFounderSetupScreen(width, height int, gameData *GameData) *FounderSetupS
 
 	// Get player level for difficulty unlocks
 	playerLevel := 1
-	profile, err := database.GetPlayerProfile(gameData.PlayerName)
+	profile, err := gameData.Store.GetPlayerProfile(gameData.PlayerName)
 	if err == nil && profile != nil {
 		playerLevel = profile.Level
 	}
@@ -122,18 +114,33 @@ func NewFounderSetupScreen(width, height int, gameData *GameData) *FounderSetupS
 	difficultyMenu.SetSize(60, 15)
 	difficultyMenu.SetHideHelp(true)
 
-	return &FounderSetupScreen{
+	s := &FounderSetupScreen{
 		width:          width,
 		height:         height,
 		gameData:       gameData,
-		step:           FounderStepName,
-		nameInput:      nameInput,
+		step:           FounderStepCompany,
 		companyInput:   companyInput,
 		categoryMenu:   categoryMenu,
 		difficultyMenu: difficultyMenu,
+		playerName:     gameData.PlayerName,
 		playerLevel:    playerLevel,
 		difficulty:     "easy",
 	}
+
+	// Check for returning player
+	stats, err := gameData.Store.GetPlayerStats(s.playerName)
+	if err == nil && stats != nil && stats.TotalGames > 0 {
+		s.welcomeBack = true
+		s.playerStats = stats
+	}
+
+	// Load player upgrades from DB
+	playerUpgrades, err := gameData.Store.GetPlayerUpgrades(s.playerName)
+	if err == nil {
+		gameData.PlayerUpgrades = playerUpgrades
+	}
+
+	return s
 }
 
 // Init initializes the founder setup screen
@@ -146,7 +153,7 @@ func (s *FounderSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		if key.Matches(msg, keys.Global.Back) {
-			if s.step > FounderStepName {
+			if s.step > FounderStepCompany {
 				s.step--
 				return s, nil
 			}
@@ -154,40 +161,6 @@ func (s *FounderSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		}
 
 		switch s.step {
-		case FounderStepName:
-			if msg.Type == tea.KeyEnter {
-				name := strings.TrimSpace(s.nameInput.Value())
-				if name != "" {
-					s.playerName = name
-					s.gameData.PlayerName = name
-
-					// Check for returning player
-					stats, err := database.GetPlayerStats(name)
-					if err == nil && stats != nil && stats.TotalGames > 0 {
-						s.welcomeBack = true
-						s.playerStats = stats
-					}
-
-					// Refresh player level
-					profile, err := database.GetPlayerProfile(name)
-					if err == nil && profile != nil {
-						s.playerLevel = profile.Level
-						// Refresh difficulty menu locks
-						s.refreshDifficultyMenu()
-					}
-
-					// Load player upgrades from DB
-					playerUpgrades, err := database.GetPlayerUpgrades(name)
-					if err == nil {
-						s.gameData.PlayerUpgrades = playerUpgrades
-					}
-
-					s.step = FounderStepCompany
-					s.companyInput.Focus()
-					return s, textinput.Blink
-				}
-			}
-
 		case FounderStepCompany:
 			if msg.Type == tea.KeyEnter {
 				company := strings.TrimSpace(s.companyInput.Value())
@@ -232,8 +205,6 @@ func (s *FounderSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	// Update current component
 	var cmd tea.Cmd
 	switch s.step {
-	case FounderStepName:
-		s.nameInput, cmd = s.nameInput.Update(msg)
 	case FounderStepCompany:
 		s.companyInput, cmd = s.companyInput.Update(msg)
 	case FounderStepCategory:
@@ -245,40 +216,6 @@ func (s *FounderSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	return s, cmd
 }
 
-func (s *FounderSetupScreen) refreshDifficultyMenu() {
-	difficultyItems := []components.MenuItem{
-		{
-			ID:          "easy",
-			Title:       "Easy",
-			Description: "More cash, slower burn, friendlier market",
-			Icon:        "🟢",
-		},
-		{
-			ID:          "medium",
-			Title:       "Medium",
-			Description: "Balanced challenge, realistic conditions",
-			Icon:        "🟡",
-		},
-		{
-			ID:          "hard",
-			Title:       "Hard",
-			Description: "Less cash, aggressive competition, higher churn",
-			Icon:        "🔴",
-			Disabled:    s.playerLevel < 5,
-		},
-		{
-			ID:          "expert",
-			Title:       "Expert",
-			Description: "Minimal runway, brutal market, investor pressure",
-			Icon:        "💀",
-			Disabled:    s.playerLevel < 10,
-		},
-	}
-	s.difficultyMenu = components.NewMenu("SELECT DIFFICULTY", difficultyItems)
-	s.difficultyMenu.SetSize(60, 15)
-	s.difficultyMenu.SetHideHelp(true)
-}
-
 func (s *FounderSetupScreen) startGame() tea.Cmd {
 	return func() tea.Msg {
 		// Load startup templates
@@ -325,6 +262,7 @@ func (s *FounderSetupScreen) startGame() tea.Cmd {
 			s.gameData.FounderState = founder.NewFounderGame(s.playerName, selectedTemplate, s.gameData.PlayerUpgrades)
 		}
 		s.gameData.CurrentMode = "founder"
+		s.gameData.Daily = nil
 
 		return SwitchScreenMsg{Screen: ScreenFounderGame}
 	}
@@ -428,8 +366,6 @@ func (s *FounderSetupScreen) View() string {
 
 	var content string
 	switch s.step {
-	case FounderStepName:
-		content = s.renderNameStep()
 	case FounderStepCompany:
 		content = s.renderCompanyStep()
 	case FounderStepCategory:
@@ -449,7 +385,7 @@ func (s *FounderSetupScreen) View() string {
 }
 
 func (s *FounderSetupScreen) renderProgress() string {
-	steps := []string{"Name", "Company", "Category", "Difficulty"}
+	steps := []string{"Company", "Category", "Difficulty"}
 	var parts []string
 
 	for i, step := range steps {
@@ -469,22 +405,6 @@ func (s *FounderSetupScreen) renderProgress() string {
 	return lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(strings.Join(parts, "  →  "))
 }
 
-func (s *FounderSetupScreen) renderNameStep() string {
-	var b strings.Builder
-
-	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
-	b.WriteString(titleStyle.Render("ENTER YOUR NAME"))
-	b.WriteString("\n\n")
-
-	inputStyle := lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
-		BorderForeground(styles.Magenta).
-		Padding(0, 1)
-	b.WriteString(inputStyle.Render(s.nameInput.View()))
-
-	return b.String()
-}
-
 func (s *FounderSetupScreen) renderCompanyStep() string {
 	var b strings.Builder
 
@@ -107,9 +107,11 @@ Goal: Maximize your net worth by game end!
 	help.WriteString(`
 • Choose a startup template (SaaS, DeepTech, GovTech, Hardware)
 • Hire team: engineers, sales, CS, marketing, C-suite
+• Manage people: promotions, managers for big teams, crunch vs. burnout
 • Acquire customers via direct sales, affiliates, partnerships
-• Raise funding rounds: Seed, Series A, B, C
+• Raise Seed, Series A, B: pitch investors, collect term sheets, negotiate
 • Manage board, advisors, equity, PR, security, tech debt
+• Run tender offers so employees (and you) get liquidity; watch for underwater options
 • Respond to crises, competitors, and market conditions
 • Exit via IPO or acquisition
 
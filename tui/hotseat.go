@@ -0,0 +1,561 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/achievements"
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/game"
+	"github.com/jamesacampbell/unicorn/tui/components"
+	"github.com/jamesacampbell/unicorn/tui/keys"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+// hotSeatDifficulties in the order the setup menu cycles through them
+var hotSeatDifficulties = []game.Difficulty{
+	game.EasyDifficulty,
+	game.MediumDifficulty,
+	game.HardDifficulty,
+	game.ExpertDifficulty,
+}
+
+// hotSeatPlayer is someone joining a hot-seat game: a local profile or a guest
+type hotSeatPlayer struct {
+	Name  string
+	Guest bool
+}
+
+// HotSeatSetupScreen picks who's playing a hot-seat game
+type HotSeatSetupScreen struct {
+	width       int
+	height      int
+	gameData    *GameData
+	profiles    []database.Profile
+	players     []hotSeatPlayer
+	difficulty  int
+	level       int // Active profile's level, which unlocks difficulties
+	menu        *components.Menu
+	addingGuest bool
+	guestInput  textinput.Model
+	err         string
+}
+
+// NewHotSeatSetupScreen creates a new hot-seat setup screen
+func NewHotSeatSetupScreen(width, height int, gameData *GameData) *HotSeatSetupScreen {
+	input := textinput.New()
+	input.Placeholder = "Guest name"
+	input.CharLimit = 30
+	input.Width = 30
+
+	s := &HotSeatSetupScreen{
+		width:      width,
+		height:     height,
+		gameData:   gameData,
+		difficulty: 1,
+		level:      1,
+		guestInput: input,
+	}
+	if profiles, err := gameData.Store.ListProfiles(); err == nil {
+		s.profiles = profiles
+	}
+	// The active profile is always invited
+	if gameData.PlayerName != "" {
+		s.players = append(s.players, hotSeatPlayer{Name: gameData.PlayerName})
+	}
+	if profile, err := gameData.Store.GetPlayerProfile(gameData.PlayerName); err == nil && profile != nil {
+		s.level = profile.Level
+	}
+	s.buildMenu()
+	return s
+}
+
+func (s *HotSeatSetupScreen) buildMenu() {
+	cursor := 0
+	if s.menu != nil {
+		cursor = s.menu.SelectedIndex()
+	}
+
+	var items []components.MenuItem
+	for _, p := range s.profiles {
+		icon := "⬜"
+		if s.seated(p.Name) {
+			icon = "✅"
+		}
+		items = append(items, components.MenuItem{
+			ID:          "profile:" + p.Name,
+			Title:       p.Name,
+			Description: "Select to add or remove",
+			Icon:        icon,
+		})
+	}
+	for _, p := range s.players {
+		if p.Guest {
+			items = append(items, components.MenuItem{
+				ID:          "guest:" + p.Name,
+				Title:       p.Name + " (guest)",
+				Description: "Guest games aren't saved - select to remove",
+				Icon:        "✅",
+			})
+		}
+	}
+	items = append(items,
+		components.MenuItem{
+			ID:          "add_guest",
+			Title:       "Add Guest",
+			Description: "Someone without a profile on this machine",
+			Icon:        "➕",
+			Disabled:    len(s.players) >= game.MaxSeats,
+		},
+		components.MenuItem{
+			ID:          "difficulty",
+			Title:       fmt.Sprintf("Difficulty: %s", hotSeatDifficulties[s.difficulty].Name),
+			Description: hotSeatDifficulties[s.difficulty].Description,
+			Icon:        "🎚️",
+		},
+		components.MenuItem{
+			ID:          "start",
+			Title:       fmt.Sprintf("Start Game (%d players)", len(s.players)),
+			Description: "Everyone shares the deals, the market and the AI rivals",
+			Icon:        "▶️",
+			Disabled:    len(s.players) < 2,
+		},
+	)
+
+	s.menu = components.NewMenu("WHO'S PLAYING?", items)
+	s.menu.SetSize(60, 20)
+	s.menu.SetHideHelp(true)
+	s.menu.SetCursor(min(cursor, len(items)-1))
+}
+
+func (s *HotSeatSetupScreen) seated(name string) bool {
+	for _, p := range s.players {
+		if strings.EqualFold(p.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *HotSeatSetupScreen) hasProfile(name string) bool {
+	for _, p := range s.profiles {
+		if strings.EqualFold(p.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *HotSeatSetupScreen) remove(name string) {
+	for i, p := range s.players {
+		if p.Name == name {
+			s.players = append(s.players[:i], s.players[i+1:]...)
+			return
+		}
+	}
+}
+
+// Init initializes the hot-seat setup screen
+func (s *HotSeatSetupScreen) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles hot-seat setup input
+func (s *HotSeatSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	// The app's quit handler skips this screen so guest names can contain q
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlC {
+		return s, Quit()
+	}
+
+	if s.addingGuest {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch msg.Type {
+			case tea.KeyEsc:
+				s.addingGuest = false
+				s.err = ""
+				return s, nil
+			case tea.KeyEnter:
+				s.addGuest()
+				return s, nil
+			}
+		}
+		var cmd tea.Cmd
+		s.guestInput, cmd = s.guestInput.Update(msg)
+		return s, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, keys.Global.Back) {
+			return s, SwitchTo(ScreenVCSetup)
+		}
+
+	case components.MenuSelectedMsg:
+		return s.handleSelection(msg.ID)
+	}
+
+	var cmd tea.Cmd
+	s.menu, cmd = s.menu.Update(msg)
+	return s, cmd
+}
+
+func (s *HotSeatSetupScreen) handleSelection(id string) (ScreenModel, tea.Cmd) {
+	s.err = ""
+	switch {
+	case strings.HasPrefix(id, "profile:"):
+		name := strings.TrimPrefix(id, "profile:")
+		if s.seated(name) {
+			s.remove(name)
+		} else if len(s.players) >= game.MaxSeats {
+			s.err = fmt.Sprintf("Hot-seat games take up to %d players", game.MaxSeats)
+		} else {
+			s.players = append(s.players, hotSeatPlayer{Name: name})
+		}
+	case strings.HasPrefix(id, "guest:"):
+		s.remove(strings.TrimPrefix(id, "guest:"))
+	case id == "add_guest":
+		s.addingGuest = true
+		s.guestInput.SetValue("")
+		s.guestInput.Focus()
+		return s, textinput.Blink
+	case id == "difficulty":
+		// Harder settings unlock with the host's level, as in a solo game
+		for {
+			s.difficulty = (s.difficulty + 1) % len(hotSeatDifficulties)
+			if s.difficultyUnlocked(s.difficulty) {
+				break
+			}
+		}
+	case id == "start":
+		return s, s.startGame()
+	}
+	s.buildMenu()
+	return s, nil
+}
+
+func (s *HotSeatSetupScreen) difficultyUnlocked(i int) bool {
+	switch hotSeatDifficulties[i].Name {
+	case game.HardDifficulty.Name:
+		return s.level >= 5
+	case game.ExpertDifficulty.Name:
+		return s.level >= 10
+	}
+	return true
+}
+
+func (s *HotSeatSetupScreen) addGuest() {
+	name := strings.TrimSpace(s.guestInput.Value())
+	switch {
+	case name == "":
+		s.err = "Enter a name for the guest"
+	case s.hasProfile(name):
+		s.err = fmt.Sprintf("%s has a profile - select it instead", name)
+	case s.seated(name):
+		s.err = fmt.Sprintf("%s is already playing", name)
+	default:
+		s.players = append(s.players, hotSeatPlayer{Name: name, Guest: true})
+		s.addingGuest = false
+		s.err = ""
+		s.buildMenu()
+	}
+}
+
+func (s *HotSeatSetupScreen) startGame() tea.Cmd {
+	var seats []game.SeatSetup
+	for _, p := range s.players {
+		seat := game.SeatSetup{
+			PlayerName: p.Name,
+			FirmName:   game.GenerateDefaultFirmName(p.Name),
+		}
+		if !p.Guest {
+			seat.Upgrades, _ = s.gameData.Store.GetPlayerUpgrades(p.Name)
+		}
+		seats = append(seats, seat)
+	}
+
+	difficulty := hotSeatDifficulties[s.difficulty]
+	gs, err := game.NewHotSeatGame(seats, difficulty, time.Now().UnixNano())
+	if err != nil {
+		s.err = err.Error()
+		return nil
+	}
+	s.gameData.GameState = gs
+	s.gameData.Difficulty = difficulty
+	s.gameData.CurrentMode = "vc"
+	s.gameData.Tournament = nil
+	s.gameData.Daily = nil
+	return SwitchTo(ScreenVCInvest)
+}
+
+// View renders the hot-seat setup screen
+func (s *HotSeatSetupScreen) View() string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Cyan).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("👥 HOT-SEAT MULTIPLAYER 👥")))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Cyan).
+		Padding(1, 2)
+
+	help := "↑/↓ navigate • enter select • esc back"
+	if s.addingGuest {
+		var content strings.Builder
+		content.WriteString(lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true).Render("ADD GUEST"))
+		content.WriteString("\n\n")
+		inputStyle := lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(styles.Cyan).
+			Padding(0, 1)
+		content.WriteString(inputStyle.Render(s.guestInput.View()))
+		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(box.Width(55).Render(content.String())))
+		help = "enter add • esc cancel"
+	} else {
+		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(box.Render(s.menu.View())))
+	}
+	b.WriteString("\n\n")
+
+	if s.err != "" {
+		errStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(errStyle.Render("✗ " + s.err))
+		b.WriteString("\n\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render(help))
+
+	return b.String()
+}
+
+// renderHandoff blanks the screen between hot-seat players so nobody sees another
+// player's fund
+func renderHandoff(width int, gs *game.GameState, note string) string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Magenta).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(headerStyle.Render("🔒 HOT-SEAT 🔒")))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(styles.Magenta).
+		Padding(1, 4).
+		Width(50).
+		Align(lipgloss.Center)
+
+	var content strings.Builder
+	content.WriteString("Pass the keyboard to\n\n")
+	content.WriteString(lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true).Render(strings.ToUpper(gs.PlayerName)))
+	content.WriteString("\n")
+	content.WriteString(lipgloss.NewStyle().Foreground(styles.Gray).Render(gs.PlayerFirmName))
+	content.WriteString("\n\n")
+	content.WriteString(note)
+
+	b.WriteString(lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(box.Render(content.String())))
+	b.WriteString("\n\n")
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render(fmt.Sprintf("enter when %s is ready", gs.PlayerName)))
+
+	return b.String()
+}
+
+// hotSeatResult is one human player's end of a hot-seat game
+type hotSeatResult struct {
+	Name     string
+	XP       int
+	Unlocked []string
+	Saved    bool // False for guests
+}
+
+// HotSeatResultsScreen ranks every fund, human and AI, once a hot-seat game ends
+type HotSeatResultsScreen struct {
+	width       int
+	height      int
+	gameData    *GameData
+	leaderboard []game.PlayerScore
+	results     []hotSeatResult
+}
+
+// NewHotSeatResultsScreen creates a new hot-seat results screen
+func NewHotSeatResultsScreen(width, height int, gameData *GameData) *HotSeatResultsScreen {
+	return &HotSeatResultsScreen{
+		width:       width,
+		height:      height,
+		gameData:    gameData,
+		leaderboard: gameData.GameState.GetLeaderboard(),
+	}
+}
+
+// Init saves each profile's game, achievements and XP
+func (s *HotSeatResultsScreen) Init() tea.Cmd {
+	gs := s.gameData.GameState
+	store := s.gameData.Store
+
+	for i := range gs.Seats {
+		gs.SwitchSeat(i)
+		result := hotSeatResult{Name: gs.PlayerName}
+		if profile, err := store.GetProfile(gs.PlayerName); err != nil || profile == nil {
+			s.results = append(s.results, result)
+			continue
+		}
+
+		netWorth, roi, exits := gs.GetFinalScore()
+		err := store.SaveGameScore(database.GameScore{
+			PlayerName:      gs.PlayerName,
+			FinalNetWorth:   netWorth,
+			ROI:             roi,
+			SuccessfulExits: exits,
+			TurnsPlayed:     gs.Portfolio.Turn - 1,
+			Difficulty:      gs.Difficulty.Name,
+			Mode:            "vc",
+			PlayedAt:        time.Now(),
+		})
+		result.Saved = err == nil
+		_ = store.SaveGameSeries(gs.PlayerName, "vc", gs.HistorySeries())
+
+		var investments []database.InvestmentHistory
+		for _, r := range gs.InvestmentRecords() {
+			investments = append(investments, database.InvestmentHistory{
+				CompanyName:       r.CompanyName,
+				Sector:            r.Category,
+				TermsType:         r.TermsType,
+				DDLevel:           r.DDLevel,
+				Outcome:           r.Outcome,
+				AmountInvested:    r.AmountInvested,
+				EntryValuation:    r.EntryValuation,
+				ExitValuation:     r.ExitValuation,
+				ExitValue:         r.ExitValue,
+				MonthsHeld:        r.MonthsHeld,
+				RelationshipScore: r.RelationshipScore,
+			})
+		}
+		_ = store.SaveGameHistory(database.GameHistory{
+			PlayerName:      gs.PlayerName,
+			GameMode:        "vc",
+			Difficulty:      gs.Difficulty.Name,
+			FinalNetWorth:   netWorth,
+			ROI:             roi,
+			SuccessfulExits: exits,
+			TurnsPlayed:     gs.Portfolio.Turn - 1,
+		}, investments)
+
+		previouslyUnlocked, _ := store.GetPlayerAchievements(gs.PlayerName)
+		unlocked := achievements.CheckAchievements(achievements.GameStats{
+			GameMode:        "vc",
+			FinalNetWorth:   netWorth,
+			ROI:             roi,
+			TotalInvested:   gs.GetTotalInvested(),
+			TurnsPlayed:     gs.Portfolio.Turn,
+			InvestmentCount: len(gs.Portfolio.Investments),
+			SuccessfulExits: exits,
+			Difficulty:      gs.Difficulty.Name,
+		}, previouslyUnlocked)
+		for _, ach := range unlocked {
+			_ = store.UnlockAchievement(gs.PlayerName, ach.ID)
+			result.Unlocked = append(result.Unlocked, ach.Name)
+		}
+
+		_, result.XP = vcXPBreakdown(roi, exits, gs.Difficulty.Name, unlocked)
+		_, _, _, _ = store.AddExperience(gs.PlayerName, result.XP)
+		s.results = append(s.results, result)
+	}
+	return nil
+}
+
+// Update handles hot-seat results input
+func (s *HotSeatResultsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		if key.Matches(msg, keys.Global.Enter) || key.Matches(msg, keys.Global.Back) {
+			return s, SwitchTo(ScreenMainMenu)
+		}
+	}
+	return s, nil
+}
+
+// View renders the combined standings
+func (s *HotSeatResultsScreen) View() string {
+	var b strings.Builder
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Magenta).
+		Bold(true).
+		Width(70).
+		Align(lipgloss.Center)
+	b.WriteString(center.Render(headerStyle.Render("🏆 HOT-SEAT FINAL STANDINGS 🏆")))
+	b.WriteString("\n\n")
+
+	for _, entry := range s.leaderboard {
+		if entry.IsHuman {
+			winStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
+			b.WriteString(center.Render(winStyle.Render(fmt.Sprintf("👑 %s wins the table with $%s", entry.Name, formatCompactMoney(entry.NetWorth)))))
+			b.WriteString("\n\n")
+			break
+		}
+	}
+
+	var table strings.Builder
+	headerRow := lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+	table.WriteString(headerRow.Render(fmt.Sprintf("   %-4s %-18s %-24s %-12s %s", "#", "Investor", "Firm", "Net Worth", "ROI")))
+	table.WriteString("\n")
+	table.WriteString(strings.Repeat("─", 70))
+	table.WriteString("\n")
+	for i, entry := range s.leaderboard {
+		marker := "🤖 "
+		rowStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+		if entry.IsHuman {
+			marker = "👤 "
+			rowStyle = lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+		}
+		table.WriteString(rowStyle.Render(fmt.Sprintf("%s%-4d %-18s %-24s %-12s %.0f%%",
+			marker, i+1, truncate(entry.Name, 18), truncate(entry.Firm, 24),
+			"$"+formatCompactMoney(entry.NetWorth), entry.ROI)))
+		table.WriteString("\n")
+	}
+
+	tableBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Magenta).
+		Padding(1, 2)
+	b.WriteString(center.Render(tableBox.Render(table.String())))
+	b.WriteString("\n\n")
+
+	for _, r := range s.results {
+		line := fmt.Sprintf("%s: guest - not saved", r.Name)
+		if r.Saved {
+			line = fmt.Sprintf("%s: +%d XP", r.Name, r.XP)
+			if len(r.Unlocked) > 0 {
+				line += " • 🏅 " + strings.Join(r.Unlocked, ", ")
+			}
+		}
+		b.WriteString(center.Render(line))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("enter main menu"))
+
+	return b.String()
+}
@@ -18,6 +18,7 @@ import (
 type LeaderboardScreen struct {
 	width         int
 	height        int
+	store         database.Store
 	table         *components.GameTable
 	menu          *components.Menu
 	currentView   string
@@ -26,7 +27,7 @@ type LeaderboardScreen struct {
 }
 
 // NewLeaderboardScreen creates a new leaderboard screen
-func NewLeaderboardScreen(width, height int) *LeaderboardScreen {
+func NewLeaderboardScreen(width, height int, store database.Store) *LeaderboardScreen {
 	// Filter menu
 	menuItems := []components.MenuItem{
 		{ID: "header_mode", Title: "── MODE ──", Disabled: true, Icon: ""},
@@ -50,6 +51,7 @@ func NewLeaderboardScreen(width, height int) *LeaderboardScreen {
 	s := &LeaderboardScreen{
 		width:       width,
 		height:      height,
+		store:       store,
 		menu:        menu,
 		currentView: "net_worth",
 		currentMode: "all",
@@ -65,11 +67,11 @@ func (s *LeaderboardScreen) loadLeaderboard(sortBy, difficulty, mode string) {
 
 	switch sortBy {
 	case "recent":
-		scores, err = database.GetRecentGamesAndMode(20, mode)
+		scores, err = s.store.GetRecentGamesAndMode(20, mode)
 	case "roi":
-		scores, err = database.GetTopScoresByROIAndMode(20, difficulty, mode)
+		scores, err = s.store.GetTopScoresByROIAndMode(20, difficulty, mode)
 	default:
-		scores, err = database.GetTopScoresByNetWorthAndMode(20, difficulty, mode)
+		scores, err = s.store.GetTopScoresByNetWorthAndMode(20, difficulty, mode)
 	}
 
 	if err != nil || len(scores) == 0 {
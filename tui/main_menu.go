@@ -7,7 +7,6 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/jamesacampbell/unicorn/database"
 	"github.com/jamesacampbell/unicorn/tui/components"
 	"github.com/jamesacampbell/unicorn/tui/keys"
 	"github.com/jamesacampbell/unicorn/tui/styles"
@@ -70,7 +69,7 @@ func NewMainMenuScreen(width, height int, gameData *GameData) *MainMenuScreen {
 	}
 
 	// Only show VC Reputation menu item if the player has a saved reputation
-	if gameData.PlayerName != "" && database.HasVCReputation(gameData.PlayerName) {
+	if gameData.PlayerName != "" && gameData.Store.HasVCReputation(gameData.PlayerName) {
 		menuItems = append(menuItems, components.MenuItem{
 			ID:          "reputation",
 			Title:       "VC Reputation",
@@ -80,6 +79,18 @@ func NewMainMenuScreen(width, height int, gameData *GameData) *MainMenuScreen {
 	}
 
 	menuItems = append(menuItems,
+		components.MenuItem{
+			ID:          "profiles",
+			Title:       "Switch Profile",
+			Description: "Change player, or create, rename and delete profiles",
+			Icon:        "👤",
+		},
+		components.MenuItem{
+			ID:          "settings",
+			Title:       "Settings",
+			Description: "Theme, default play mode and score submission",
+			Icon:        "⚙️",
+		},
 		components.MenuItem{
 			ID:          "help",
 			Title:       "Help & Info",
@@ -146,6 +157,10 @@ func (m *MainMenuScreen) handleSelection(id string) tea.Cmd {
 		return PushTo(ScreenAnalytics)
 	case "reputation":
 		return PushTo(ScreenReputation)
+	case "profiles":
+		return SwitchTo(ScreenProfiles)
+	case "settings":
+		return PushTo(ScreenSettings)
 	case "help":
 		return PushTo(ScreenHelp)
 	case "quit":
@@ -185,6 +200,9 @@ func (m *MainMenuScreen) View() string {
 		Width(m.width).
 		Align(lipgloss.Center)
 	b.WriteString(titleContainer.Render(title))
+	b.WriteString("\n")
+	playerStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(m.width).Align(lipgloss.Center)
+	b.WriteString(playerStyle.Render(fmt.Sprintf("Playing as %s", m.gameData.PlayerName)))
 	b.WriteString("\n\n")
 
 	// Menu
@@ -0,0 +1,595 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/netplay"
+	"github.com/jamesacampbell/unicorn/tui/components"
+	"github.com/jamesacampbell/unicorn/tui/keys"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+// netEventMsg carries news from the host into the UI
+type netEventMsg netplay.Event
+
+// netClockMsg redraws the turn timer
+type netClockMsg struct{}
+
+// netInput is what the amount box is collecting
+type netInput int
+
+const (
+	netInputNone netInput = iota
+	netInputInvest
+	netInputFollowOn
+)
+
+// NetGameScreen is a remote player's view of a game run by `unicorn host`
+type NetGameScreen struct {
+	width  int
+	height int
+	client *netplay.Client
+	view   netplay.View
+
+	startupTable   *components.GameTable
+	portfolioTable *components.GameTable
+	termsMenu      *components.Menu
+	amountInput    textinput.Model
+
+	input    netInput
+	company  string // Company the amount box or terms menu is for
+	selected int    // Turn phase: index into the month's decisions
+	status   string // Connection news
+	errorMsg string
+	closed   bool
+}
+
+// NewNetGameScreen creates the screen for a client already connected to a host
+func NewNetGameScreen(client *netplay.Client) *NetGameScreen {
+	amountInput := textinput.New()
+	amountInput.Placeholder = "Enter amount (e.g., 100000)"
+	amountInput.CharLimit = 15
+	amountInput.Width = 20
+
+	s := &NetGameScreen{
+		width:       80,
+		height:      24,
+		client:      client,
+		view:        client.View(),
+		amountInput: amountInput,
+	}
+	s.startupTable = components.NewGameTable("", []table.Column{
+		{Title: "#", Width: 3},
+		{Title: "Name", Width: 18},
+		{Title: "Category", Width: 12},
+		{Title: "Valuation", Width: 9},
+		{Title: "Risk", Width: 6},
+		{Title: "Growth", Width: 6},
+	}, nil)
+	s.portfolioTable = components.NewGameTable("", []table.Column{
+		{Title: "Company", Width: 18},
+		{Title: "Invested", Width: 10},
+		{Title: "Value", Width: 10},
+		{Title: "Equity", Width: 8},
+		{Title: "P/L", Width: 10},
+	}, nil)
+	s.refreshTables()
+	return s
+}
+
+func waitForNetEvent(client *netplay.Client) tea.Cmd {
+	return func() tea.Msg {
+		e, ok := <-client.Events()
+		if !ok {
+			return netEventMsg{Closed: true, Status: "Disconnected from the host"}
+		}
+		return netEventMsg(e)
+	}
+}
+
+func netClock() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return netClockMsg{} })
+}
+
+// Init starts listening to the host
+func (s *NetGameScreen) Init() tea.Cmd {
+	return tea.Batch(waitForNetEvent(s.client), netClock())
+}
+
+// Update handles host events and player input
+func (s *NetGameScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		s.height = msg.Height
+		s.refreshTables()
+		return s, nil
+
+	case netClockMsg:
+		return s, netClock()
+
+	case netEventMsg:
+		return s, s.handleEvent(netplay.Event(msg))
+
+	case components.MenuSelectedMsg:
+		return s, s.handleTerms(msg.ID)
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			s.client.Close()
+			return s, tea.Quit
+		}
+		return s, s.handleKey(msg)
+	}
+	return s, nil
+}
+
+func (s *NetGameScreen) handleEvent(e netplay.Event) tea.Cmd {
+	if e.Closed {
+		s.closed = true
+		s.status = e.Status
+		return nil
+	}
+	s.status = e.Status
+	if e.Err != "" {
+		s.errorMsg = e.Err
+	}
+	phaseChanged := s.view.Phase != e.View.Phase || s.view.Month != e.View.Month
+	s.view = e.View
+	if phaseChanged {
+		s.input = netInputNone
+		s.termsMenu = nil
+		s.selected = 0
+		s.errorMsg = ""
+	}
+
+	// The host answered our quote: pick the terms
+	if q := s.view.Quote; q != nil && s.termsMenu == nil && q.Company == s.company {
+		items := make([]components.MenuItem, len(q.Terms))
+		for i, opt := range q.Terms {
+			desc := ""
+			if opt.HasProRataRights {
+				desc += "Pro-Rata "
+			}
+			if opt.HasBoardSeat {
+				desc += "Board "
+			}
+			if opt.LiquidationPref > 0 {
+				desc += fmt.Sprintf("%.0fx Liq ", opt.LiquidationPref)
+			}
+			if desc == "" {
+				desc = "Basic terms"
+			}
+			items[i] = components.MenuItem{ID: strconv.Itoa(i), Title: opt.Type, Description: desc, Icon: "📜"}
+		}
+		s.termsMenu = components.NewMenu("SELECT INVESTMENT TERMS", items)
+		s.termsMenu.SetSize(50, 15)
+		s.termsMenu.SetHideHelp(true)
+	}
+	if s.selected >= s.decisionCount() {
+		s.selected = 0
+	}
+	s.refreshTables()
+	return waitForNetEvent(s.client)
+}
+
+func (s *NetGameScreen) send(a netplay.Action) {
+	s.errorMsg = ""
+	if err := s.client.Send(a); err != nil {
+		s.errorMsg = err.Error()
+	}
+}
+
+func (s *NetGameScreen) handleKey(msg tea.KeyMsg) tea.Cmd {
+	if s.closed || s.view.Phase == netplay.PhaseOver {
+		if key.Matches(msg, keys.Global.Enter) || key.Matches(msg, keys.Global.Back) || msg.String() == "q" {
+			s.client.Close()
+			return tea.Quit
+		}
+		return nil
+	}
+
+	// Amount box
+	if s.input != netInputNone {
+		switch {
+		case key.Matches(msg, keys.Global.Back):
+			s.input = netInputNone
+			s.errorMsg = ""
+		case key.Matches(msg, keys.Global.Enter):
+			amount, err := strconv.ParseInt(strings.TrimSpace(s.amountInput.Value()), 10, 64)
+			if err != nil || amount <= 0 {
+				s.errorMsg = "Invalid amount"
+				return nil
+			}
+			kind := netplay.ActionQuote
+			if s.input == netInputFollowOn {
+				kind = netplay.ActionFollowOn
+			}
+			s.input = netInputNone
+			s.send(netplay.Action{Kind: kind, Company: s.company, Amount: amount})
+		default:
+			var cmd tea.Cmd
+			s.amountInput, cmd = s.amountInput.Update(msg)
+			return cmd
+		}
+		return nil
+	}
+
+	// Terms menu
+	if s.termsMenu != nil {
+		if key.Matches(msg, keys.Global.Back) {
+			s.termsMenu = nil
+			s.company = ""
+			return nil
+		}
+		var cmd tea.Cmd
+		s.termsMenu, cmd = s.termsMenu.Update(msg)
+		return cmd
+	}
+
+	if msg.String() == "q" {
+		s.client.Close()
+		return tea.Quit
+	}
+
+	switch s.view.Phase {
+	case netplay.PhaseInvest:
+		switch {
+		case key.Matches(msg, keys.Global.Enter):
+			idx := s.startupTable.Cursor()
+			if idx >= 0 && idx < len(s.view.Startups) {
+				return s.askAmount(netInputInvest, s.view.Startups[idx].Name)
+			}
+		case msg.String() == "d":
+			s.send(netplay.Action{Kind: netplay.ActionDone})
+		default:
+			var cmd tea.Cmd
+			s.startupTable, cmd = s.startupTable.Update(msg)
+			return cmd
+		}
+
+	case netplay.PhaseTurn:
+		votes, dds := len(s.view.Votes), len(s.view.DDDecisions)
+		switch {
+		case msg.String() == "up" || msg.String() == "k":
+			if s.selected > 0 {
+				s.selected--
+			}
+		case msg.String() == "down" || msg.String() == "j":
+			if s.selected < s.decisionCount()-1 {
+				s.selected++
+			}
+		case msg.String() == "d" || msg.String() == "n":
+			s.send(netplay.Action{Kind: netplay.ActionDone})
+		case s.selected < votes && (msg.String() == "a" || msg.String() == "b"):
+			vote := s.view.Votes[s.selected]
+			s.send(netplay.Action{Kind: netplay.ActionVote, Company: vote.CompanyName, Detail: vote.VoteType, Approve: msg.String() == "a"})
+		case s.selected >= votes && s.selected < votes+dds && (msg.String() == "i" || msg.String() == "x"):
+			s.send(netplay.Action{Kind: netplay.ActionDD, Index: s.selected - votes, Approve: msg.String() == "i"})
+		case s.selected >= votes+dds && s.selected < s.decisionCount() && key.Matches(msg, keys.Global.Enter):
+			return s.askAmount(netInputFollowOn, s.view.FollowOns[s.selected-votes-dds].CompanyName)
+		}
+	}
+	return nil
+}
+
+func (s *NetGameScreen) askAmount(input netInput, company string) tea.Cmd {
+	s.input = input
+	s.company = company
+	s.termsMenu = nil
+	s.errorMsg = ""
+	s.amountInput.SetValue("")
+	s.amountInput.Focus()
+	return textinput.Blink
+}
+
+func (s *NetGameScreen) handleTerms(id string) tea.Cmd {
+	q := s.view.Quote
+	idx, err := strconv.Atoi(id)
+	if q == nil || err != nil {
+		return nil
+	}
+	s.termsMenu = nil
+	s.company = ""
+	s.send(netplay.Action{Kind: netplay.ActionInvest, Company: q.Company, Amount: q.Amount, Index: idx})
+	return nil
+}
+
+// decisionCount is the votes, diligence calls and follow-ons waiting this month
+func (s *NetGameScreen) decisionCount() int {
+	return len(s.view.Votes) + len(s.view.DDDecisions) + len(s.view.FollowOns)
+}
+
+func (s *NetGameScreen) refreshTables() {
+	tableWidth := s.width
+	if tableWidth > 88 {
+		tableWidth = 88
+	}
+
+	rows := make([]table.Row, len(s.view.Startups))
+	for i, startup := range s.view.Startups {
+		riskLabel := "Low"
+		if startup.RiskScore > 0.85 {
+			riskLabel = "V.High"
+		} else if startup.RiskScore > 0.6 {
+			riskLabel = "High"
+		} else if startup.RiskScore > 0.4 {
+			riskLabel = "Med"
+		}
+
+		growthLabel := "High"
+		if startup.GrowthPotential > 0.85 {
+			growthLabel = "V.High"
+		} else if startup.GrowthPotential < 0.4 {
+			growthLabel = "Low"
+		} else if startup.GrowthPotential < 0.6 {
+			growthLabel = "Med"
+		}
+
+		rows[i] = table.Row{
+			fmt.Sprintf("%d", i+1),
+			truncate(startup.Name, 18),
+			truncate(startup.Category, 12),
+			formatCompactMoney(startup.Valuation),
+			riskLabel,
+			growthLabel,
+		}
+	}
+	s.startupTable.SetRows(rows)
+	s.startupTable.SetSize(tableWidth, 12)
+
+	rows = make([]table.Row, len(s.view.Investments))
+	for i, inv := range s.view.Investments {
+		value := int64((inv.EquityPercent / 100.0) * float64(inv.CurrentValuation))
+		profit := value - inv.AmountInvested
+
+		profitStr := fmt.Sprintf("+$%s", formatCompactMoney(profit))
+		if profit < 0 {
+			profitStr = fmt.Sprintf("-$%s", formatCompactMoney(-profit))
+		}
+
+		rows[i] = table.Row{
+			truncate(inv.CompanyName, 18),
+			fmt.Sprintf("$%s", formatCompactMoney(inv.AmountInvested)),
+			fmt.Sprintf("$%s", formatCompactMoney(value)),
+			fmt.Sprintf("%.1f%%", inv.EquityPercent),
+			profitStr,
+		}
+	}
+	s.portfolioTable.SetRows(rows)
+	s.portfolioTable.SetSize(tableWidth, 8)
+}
+
+// View renders the remote game
+func (s *NetGameScreen) View() string {
+	var b strings.Builder
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Cyan).
+		Bold(true).
+		Width(s.width).
+		Align(lipgloss.Center).
+		Padding(0, 2)
+
+	title := fmt.Sprintf("🌐 %s - WAITING FOR PLAYERS", s.view.FirmName)
+	switch s.view.Phase {
+	case netplay.PhaseInvest:
+		title = fmt.Sprintf("🌐 %s - INVESTMENT PHASE", s.view.FirmName)
+	case netplay.PhaseTurn:
+		title = fmt.Sprintf("🌐 %s - MONTH %d/%d", s.view.FirmName, s.view.Month, s.view.MaxMonths)
+	case netplay.PhaseOver:
+		title = "🏆 FINAL STANDINGS 🏆"
+	}
+	b.WriteString(center.Render(headerStyle.Render(title)))
+	b.WriteString("\n")
+	b.WriteString(center.Render(s.renderTable()))
+	b.WriteString("\n\n")
+
+	switch {
+	case s.view.Phase == netplay.PhaseLobby:
+		b.WriteString(center.Render(fmt.Sprintf("%d of %d players have joined - the game starts when the table is full", len(s.view.Seats), s.view.Needed)))
+		b.WriteString("\n")
+	case s.view.Phase == netplay.PhaseOver:
+		b.WriteString(s.renderStandings())
+	case s.input != netInputNone:
+		b.WriteString(s.renderAmountInput())
+	case s.termsMenu != nil:
+		box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(styles.Cyan).Padding(1, 2)
+		b.WriteString(center.Render(box.Render(s.termsMenu.View())))
+		b.WriteString("\n")
+	case s.view.Phase == netplay.PhaseInvest:
+		b.WriteString(center.Render(s.startupTable.View()))
+		b.WriteString("\n")
+	case s.view.Phase == netplay.PhaseTurn:
+		b.WriteString(center.Render(s.portfolioTable.View()))
+		b.WriteString("\n")
+		b.WriteString(s.renderDecisions())
+	}
+
+	b.WriteString(s.renderNews())
+
+	if s.status != "" {
+		b.WriteString(center.Render(lipgloss.NewStyle().Foreground(styles.Yellow).Render("🔌 " + s.status)))
+		b.WriteString("\n")
+	}
+	if s.errorMsg != "" {
+		b.WriteString(center.Render(lipgloss.NewStyle().Foreground(styles.Red).Render("❌ " + s.errorMsg)))
+		b.WriteString("\n")
+	}
+
+	if s.view.Phase == netplay.PhaseInvest || s.view.Phase == netplay.PhaseTurn {
+		statusBar := components.GameStatusBar(s.width, s.view.Month, s.view.MaxMonths, s.view.Cash, s.view.NetWorth)
+		statusBar.SetShowHelp(false)
+		b.WriteString(statusBar.View())
+		b.WriteString("\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render(s.help()))
+	return b.String()
+}
+
+// renderTable shows who's at the table, who the host is waiting on and the clock
+func (s *NetGameScreen) renderTable() string {
+	var parts []string
+	for _, seat := range s.view.Seats {
+		mark := "⏳"
+		if !seat.Connected {
+			mark = "💤"
+		} else if seat.Done {
+			mark = "✓"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", mark, seat.Name))
+	}
+	line := strings.Join(parts, "  ")
+	if !s.view.Deadline.IsZero() && s.view.Phase != netplay.PhaseOver {
+		left := time.Until(s.view.Deadline).Round(time.Second)
+		if left < 0 {
+			left = 0
+		}
+		line += fmt.Sprintf("  •  ⏱ %d:%02d left", int(left.Minutes()), int(left.Seconds())%60)
+	}
+	return lipgloss.NewStyle().Foreground(styles.Yellow).Render(line)
+}
+
+func (s *NetGameScreen) renderAmountInput() string {
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	title := fmt.Sprintf("INVEST IN %s", strings.ToUpper(s.company))
+	if s.input == netInputFollowOn {
+		title = fmt.Sprintf("FOLLOW ON IN %s", strings.ToUpper(s.company))
+	}
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true).Render(title))
+	content.WriteString("\n\n")
+	content.WriteString(fmt.Sprintf("Available cash: $%s\n\n", formatCompactMoney(s.view.Cash)))
+	inputStyle := lipgloss.NewStyle().Border(lipgloss.NormalBorder()).BorderForeground(styles.Cyan).Padding(0, 1)
+	content.WriteString(inputStyle.Render(s.amountInput.View()))
+
+	box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(styles.Cyan).Padding(1, 2).Width(55)
+	return center.Render(box.Render(content.String())) + "\n"
+}
+
+// renderDecisions lists the month's board votes, diligence calls and follow-ons
+func (s *NetGameScreen) renderDecisions() string {
+	if s.decisionCount() == 0 {
+		return ""
+	}
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	rowStyle := func(i int) lipgloss.Style {
+		if i == s.selected {
+			return lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+		}
+		return lipgloss.NewStyle().Foreground(styles.White)
+	}
+
+	var lines []string
+	i := 0
+	for _, vote := range s.view.Votes {
+		lines = append(lines, rowStyle(i).Render(fmt.Sprintf("🗳️  %s: %s - [a] %s / [b] %s", vote.CompanyName, vote.Title, vote.OptionA, vote.OptionB)))
+		i++
+	}
+	for _, dd := range s.view.DDDecisions {
+		red := 0
+		for _, f := range dd.Findings {
+			if f.Type == "red_flag" {
+				red++
+			}
+		}
+		lines = append(lines, rowStyle(i).Render(fmt.Sprintf("🔍 %s: $%s in diligence, %d red flags, round closes month %d - [i] close / [x] walk",
+			dd.CompanyName, formatCompactMoney(dd.Amount), red, dd.RoundCloseTurn)))
+		i++
+	}
+	for _, opp := range s.view.FollowOns {
+		lines = append(lines, rowStyle(i).Render(fmt.Sprintf("💰 %s %s: follow on $%s-$%s - [enter]",
+			opp.CompanyName, opp.RoundName, formatCompactMoney(opp.MinInvestment), formatCompactMoney(opp.MaxInvestment))))
+		i++
+	}
+
+	box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(styles.Magenta).Padding(0, 1)
+	return center.Render(box.Render(strings.Join(lines, "\n"))) + "\n"
+}
+
+func (s *NetGameScreen) renderNews() string {
+	if len(s.view.News) == 0 {
+		return ""
+	}
+	panelWidth := 72
+	if s.width < panelWidth {
+		panelWidth = s.width
+	}
+	panelStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Yellow).
+		Padding(0, 1).
+		Width(panelWidth)
+
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true).Render("📰 NEWS"))
+	b.WriteString("\n")
+	start := 0
+	if len(s.view.News) > 5 {
+		start = len(s.view.News) - 5
+	}
+	for _, msg := range s.view.News[start:] {
+		b.WriteString("• " + truncate(msg, panelWidth-6))
+		b.WriteString("\n")
+	}
+	return lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(panelStyle.Render(b.String())) + "\n"
+}
+
+func (s *NetGameScreen) renderStandings() string {
+	var table strings.Builder
+	for i, entry := range s.view.Leaderboard {
+		marker := "🤖 "
+		rowStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+		if entry.IsHuman {
+			marker = "👤 "
+			rowStyle = lipgloss.NewStyle().Foreground(styles.Cyan)
+		}
+		if entry.IsPlayer {
+			rowStyle = rowStyle.Bold(true).Foreground(styles.Yellow)
+		}
+		table.WriteString(rowStyle.Render(fmt.Sprintf("%s%-4d %-18s %-24s $%-11s %.0f%%",
+			marker, i+1, truncate(entry.Name, 18), truncate(entry.Firm, 24), formatCompactMoney(entry.NetWorth), entry.ROI)))
+		table.WriteString("\n")
+	}
+	box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(styles.Magenta).Padding(1, 2)
+	return lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(box.Render(table.String())) + "\n"
+}
+
+func (s *NetGameScreen) help() string {
+	switch {
+	case s.closed || s.view.Phase == netplay.PhaseOver:
+		return "enter quit"
+	case s.input != netInputNone:
+		return "enter submit • esc cancel"
+	case s.termsMenu != nil:
+		return "↑/↓ navigate • enter select • esc back"
+	case s.view.Phase == netplay.PhaseInvest:
+		return "↑/↓ navigate • enter invest • d done investing • q leave"
+	case s.view.Phase == netplay.PhaseTurn:
+		return "↑/↓ select • a/b vote • i/x diligence • enter follow on • d end month • q leave"
+	}
+	return "q leave"
+}
+
+// RunNetClient plays a networked game in the terminal until the player leaves
+func RunNetClient(client *netplay.Client) error {
+	p := tea.NewProgram(
+		NewNetGameScreen(client),
+		tea.WithAltScreen(),
+	)
+	_, err := p.Run()
+	return err
+}
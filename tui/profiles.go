@@ -0,0 +1,341 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/tui/components"
+	"github.com/jamesacampbell/unicorn/tui/keys"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+// newProfileID is the menu entry for creating a profile; real profiles use their name
+const newProfileID = "\x00new"
+
+type profilesMode int
+
+const (
+	profilesBrowse profilesMode = iota
+	profilesCreate
+	profilesRename
+	profilesConfirmDelete
+)
+
+// ProfilesScreen picks the active local profile and manages the others
+type ProfilesScreen struct {
+	width    int
+	height   int
+	gameData *GameData
+	profiles []database.Profile
+	menu     *components.Menu
+	mode     profilesMode
+	input    textinput.Model
+	target   string // Profile being renamed or deleted
+	err      string
+}
+
+// NewProfilesScreen creates a new profiles screen
+func NewProfilesScreen(width, height int, gameData *GameData) *ProfilesScreen {
+	input := textinput.New()
+	input.Placeholder = "Enter your name"
+	input.CharLimit = 30
+	input.Width = 30
+
+	s := &ProfilesScreen{
+		width:    width,
+		height:   height,
+		gameData: gameData,
+		input:    input,
+	}
+	s.reload()
+
+	// First run: go straight to creating one
+	if len(s.profiles) == 0 {
+		s.startInput(profilesCreate, "")
+	}
+	return s
+}
+
+// reload rebuilds the menu from the store, keeping the cursor where it was
+func (s *ProfilesScreen) reload() {
+	cursor := 0
+	if s.menu != nil {
+		cursor = s.menu.SelectedIndex()
+	}
+
+	profiles, err := s.gameData.Store.ListProfiles()
+	if err != nil {
+		s.err = err.Error()
+	}
+	s.profiles = profiles
+
+	var items []components.MenuItem
+	for _, p := range profiles {
+		icon := "👤"
+		if s.gameData.Profile != nil && s.gameData.Profile.Name == p.Name {
+			icon = "▶️"
+		}
+		items = append(items, components.MenuItem{
+			ID:          p.Name,
+			Title:       p.Name,
+			Description: s.describe(p.Name),
+			Icon:        icon,
+		})
+	}
+	items = append(items, components.MenuItem{
+		ID:          newProfileID,
+		Title:       "New Profile",
+		Description: "Start a fresh career",
+		Icon:        "➕",
+	})
+
+	s.menu = components.NewMenu("SELECT PROFILE", items)
+	s.menu.SetSize(50, 20)
+	s.menu.SetHideHelp(true)
+	s.menu.SetCursor(min(cursor, len(items)-1))
+}
+
+func (s *ProfilesScreen) describe(name string) string {
+	level := 1
+	if profile, err := s.gameData.Store.GetPlayerProfile(name); err == nil && profile != nil {
+		level = profile.Level
+	}
+	games := 0
+	if stats, err := s.gameData.Store.GetPlayerStats(name); err == nil && stats != nil {
+		games = stats.TotalGames
+	}
+	return fmt.Sprintf("Level %d • %d games", level, games)
+}
+
+func (s *ProfilesScreen) startInput(mode profilesMode, value string) tea.Cmd {
+	s.mode = mode
+	s.err = ""
+	s.input.SetValue(value)
+	s.input.CursorEnd()
+	s.input.Focus()
+	return textinput.Blink
+}
+
+// Init initializes the profiles screen
+func (s *ProfilesScreen) Init() tea.Cmd {
+	if s.mode == profilesCreate {
+		return textinput.Blink
+	}
+	return nil
+}
+
+// Update handles profiles screen input
+func (s *ProfilesScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	// The app's quit handler skips this screen so names can contain q
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlC {
+		return s, Quit()
+	}
+
+	switch s.mode {
+	case profilesCreate, profilesRename:
+		return s.updateInput(msg)
+	case profilesConfirmDelete:
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch msg.String() {
+			case "y", "Y":
+				return s, s.deleteTarget()
+			case "n", "N", "esc":
+				s.mode = profilesBrowse
+			}
+		}
+		return s, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Global.Back):
+			if s.gameData.Profile != nil {
+				return s, SwitchTo(ScreenMainMenu)
+			}
+			return s, nil
+		case key.Matches(msg, keys.Global.Quit):
+			return s, Quit()
+		case msg.String() == "n":
+			return s, s.startInput(profilesCreate, "")
+		case msg.String() == "r", msg.String() == "d":
+			id := s.menu.SelectedID()
+			if id == newProfileID {
+				return s, nil
+			}
+			s.target = id
+			if msg.String() == "r" {
+				return s, s.startInput(profilesRename, id)
+			}
+			s.err = ""
+			s.mode = profilesConfirmDelete
+			return s, nil
+		}
+
+	case components.MenuSelectedMsg:
+		if msg.ID == newProfileID {
+			return s, s.startInput(profilesCreate, "")
+		}
+		profile, err := s.gameData.Store.GetProfile(msg.ID)
+		if err != nil || profile == nil {
+			s.err = fmt.Sprintf("Couldn't load %s", msg.ID)
+			return s, nil
+		}
+		s.gameData.SetProfile(profile)
+		return s, SwitchTo(ScreenMainMenu)
+	}
+
+	var cmd tea.Cmd
+	s.menu, cmd = s.menu.Update(msg)
+	return s, cmd
+}
+
+func (s *ProfilesScreen) updateInput(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.Type {
+		case tea.KeyEsc:
+			if len(s.profiles) > 0 {
+				s.mode = profilesBrowse
+				s.err = ""
+			}
+			return s, nil
+		case tea.KeyEnter:
+			return s, s.submitInput()
+		}
+	}
+
+	var cmd tea.Cmd
+	s.input, cmd = s.input.Update(msg)
+	return s, cmd
+}
+
+func (s *ProfilesScreen) submitInput() tea.Cmd {
+	name := strings.TrimSpace(s.input.Value())
+	store := s.gameData.Store
+
+	if s.mode == profilesRename {
+		if name == s.target {
+			s.mode = profilesBrowse
+			return nil
+		}
+		if err := store.RenameProfile(s.target, name); err != nil {
+			s.err = err.Error()
+			return nil
+		}
+		// Renaming the active profile renames the player too
+		if s.gameData.Profile != nil && s.gameData.Profile.Name == s.target {
+			if profile, err := store.GetProfile(name); err == nil && profile != nil {
+				s.gameData.SetProfile(profile)
+			}
+		}
+		s.mode = profilesBrowse
+		s.reload()
+		return nil
+	}
+
+	profile, err := store.CreateProfile(name)
+	if err != nil {
+		s.err = err.Error()
+		return nil
+	}
+	s.gameData.SetProfile(profile)
+	return SwitchTo(ScreenMainMenu)
+}
+
+func (s *ProfilesScreen) deleteTarget() tea.Cmd {
+	if err := s.gameData.Store.DeleteProfile(s.target); err != nil {
+		s.err = err.Error()
+		s.mode = profilesBrowse
+		return nil
+	}
+	if s.gameData.Profile != nil && s.gameData.Profile.Name == s.target {
+		s.gameData.Profile = nil
+		s.gameData.PlayerName = ""
+	}
+
+	s.mode = profilesBrowse
+	s.reload()
+	if len(s.profiles) == 0 {
+		return s.startInput(profilesCreate, "")
+	}
+	return nil
+}
+
+// View renders the profiles screen
+func (s *ProfilesScreen) View() string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Cyan).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("👤 PLAYER PROFILES 👤")))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Cyan).
+		Padding(1, 2).
+		Width(55)
+
+	var content strings.Builder
+	var help string
+	switch s.mode {
+	case profilesCreate, profilesRename:
+		titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
+		if s.mode == profilesRename {
+			content.WriteString(titleStyle.Render(fmt.Sprintf("RENAME %s", s.target)))
+		} else if len(s.profiles) == 0 {
+			content.WriteString(titleStyle.Render("WELCOME! ENTER YOUR NAME"))
+		} else {
+			content.WriteString(titleStyle.Render("NEW PROFILE"))
+		}
+		content.WriteString("\n\n")
+
+		inputStyle := lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(styles.Cyan).
+			Padding(0, 1)
+		content.WriteString(inputStyle.Render(s.input.View()))
+		help = "enter confirm • esc cancel"
+		if len(s.profiles) == 0 {
+			help = "enter confirm"
+		}
+
+	case profilesConfirmDelete:
+		warnStyle := lipgloss.NewStyle().Foreground(styles.Red).Bold(true)
+		content.WriteString(warnStyle.Render(fmt.Sprintf("Delete %s?", s.target)))
+		content.WriteString("\n\n")
+		content.WriteString("Every game, score, achievement and upgrade saved\nunder this profile will be removed for good.\n\n[Y]es / [N]o")
+		help = "y delete • n cancel"
+
+	default:
+		content.WriteString(s.menu.View())
+		help = "↑/↓ navigate • enter play • n new • r rename • d delete"
+		if s.gameData.Profile != nil {
+			help += " • esc back"
+		}
+	}
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(box.Render(content.String())))
+	b.WriteString("\n\n")
+
+	if s.err != "" {
+		errStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(errStyle.Render("✗ " + s.err))
+		b.WriteString("\n\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render(help))
+
+	return b.String()
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jamesacampbell/unicorn/database"
@@ -18,38 +17,22 @@ import (
 type ProgressionScreen struct {
 	width      int
 	height     int
+	store      database.Store
 	playerName string
-	nameInput  textinput.Model
 	profile    *database.PlayerProfile
-	inputMode  bool
 }
 
 // NewProgressionScreen creates a new progression screen
-func NewProgressionScreen(width, height int, playerName string) *ProgressionScreen {
-	nameInput := textinput.New()
-	nameInput.Placeholder = "Enter player name"
-	nameInput.CharLimit = 30
-	nameInput.Width = 25
-	
+func NewProgressionScreen(width, height int, store database.Store, playerName string) *ProgressionScreen {
 	s := &ProgressionScreen{
 		width:      width,
 		height:     height,
-		nameInput:  nameInput,
+		store:      store,
 		playerName: playerName,
 	}
 	
-	if playerName != "" {
-		profile, err := database.GetPlayerProfile(playerName)
-		if err == nil {
-			s.profile = profile
-			s.inputMode = false
-		} else {
-			s.inputMode = true
-			s.nameInput.Focus()
-		}
-	} else {
-		s.inputMode = true
-		s.nameInput.Focus()
+	if profile, err := s.store.GetPlayerProfile(playerName); err == nil {
+		s.profile = profile
 	}
 	
 	return s
@@ -57,9 +40,6 @@ func NewProgressionScreen(width, height int, playerName string) *ProgressionScre
 
 // Init initializes the progression screen
 func (s *ProgressionScreen) Init() tea.Cmd {
-	if s.inputMode {
-		return textinput.Blink
-	}
 	return nil
 }
 
@@ -68,31 +48,8 @@ func (s *ProgressionScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		if key.Matches(msg, keys.Global.Back) {
-			if s.inputMode && s.playerName != "" {
-				s.inputMode = false
-				return s, nil
-			}
 			return s, PopScreen()
 		}
-		
-		if s.inputMode && msg.Type == tea.KeyEnter {
-			name := strings.TrimSpace(s.nameInput.Value())
-			if name != "" {
-				s.playerName = name
-				profile, err := database.GetPlayerProfile(name)
-				if err == nil {
-					s.profile = profile
-				}
-				s.inputMode = false
-			}
-			return s, nil
-		}
-	}
-	
-	if s.inputMode {
-		var cmd tea.Cmd
-		s.nameInput, cmd = s.nameInput.Update(msg)
-		return s, cmd
 	}
 	
 	return s, nil
@@ -113,95 +70,80 @@ func (s *ProgressionScreen) View() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("📈 PROGRESSION & LEVELS 📈")))
 	b.WriteString("\n\n")
 	
-	if s.inputMode {
-		// Name input
-		inputBox := lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(styles.Magenta).
-			Padding(1, 2).
-			Width(40)
+	// Progression display
+	progBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Magenta).
+		Padding(1, 2).
+		Width(55)
+	
+	var prog strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Magenta).Bold(true)
+	prog.WriteString(titleStyle.Render(fmt.Sprintf("Progress for %s", s.playerName)))
+	prog.WriteString("\n\n")
+	
+	if s.profile == nil {
+		prog.WriteString("No progression data yet - play some games!")
+	} else {
+		// Level info
+		levelStyle := lipgloss.NewStyle().Foreground(styles.Gold).Bold(true)
+		title := progression.GetLevelInfo(s.profile.Level).Title
+		prog.WriteString(levelStyle.Render(fmt.Sprintf("Level %d - %s", s.profile.Level, title)))
+		prog.WriteString("\n\n")
 		
-		var input strings.Builder
-		input.WriteString("Enter player name:\n\n")
-		input.WriteString(s.nameInput.View())
+		// XP bar
+		xpProgress := s.profile.ProgressPercent / 100.0
+		if xpProgress > 1 {
+			xpProgress = 1
+		}
 		
-		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(inputBox.Render(input.String())))
-	} else {
-		// Progression display
-		progBox := lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(styles.Magenta).
-			Padding(1, 2).
-			Width(55)
+		barWidth := 30
+		filledWidth := int(xpProgress * float64(barWidth))
 		
-		var prog strings.Builder
-		titleStyle := lipgloss.NewStyle().Foreground(styles.Magenta).Bold(true)
-		prog.WriteString(titleStyle.Render(fmt.Sprintf("Progress for %s", s.playerName)))
-		prog.WriteString("\n\n")
+		labelStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
+		prog.WriteString(labelStyle.Render("Experience: "))
+		
+		filledStyle := lipgloss.NewStyle().Foreground(styles.Green)
+		emptyStyle := lipgloss.NewStyle().Foreground(styles.DarkGray)
+		prog.WriteString(filledStyle.Render(strings.Repeat("█", filledWidth)))
+		prog.WriteString(emptyStyle.Render(strings.Repeat("░", barWidth-filledWidth)))
+		prog.WriteString(fmt.Sprintf(" %d/%d XP\n\n", s.profile.ExperiencePoints, s.profile.NextLevelXP))
+		
+		// Stats
+		prog.WriteString(labelStyle.Render("Total Points Earned: "))
+		prog.WriteString(fmt.Sprintf("%d\n", s.profile.TotalPointsEarned))
+		
+		prog.WriteString(labelStyle.Render("Level-up Points: "))
+		prog.WriteString(fmt.Sprintf("%d\n", s.profile.LevelUpPoints))
+		
+		// Unlocks
+		prog.WriteString("\n")
+		prog.WriteString(titleStyle.Render("Unlocks:\n"))
+		
+		unlockStyle := lipgloss.NewStyle().Foreground(styles.Green)
+		lockedStyle := lipgloss.NewStyle().Foreground(styles.Gray)
 		
-		if s.profile == nil {
-			prog.WriteString("No progression data yet - play some games!")
+		if s.profile.Level >= 2 {
+			prog.WriteString(unlockStyle.Render("✓ Syndicate Investing (Lvl 2)\n"))
 		} else {
-			// Level info
-			levelStyle := lipgloss.NewStyle().Foreground(styles.Gold).Bold(true)
-			title := progression.GetLevelInfo(s.profile.Level).Title
-			prog.WriteString(levelStyle.Render(fmt.Sprintf("Level %d - %s", s.profile.Level, title)))
-			prog.WriteString("\n\n")
-			
-			// XP bar
-			xpProgress := s.profile.ProgressPercent / 100.0
-			if xpProgress > 1 {
-				xpProgress = 1
-			}
-			
-			barWidth := 30
-			filledWidth := int(xpProgress * float64(barWidth))
-			
-			labelStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
-			prog.WriteString(labelStyle.Render("Experience: "))
-			
-			filledStyle := lipgloss.NewStyle().Foreground(styles.Green)
-			emptyStyle := lipgloss.NewStyle().Foreground(styles.DarkGray)
-			prog.WriteString(filledStyle.Render(strings.Repeat("█", filledWidth)))
-			prog.WriteString(emptyStyle.Render(strings.Repeat("░", barWidth-filledWidth)))
-			prog.WriteString(fmt.Sprintf(" %d/%d XP\n\n", s.profile.ExperiencePoints, s.profile.NextLevelXP))
-			
-			// Stats
-			prog.WriteString(labelStyle.Render("Total Points Earned: "))
-			prog.WriteString(fmt.Sprintf("%d\n", s.profile.TotalPointsEarned))
-			
-			prog.WriteString(labelStyle.Render("Level-up Points: "))
-			prog.WriteString(fmt.Sprintf("%d\n", s.profile.LevelUpPoints))
-			
-			// Unlocks
-			prog.WriteString("\n")
-			prog.WriteString(titleStyle.Render("Unlocks:\n"))
-			
-			unlockStyle := lipgloss.NewStyle().Foreground(styles.Green)
-			lockedStyle := lipgloss.NewStyle().Foreground(styles.Gray)
-			
-			if s.profile.Level >= 2 {
-				prog.WriteString(unlockStyle.Render("✓ Syndicate Investing (Lvl 2)\n"))
-			} else {
-				prog.WriteString(lockedStyle.Render("🔒 Syndicate Investing (Lvl 2)\n"))
-			}
-			
-			if s.profile.Level >= 5 {
-				prog.WriteString(unlockStyle.Render("✓ Hard Difficulty (Lvl 5)\n"))
-			} else {
-				prog.WriteString(lockedStyle.Render("🔒 Hard Difficulty (Lvl 5)\n"))
-			}
-			
-			if s.profile.Level >= 10 {
-				prog.WriteString(unlockStyle.Render("✓ Expert Difficulty (Lvl 10)\n"))
-			} else {
-				prog.WriteString(lockedStyle.Render("🔒 Expert Difficulty (Lvl 10)\n"))
-			}
+			prog.WriteString(lockedStyle.Render("🔒 Syndicate Investing (Lvl 2)\n"))
 		}
 		
-		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(progBox.Render(prog.String())))
+		if s.profile.Level >= 5 {
+			prog.WriteString(unlockStyle.Render("✓ Hard Difficulty (Lvl 5)\n"))
+		} else {
+			prog.WriteString(lockedStyle.Render("🔒 Hard Difficulty (Lvl 5)\n"))
+		}
+		
+		if s.profile.Level >= 10 {
+			prog.WriteString(unlockStyle.Render("✓ Expert Difficulty (Lvl 10)\n"))
+		} else {
+			prog.WriteString(lockedStyle.Render("🔒 Expert Difficulty (Lvl 10)\n"))
+		}
 	}
 	
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(progBox.Render(prog.String())))
+	
 	b.WriteString("\n\n")
 	
 	// Help
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jamesacampbell/unicorn/database"
@@ -17,40 +16,25 @@ import (
 type ReputationScreen struct {
 	width      int
 	height     int
+	store      database.Store
 	playerName string
 	reputation *database.VCReputation
-	nameInput  textinput.Model
-	inputMode  bool
 }
 
 // NewReputationScreen creates a new reputation screen
-func NewReputationScreen(width, height int, playerName string) *ReputationScreen {
-	ti := textinput.New()
-	ti.Placeholder = "Enter player name"
-	ti.CharLimit = 30
-	ti.Width = 30
-
-	if playerName == "" {
-		ti.Focus()
-	}
-
+func NewReputationScreen(width, height int, store database.Store, playerName string) *ReputationScreen {
 	s := &ReputationScreen{
 		width:      width,
 		height:     height,
+		store:      store,
 		playerName: playerName,
-		nameInput:  ti,
-		inputMode:  playerName == "",
 	}
-
-	if playerName != "" {
-		s.loadReputation()
-	}
-
+	s.loadReputation()
 	return s
 }
 
 func (s *ReputationScreen) loadReputation() {
-	rep, err := database.GetVCReputation(s.playerName)
+	rep, err := s.store.GetVCReputation(s.playerName)
 	if err == nil {
 		s.reputation = rep
 	}
@@ -58,9 +42,6 @@ func (s *ReputationScreen) loadReputation() {
 
 // Init initializes the reputation screen
 func (s *ReputationScreen) Init() tea.Cmd {
-	if s.inputMode {
-		return textinput.Blink
-	}
 	return nil
 }
 
@@ -68,24 +49,6 @@ func (s *ReputationScreen) Init() tea.Cmd {
 func (s *ReputationScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if s.inputMode {
-			switch msg.String() {
-			case "enter":
-				name := strings.TrimSpace(s.nameInput.Value())
-				if name != "" {
-					s.playerName = name
-					s.inputMode = false
-					s.loadReputation()
-				}
-				return s, nil
-			case "esc":
-				return s, PopScreen()
-			}
-			var cmd tea.Cmd
-			s.nameInput, cmd = s.nameInput.Update(msg)
-			return s, cmd
-		}
-
 		if key.Matches(msg, keys.Global.Back) {
 			return s, PopScreen()
 		}
@@ -109,10 +72,6 @@ func (s *ReputationScreen) View() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("⭐ VC REPUTATION ⭐")))
 	b.WriteString("\n\n")
 
-	if s.inputMode {
-		return s.renderNameInput()
-	}
-
 	if s.reputation == nil {
 		infoStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
 		b.WriteString(infoStyle.Render("No reputation data found for this player."))
@@ -194,39 +153,6 @@ func (s *ReputationScreen) View() string {
 	return b.String()
 }
 
-func (s *ReputationScreen) renderNameInput() string {
-	var b strings.Builder
-
-	headerStyle := lipgloss.NewStyle().
-		Foreground(styles.Black).
-		Background(styles.Gold).
-		Bold(true).
-		Width(70).
-		Align(lipgloss.Center)
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("⭐ VC REPUTATION ⭐")))
-	b.WriteString("\n\n")
-
-	boxStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.Cyan).
-		Padding(1, 2).
-		Width(40)
-
-	var content strings.Builder
-	content.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true).Render("Enter Player Name"))
-	content.WriteString("\n\n")
-	content.WriteString(s.nameInput.View())
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(boxStyle.Render(content.String())))
-	b.WriteString("\n\n")
-
-	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("enter submit • esc back"))
-
-	return b.String()
-}
-
 func (s *ReputationScreen) renderScoreBar(label string, score float64) string {
 	bars := int(score / 10)
 	barStr := ""
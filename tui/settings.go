@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/tui/components"
+	"github.com/jamesacampbell/unicorn/tui/keys"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+// SettingsScreen edits the active profile's preferences
+type SettingsScreen struct {
+	width    int
+	height   int
+	gameData *GameData
+	menu     *components.Menu
+	err      string
+}
+
+// NewSettingsScreen creates a new settings screen
+func NewSettingsScreen(width, height int, gameData *GameData) *SettingsScreen {
+	s := &SettingsScreen{
+		width:    width,
+		height:   height,
+		gameData: gameData,
+	}
+	s.buildMenu()
+	return s
+}
+
+func (s *SettingsScreen) buildMenu() {
+	cursor := 0
+	if s.menu != nil {
+		cursor = s.menu.SelectedIndex()
+	}
+	p := s.gameData.Profile
+
+	theme := styles.Themes[0]
+	for _, t := range styles.Themes {
+		if t.ID == p.Theme {
+			theme = t
+		}
+	}
+	playMode := "Manual"
+	if p.AutoMode {
+		playMode = "Automated"
+	}
+	submit, submitDesc := "Off", "Scores stay on this machine"
+	if p.SubmitScores {
+		submit, submitDesc = "On", "Finished games are sent to the global leaderboard"
+	}
+
+	items := []components.MenuItem{
+		{
+			ID:          "theme",
+			Title:       fmt.Sprintf("Theme: %s", theme.Name),
+			Description: theme.Description,
+			Icon:        "🎨",
+		},
+		{
+			ID:          "auto_mode",
+			Title:       fmt.Sprintf("Default Play Mode: %s", playMode),
+			Description: "Preselected when setting up a VC game",
+			Icon:        "⏩",
+		},
+		{
+			ID:          "submit_scores",
+			Title:       fmt.Sprintf("Global Leaderboard: %s", submit),
+			Description: submitDesc,
+			Icon:        "🌐",
+		},
+	}
+
+	s.menu = components.NewMenu(fmt.Sprintf("SETTINGS FOR %s", strings.ToUpper(p.Name)), items)
+	s.menu.SetSize(60, 15)
+	s.menu.SetHideHelp(true)
+	s.menu.SetCursor(cursor)
+}
+
+// Init initializes the settings screen
+func (s *SettingsScreen) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles settings input
+func (s *SettingsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, keys.Global.Back) {
+			return s, PopScreen()
+		}
+
+	case components.MenuSelectedMsg:
+		s.toggle(msg.ID)
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	s.menu, cmd = s.menu.Update(msg)
+	return s, cmd
+}
+
+// toggle changes one preference, saves it and applies it straight away
+func (s *SettingsScreen) toggle(id string) {
+	p := s.gameData.Profile
+	switch id {
+	case "theme":
+		next := 0
+		for i, t := range styles.Themes {
+			if t.ID == p.Theme {
+				next = (i + 1) % len(styles.Themes)
+			}
+		}
+		p.Theme = styles.Themes[next].ID
+		styles.ApplyTheme(p.Theme)
+	case "auto_mode":
+		p.AutoMode = !p.AutoMode
+		s.gameData.AutoMode = p.AutoMode
+	case "submit_scores":
+		p.SubmitScores = !p.SubmitScores
+	}
+
+	s.err = ""
+	if err := s.gameData.Store.SaveProfile(p); err != nil {
+		s.err = err.Error()
+	}
+	s.buildMenu()
+}
+
+// View renders the settings screen
+func (s *SettingsScreen) View() string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Cyan).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("⚙️  SETTINGS ⚙️")))
+	b.WriteString("\n\n")
+
+	menuBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Cyan).
+		Padding(1, 2)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(menuBox.Render(s.menu.View())))
+	b.WriteString("\n\n")
+
+	if s.err != "" {
+		errStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(errStyle.Render("✗ " + s.err))
+		b.WriteString("\n\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("↑/↓ navigate • enter change • esc back"))
+
+	return b.String()
+}
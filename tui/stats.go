@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jamesacampbell/unicorn/database"
@@ -13,70 +12,39 @@ import (
 	"github.com/jamesacampbell/unicorn/tui/styles"
 )
 
-// StatsScreen shows player statistics
+// StatsScreen shows the active profile's statistics
 type StatsScreen struct {
-	width       int
-	height      int
-	nameInput   textinput.Model
-	stats       *database.PlayerStats
-	playerName  string
-	inputMode   bool
+	width      int
+	height     int
+	stats      *database.PlayerStats
+	career     *database.Career
+	history    []database.CareerEvent
+	playerName string
 }
 
 // NewStatsScreen creates a new stats screen
-func NewStatsScreen(width, height int) *StatsScreen {
-	nameInput := textinput.New()
-	nameInput.Placeholder = "Enter player name"
-	nameInput.Focus()
-	nameInput.CharLimit = 30
-	nameInput.Width = 25
-	
-	return &StatsScreen{
-		width:     width,
-		height:    height,
-		nameInput: nameInput,
-		inputMode: true,
+func NewStatsScreen(width, height int, store database.Store, playerName string) *StatsScreen {
+	s := &StatsScreen{
+		width:      width,
+		height:     height,
+		playerName: playerName,
 	}
+	s.stats, _ = store.GetPlayerStats(playerName)
+	s.career, _ = store.GetCareer(playerName)
+	s.history, _ = store.GetCareerHistory(playerName, 5)
+	return s
 }
 
 // Init initializes the stats screen
 func (s *StatsScreen) Init() tea.Cmd {
-	return textinput.Blink
+	return nil
 }
 
 // Update handles stats input
 func (s *StatsScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		if key.Matches(msg, keys.Global.Back) {
-			if s.inputMode {
-				return s, PopScreen()
-			}
-			s.inputMode = true
-			s.stats = nil
-			return s, textinput.Blink
-		}
-		
-		if s.inputMode && msg.Type == tea.KeyEnter {
-			name := strings.TrimSpace(s.nameInput.Value())
-			if name != "" {
-				s.playerName = name
-				stats, err := database.GetPlayerStats(name)
-				if err == nil {
-					s.stats = stats
-				}
-				s.inputMode = false
-			}
-			return s, nil
-		}
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, keys.Global.Back) {
+		return s, PopScreen()
 	}
-	
-	if s.inputMode {
-		var cmd tea.Cmd
-		s.nameInput, cmd = s.nameInput.Update(msg)
-		return s, cmd
-	}
-	
 	return s, nil
 }
 
@@ -95,60 +63,68 @@ func (s *StatsScreen) View() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("📊 PLAYER STATISTICS 📊")))
 	b.WriteString("\n\n")
 	
-	if s.inputMode {
-		// Name input
-		inputBox := lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(styles.Cyan).
-			Padding(1, 2).
-			Width(40)
+	// Stats display
+	statsBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Cyan).
+		Padding(1, 2).
+		Width(50)
+	
+	var stats strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
+	stats.WriteString(titleStyle.Render(fmt.Sprintf("Stats for %s", s.playerName)))
+	stats.WriteString("\n\n")
+	
+	if s.stats == nil || s.stats.TotalGames == 0 {
+		stats.WriteString("No games played yet")
+	} else {
+		labelStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
 		
-		var input strings.Builder
-		input.WriteString("Enter player name:\n\n")
-		input.WriteString(s.nameInput.View())
+		stats.WriteString(labelStyle.Render("Total Games: "))
+		stats.WriteString(fmt.Sprintf("%d\n", s.stats.TotalGames))
 		
-		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(inputBox.Render(input.String())))
-	} else {
-		// Stats display
-		statsBox := lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(styles.Cyan).
-			Padding(1, 2).
-			Width(50)
+		stats.WriteString(labelStyle.Render("Best Net Worth: "))
+		stats.WriteString(fmt.Sprintf("$%s\n", formatCompactMoney(s.stats.BestNetWorth)))
 		
-		var stats strings.Builder
-		titleStyle := lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true)
-		stats.WriteString(titleStyle.Render(fmt.Sprintf("Stats for %s", s.playerName)))
-		stats.WriteString("\n\n")
+		stats.WriteString(labelStyle.Render("Best ROI: "))
+		roiStyle := lipgloss.NewStyle().Foreground(styles.Green)
+		stats.WriteString(roiStyle.Render(fmt.Sprintf("%.1f%%\n", s.stats.BestROI*100)))
 		
-		if s.stats == nil || s.stats.TotalGames == 0 {
-			stats.WriteString("No games played yet")
-		} else {
-			labelStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
-			
-			stats.WriteString(labelStyle.Render("Total Games: "))
-			stats.WriteString(fmt.Sprintf("%d\n", s.stats.TotalGames))
-			
-			stats.WriteString(labelStyle.Render("Best Net Worth: "))
-			stats.WriteString(fmt.Sprintf("$%s\n", formatCompactMoney(s.stats.BestNetWorth)))
-			
-			stats.WriteString(labelStyle.Render("Best ROI: "))
-			roiStyle := lipgloss.NewStyle().Foreground(styles.Green)
-			stats.WriteString(roiStyle.Render(fmt.Sprintf("%.1f%%\n", s.stats.BestROI*100)))
-			
-			stats.WriteString(labelStyle.Render("Total Exits: "))
-			stats.WriteString(fmt.Sprintf("%d\n", s.stats.TotalExits))
-			
-			stats.WriteString(labelStyle.Render("Average Net Worth: "))
-			stats.WriteString(fmt.Sprintf("$%s\n", formatCompactMoney(int64(s.stats.AverageNetWorth))))
-			
-			stats.WriteString(labelStyle.Render("Win Rate: "))
-			stats.WriteString(fmt.Sprintf("%.1f%%\n", s.stats.WinRate))
-		}
+		stats.WriteString(labelStyle.Render("Total Exits: "))
+		stats.WriteString(fmt.Sprintf("%d\n", s.stats.TotalExits))
 		
-		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(statsBox.Render(stats.String())))
+		stats.WriteString(labelStyle.Render("Average Net Worth: "))
+		stats.WriteString(fmt.Sprintf("$%s\n", formatCompactMoney(int64(s.stats.AverageNetWorth))))
+		
+		stats.WriteString(labelStyle.Render("Win Rate: "))
+		stats.WriteString(fmt.Sprintf("%.1f%%\n", s.stats.WinRate))
+	}
+
+	if s.career != nil && len(s.history) > 0 {
+		labelStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
+		stats.WriteString("\n")
+		stats.WriteString(titleStyle.Render("Career"))
+		stats.WriteString("\n")
+		stats.WriteString(labelStyle.Render("Personal Net Worth: "))
+		stats.WriteString(fmt.Sprintf("$%s\n", formatCompactMoney(s.career.NetWorth)))
+		stats.WriteString(labelStyle.Render("Founder Exits: "))
+		stats.WriteString(fmt.Sprintf("%d • Funds Anchored: %d • Angel Runs: %d\n",
+			s.career.FounderExits, s.career.FundsAnchored, s.career.AngelGames))
+		for _, e := range s.history {
+			icon := "💼"
+			if e.GameMode == "founder" {
+				icon = "🚀"
+			}
+			sign, amount := "+", e.Amount
+			if amount < 0 {
+				sign, amount = "-", -amount
+			}
+			stats.WriteString(fmt.Sprintf("%s %s (%s$%s)\n", icon, truncate(e.Event, 28), sign, formatCompactMoney(amount)))
+		}
 	}
 	
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(statsBox.Render(stats.String())))
+	
 	b.WriteString("\n\n")
 	
 	// Help
@@ -27,8 +27,37 @@ var (
 	DimWhite   = lipgloss.Color("#A0A0A0")
 )
 
-// Base styles
+// Shared styles, built from the palette by buildStyles
 var (
+	// Base styles
+	AppStyle, TitleStyle, SubtitleStyle, HeaderStyle, BoxStyle, FocusedBoxStyle, LeftPanelStyle, RightPanelStyle lipgloss.Style
+	// Menu styles
+	MenuItemStyle, SelectedMenuItemStyle, MenuDescriptionStyle lipgloss.Style
+	// Status and info styles
+	StatusBarStyle, HelpStyle, HelpKeyStyle, HelpDescStyle lipgloss.Style
+	// Game-specific styles
+	MoneyPositiveStyle, MoneyNegativeStyle, MoneyNeutralStyle, RiskLowStyle, RiskMediumStyle, RiskHighStyle, RiskVeryHighStyle, GrowthVeryHighStyle, GrowthHighStyle, GrowthMediumStyle, GrowthLowStyle lipgloss.Style
+	// News and events
+	NewsHeaderStyle, NewsGoodStyle, NewsBadStyle, NewsNeutralStyle lipgloss.Style
+	// Dialog styles
+	DialogBoxStyle, DialogTitleStyle, DialogButtonStyle, DialogButtonActiveStyle lipgloss.Style
+	// Table styles
+	TableHeaderStyle, TableRowStyle, TableSelectedRowStyle, TableCellStyle lipgloss.Style
+	// Input styles
+	InputStyle, InputFocusedStyle, InputLabelStyle, InputPromptStyle, InputPlaceholderStyle lipgloss.Style
+	// Achievement and progression
+	AchievementStyle, AchievementLockedStyle, LevelStyle, XPStyle lipgloss.Style
+	// Leaderboard styles
+	LeaderboardFirstStyle, LeaderboardSecondStyle, LeaderboardThirdStyle, LeaderboardPlayerStyle lipgloss.Style
+	// Spinner style
+	SpinnerStyle lipgloss.Style
+	// Logo/splash styles
+	LogoStyle, SplashBoxStyle lipgloss.Style
+)
+
+// buildStyles derives the shared styles from the current palette
+func buildStyles() {
+	// Base styles
 	// App container
 	AppStyle = lipgloss.NewStyle().
 			Padding(1, 2)
@@ -74,10 +103,8 @@ var (
 			BorderForeground(Cyan).
 			Padding(0, 1).
 			Width(35)
-)
 
-// Menu styles
-var (
+	// Menu styles
 	MenuItemStyle = lipgloss.NewStyle().
 			Foreground(White).
 			Padding(0, 2)
@@ -92,10 +119,8 @@ var (
 				Foreground(Gray).
 				Italic(true).
 				PaddingLeft(4)
-)
 
-// Status and info styles
-var (
+	// Status and info styles
 	// Status bar at bottom
 	StatusBarStyle = lipgloss.NewStyle().
 			Foreground(DimWhite).
@@ -112,10 +137,8 @@ var (
 
 	HelpDescStyle = lipgloss.NewStyle().
 			Foreground(Gray)
-)
 
-// Game-specific styles
-var (
+	// Game-specific styles
 	// Money/currency
 	MoneyPositiveStyle = lipgloss.NewStyle().
 				Foreground(Green).
@@ -158,10 +181,8 @@ var (
 
 	GrowthLowStyle = lipgloss.NewStyle().
 			Foreground(Red)
-)
 
-// News and events
-var (
+	// News and events
 	NewsHeaderStyle = lipgloss.NewStyle().
 			Foreground(Cyan).
 			Bold(true).
@@ -176,10 +197,8 @@ var (
 
 	NewsNeutralStyle = lipgloss.NewStyle().
 				Foreground(Yellow)
-)
 
-// Dialog styles
-var (
+	// Dialog styles
 	DialogBoxStyle = lipgloss.NewStyle().
 			Border(lipgloss.DoubleBorder()).
 			BorderForeground(Magenta).
@@ -203,10 +222,8 @@ var (
 				Bold(true).
 				Padding(0, 2).
 				Margin(0, 1)
-)
 
-// Table styles
-var (
+	// Table styles
 	TableHeaderStyle = lipgloss.NewStyle().
 				Foreground(Cyan).
 				Bold(true).
@@ -223,10 +240,8 @@ var (
 
 	TableCellStyle = lipgloss.NewStyle().
 			Padding(0, 1)
-)
 
-// Input styles
-var (
+	// Input styles
 	InputStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
 			BorderForeground(Cyan).
@@ -246,10 +261,8 @@ var (
 
 	InputPlaceholderStyle = lipgloss.NewStyle().
 				Foreground(Gray)
-)
 
-// Achievement and progression
-var (
+	// Achievement and progression
 	AchievementStyle = lipgloss.NewStyle().
 				Foreground(Gold).
 				Bold(true)
@@ -263,10 +276,8 @@ var (
 
 	XPStyle = lipgloss.NewStyle().
 		Foreground(LightGreen)
-)
 
-// Leaderboard styles
-var (
+	// Leaderboard styles
 	LeaderboardFirstStyle = lipgloss.NewStyle().
 				Foreground(Gold).
 				Bold(true)
@@ -282,16 +293,12 @@ var (
 	LeaderboardPlayerStyle = lipgloss.NewStyle().
 				Foreground(Cyan).
 				Bold(true)
-)
 
-// Spinner style
-var (
+	// Spinner style
 	SpinnerStyle = lipgloss.NewStyle().
 			Foreground(Cyan)
-)
 
-// Logo/splash styles
-var (
+	// Logo/splash styles
 	LogoStyle = lipgloss.NewStyle().
 			Foreground(Magenta).
 			Bold(true).
@@ -302,7 +309,7 @@ var (
 			BorderForeground(Cyan).
 			Padding(2, 4).
 			Align(lipgloss.Center)
-)
+}
 
 // Helper functions
 
@@ -0,0 +1,75 @@
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// Palette is the set of colors every screen draws with
+type Palette struct {
+	Cyan, Green, Yellow, Magenta, Red, White, Gray, DarkGray, Black lipgloss.Color
+	Gold, Orange, LightCyan, LightGreen, DimWhite                   lipgloss.Color
+}
+
+// Theme is a named palette a profile can pick
+type Theme struct {
+	ID          string
+	Name        string
+	Description string
+	Palette     Palette
+}
+
+// DefaultTheme is the original neon-on-black look
+const DefaultTheme = "classic"
+
+// Themes lists every selectable theme, classic first
+var Themes = []Theme{
+	{ID: DefaultTheme, Name: "Classic", Description: "Neon on black"},
+	{
+		ID: "solarized", Name: "Solarized", Description: "Softer, low-glare colors",
+		Palette: Palette{
+			Cyan: "#2AA198", Green: "#859900", Yellow: "#B58900", Magenta: "#D33682", Red: "#DC322F",
+			White: "#EEE8D5", Gray: "#839496", DarkGray: "#073642", Black: "#002B36",
+			Gold: "#B58900", Orange: "#CB4B16", LightCyan: "#93A1A1", LightGreen: "#A4B84A", DimWhite: "#93A1A1",
+		},
+	},
+	{
+		ID: "mono", Name: "Monochrome", Description: "Grayscale for light or limited terminals",
+		Palette: Palette{
+			Cyan: "#FFFFFF", Green: "#D0D0D0", Yellow: "#E0E0E0", Magenta: "#FFFFFF", Red: "#A0A0A0",
+			White: "#FFFFFF", Gray: "#808080", DarkGray: "#404040", Black: "#000000",
+			Gold: "#F0F0F0", Orange: "#C0C0C0", LightCyan: "#E8E8E8", LightGreen: "#D8D8D8", DimWhite: "#A0A0A0",
+		},
+	},
+}
+
+var currentTheme = DefaultTheme
+
+func init() {
+	// The classic palette is whatever the color vars start as
+	Themes[0].Palette = Palette{
+		Cyan: Cyan, Green: Green, Yellow: Yellow, Magenta: Magenta, Red: Red,
+		White: White, Gray: Gray, DarkGray: DarkGray, Black: Black,
+		Gold: Gold, Orange: Orange, LightCyan: LightCyan, LightGreen: LightGreen, DimWhite: DimWhite,
+	}
+	buildStyles()
+}
+
+// ApplyTheme switches the palette and rebuilds the shared styles. Unknown IDs fall
+// back to the classic theme.
+func ApplyTheme(id string) {
+	theme := Themes[0]
+	for _, t := range Themes {
+		if t.ID == id {
+			theme = t
+		}
+	}
+	p := theme.Palette
+	Cyan, Green, Yellow, Magenta, Red = p.Cyan, p.Green, p.Yellow, p.Magenta, p.Red
+	White, Gray, DarkGray, Black = p.White, p.Gray, p.DarkGray, p.Black
+	Gold, Orange, LightCyan, LightGreen, DimWhite = p.Gold, p.Orange, p.LightCyan, p.LightGreen, p.DimWhite
+	currentTheme = theme.ID
+	buildStyles()
+}
+
+// CurrentTheme returns the ID of the theme in use
+func CurrentTheme() string {
+	return currentTheme
+}
@@ -0,0 +1,259 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jamesacampbell/unicorn/database"
+	"github.com/jamesacampbell/unicorn/game"
+	"github.com/jamesacampbell/unicorn/tournament"
+	"github.com/jamesacampbell/unicorn/tui/components"
+	"github.com/jamesacampbell/unicorn/tui/keys"
+	"github.com/jamesacampbell/unicorn/tui/styles"
+)
+
+// TournamentScreen lists the tournaments on this machine and plays their rounds
+type TournamentScreen struct {
+	width    int
+	height   int
+	gameData *GameData
+
+	tournaments []*tournament.Tournament
+	menu        *components.Menu
+
+	// The tournament being looked at, nil on the list
+	selected  *tournament.Tournament
+	results   []database.TournamentResult
+	nextRound int
+	blocked   string // Why the active profile can't play on
+
+	err string
+}
+
+// NewTournamentScreen creates a new tournament screen
+func NewTournamentScreen(width, height int, gameData *GameData) *TournamentScreen {
+	s := &TournamentScreen{
+		width:    width,
+		height:   height,
+		gameData: gameData,
+	}
+	saved, err := gameData.Store.ListTournaments()
+	if err != nil {
+		s.err = err.Error()
+	}
+	for _, t := range saved {
+		if parsed, err := tournament.Parse([]byte(t.Definition)); err == nil {
+			s.tournaments = append(s.tournaments, parsed)
+		}
+	}
+	s.buildListMenu()
+	return s
+}
+
+func (s *TournamentScreen) buildListMenu() {
+	var items []components.MenuItem
+	for _, t := range s.tournaments {
+		items = append(items, components.MenuItem{
+			ID:          t.ID,
+			Title:       t.Name,
+			Description: fmt.Sprintf("%d rounds • %s", len(t.Rounds), t.ID),
+			Icon:        "🏆",
+		})
+	}
+	if len(items) == 0 {
+		items = append(items, components.MenuItem{
+			ID:          "none",
+			Title:       "No tournaments yet",
+			Description: "Create one with `unicorn tournament new` or load a shared one with `unicorn tournament add`",
+			Icon:        "📭",
+			Disabled:    true,
+		})
+	}
+	s.menu = components.NewMenu("TOURNAMENTS", items)
+	s.menu.SetSize(60, 15)
+	s.menu.SetHideHelp(true)
+}
+
+func (s *TournamentScreen) open(t *tournament.Tournament) {
+	s.selected = t
+	s.err = ""
+	s.results, _ = s.gameData.Store.GetTournamentResults(t.ID)
+	s.nextRound, s.blocked = 0, ""
+	if s.gameData.PlayerName == "" {
+		s.blocked = "Pick a profile before entering a tournament"
+	} else if round, err := t.NextRound(s.results, s.gameData.PlayerName); err != nil {
+		s.blocked = err.Error()
+	} else {
+		s.nextRound = round
+	}
+
+	var items []components.MenuItem
+	if s.nextRound > 0 {
+		r := t.Rounds[s.nextRound-1]
+		difficulty, _ := tournament.ParseDifficulty(r.Difficulty)
+		items = append(items, components.MenuItem{
+			ID:          "play",
+			Title:       fmt.Sprintf("Play %s", t.RoundName(s.nextRound)),
+			Description: fmt.Sprintf("%s • %s • your first finish counts", difficulty.Name, upgradeRule(r.Upgrades)),
+			Icon:        "▶️",
+		})
+	}
+	items = append(items, components.MenuItem{
+		ID:          "back",
+		Title:       "Back",
+		Description: "All tournaments",
+		Icon:        "↩️",
+	})
+	s.menu = components.NewMenu(strings.ToUpper(t.Name), items)
+	s.menu.SetSize(60, 8)
+	s.menu.SetHideHelp(true)
+}
+
+func upgradeRule(allowed []string) string {
+	switch len(allowed) {
+	case 0:
+		return "no upgrades"
+	case 1:
+		return "1 upgrade allowed"
+	}
+	return fmt.Sprintf("%d upgrades allowed", len(allowed))
+}
+
+// Init initializes the tournament screen
+func (s *TournamentScreen) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles tournament screen input
+func (s *TournamentScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, keys.Global.Back) {
+			if s.selected != nil {
+				s.selected = nil
+				s.buildListMenu()
+				return s, nil
+			}
+			return s, SwitchTo(ScreenVCSetup)
+		}
+
+	case components.MenuSelectedMsg:
+		return s.handleSelection(msg.ID)
+	}
+
+	var cmd tea.Cmd
+	s.menu, cmd = s.menu.Update(msg)
+	return s, cmd
+}
+
+func (s *TournamentScreen) handleSelection(id string) (ScreenModel, tea.Cmd) {
+	if s.selected == nil {
+		for _, t := range s.tournaments {
+			if t.ID == id {
+				s.open(t)
+			}
+		}
+		return s, nil
+	}
+
+	switch id {
+	case "play":
+		return s, s.startRound()
+	case "back":
+		s.selected = nil
+		s.buildListMenu()
+	}
+	return s, nil
+}
+
+func (s *TournamentScreen) startRound() tea.Cmd {
+	owned, _ := s.gameData.Store.GetPlayerUpgrades(s.gameData.PlayerName)
+	gs, err := s.selected.NewGame(s.nextRound, s.gameData.PlayerName, game.GenerateDefaultFirmName(s.gameData.PlayerName), owned)
+	if err != nil {
+		s.err = err.Error()
+		return nil
+	}
+	s.gameData.GameState = gs
+	s.gameData.FirmName = gs.PlayerFirmName
+	s.gameData.Difficulty = gs.Difficulty
+	s.gameData.CurrentMode = "vc"
+	s.gameData.Tournament = s.selected
+	s.gameData.TournamentRound = s.nextRound
+	s.gameData.Daily = nil
+	return SwitchTo(ScreenVCInvest)
+}
+
+// View renders the tournament screen
+func (s *TournamentScreen) View() string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Gold).
+		Bold(true).
+		Width(60).
+		Align(lipgloss.Center)
+
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	b.WriteString(center.Render(headerStyle.Render("🏆 TOURNAMENTS 🏆")))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Gold).
+		Padding(1, 2)
+
+	if s.selected != nil {
+		b.WriteString(center.Render(box.Render(s.renderStandings())))
+		b.WriteString("\n\n")
+		if s.blocked != "" {
+			b.WriteString(center.Render(lipgloss.NewStyle().Foreground(styles.Yellow).Render(s.blocked)))
+			b.WriteString("\n\n")
+		}
+	}
+	b.WriteString(center.Render(box.Render(s.menu.View())))
+	b.WriteString("\n\n")
+
+	if s.err != "" {
+		errStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(errStyle.Render("✗ " + s.err))
+		b.WriteString("\n\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("↑/↓ navigate • enter select • esc back"))
+
+	return b.String()
+}
+
+func (s *TournamentScreen) renderStandings() string {
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
+	b.WriteString(titleStyle.Render("STANDINGS"))
+	b.WriteString("\n\n")
+
+	standings := s.selected.Standings(s.results)
+	if len(standings) == 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Gray).Render("Nobody on this machine has finished a round yet"))
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf("%-4s %-20s %5s %5s %7s %10s\n", "#", "Player", "Pts", "Wins", "Rounds", "Net Worth"))
+	for i, st := range standings {
+		line := fmt.Sprintf("%-4d %-20s %5d %5d %7s %10s", i+1, truncate(st.PlayerName, 20), st.Points, st.Wins,
+			fmt.Sprintf("%d/%d", st.RoundsPlayed, len(s.selected.Rounds)), "$"+formatCompactMoney(st.TotalNetWorth))
+		style := lipgloss.NewStyle().Foreground(styles.White)
+		if st.PlayerName == s.gameData.PlayerName {
+			style = style.Foreground(styles.Cyan).Bold(true)
+		}
+		if st.Eliminated {
+			style = style.Foreground(styles.Gray)
+			line += " cut"
+		}
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jamesacampbell/unicorn/achievements"
@@ -20,39 +19,20 @@ import (
 type UpgradesScreen struct {
 	width           int
 	height          int
+	store           database.Store
 	playerName      string
 	availablePoints int
 	ownedUpgrades   []string
 	menu            *components.Menu
 	selectedUpgrade *upgrades.Upgrade
 	confirmPurchase bool
-
-	// Name entry (when player name not provided)
-	needsName bool
-	nameInput textinput.Model
 }
 
 // NewUpgradesScreen creates a new upgrades screen
-func NewUpgradesScreen(width, height int, playerName, mode string) *UpgradesScreen {
-	// If no player name, show name input first
-	if playerName == "" {
-		nameInput := textinput.New()
-		nameInput.Placeholder = "Enter your name"
-		nameInput.Focus()
-		nameInput.CharLimit = 30
-		nameInput.Width = 30
-
-		return &UpgradesScreen{
-			width:     width,
-			height:    height,
-			needsName: true,
-			nameInput: nameInput,
-		}
-	}
-
+func NewUpgradesScreen(width, height int, store database.Store, playerName, mode string) *UpgradesScreen {
 	// Load player data
-	allUnlocked, _ := database.GetPlayerAchievements(playerName)
-	ownedUpgrades, _ := database.GetPlayerUpgrades(playerName)
+	allUnlocked, _ := store.GetPlayerAchievements(playerName)
+	ownedUpgrades, _ := store.GetPlayerUpgrades(playerName)
 
 	// Calculate available points
 	totalPoints := 0
@@ -63,7 +43,7 @@ func NewUpgradesScreen(width, height int, playerName, mode string) *UpgradesScre
 	}
 
 	// Add level-up points
-	profile, _ := database.GetPlayerProfile(playerName)
+	profile, _ := store.GetPlayerProfile(playerName)
 	if profile != nil {
 		totalPoints += profile.LevelUpPoints
 	}
@@ -95,6 +75,7 @@ func NewUpgradesScreen(width, height int, playerName, mode string) *UpgradesScre
 	return &UpgradesScreen{
 		width:           width,
 		height:          height,
+		store:           store,
 		playerName:      playerName,
 		availablePoints: totalPoints,
 		ownedUpgrades:   ownedUpgrades,
@@ -104,9 +85,6 @@ func NewUpgradesScreen(width, height int, playerName, mode string) *UpgradesScre
 
 // Init initializes the upgrades screen
 func (s *UpgradesScreen) Init() tea.Cmd {
-	if s.needsName {
-		return textinput.Blink
-	}
 	return nil
 }
 
@@ -139,27 +117,6 @@ func buildUpgradeMenuItem(upgrade upgrades.Upgrade, ownedUpgrades []string, tota
 
 // Update handles upgrades input
 func (s *UpgradesScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
-	// Handle name input state
-	if s.needsName {
-		switch msg := msg.(type) {
-		case tea.KeyMsg:
-			switch {
-			case key.Matches(msg, keys.Global.Back):
-				return s, PopScreen()
-			case msg.Type == tea.KeyEnter:
-				name := strings.TrimSpace(s.nameInput.Value())
-				if name != "" {
-					// Reload screen with player name
-					return NewUpgradesScreen(s.width, s.height, name, ""), textinput.Blink
-				}
-			}
-		}
-
-		var cmd tea.Cmd
-		s.nameInput, cmd = s.nameInput.Update(msg)
-		return s, cmd
-	}
-
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		if s.confirmPurchase {
@@ -206,7 +163,7 @@ func (s *UpgradesScreen) doPurchase() (ScreenModel, tea.Cmd) {
 		return s, nil
 	}
 
-	err := database.PurchaseUpgrade(s.playerName, s.selectedUpgrade.ID)
+	err := s.store.PurchaseUpgrade(s.playerName, s.selectedUpgrade.ID)
 	if err != nil {
 		s.confirmPurchase = false
 		s.selectedUpgrade = nil
@@ -220,7 +177,7 @@ func (s *UpgradesScreen) doPurchase() (ScreenModel, tea.Cmd) {
 	s.selectedUpgrade = nil
 
 	// Refresh screen
-	return NewUpgradesScreen(s.width, s.height, s.playerName, ""), nil
+	return NewUpgradesScreen(s.width, s.height, s.store, s.playerName, ""), nil
 }
 
 // View renders the upgrades screen
@@ -238,11 +195,6 @@ func (s *UpgradesScreen) View() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🎁 UPGRADE STORE 🎁")))
 	b.WriteString("\n\n")
 
-	// Name input screen
-	if s.needsName {
-		return s.renderNameInput()
-	}
-
 	// Points display
 	pointsBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -285,49 +237,3 @@ func (s *UpgradesScreen) View() string {
 
 	return b.String()
 }
-
-func (s *UpgradesScreen) renderNameInput() string {
-	var b strings.Builder
-
-	// Header
-	headerStyle := lipgloss.NewStyle().
-		Foreground(styles.Black).
-		Background(styles.Magenta).
-		Bold(true).
-		Width(70).
-		Align(lipgloss.Center)
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🎁 UPGRADE STORE 🎁")))
-	b.WriteString("\n\n")
-
-	// Explanation
-	infoBox := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.Yellow).
-		Padding(1, 2).
-		Width(50)
-
-	info := "Enter your player name to view your upgrades and achievement points."
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(infoBox.Render(info)))
-	b.WriteString("\n\n")
-
-	// Name input
-	titleStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Bold(true)
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(titleStyle.Render("PLAYER NAME")))
-	b.WriteString("\n")
-
-	inputBox := lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
-		BorderForeground(styles.Cyan).
-		Padding(0, 1).
-		Width(35)
-
-	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(inputBox.Render(s.nameInput.View())))
-	b.WriteString("\n\n")
-
-	// Help
-	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("enter confirm • esc back"))
-
-	return b.String()
-}
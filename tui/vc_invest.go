@@ -10,7 +10,6 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/jamesacampbell/unicorn/database"
 	"github.com/jamesacampbell/unicorn/game"
 	"github.com/jamesacampbell/unicorn/tui/components"
 	"github.com/jamesacampbell/unicorn/tui/keys"
@@ -28,6 +27,7 @@ const (
 	PhaseDDResults
 	PhaseSyndicateList
 	PhaseSyndicateAmount
+	PhaseNegotiate
 )
 
 // VCInvestScreen handles the investment phase
@@ -42,6 +42,7 @@ type VCInvestScreen struct {
 	amountInput    textinput.Model
 	termsMenu      *components.Menu
 	ddMenu         *components.Menu
+	negotiateMenu  *components.Menu
 	syndicateTable *components.GameTable
 
 	// State
@@ -62,6 +63,14 @@ type VCInvestScreen struct {
 
 	// Syndicate state
 	selectedSyndicate int
+
+	// Term sheet negotiation state
+	negotiation  *game.TermSheetNegotiation
+	draft        game.TermSheetProposal
+	negotiateMsg string
+
+	// Hot-seat: hide the screen until the next player is at the keyboard
+	handoff bool
 }
 
 // NewVCInvestScreen creates a new investment screen
@@ -78,6 +87,7 @@ func NewVCInvestScreen(width, height int, gameData *GameData) *VCInvestScreen {
 		gameData:    gameData,
 		phase:       PhaseStartupList,
 		amountInput: amountInput,
+		handoff:     gameData.GameState.IsHotSeat(),
 	}
 
 	s.refreshStartupTable()
@@ -156,7 +166,7 @@ func (s *VCInvestScreen) Init() tea.Cmd {
 	// Get player level for syndicate unlock
 	playerLevel := 1
 	if s.gameData.PlayerName != "" {
-		profile, err := database.GetPlayerProfile(s.gameData.PlayerName)
+		profile, err := s.gameData.Store.GetPlayerProfile(s.gameData.PlayerName)
 		if err == nil && profile != nil {
 			playerLevel = profile.Level
 		}
@@ -174,6 +184,19 @@ func (s *VCInvestScreen) Init() tea.Cmd {
 func (s *VCInvestScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	gs := s.gameData.GameState
 
+	if s.handoff {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch {
+			case key.Matches(msg, keys.Global.Enter):
+				s.handoff = false
+				s.refreshStartupTable()
+			case key.Matches(msg, keys.Global.Back):
+				return s, SwitchTo(ScreenMainMenu)
+			}
+		}
+		return s, nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch s.phase {
@@ -183,8 +206,7 @@ func (s *VCInvestScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, SwitchTo(ScreenMainMenu)
 			case msg.String() == "d":
 				// Done investing, start game
-				gs.AIPlayerMakeInvestments()
-				return s, SwitchTo(ScreenVCTurn)
+				return s.finishInvesting()
 			case msg.String() == "s":
 				// Show syndicate opportunities
 				if len(gs.SyndicateOpportunities) > 0 {
@@ -258,6 +280,13 @@ func (s *VCInvestScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, nil
 			}
 
+		case PhaseNegotiate:
+			if key.Matches(msg, keys.Global.Back) {
+				s.negotiation = nil
+				s.phase = PhaseTermsSelect
+				return s, nil
+			}
+
 		case PhaseSyndicateAmount:
 			opp := gs.SyndicateOpportunities[s.selectedSyndicate]
 			switch msg.String() {
@@ -286,6 +315,8 @@ func (s *VCInvestScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 			return s.handleTermsSelection(msg.ID)
 		case PhaseDueDiligence:
 			return s.handleDDSelection(msg.ID)
+		case PhaseNegotiate:
+			return s.handleNegotiateSelection(msg.ID)
 		}
 
 	case components.TableRowSelectedMsg:
@@ -331,6 +362,10 @@ func (s *VCInvestScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		if s.ddMenu != nil {
 			s.ddMenu, cmd = s.ddMenu.Update(msg)
 		}
+	case PhaseNegotiate:
+		if s.negotiateMenu != nil {
+			s.negotiateMenu, cmd = s.negotiateMenu.Update(msg)
+		}
 	case PhaseSyndicateList:
 		if s.syndicateTable != nil {
 			s.syndicateTable, cmd = s.syndicateTable.Update(msg)
@@ -417,6 +452,12 @@ func (s *VCInvestScreen) buildTermsMenu() {
 			Icon:        "📜",
 		}
 	}
+	items = append(items, components.MenuItem{
+		ID:          "negotiate",
+		Title:       "Negotiate Custom Term Sheet",
+		Description: "Propose valuation, pref, board and control terms",
+		Icon:        "✍️",
+	})
 
 	s.termsMenu = components.NewMenu("SELECT INVESTMENT TERMS", items)
 	s.termsMenu.SetSize(50, 15)
@@ -425,6 +466,19 @@ func (s *VCInvestScreen) buildTermsMenu() {
 
 func (s *VCInvestScreen) handleTermsSelection(id string) (ScreenModel, tea.Cmd) {
 	gs := s.gameData.GameState
+	if id == "negotiate" {
+		negotiation, err := gs.OpenTermSheetNegotiation(s.selectedIdx, s.investAmount)
+		if err != nil {
+			s.errorMsg = err.Error()
+			return s, nil
+		}
+		s.negotiation = negotiation
+		s.draft = negotiation.Proposal
+		s.negotiateMsg = ""
+		s.buildNegotiateMenu()
+		s.phase = PhaseNegotiate
+		return s, nil
+	}
 	idx, _ := strconv.Atoi(id)
 	options := gs.GenerateTermOptions(s.selectedStartup, s.investAmount)
 
@@ -446,6 +500,110 @@ func (s *VCInvestScreen) handleTermsSelection(id string) (ScreenModel, tea.Cmd)
 	return s, nil
 }
 
+func (s *VCInvestScreen) buildNegotiateMenu() {
+	toggle := func(on bool) string {
+		if on {
+			return "ON"
+		}
+		return "OFF"
+	}
+
+	items := []components.MenuItem{
+		{ID: "val_down", Title: "Lower Valuation 10%", Description: "Cheaper entry, more equity", Icon: "⬇️"},
+		{ID: "val_up", Title: "Raise Valuation 10%", Description: "Meet the founder's ask", Icon: "⬆️"},
+		{ID: "pref", Title: fmt.Sprintf("Liquidation Pref: %.1fx", s.draft.LiquidationPref), Description: "Cycle 1x → 1.5x → 2x → 3x", Icon: "🏦"},
+		{ID: "participating", Title: "Participating: " + toggle(s.draft.Participating), Description: "Pref plus a share of what's left", Icon: "➕"},
+		{ID: "board", Title: "Board Seat: " + toggle(s.draft.BoardSeat), Description: "Vote on major decisions", Icon: "🪑"},
+		{ID: "prorata", Title: "Pro-Rata: " + toggle(s.draft.ProRata), Description: "Right to follow on", Icon: "📈"},
+		{ID: "info", Title: "Info Rights: " + toggle(s.draft.InfoRights), Description: "Monthly financials", Icon: "📊"},
+		{ID: "protective", Title: "Protective Provisions: " + toggle(s.draft.ProtectiveProvisions), Description: "Veto new rounds, sales and debt", Icon: "🛡️"},
+		{ID: "send", Title: "Send Term Sheet", Description: "The founder accepts, counters or walks", Icon: "📨"},
+	}
+	if s.negotiation != nil && s.negotiation.Counter != nil {
+		items = append(items, components.MenuItem{ID: "accept_counter", Title: "Accept Founder's Counter", Description: "Sign the founder's version", Icon: "🤝"})
+	}
+
+	s.negotiateMenu = components.NewMenu("NEGOTIATE TERMS", items)
+	s.negotiateMenu.SetSize(60, 20)
+	s.negotiateMenu.SetHideHelp(true)
+}
+
+func (s *VCInvestScreen) handleNegotiateSelection(id string) (ScreenModel, tea.Cmd) {
+	gs := s.gameData.GameState
+	n := s.negotiation
+	if n == nil {
+		return s, nil
+	}
+	s.negotiateMsg = ""
+
+	switch id {
+	case "val_down":
+		s.draft.PreMoneyValuation = s.draft.PreMoneyValuation * 9 / 10
+	case "val_up":
+		s.draft.PreMoneyValuation = s.draft.PreMoneyValuation * 11 / 10
+	case "pref":
+		switch {
+		case s.draft.LiquidationPref < 1.5:
+			s.draft.LiquidationPref = 1.5
+		case s.draft.LiquidationPref < 2:
+			s.draft.LiquidationPref = 2
+		case s.draft.LiquidationPref < 3:
+			s.draft.LiquidationPref = 3
+		default:
+			s.draft.LiquidationPref = 1
+		}
+	case "participating":
+		s.draft.Participating = !s.draft.Participating
+	case "board":
+		s.draft.BoardSeat = !s.draft.BoardSeat
+	case "prorata":
+		s.draft.ProRata = !s.draft.ProRata
+	case "info":
+		s.draft.InfoRights = !s.draft.InfoRights
+	case "protective":
+		s.draft.ProtectiveProvisions = !s.draft.ProtectiveProvisions
+	case "send":
+		s.negotiateMsg = gs.ProposeTermSheet(n, s.draft)
+		if n.Counter != nil {
+			s.draft = *n.Counter
+		}
+	case "accept_counter":
+		if err := n.AcceptCounter(); err != nil {
+			s.negotiateMsg = err.Error()
+		}
+	}
+
+	switch n.Outcome {
+	case "accepted":
+		terms, err := gs.SignedTerms(n)
+		if err != nil {
+			s.negotiateMsg = err.Error()
+			return s, nil
+		}
+		s.selectedTerms = terms
+		s.negotiation = nil
+		if s.gameData.AutoMode {
+			s.ddLevel = "none"
+			return s.finalizeInvestment()
+		}
+		s.buildDDMenu()
+		s.phase = PhaseDueDiligence
+		return s, nil
+	case "walked":
+		s.negotiation = nil
+		s.phase = PhaseStartupList
+		s.selectedStartup = nil
+		s.errorMsg = s.negotiateMsg
+		s.refreshStartupTable()
+		return s, nil
+	}
+
+	cursor := s.negotiateMenu.SelectedIndex()
+	s.buildNegotiateMenu()
+	s.negotiateMenu.SetCursor(cursor)
+	return s, nil
+}
+
 func (s *VCInvestScreen) buildDDMenu() {
 	levels := game.GetDDLevels()
 	items := make([]components.MenuItem, len(levels))
@@ -467,8 +625,28 @@ func (s *VCInvestScreen) buildDDMenu() {
 		}
 	}
 
+	// Diligence workstreams run over the coming months while the round keeps moving
+	for _, pkg := range ddWorkstreamPackages() {
+		var cost int64
+		var names []string
+		turns := 0
+		for _, id := range pkg.workstreams {
+			ws, _ := game.GetDDWorkstream(id)
+			cost += ws.Cost
+			names = append(names, ws.Name)
+			if ws.Turns > turns {
+				turns = ws.Turns
+			}
+		}
+		items = append(items, components.MenuItem{
+			ID:          pkg.id,
+			Title:       fmt.Sprintf("📅 %s ($%s, %d mo)", pkg.name, formatCompactMoney(cost), turns),
+			Description: fmt.Sprintf("%s - the round may close without you", strings.Join(names, ", ")),
+		})
+	}
+
 	s.ddMenu = components.NewMenu("DUE DILIGENCE OPTIONS", items)
-	s.ddMenu.SetSize(60, 15)
+	s.ddMenu.SetSize(60, 20)
 	s.ddMenu.SetHideHelp(true)
 }
 
@@ -480,6 +658,22 @@ func (s *VCInvestScreen) handleDDSelection(id string) (ScreenModel, tea.Cmd) {
 		return s.finalizeInvestment()
 	}
 
+	for _, pkg := range ddWorkstreamPackages() {
+		if pkg.id != id {
+			continue
+		}
+		if err := gs.StartDDWorkstreams(s.selectedIdx, s.investAmount, s.selectedTerms, pkg.workstreams); err != nil {
+			s.errorMsg = err.Error()
+			return s, nil
+		}
+		s.phase = PhaseStartupList
+		s.selectedStartup = nil
+		s.investAmount = 0
+		s.errorMsg = ""
+		s.refreshStartupTable()
+		return s, nil
+	}
+
 	// Find the DD level
 	levels := game.GetDDLevels()
 	var selectedLevel game.DDLevel
@@ -498,7 +692,7 @@ func (s *VCInvestScreen) handleDDSelection(id string) (ScreenModel, tea.Cmd) {
 
 	// Perform DD
 	gs.Portfolio.Cash -= selectedLevel.Cost
-	s.ddFindings = game.PerformDueDiligence(s.selectedStartup, id)
+	s.ddFindings = gs.PerformDueDiligence(s.selectedStartup, id)
 	s.ddLevel = id
 
 	// Check if should block
@@ -511,6 +705,19 @@ func (s *VCInvestScreen) handleDDSelection(id string) (ScreenModel, tea.Cmd) {
 	return s, nil
 }
 
+type ddWorkstreamPackage struct {
+	id          string
+	name        string
+	workstreams []string
+}
+
+func ddWorkstreamPackages() []ddWorkstreamPackage {
+	return []ddWorkstreamPackage{
+		{id: "ws_references", name: "Reference Calls", workstreams: []string{"customer_calls", "background_check"}},
+		{id: "ws_full", name: "Full Diligence", workstreams: []string{"customer_calls", "background_check", "technical_audit", "financial_review"}},
+	}
+}
+
 func (s *VCInvestScreen) finalizeInvestment() (ScreenModel, tea.Cmd) {
 	gs := s.gameData.GameState
 
@@ -524,9 +731,9 @@ func (s *VCInvestScreen) finalizeInvestment() (ScreenModel, tea.Cmd) {
 	// Initialize founder relationship with DD bonus
 	if len(gs.Portfolio.Investments) > 0 {
 		lastInv := &gs.Portfolio.Investments[len(gs.Portfolio.Investments)-1]
-		lastInv.FounderName = game.GenerateFounderName()
+		lastInv.FounderName = gs.FounderNameFor(lastInv.CompanyName)
 		lastInv.HasDueDiligence = s.ddLevel != "none"
-		lastInv.RelationshipScore = game.CalculateInitialRelationship(s.selectedTerms, lastInv.HasDueDiligence, s.investAmount)
+		lastInv.RelationshipScore = gs.CalculateInitialRelationship(s.selectedTerms, lastInv.HasDueDiligence, s.investAmount)
 		lastInv.LastInteraction = gs.Portfolio.Turn
 	}
 
@@ -541,13 +748,27 @@ func (s *VCInvestScreen) finalizeInvestment() (ScreenModel, tea.Cmd) {
 
 	// Check if out of money
 	if gs.Portfolio.Cash < 10000 {
-		gs.AIPlayerMakeInvestments()
-		return s, SwitchTo(ScreenVCTurn)
+		return s.finishInvesting()
 	}
 
 	return s, nil
 }
 
+// finishInvesting passes the keyboard to the next hot-seat player, or starts the
+// game once everyone has invested
+func (s *VCInvestScreen) finishInvesting() (ScreenModel, tea.Cmd) {
+	gs := s.gameData.GameState
+	if gs.IsHotSeat() && gs.ActiveSeat < len(gs.Seats)-1 {
+		gs.SwitchSeat(gs.ActiveSeat + 1)
+		s.phase = PhaseStartupList
+		s.errorMsg = ""
+		s.handoff = true
+		return s, nil
+	}
+	gs.AIPlayerMakeInvestments()
+	return s, SwitchTo(ScreenVCTurn)
+}
+
 func (s *VCInvestScreen) buildSyndicateTable() {
 	gs := s.gameData.GameState
 
@@ -637,8 +858,8 @@ func (s *VCInvestScreen) makeInvestment(terms game.InvestmentTerms) (ScreenModel
 	// Initialize founder relationship
 	if len(gs.Portfolio.Investments) > 0 {
 		lastInv := &gs.Portfolio.Investments[len(gs.Portfolio.Investments)-1]
-		lastInv.FounderName = game.GenerateFounderName()
-		lastInv.RelationshipScore = game.CalculateInitialRelationship(terms, false, s.investAmount)
+		lastInv.FounderName = gs.FounderNameFor(lastInv.CompanyName)
+		lastInv.RelationshipScore = gs.CalculateInitialRelationship(terms, false, s.investAmount)
 		lastInv.LastInteraction = gs.Portfolio.Turn
 	}
 
@@ -651,8 +872,7 @@ func (s *VCInvestScreen) makeInvestment(terms game.InvestmentTerms) (ScreenModel
 
 	// Check if out of money
 	if gs.Portfolio.Cash < 10000 {
-		gs.AIPlayerMakeInvestments()
-		return s, SwitchTo(ScreenVCTurn)
+		return s.finishInvesting()
 	}
 
 	return s, nil
@@ -661,6 +881,9 @@ func (s *VCInvestScreen) makeInvestment(terms game.InvestmentTerms) (ScreenModel
 // View renders the investment screen
 func (s *VCInvestScreen) View() string {
 	gs := s.gameData.GameState
+	if s.handoff {
+		return renderHandoff(s.width, gs, "It's your turn to pick this month's deals")
+	}
 	var b strings.Builder
 
 	// Header
@@ -684,6 +907,8 @@ func (s *VCInvestScreen) View() string {
 		b.WriteString(s.renderSyndicateList())
 	case PhaseSyndicateAmount:
 		b.WriteString(s.renderSyndicateAmount())
+	case PhaseNegotiate:
+		b.WriteString(s.renderNegotiate())
 	}
 
 	// Status bar - but don't show turn screen help
@@ -713,7 +938,7 @@ func (s *VCInvestScreen) renderHeader() string {
 		Align(lipgloss.Center).
 		Padding(0, 2)
 
-	title := fmt.Sprintf("🦄 %s - INVESTMENT PHASE", s.gameData.FirmName)
+	title := fmt.Sprintf("🦄 %s - INVESTMENT PHASE", gs.PlayerFirmName)
 
 	var b strings.Builder
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render(title)))
@@ -784,6 +1009,32 @@ func (s *VCInvestScreen) renderStartupList() string {
 		b.WriteString("\n")
 	}
 
+	// Deals under diligence
+	if len(gs.PendingDDDecisions) > 0 {
+		ddStyle := lipgloss.NewStyle().
+			Foreground(styles.Cyan).
+			Width(s.width).
+			Align(lipgloss.Center)
+		b.WriteString(ddStyle.Render(fmt.Sprintf("🔍 %d deal(s) in diligence - decide from the turn screen (press 'p')", len(gs.PendingDDDecisions))))
+		b.WriteString("\n")
+	}
+
+	// Warm intros from the player's founder network
+	intros := []string{}
+	for _, startup := range gs.AvailableStartups {
+		if startup.ReferredBy != "" {
+			intros = append(intros, startup.Name)
+		}
+	}
+	if len(intros) > 0 {
+		introStyle := lipgloss.NewStyle().
+			Foreground(styles.Green).
+			Width(s.width).
+			Align(lipgloss.Center)
+		b.WriteString(introStyle.Render(fmt.Sprintf("🤝 Warm intros from your network: %s", strings.Join(intros, ", "))))
+		b.WriteString("\n")
+	}
+
 	// Syndicate hint
 	if len(gs.SyndicateOpportunities) > 0 {
 		syndicateStyle := lipgloss.NewStyle().
@@ -836,6 +1087,24 @@ func (s *VCInvestScreen) renderAmountInput() string {
 	details.WriteString(labelStyle.Render("Category: "))
 	details.WriteString(startup.Category)
 	details.WriteString("\n")
+	if startup.Stage != "" {
+		details.WriteString(labelStyle.Render("Stage: "))
+		details.WriteString(startup.Stage)
+		details.WriteString("\n")
+	}
+	if startup.Founder.Name != "" {
+		details.WriteString(labelStyle.Render("Founder: "))
+		details.WriteString(fmt.Sprintf("%s (%s, %d yrs", startup.Founder.Name, startup.Founder.Background, startup.Founder.YearsExperience))
+		if startup.Founder.PriorExits > 0 {
+			details.WriteString(fmt.Sprintf(", %d prior exit(s)", startup.Founder.PriorExits))
+		}
+		details.WriteString(")\n")
+	}
+	if startup.ReferredBy != "" {
+		details.WriteString(labelStyle.Render("Warm intro: "))
+		details.WriteString(startup.ReferredBy)
+		details.WriteString("\n")
+	}
 	details.WriteString(labelStyle.Render("Valuation: "))
 	details.WriteString(fmt.Sprintf("$%s", formatCompactMoney(startup.Valuation)))
 	details.WriteString("\n")
@@ -846,6 +1115,11 @@ func (s *VCInvestScreen) renderAmountInput() string {
 	}
 	details.WriteString(labelStyle.Render("Max Investment: "))
 	details.WriteString(fmt.Sprintf("$%d (20%% of valuation)", maxInvest))
+	if startup.Stage != "" {
+		details.WriteString("\n")
+		details.WriteString(labelStyle.Render("Planned Check: "))
+		details.WriteString(fmt.Sprintf("$%s (%s, per construction plan)", formatCompactMoney(gs.PlannedCheckSize(startup.Stage)), startup.Stage))
+	}
 
 	detailContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
 	b.WriteString(detailContainer.Render(detailBox.Render(details.String())))
@@ -899,9 +1173,94 @@ func (s *VCInvestScreen) renderTermsSelect() string {
 		b.WriteString(menuContainer.Render(menuBox.Render(s.termsMenu.View())))
 	}
 
+	if s.errorMsg != "" {
+		b.WriteString("\n")
+		errStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(errStyle.Render(s.errorMsg))
+	}
+
+	return b.String()
+}
+
+func (s *VCInvestScreen) renderNegotiate() string {
+	var b strings.Builder
+	n := s.negotiation
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(styles.Cyan).
+		Bold(true).
+		Width(s.width).
+		Align(lipgloss.Center)
+	b.WriteString(titleStyle.Render(fmt.Sprintf("✍️  TERM SHEET - %s", n.CompanyName)))
+	b.WriteString("\n\n")
+
+	infoBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Yellow).
+		Padding(0, 2).
+		Width(60)
+
+	var info strings.Builder
+	info.WriteString(fmt.Sprintf("Founder's ask: $%s pre-money\n", formatCompactMoney(n.AskingValuation)))
+	competition := "No other term sheets on the table"
+	if n.CompetingOffers > 0 {
+		competition = fmt.Sprintf("%d competing term sheet(s) on the table", n.CompetingOffers)
+	}
+	info.WriteString(competition + "\n")
+	info.WriteString(fmt.Sprintf("Your draft: $%s pre-money, %s\n", formatCompactMoney(s.draft.PreMoneyValuation), describeProposal(s.draft)))
+	if n.Counter != nil {
+		counterStyle := lipgloss.NewStyle().Foreground(styles.Magenta)
+		info.WriteString(counterStyle.Render(fmt.Sprintf("Counter: $%s pre-money, %s",
+			formatCompactMoney(n.Counter.PreMoneyValuation), describeProposal(*n.Counter))))
+		info.WriteString("\n")
+	}
+	info.WriteString(fmt.Sprintf("Aggressiveness: %.2f", s.draft.Aggressiveness(n.AskingValuation)))
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(infoBox.Render(info.String())))
+	b.WriteString("\n")
+
+	if s.negotiateMenu != nil {
+		menuContainer := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+		menuBox := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(styles.Cyan).
+			Padding(0, 2)
+		b.WriteString(menuContainer.Render(menuBox.Render(s.negotiateMenu.View())))
+	}
+
+	if s.negotiateMsg != "" {
+		b.WriteString("\n")
+		msgStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(msgStyle.Render(s.negotiateMsg))
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("enter adjust/send • esc back"))
+
 	return b.String()
 }
 
+// describeProposal summarizes the non-price terms of a term sheet
+func describeProposal(p game.TermSheetProposal) string {
+	parts := []string{fmt.Sprintf("%.1fx pref", p.LiquidationPref)}
+	if p.Participating {
+		parts = append(parts, "participating")
+	}
+	if p.BoardSeat {
+		parts = append(parts, "board")
+	}
+	if p.ProRata {
+		parts = append(parts, "pro-rata")
+	}
+	if p.InfoRights {
+		parts = append(parts, "info")
+	}
+	if p.ProtectiveProvisions {
+		parts = append(parts, "vetoes")
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (s *VCInvestScreen) renderDueDiligence() string {
 	var b strings.Builder
 
@@ -67,6 +67,13 @@ type VCResultsScreen struct {
 	// Score saved
 	scoreSaved bool
 
+	// Tournament round recorded, or why it wasn't
+	tournamentNote string
+
+	// Daily challenge recorded, and the streak it left
+	dailyNote   string
+	dailyStreak int
+
 	// Animated counters for spring-animated number displays
 	netWorthCounter *components.AnimatedCounter
 	roiCounter      *components.AnimatedCounter
@@ -166,10 +173,8 @@ func calculateRating(roi float64) (string, string) {
 	return "Lost Money - Better Luck Next Time", "⚠"
 }
 
-// Init initializes the results screen
-func (s *VCResultsScreen) Init() tea.Cmd {
-	// Save score to database
-	gs := s.gameData.GameState
+// saveScore records the game in the local leaderboard, charts and analytics
+func (s *VCResultsScreen) saveScore(gs *game.GameState) {
 	score := database.GameScore{
 		PlayerName:      gs.PlayerName,
 		FinalNetWorth:   s.netWorth,
@@ -181,13 +186,78 @@ func (s *VCResultsScreen) Init() tea.Cmd {
 		PlayedAt:        time.Now(),
 	}
 
-	err := database.SaveGameScore(score)
+	err := s.gameData.Store.SaveGameScore(score)
 	if err == nil {
 		s.scoreSaved = true
 	}
 
-	// Auto-submit to global leaderboard (silent, skips on API unavailable)
-	if leaderboard.IsAPIAvailable("") {
+	_ = s.gameData.Store.SaveGameSeries(gs.PlayerName, "vc", gs.HistorySeries())
+
+	// Save per-investment history for portfolio analytics
+	var investments []database.InvestmentHistory
+	for _, r := range gs.InvestmentRecords() {
+		investments = append(investments, database.InvestmentHistory{
+			CompanyName:       r.CompanyName,
+			Sector:            r.Category,
+			TermsType:         r.TermsType,
+			DDLevel:           r.DDLevel,
+			Outcome:           r.Outcome,
+			AmountInvested:    r.AmountInvested,
+			EntryValuation:    r.EntryValuation,
+			ExitValuation:     r.ExitValuation,
+			ExitValue:         r.ExitValue,
+			MonthsHeld:        r.MonthsHeld,
+			RelationshipScore: r.RelationshipScore,
+		})
+	}
+	_ = s.gameData.Store.SaveGameHistory(database.GameHistory{
+		PlayerName:      gs.PlayerName,
+		GameMode:        "vc",
+		Difficulty:      gs.Difficulty.Name,
+		FinalNetWorth:   s.netWorth,
+		ROI:             s.roi,
+		SuccessfulExits: s.successfulExits,
+		TurnsPlayed:     gs.Portfolio.Turn - 1,
+	}, investments)
+}
+
+// Init initializes the results screen
+func (s *VCResultsScreen) Init() tea.Cmd {
+	gs := s.gameData.GameState
+
+	// Daily challenges bend the rules, so they only count on the daily board and
+	// stay out of local scores and analytics. Tournament rounds play by the normal
+	// rules and are kept like any other game.
+	if s.gameData.Daily == nil {
+		s.saveScore(gs)
+	}
+
+	// Tournament rounds count once, the first time they're finished
+	tournamentID := ""
+	if t := s.gameData.Tournament; t != nil {
+		err := s.gameData.Store.SaveTournamentResult(database.TournamentResult{
+			TournamentID:    t.ID,
+			Round:           s.gameData.TournamentRound,
+			PlayerName:      gs.PlayerName,
+			FinalNetWorth:   s.netWorth,
+			ROI:             s.roi,
+			SuccessfulExits: s.successfulExits,
+		})
+		if err != nil {
+			s.tournamentNote = "✗ " + err.Error()
+		} else {
+			tournamentID = t.ID
+			s.tournamentNote = fmt.Sprintf("🏆 %s: %s recorded", t.Name, t.RoundName(s.gameData.TournamentRound))
+		}
+	}
+
+	// The daily challenge goes to its own leaderboard and extends the streak
+	if s.gameData.Daily != nil {
+		s.dailyStreak, s.dailyNote = s.gameData.finishDaily(gs.PlayerName, s.netWorth, s.roi)
+	}
+
+	// Auto-submit to global leaderboard (silent, skips on API unavailable or opted out)
+	if s.gameData.Daily == nil && s.gameData.SubmitScores() && leaderboard.IsAPIAvailable("") {
 		submission := leaderboard.ScoreSubmission{
 			PlayerName:      gs.PlayerName,
 			FinalNetWorth:   s.netWorth,
@@ -196,9 +266,29 @@ func (s *VCResultsScreen) Init() tea.Cmd {
 			TurnsPlayed:     gs.Portfolio.Turn - 1,
 			Difficulty:      gs.Difficulty.Name,
 		}
+		if tournamentID != "" {
+			submission.TournamentID = tournamentID
+			submission.TournamentRound = s.gameData.TournamentRound
+		}
 		_ = leaderboard.SubmitScore(submission, "")
 	}
 
+	// Personal capital from a career game goes back into the player's net worth
+	if gs.Career != nil {
+		if career, err := s.gameData.Store.GetCareer(gs.PlayerName); err == nil {
+			personal := gs.CareerPersonalReturn()
+			event := fmt.Sprintf("Anchored %s", gs.PlayerFirmName)
+			if gs.Career.Path == "angel" {
+				career.AngelGames++
+				event = "Angel portfolio"
+			} else {
+				career.FundsAnchored++
+			}
+			_ = s.gameData.Store.RecordCareerEvent(career, "vc", fmt.Sprintf("%s: $%s → $%s", event,
+				formatCompactMoney(gs.Career.Commitment), formatCompactMoney(personal)), personal-gs.Career.Commitment)
+		}
+	}
+
 	// Update and save VC reputation
 	if gs.PlayerReputation != nil {
 		hadSuccessfulExit := s.successfulExits > 0
@@ -225,11 +315,11 @@ func (s *VCResultsScreen) Init() tea.Cmd {
 			AvgROILast5:      updatedRep.AvgROILast5,
 		}
 
-		_ = database.SaveVCReputation(dbRep)
+		_ = s.gameData.Store.SaveVCReputation(dbRep)
 	}
 
 	// Get profile before XP is added
-	s.profileBefore, _ = database.GetPlayerProfile(gs.PlayerName)
+	s.profileBefore, _ = s.gameData.Store.GetPlayerProfile(gs.PlayerName)
 	if s.profileBefore != nil {
 		s.oldLevel = s.profileBefore.Level
 	} else {
@@ -244,9 +334,9 @@ func (s *VCResultsScreen) Init() tea.Cmd {
 
 	// Add XP to player
 	var levelUpErr error
-	s.leveledUp, s.newLevel, s.levelUpPoints, levelUpErr = database.AddExperience(gs.PlayerName, s.totalXP)
+	s.leveledUp, s.newLevel, s.levelUpPoints, levelUpErr = s.gameData.Store.AddExperience(gs.PlayerName, s.totalXP)
 	if levelUpErr == nil {
-		s.profileAfter, _ = database.GetPlayerProfile(gs.PlayerName)
+		s.profileAfter, _ = s.gameData.Store.GetPlayerProfile(gs.PlayerName)
 	}
 
 	// Initialize animated counters for the results display
@@ -263,49 +353,56 @@ func (s *VCResultsScreen) Init() tea.Cmd {
 
 func (s *VCResultsScreen) calculateXPBreakdown() {
 	gs := s.gameData.GameState
-	s.xpBreakdown = make(map[string]int)
+	s.xpBreakdown, s.totalXP = vcXPBreakdown(s.roi, s.successfulExits, gs.Difficulty.Name, s.newAchievementObjs)
+}
+
+// vcXPBreakdown is the XP a finished VC game earns, line by line
+func vcXPBreakdown(roi float64, successfulExits int, difficulty string, newAchievements []achievements.Achievement) (map[string]int, int) {
+	breakdown := make(map[string]int)
 
 	// Base XP for completing a game
-	s.xpBreakdown["Game Completion"] = progression.XPGameComplete
-	s.totalXP = progression.XPGameComplete
+	breakdown["Game Completion"] = progression.XPGameComplete
+	total := progression.XPGameComplete
 
 	// Positive ROI bonus
-	if s.roi > 0 {
-		s.xpBreakdown["Positive ROI"] = progression.XPPositiveROI
-		s.totalXP += progression.XPPositiveROI
+	if roi > 0 {
+		breakdown["Positive ROI"] = progression.XPPositiveROI
+		total += progression.XPPositiveROI
 	}
 
 	// Successful exits bonus
-	if s.successfulExits > 0 {
-		exitXP := progression.XPSuccessfulExit * s.successfulExits
-		s.xpBreakdown[fmt.Sprintf("Successful Exits (%d)", s.successfulExits)] = exitXP
-		s.totalXP += exitXP
+	if successfulExits > 0 {
+		exitXP := progression.XPSuccessfulExit * successfulExits
+		breakdown[fmt.Sprintf("Successful Exits (%d)", successfulExits)] = exitXP
+		total += exitXP
 	}
 
 	// Difficulty bonus
-	switch strings.ToLower(gs.Difficulty.Name) {
+	switch strings.ToLower(difficulty) {
 	case "medium":
-		s.xpBreakdown["Medium Difficulty"] = progression.XPDifficultyMedium
-		s.totalXP += progression.XPDifficultyMedium
+		breakdown["Medium Difficulty"] = progression.XPDifficultyMedium
+		total += progression.XPDifficultyMedium
 	case "hard":
-		s.xpBreakdown["Hard Difficulty"] = progression.XPDifficultyHard
-		s.totalXP += progression.XPDifficultyHard
+		breakdown["Hard Difficulty"] = progression.XPDifficultyHard
+		total += progression.XPDifficultyHard
 	case "expert":
-		s.xpBreakdown["Expert Difficulty"] = progression.XPDifficultyExpert
-		s.totalXP += progression.XPDifficultyExpert
+		breakdown["Expert Difficulty"] = progression.XPDifficultyExpert
+		total += progression.XPDifficultyExpert
 	}
 
 	// Achievement bonuses
-	if len(s.newAchievementObjs) > 0 {
+	if len(newAchievements) > 0 {
 		achXP := 0
-		for _, ach := range s.newAchievementObjs {
+		for _, ach := range newAchievements {
 			achXP += ach.Points * progression.XPAchievementBase
 		}
 		if achXP > 0 {
-			s.xpBreakdown[fmt.Sprintf("New Achievements (%d)", len(s.newAchievementObjs))] = achXP
-			s.totalXP += achXP
+			breakdown[fmt.Sprintf("New Achievements (%d)", len(newAchievements))] = achXP
+			total += achXP
 		}
 	}
+
+	return breakdown, total
 }
 
 func (s *VCResultsScreen) checkAchievements() {
@@ -321,10 +418,11 @@ func (s *VCResultsScreen) checkAchievements() {
 		InvestmentCount: len(gs.Portfolio.Investments),
 		SuccessfulExits: s.successfulExits,
 		Difficulty:      gs.Difficulty.Name,
+		DailyStreak:     s.dailyStreak,
 	}
 
 	// Get previously unlocked achievements
-	previouslyUnlocked, _ := database.GetPlayerAchievements(gs.PlayerName)
+	previouslyUnlocked, _ := s.gameData.Store.GetPlayerAchievements(gs.PlayerName)
 
 	// Check for new achievements
 	newUnlocks := achievements.CheckAchievements(stats, previouslyUnlocked)
@@ -332,7 +430,7 @@ func (s *VCResultsScreen) checkAchievements() {
 	for _, ach := range newUnlocks {
 		s.newAchievements = append(s.newAchievements, ach.Name)
 		s.newAchievementObjs = append(s.newAchievementObjs, ach)
-		database.UnlockAchievement(gs.PlayerName, ach.ID)
+		s.gameData.Store.UnlockAchievement(gs.PlayerName, ach.ID)
 	}
 }
 
@@ -511,6 +609,16 @@ func (s *VCResultsScreen) renderResults() string {
 		b.WriteString(savedStyle.Render("✓ Score saved to leaderboard"))
 		b.WriteString("\n\n")
 	}
+	if s.tournamentNote != "" {
+		noteStyle := lipgloss.NewStyle().Foreground(styles.Gold).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(noteStyle.Render(s.tournamentNote))
+		b.WriteString("\n\n")
+	}
+	if s.dailyNote != "" {
+		noteStyle := lipgloss.NewStyle().Foreground(styles.Cyan).Width(s.width).Align(lipgloss.Center)
+		b.WriteString(noteStyle.Render(s.dailyNote))
+		b.WriteString("\n\n")
+	}
 
 	// Help
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
@@ -582,6 +690,16 @@ func (s *VCResultsScreen) renderLeaderboard() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(tableBox.Render(tableContent.String())))
 	b.WriteString("\n\n")
 
+	// How the race played out
+	if len(gs.History) > 1 {
+		chartBox := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(styles.Cyan).
+			Padding(0, 1)
+		b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(chartBox.Render(netWorthChart(gs, 74, 10).View())))
+		b.WriteString("\n\n")
+	}
+
 	// Player position
 	posStyle := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
 	if s.playerRank == 1 {
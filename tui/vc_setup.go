@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -20,9 +21,9 @@ type SetupStep int
 
 const (
 	StepGameMode SetupStep = iota
-	StepPlayerName
 	StepDifficulty
 	StepFirmName
+	StepCareer
 	StepPlayMode
 	StepReady
 )
@@ -36,10 +37,15 @@ type VCSetupScreen struct {
 
 	// Components for each step
 	gameModeMenu   *components.Menu
-	nameInput      textinput.Model
 	difficultyMenu *components.Menu
 	firmInput      textinput.Model
 	playModeMenu   *components.Menu
+	careerMenu     *components.Menu // nil unless the player has career capital
+
+	// Career mode
+	career     *database.Career
+	network    []string
+	careerPath string // "", "anchor" or "angel"
 
 	// Computed values
 	playerLevel int
@@ -63,21 +69,32 @@ func NewVCSetupScreen(width, height int, gameData *GameData) *VCSetupScreen {
 			Description: "Build your own startup from the ground up",
 			Icon:        "🚀",
 		},
+		{
+			ID:          "hotseat",
+			Title:       "Hot-Seat Multiplayer",
+			Description: "2-6 players take turns at this keyboard, chasing the same deals",
+			Icon:        "👥",
+		},
+		{
+			ID:          "tournament",
+			Title:       "Tournament",
+			Description: "Play seeded rounds everyone in the tournament plays, for standings",
+			Icon:        "🏆",
+		},
+		{
+			ID:          "daily",
+			Title:       "Daily Challenge",
+			Description: "Today's seeded game with a twist - one attempt, one leaderboard",
+			Icon:        "📅",
+		},
 	}
 	gameModeMenu := components.NewMenu("SELECT GAME MODE", gameModeItems)
 	gameModeMenu.SetSize(60, 10)
 	gameModeMenu.SetHideHelp(true)
 
-	// Player name input
-	nameInput := textinput.New()
-	nameInput.Placeholder = "Enter your name"
-	nameInput.Focus()
-	nameInput.CharLimit = 30
-	nameInput.Width = 30
-
 	// Get player level for difficulty unlocks
 	playerLevel := 1
-	profile, err := database.GetPlayerProfile(gameData.PlayerName)
+	profile, err := gameData.Store.GetPlayerProfile(gameData.PlayerName)
 	if err == nil && profile != nil {
 		playerLevel = profile.Level
 	}
@@ -139,6 +156,9 @@ func NewVCSetupScreen(width, height int, gameData *GameData) *VCSetupScreen {
 	playModeMenu := components.NewMenu("SELECT PLAY MODE", playModeItems)
 	playModeMenu.SetSize(60, 10)
 	playModeMenu.SetHideHelp(true)
+	if gameData.AutoMode {
+		playModeMenu.SetCursor(1)
+	}
 
 	return &VCSetupScreen{
 		width:          width,
@@ -146,7 +166,6 @@ func NewVCSetupScreen(width, height int, gameData *GameData) *VCSetupScreen {
 		gameData:       gameData,
 		step:           StepGameMode,
 		gameModeMenu:   gameModeMenu,
-		nameInput:      nameInput,
 		difficultyMenu: difficultyMenu,
 		firmInput:      firmInput,
 		playModeMenu:   playModeMenu,
@@ -167,6 +186,9 @@ func (s *VCSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		if key.Matches(msg, keys.Global.Back) {
 			if s.step > StepGameMode {
 				s.step--
+				if s.step == StepCareer && s.careerMenu == nil {
+					s.step--
+				}
 				return s, nil
 			}
 			return s, SwitchTo(ScreenMainMenu)
@@ -181,12 +203,6 @@ func (s *VCSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	switch s.step {
 	case StepGameMode:
 		s.gameModeMenu, cmd = s.gameModeMenu.Update(msg)
-	case StepPlayerName:
-		s.nameInput, cmd = s.nameInput.Update(msg)
-		// Check for enter to proceed
-		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter {
-			return s.handleNameSubmit()
-		}
 	case StepDifficulty:
 		s.difficultyMenu, cmd = s.difficultyMenu.Update(msg)
 	case StepFirmName:
@@ -195,6 +211,8 @@ func (s *VCSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter {
 			return s.handleFirmSubmit()
 		}
+	case StepCareer:
+		s.careerMenu, cmd = s.careerMenu.Update(msg)
 	case StepPlayMode:
 		s.playModeMenu, cmd = s.playModeMenu.Update(msg)
 	}
@@ -205,13 +223,20 @@ func (s *VCSetupScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 func (s *VCSetupScreen) handleMenuSelection(id string) (ScreenModel, tea.Cmd) {
 	switch s.step {
 	case StepGameMode:
-		if id == "founder" {
+		switch id {
+		case "founder":
 			return s, SwitchTo(ScreenFounderSetup)
+		case "hotseat":
+			return s, SwitchTo(ScreenHotSeatSetup)
+		case "tournament":
+			return s, SwitchTo(ScreenTournament)
+		case "daily":
+			return s, SwitchTo(ScreenDaily)
 		}
-		// VC mode selected, move to name
-		s.step = StepPlayerName
-		s.nameInput.Focus()
-		return s, textinput.Blink
+		// VC mode selected, load the active profile and move to difficulty
+		s.loadPlayer()
+		s.step = StepDifficulty
+		return s, nil
 
 	case StepDifficulty:
 		// Set difficulty
@@ -239,6 +264,14 @@ func (s *VCSetupScreen) handleMenuSelection(id string) (ScreenModel, tea.Cmd) {
 		s.firmInput.Focus()
 		return s, textinput.Blink
 
+	case StepCareer:
+		s.careerPath = ""
+		if id == "anchor" || id == "angel" {
+			s.careerPath = id
+		}
+		s.step = StepPlayMode
+		return s, nil
+
 	case StepPlayMode:
 		s.gameData.AutoMode = (id == "auto")
 		// Setup complete, start game
@@ -248,22 +281,18 @@ func (s *VCSetupScreen) handleMenuSelection(id string) (ScreenModel, tea.Cmd) {
 	return s, nil
 }
 
-func (s *VCSetupScreen) handleNameSubmit() (ScreenModel, tea.Cmd) {
-	name := strings.TrimSpace(s.nameInput.Value())
-	if name == "" {
-		return s, nil
-	}
-
-	s.gameData.PlayerName = name
+// loadPlayer pulls the active profile's history, upgrades and career
+func (s *VCSetupScreen) loadPlayer() {
+	name := s.gameData.PlayerName
 
 	// Check for returning player
-	stats, err := database.GetPlayerStats(name)
+	stats, err := s.gameData.Store.GetPlayerStats(name)
 	if err == nil && stats.TotalGames > 0 {
 		s.welcomeBack = true
 		s.playerStats = stats
 
 		// Update player level
-		profile, err := database.GetPlayerProfile(name)
+		profile, err := s.gameData.Store.GetPlayerProfile(name)
 		if err == nil && profile != nil {
 			s.playerLevel = profile.Level
 		}
@@ -273,11 +302,12 @@ func (s *VCSetupScreen) handleNameSubmit() (ScreenModel, tea.Cmd) {
 	}
 
 	// Load player upgrades
-	upgrades, _ := database.GetPlayerUpgrades(name)
+	upgrades, _ := s.gameData.Store.GetPlayerUpgrades(name)
 	s.gameData.PlayerUpgrades = upgrades
 
-	s.step = StepDifficulty
-	return s, nil
+	// Founder exits and contacts carry into career mode
+	s.career, _ = s.gameData.Store.GetCareer(name)
+	s.network, _ = s.gameData.Store.GetNetworkContacts(name)
 }
 
 func (s *VCSetupScreen) handleFirmSubmit() (ScreenModel, tea.Cmd) {
@@ -287,10 +317,48 @@ func (s *VCSetupScreen) handleFirmSubmit() (ScreenModel, tea.Cmd) {
 	}
 	s.gameData.FirmName = firm
 
+	s.careerMenu = nil
+	s.careerPath = ""
+	if s.career != nil && game.CareerCommitmentFor(s.career.NetWorth) >= game.MinCareerCommitment {
+		s.buildCareerMenu()
+		s.step = StepCareer
+		return s, nil
+	}
 	s.step = StepPlayMode
 	return s, nil
 }
 
+func (s *VCSetupScreen) buildCareerMenu() {
+	commitment := game.CareerCommitmentFor(s.career.NetWorth)
+	items := []components.MenuItem{
+		{
+			ID:          "anchor",
+			Title:       "Anchor Your First Fund",
+			Description: fmt.Sprintf("Commit $%s of your $%s - LPs match it %dx", formatSetupMoney(commitment), formatSetupMoney(s.career.NetWorth), game.AnchorLPMatch),
+			Icon:        "🏦",
+		},
+		{
+			ID:          "angel",
+			Title:       "Angel Portfolio",
+			Description: fmt.Sprintf("Invest $%s of your own money - no LPs, no fees", formatSetupMoney(commitment)),
+			Icon:        "👼",
+		},
+		{
+			ID:          "classic",
+			Title:       "Classic Fund",
+			Description: "Keep your money out of it and raise from LPs as usual",
+			Icon:        "💼",
+		},
+	}
+	title := "CAREER CAPITAL"
+	if len(s.network) > 0 {
+		title = fmt.Sprintf("CAREER CAPITAL • %d CONTACTS IN YOUR NETWORK", len(s.network))
+	}
+	s.careerMenu = components.NewMenu(title, items)
+	s.careerMenu.SetSize(60, 10)
+	s.careerMenu.SetHideHelp(true)
+}
+
 func (s *VCSetupScreen) updateDifficultyUnlocks() {
 	// Rebuild difficulty menu with current unlock status
 	difficultyItems := []components.MenuItem{
@@ -335,19 +403,11 @@ func unlockText(unlocked bool, level int) string {
 
 func (s *VCSetupScreen) startGame() tea.Cmd {
 	return func() tea.Msg {
-		// Create the game state
-		s.gameData.GameState = game.NewGame(
-			s.gameData.PlayerName,
-			s.gameData.FirmName,
-			s.gameData.Difficulty,
-			s.gameData.PlayerUpgrades,
-		)
-		s.gameData.CurrentMode = "vc"
-
-		// Load reputation
-		dbRep, err := database.GetVCReputation(s.gameData.PlayerName)
+		// Load reputation first so it can shape the deal flow
+		var reputation *game.VCReputation
+		dbRep, err := s.gameData.Store.GetVCReputation(s.gameData.PlayerName)
 		if err == nil && dbRep != nil {
-			s.gameData.GameState.PlayerReputation = &game.VCReputation{
+			reputation = &game.VCReputation{
 				PlayerName:       dbRep.PlayerName,
 				PerformanceScore: dbRep.PerformanceScore,
 				FounderScore:     dbRep.FounderScore,
@@ -358,6 +418,28 @@ func (s *VCSetupScreen) startGame() tea.Cmd {
 			}
 		}
 
+		// Create the game state
+		s.gameData.GameState = game.NewGameWithSeed(
+			s.gameData.PlayerName,
+			s.gameData.FirmName,
+			s.gameData.Difficulty,
+			s.gameData.PlayerUpgrades,
+			reputation,
+			time.Now().UnixNano(),
+		)
+		s.gameData.CurrentMode = "vc"
+		s.gameData.Tournament = nil
+		s.gameData.Daily = nil
+
+		if s.careerPath != "" && s.career != nil {
+			_, _ = s.gameData.GameState.ApplyCareerCapital(game.CareerCapital{
+				Path:       s.careerPath,
+				NetWorth:   s.career.NetWorth,
+				Commitment: game.CareerCommitmentFor(s.career.NetWorth),
+				Network:    s.network,
+			})
+		}
+
 		return SwitchScreenMsg{Screen: ScreenVCInvest}
 	}
 }
@@ -397,12 +479,12 @@ func (s *VCSetupScreen) View() string {
 	switch s.step {
 	case StepGameMode:
 		content = s.gameModeMenu.View()
-	case StepPlayerName:
-		content = s.renderNameStep()
 	case StepDifficulty:
-		content = s.difficultyMenu.View()
+		content = s.renderWelcomeBack() + s.difficultyMenu.View()
 	case StepFirmName:
 		content = s.renderFirmStep()
+	case StepCareer:
+		content = s.careerMenu.View()
 	case StepPlayMode:
 		content = s.playModeMenu.View()
 	}
@@ -424,7 +506,7 @@ func (s *VCSetupScreen) View() string {
 }
 
 func (s *VCSetupScreen) renderProgress() string {
-	steps := []string{"Mode", "Name", "Difficulty", "Firm", "Play Style"}
+	steps := []string{"Mode", "Difficulty", "Firm", "Career", "Play Style"}
 	var parts []string
 
 	for i, step := range steps {
@@ -450,32 +532,15 @@ func (s *VCSetupScreen) renderProgress() string {
 	return progressStyle.Render(strings.Join(parts, "  →  "))
 }
 
-func (s *VCSetupScreen) renderNameStep() string {
-	var b strings.Builder
-
-	titleStyle := lipgloss.NewStyle().
-		Foreground(styles.Yellow).
-		Bold(true)
-	b.WriteString(titleStyle.Render("ENTER YOUR NAME"))
-	b.WriteString("\n\n")
-
-	// Input
-	inputStyle := lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
-		BorderForeground(styles.Cyan).
-		Padding(0, 1)
-	b.WriteString(inputStyle.Render(s.nameInput.View()))
-	b.WriteString("\n\n")
-
-	// Welcome back message if returning player
-	if s.welcomeBack && s.playerStats != nil {
-		welcomeStyle := lipgloss.NewStyle().
-			Foreground(styles.Green)
-		b.WriteString(welcomeStyle.Render(fmt.Sprintf("🎉 Welcome back! Games: %d | Best: $%s",
-			s.playerStats.TotalGames, formatSetupMoney(s.playerStats.BestNetWorth))))
+// renderWelcomeBack greets a returning player above the difficulty menu
+func (s *VCSetupScreen) renderWelcomeBack() string {
+	if !s.welcomeBack || s.playerStats == nil {
+		return ""
 	}
-
-	return b.String()
+	welcomeStyle := lipgloss.NewStyle().
+		Foreground(styles.Green)
+	return welcomeStyle.Render(fmt.Sprintf("🎉 Welcome back, %s! Games: %d | Best: $%s",
+		s.gameData.PlayerName, s.playerStats.TotalGames, formatSetupMoney(s.playerStats.BestNetWorth))) + "\n\n"
 }
 
 func (s *VCSetupScreen) renderFirmStep() string {
@@ -2,6 +2,9 @@ package tui
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +32,9 @@ const (
 	ViewBoardVote      // Board vote required
 	ViewFollowOnAmount // Entering follow-on amount
 	ViewConfirmQuit    // Quit confirmation
+	ViewDiligence      // Deals under due diligence
+	ViewConstruction   // Portfolio construction plan and Monte Carlo
+	ViewHandoff        // Hot-seat: waiting for the next player
 )
 
 // VCTurnScreen handles the main game turn loop
@@ -53,6 +59,7 @@ type VCTurnScreen struct {
 	pendingVotes []game.BoardVote
 	currentVote  int
 	boardVoteMsg string
+	lobbyTarget  int // Index into the vote's non-player directors
 
 	// Value-add state
 	valueAddCompany string // Selected company for value-add
@@ -60,7 +67,20 @@ type VCTurnScreen struct {
 	valueAddMsg     string // Feedback message
 
 	// Secondary market state
-	selectedSecondaryOffer int // Selected offer index (-1 = none)
+	selectedSecondaryOffer int     // Selected offer index (-1 = none)
+	secondaryTab           int     // 0 = inbound offers, 1 = order book, 2 = my positions
+	selectedOrder          int     // Selected order book row (-1 = none)
+	selectedPosition       int     // Selected portfolio position (-1 = none)
+	listPremium            float64 // Premium over fair value for new listings
+	secondaryMsg           string  // Feedback message
+
+	// Due diligence state
+	selectedDD int    // Selected diligence decision index
+	ddMsg      string // Feedback message
+
+	// Portfolio construction state
+	constructionTab int // 0 = plan & reserves, 1 = Monte Carlo
+	simulation      game.MonteCarloResult
 
 	// Quit confirmation
 	confirmQuitMenu *components.Menu
@@ -84,6 +104,8 @@ func NewVCTurnScreen(width, height int, gameData *GameData) *VCTurnScreen {
 		view:                    ViewTurnSummary,
 		followOnAmount:          followOnInput,
 		selectedSecondaryOffer:  -1,
+		selectedOrder:           -1,
+		selectedPosition:        -1,
 	}
 
 	s.refreshPortfolioTable()
@@ -171,6 +193,12 @@ func doTick() tea.Cmd {
 
 // Init initializes the turn screen
 func (s *VCTurnScreen) Init() tea.Cmd {
+	// Everyone in a hot-seat game has just invested, so the first month runs straight away
+	if s.gameData.GameState.IsHotSeat() {
+		s.advanceMonth()
+		return nil
+	}
+
 	// Process first turn
 	s.processTurn()
 
@@ -191,7 +219,7 @@ func (s *VCTurnScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 			switch {
 			case key.Matches(msg, keys.Global.Enter):
 				if gs.IsGameOver() {
-					return s, SwitchTo(ScreenVCResults)
+					return s, SwitchTo(s.resultsScreen())
 				}
 				s.processTurn()
 				s.refreshPortfolioTable()
@@ -210,6 +238,18 @@ func (s *VCTurnScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				s.view = ViewSecondaryMarket
 				return s, nil
 
+			case msg.String() == "c":
+				s.constructionTab = 0
+				s.runSimulation()
+				s.view = ViewConstruction
+				return s, nil
+
+			case msg.String() == "p":
+				s.selectedDD = 0
+				s.ddMsg = ""
+				s.view = ViewDiligence
+				return s, nil
+
 			case key.Matches(msg, keys.Global.Back), msg.String() == "q":
 				// Show quit confirmation
 				s.confirmQuitMenu = components.NewMenu("QUIT GAME?", []components.MenuItem{
@@ -222,31 +262,56 @@ func (s *VCTurnScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s, nil
 			}
 
-		case ViewDashboard, ViewSecondaryMarket:
+		case ViewHandoff:
+			if key.Matches(msg, keys.Global.Enter) {
+				s.startSeatTurn()
+			}
+			return s, nil
+
+		case ViewDashboard:
 			if key.Matches(msg, keys.Global.Back) || msg.String() == "q" {
 				s.view = ViewTurnSummary
-				s.selectedSecondaryOffer = -1
 				return s, nil
 			}
-			// Handle number keys for secondary market offer selection
-			if s.view == ViewSecondaryMarket {
-				keyStr := msg.String()
-				if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '9' {
-					num := int(keyStr[0] - '0')
-					gs := s.gameData.GameState
-					if num <= len(gs.SecondaryMarketOffers) {
-						s.selectedSecondaryOffer = num - 1
-						return s, nil
-					}
-				}
-				// Accept selected offer
-				if msg.String() == "a" && s.selectedSecondaryOffer >= 0 {
-					return s.handleSecondaryMarketAccept()
-				}
-				// Reject selected offer
-				if msg.String() == "r" && s.selectedSecondaryOffer >= 0 {
-					return s.handleSecondaryMarketReject()
+
+		case ViewSecondaryMarket:
+			return s.handleSecondaryMarketKey(msg.String())
+
+		case ViewConstruction:
+			switch msg.String() {
+			case "esc", "q":
+				s.view = ViewTurnSummary
+			case "tab":
+				s.constructionTab = (s.constructionTab + 1) % 2
+			case "+", "=":
+				gs.AdjustPlan(1, 0)
+			case "-":
+				gs.AdjustPlan(-1, 0)
+			case "]":
+				gs.AdjustPlan(0, 0.05)
+			case "[":
+				gs.AdjustPlan(0, -0.05)
+			case "r":
+				s.runSimulation()
+			}
+			return s, nil
+
+		case ViewDiligence:
+			if key.Matches(msg, keys.Global.Back) || msg.String() == "q" {
+				s.view = ViewTurnSummary
+				return s, nil
+			}
+			keyStr := msg.String()
+			if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '9' {
+				num := int(keyStr[0] - '0')
+				if num <= len(gs.PendingDDDecisions) {
+					s.selectedDD = num - 1
+					s.ddMsg = ""
 				}
+				return s, nil
+			}
+			if keyStr == "i" || keyStr == "x" {
+				return s.handleDDDecision(keyStr == "i")
 			}
 
 		case ViewValueAdd:
@@ -300,6 +365,12 @@ func (s *VCTurnScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				return s.handleBoardVote(true) // Accept/Approve
 			case msg.String() == "b" || msg.String() == "2":
 				return s.handleBoardVote(false) // Reject/Decline
+			case msg.String() == "l":
+				s.lobbyTarget++
+				s.boardVoteMsg = ""
+				return s, nil
+			case msg.String() == "y" || msg.String() == "n":
+				return s.handleLobby(msg.String() == "y")
 			}
 
 		case ViewConfirmQuit:
@@ -328,7 +399,7 @@ func (s *VCTurnScreen) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 				s.refreshLeaderboard()
 
 				if gs.IsGameOver() {
-					return s, SwitchTo(ScreenVCResults)
+					return s, SwitchTo(s.resultsScreen())
 				}
 			}
 			return s, doTick()
@@ -422,13 +493,7 @@ func (s *VCTurnScreen) handleBoardVote(approve bool) (ScreenModel, tea.Cmd) {
 	vote := s.pendingVotes[s.currentVote]
 
 	// Find the vote index in pending votes
-	voteIndex := -1
-	for i, v := range gs.GetPendingBoardVotes() {
-		if v.CompanyName == vote.CompanyName && v.VoteType == vote.VoteType {
-			voteIndex = i
-			break
-		}
-	}
+	voteIndex := s.currentVoteIndex()
 
 	if voteIndex == -1 {
 		s.currentVote++
@@ -453,8 +518,11 @@ func (s *VCTurnScreen) handleBoardVote(approve bool) (ScreenModel, tea.Cmd) {
 	outcomeMessages := gs.ExecuteBoardVoteOutcome(vote, passed)
 	s.turnMessages = append(s.turnMessages, result)
 	s.turnMessages = append(s.turnMessages, outcomeMessages...)
+	s.turnMessages = append(s.turnMessages, gs.TakeInbox()...)
 
 	s.currentVote++
+	s.lobbyTarget = 0
+	s.boardVoteMsg = ""
 	if s.currentVote >= len(s.pendingVotes) {
 		s.pendingVotes = nil
 		s.view = ViewTurnSummary
@@ -465,6 +533,164 @@ func (s *VCTurnScreen) handleBoardVote(approve bool) (ScreenModel, tea.Cmd) {
 	return s, nil
 }
 
+// currentVoteIndex finds the vote on screen in the game's pending list
+func (s *VCTurnScreen) currentVoteIndex() int {
+	if s.currentVote >= len(s.pendingVotes) {
+		return -1
+	}
+	vote := s.pendingVotes[s.currentVote]
+	for i, v := range s.gameData.GameState.PendingBoardVotes {
+		if v.CompanyName == vote.CompanyName && v.VoteType == vote.VoteType {
+			return i
+		}
+	}
+	return -1
+}
+
+// lobbyableDirectors returns the directors on the current vote's board other than the player
+func (s *VCTurnScreen) lobbyableDirectors() []game.BoardDirector {
+	directors := []game.BoardDirector{}
+	if s.currentVote >= len(s.pendingVotes) {
+		return directors
+	}
+	for _, d := range s.gameData.GameState.GetBoard(s.pendingVotes[s.currentVote].CompanyName).Directors {
+		if d.Role != "player" {
+			directors = append(directors, d)
+		}
+	}
+	return directors
+}
+
+func (s *VCTurnScreen) handleLobby(forA bool) (ScreenModel, tea.Cmd) {
+	directors := s.lobbyableDirectors()
+	voteIndex := s.currentVoteIndex()
+	if len(directors) == 0 || voteIndex < 0 {
+		return s, nil
+	}
+
+	target := directors[s.lobbyTarget%len(directors)]
+	result, err := s.gameData.GameState.LobbyDirector(voteIndex, target.Name, forA)
+	if err != nil {
+		s.boardVoteMsg = err.Error()
+		return s, nil
+	}
+	s.boardVoteMsg = result
+	return s, nil
+}
+
+func (s *VCTurnScreen) handleSecondaryMarketKey(k string) (ScreenModel, tea.Cmd) {
+	gs := s.gameData.GameState
+
+	switch k {
+	case "esc", "q":
+		s.view = ViewTurnSummary
+		s.selectedSecondaryOffer = -1
+		s.selectedOrder = -1
+		s.selectedPosition = -1
+		s.secondaryMsg = ""
+		return s, nil
+	case "tab":
+		s.secondaryTab = (s.secondaryTab + 1) % 3
+		s.selectedSecondaryOffer = -1
+		s.selectedOrder = -1
+		s.selectedPosition = -1
+		s.secondaryMsg = ""
+		return s, nil
+	}
+
+	// Number keys select a row on the current tab
+	if len(k) == 1 && k[0] >= '1' && k[0] <= '9' {
+		num := int(k[0] - '0')
+		switch s.secondaryTab {
+		case 0:
+			if num <= len(gs.SecondaryMarketOffers) {
+				s.selectedSecondaryOffer = num - 1
+			}
+		case 1:
+			if num <= len(gs.SecondaryOrders) {
+				s.selectedOrder = num - 1
+			}
+		case 2:
+			if num <= len(gs.Portfolio.Investments) {
+				s.selectedPosition = num - 1
+			}
+		}
+		return s, nil
+	}
+
+	switch s.secondaryTab {
+	case 0:
+		if k == "a" && s.selectedSecondaryOffer >= 0 {
+			return s.handleSecondaryMarketAccept()
+		}
+		if k == "r" && s.selectedSecondaryOffer >= 0 {
+			return s.handleSecondaryMarketReject()
+		}
+
+	case 1:
+		if s.selectedOrder < 0 || s.selectedOrder >= len(gs.SecondaryOrders) {
+			return s, nil
+		}
+		order := gs.SecondaryOrders[s.selectedOrder]
+		var result string
+		var err error
+		switch {
+		case k == "b" && order.Side == "ask" && !order.IsPlayer:
+			result, err = gs.BuySecondaryStake(order.ID)
+		case k == "h" && order.Side == "bid":
+			result, err = gs.SellToBid(order.ID)
+		case k == "x" && gs.IsOwnOrder(order):
+			err = gs.CancelListing(order.ID)
+			result = fmt.Sprintf("✗ Pulled your listing for %s", order.CompanyName)
+		default:
+			return s, nil
+		}
+		s.selectedOrder = -1
+		if err != nil {
+			s.secondaryMsg = err.Error()
+			return s, nil
+		}
+		s.secondaryMsg = result
+		s.turnMessages = append(s.turnMessages, result)
+		s.refreshPortfolioTable()
+		s.refreshLeaderboard()
+
+	case 2:
+		switch k {
+		case "+", "=":
+			s.listPremium = math.Min(0.5, s.listPremium+0.05)
+		case "-":
+			s.listPremium = math.Max(-0.3, s.listPremium-0.05)
+		case "l", "L":
+			if s.selectedPosition < 0 || s.selectedPosition >= len(gs.Portfolio.Investments) {
+				return s, nil
+			}
+			share := 1.0
+			if k == "l" {
+				share = 0.5
+			}
+			inv := gs.Portfolio.Investments[s.selectedPosition]
+			if err := gs.ListPosition(inv.CompanyName, share, s.listPremium); err != nil {
+				s.secondaryMsg = err.Error()
+			} else {
+				s.secondaryMsg = fmt.Sprintf("📋 Listed %.0f%% of your %s stake", share*100, inv.CompanyName)
+			}
+		case "v":
+			result, err := gs.ExecuteContinuationVehicle()
+			if err != nil {
+				s.secondaryMsg = err.Error()
+				return s, nil
+			}
+			s.secondaryMsg = result
+			s.turnMessages = append(s.turnMessages, result)
+			s.selectedPosition = -1
+			s.refreshPortfolioTable()
+			s.refreshLeaderboard()
+		}
+	}
+	return s, nil
+}
+
 func (s *VCTurnScreen) handleSecondaryMarketAccept() (ScreenModel, tea.Cmd) {
 	gs := s.gameData.GameState
 	idx := s.selectedSecondaryOffer
@@ -503,39 +729,79 @@ func (s *VCTurnScreen) handleSecondaryMarketReject() (ScreenModel, tea.Cmd) {
 	return s, nil
 }
 
+func (s *VCTurnScreen) handleDDDecision(invest bool) (ScreenModel, tea.Cmd) {
+	gs := s.gameData.GameState
+	if s.selectedDD < 0 || s.selectedDD >= len(gs.PendingDDDecisions) {
+		return s, nil
+	}
+
+	result, err := gs.ResolveDDDecision(s.selectedDD, invest)
+	if err != nil {
+		s.ddMsg = err.Error()
+		return s, nil
+	}
+
+	s.ddMsg = result
+	s.turnMessages = append(s.turnMessages, result)
+	s.selectedDD = 0
+	s.refreshPortfolioTable()
+	s.refreshLeaderboard()
+	return s, nil
+}
+
 func (s *VCTurnScreen) continueProcessTurn() {
 	gs := s.gameData.GameState
 
+	// In hot-seat the month only runs once the last player is done
+	if gs.IsHotSeat() {
+		if gs.ActiveSeat < len(gs.Seats)-1 {
+			gs.SwitchSeat(gs.ActiveSeat + 1)
+			s.view = ViewHandoff
+			return
+		}
+		s.advanceMonth()
+		return
+	}
+
 	// Process the turn
 	messages := gs.ProcessTurn()
+	messages = append(messages, gs.ProcessPortfolioMonth()...)
 
-	// Process value-add actions
-	valueAddMsgs := gs.ProcessActiveValueAddActions()
-	messages = append(messages, valueAddMsgs...)
-
-	// Process relationships
-	for i := range gs.Portfolio.Investments {
-		inv := &gs.Portfolio.Investments[i]
-		if inv.FounderName != "" {
-			event := game.GenerateRelationshipEvent(inv, gs.Portfolio.Turn)
-			if event != nil {
-				inv.RelationshipScore = game.ApplyRelationshipChange(
-					inv.RelationshipScore,
-					event.ScoreChange)
-				messages = append(messages, event.Description)
-			}
-		}
+	// Check for board votes
+	pendingVotes := gs.GetPendingBoardVotes()
+	if len(pendingVotes) > 0 {
+		s.pendingVotes = pendingVotes
+		s.currentVote = 0
+		s.boardVoteMsg = ""
+		s.view = ViewBoardVote
 	}
 
-	// Generate secondary offers
-	newOffers := gs.GenerateSecondaryOffers()
-	gs.SecondaryMarketOffers = append(gs.SecondaryMarketOffers, newOffers...)
+	s.turnMessages = messages
+	s.refreshPortfolioTable()
+	s.refreshLeaderboard()
+}
+
+// advanceMonth runs a hot-seat month for everyone and hands the keyboard back to
+// the first player
+func (s *VCTurnScreen) advanceMonth() {
+	gs := s.gameData.GameState
+	gs.ProcessTurn()
+	gs.EachSeat(gs.ProcessPortfolioMonth)
+	gs.SwitchSeat(0)
+	s.view = ViewHandoff
+}
 
-	// Process expirations
-	expiredMsgs := gs.ProcessSecondaryOfferExpirations()
-	messages = append(messages, expiredMsgs...)
+// startSeatTurn shows the hot-seat player at the keyboard their news and votes
+func (s *VCTurnScreen) startSeatTurn() {
+	gs := s.gameData.GameState
+	s.view = ViewTurnSummary
+	s.turnMessages = gs.TakeInbox()
+	s.valueAddPhase = 0
+	s.valueAddCompany = ""
+	s.valueAddMsg = ""
+	s.secondaryMsg = ""
+	s.ddMsg = ""
 
-	// Check for board votes
 	pendingVotes := gs.GetPendingBoardVotes()
 	if len(pendingVotes) > 0 {
 		s.pendingVotes = pendingVotes
@@ -544,11 +810,18 @@ func (s *VCTurnScreen) continueProcessTurn() {
 		s.view = ViewBoardVote
 	}
 
-	s.turnMessages = messages
 	s.refreshPortfolioTable()
 	s.refreshLeaderboard()
 }
 
+// resultsScreen is where the game ends: hot-seat games rank every player together
+func (s *VCTurnScreen) resultsScreen() Screen {
+	if s.gameData.GameState.IsHotSeat() {
+		return ScreenHotSeatResults
+	}
+	return ScreenVCResults
+}
+
 func (s *VCTurnScreen) handleValueAddSelection(num int) (ScreenModel, tea.Cmd) {
 	gs := s.gameData.GameState
 	opportunities := gs.GetValueAddOpportunities()
@@ -656,45 +929,7 @@ func (s *VCTurnScreen) processTurn() {
 		return // Don't process turn yet - wait for follow-on decisions
 	}
 
-	// Process the turn
-	messages := gs.ProcessTurn()
-
-	// Process value-add actions
-	valueAddMsgs := gs.ProcessActiveValueAddActions()
-	messages = append(messages, valueAddMsgs...)
-
-	// Process relationships
-	for i := range gs.Portfolio.Investments {
-		inv := &gs.Portfolio.Investments[i]
-		if inv.FounderName != "" {
-			event := game.GenerateRelationshipEvent(inv, gs.Portfolio.Turn)
-			if event != nil {
-				inv.RelationshipScore = game.ApplyRelationshipChange(
-					inv.RelationshipScore,
-					event.ScoreChange)
-				messages = append(messages, event.Description)
-			}
-		}
-	}
-
-	// Generate secondary offers
-	newOffers := gs.GenerateSecondaryOffers()
-	gs.SecondaryMarketOffers = append(gs.SecondaryMarketOffers, newOffers...)
-
-	// Process expirations
-	expiredMsgs := gs.ProcessSecondaryOfferExpirations()
-	messages = append(messages, expiredMsgs...)
-
-	// Check for board votes AFTER processing turn
-	pendingVotes := gs.GetPendingBoardVotes()
-	if len(pendingVotes) > 0 {
-		s.pendingVotes = pendingVotes
-		s.currentVote = 0
-		s.boardVoteMsg = ""
-		s.view = ViewBoardVote
-	}
-
-	s.turnMessages = messages
+	s.continueProcessTurn()
 }
 
 // View renders the turn screen
@@ -712,6 +947,12 @@ func (s *VCTurnScreen) View() string {
 		return s.renderBoardVote()
 	case ViewConfirmQuit:
 		return s.renderConfirmQuit()
+	case ViewDiligence:
+		return s.renderDiligence()
+	case ViewConstruction:
+		return s.renderConstruction()
+	case ViewHandoff:
+		return renderHandoff(s.width, s.gameData.GameState, fmt.Sprintf("Month %d news is waiting for you", s.gameData.GameState.Portfolio.Turn))
 	default:
 		return s.renderTurnSummary()
 	}
@@ -729,7 +970,7 @@ func (s *VCTurnScreen) renderTurnSummary() string {
 		Width(s.width).
 		Align(lipgloss.Center)
 
-	header := fmt.Sprintf("🦄 %s - MONTH %d/%d", s.gameData.FirmName, gs.Portfolio.Turn, gs.Portfolio.MaxTurns)
+	header := fmt.Sprintf("🦄 %s - MONTH %d/%d", gs.PlayerFirmName, gs.Portfolio.Turn, gs.Portfolio.MaxTurns)
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render(header)))
 	b.WriteString("\n\n")
 
@@ -778,7 +1019,7 @@ func (s *VCTurnScreen) renderTurnSummary() string {
 	if gs.IsGameOver() {
 		b.WriteString(helpStyle.Render("🏁 GAME OVER - Press Enter to see results"))
 	} else {
-		b.WriteString(helpStyle.Render("enter next • d dashboard • v value-add • s secondary • q quit"))
+		b.WriteString(helpStyle.Render("enter next • d dashboard • v value-add • s secondary • p diligence • c construction • q quit"))
 	}
 
 	return b.String()
@@ -825,6 +1066,11 @@ func (s *VCTurnScreen) renderPortfolioPanel() string {
 				formatCompactMoney(inv.AmountInvested),
 				formatCompactMoney(value),
 				inv.EquityPercent)))
+			if trend := gs.ValuationSeries(inv.CompanyName); len(trend) > 1 {
+				b.WriteString(" ")
+				b.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Render(
+					components.Sparkline(components.FloatSeries(trend), 6)))
+			}
 			b.WriteString("\n")
 		}
 	}
@@ -982,6 +1228,14 @@ func (s *VCTurnScreen) renderDashboard() string {
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(statsBox.Render(stats.String())))
 	b.WriteString("\n\n")
 
+	// Net worth over time
+	chartBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Cyan).
+		Padding(0, 1)
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(chartBox.Render(netWorthChart(gs, 66, 8).View())))
+	b.WriteString("\n\n")
+
 	// Help
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
 	b.WriteString(helpStyle.Render("esc back"))
@@ -1131,47 +1385,343 @@ func (s *VCTurnScreen) renderSecondaryMarket() string {
 		Align(lipgloss.Center)
 
 	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("💱 SECONDARY MARKET")))
+	b.WriteString("\n")
+
+	cycle := gs.MarketCycle
+	if cycle != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center).Render(
+			fmt.Sprintf("%s • valuations %.2fx • fee %.0f%%", cycle.Name, cycle.ValuationMult, game.GetSecondaryMarketFee()*100)))
+	}
+	b.WriteString("\n")
+
+	tabs := []string{"Inbound Offers", "Order Book", "My Positions"}
+	var tabLine strings.Builder
+	for i, tab := range tabs {
+		style := lipgloss.NewStyle().Padding(0, 2).Foreground(styles.Gray)
+		if i == s.secondaryTab {
+			style = style.Foreground(styles.Cyan).Bold(true).Underline(true)
+		}
+		tabLine.WriteString(style.Render(tab))
+	}
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(tabLine.String()))
 	b.WriteString("\n\n")
 
-	if len(gs.SecondaryMarketOffers) == 0 {
-		infoStyle := lipgloss.NewStyle().
-			Foreground(styles.Yellow).
-			Width(s.width).
-			Align(lipgloss.Center)
-		b.WriteString(infoStyle.Render("No secondary market offers available"))
-	} else {
-		// List offers
-		for i, offer := range gs.SecondaryMarketOffers {
-			borderColor := styles.Orange
-			if i == s.selectedSecondaryOffer {
-				borderColor = styles.Green
-			}
-			offerStyle := lipgloss.NewStyle().
-				Border(lipgloss.NormalBorder()).
-				BorderForeground(borderColor).
-				Padding(0, 1).
-				Width(50)
+	infoStyle := lipgloss.NewStyle().
+		Foreground(styles.Yellow).
+		Width(s.width).
+		Align(lipgloss.Center)
+	rowStyle := func(selected bool) lipgloss.Style {
+		borderColor := styles.Orange
+		if selected {
+			borderColor = styles.Green
+		}
+		return lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(borderColor).
+			Padding(0, 1).
+			Width(64)
+	}
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	help := "tab switch • esc back"
 
+	switch s.secondaryTab {
+	case 0:
+		if len(gs.SecondaryMarketOffers) == 0 {
+			b.WriteString(infoStyle.Render("No secondary market offers available"))
+		}
+		for i, offer := range gs.SecondaryMarketOffers {
 			offerText := fmt.Sprintf("%d. %s - %.1f%% stake @ $%s",
 				i+1, offer.CompanyName, offer.EquityOffered, formatCompactMoney(offer.OfferAmount))
-
 			if i == s.selectedSecondaryOffer {
 				offerText = "► " + offerText + " ◄"
 			}
+			b.WriteString(center.Render(rowStyle(i == s.selectedSecondaryOffer).Render(offerText)))
+			b.WriteString("\n")
+		}
+		if s.selectedSecondaryOffer >= 0 {
+			help = "a accept • r reject • " + help
+		} else {
+			help = "1-9 select offer • " + help
+		}
 
-			b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(offerStyle.Render(offerText)))
+	case 1:
+		if len(gs.SecondaryOrders) == 0 {
+			b.WriteString(infoStyle.Render("The order book is empty - check back next month"))
+		}
+		for i, order := range gs.SecondaryOrders {
+			if i >= 9 {
+				break
+			}
+			side := "BID"
+			if order.Side == "ask" {
+				side = "ASK"
+			}
+			party := order.PartyFirm
+			if gs.IsOwnOrder(order) {
+				party = "You"
+			}
+			owned := ""
+			if order.Side == "bid" {
+				for _, inv := range gs.Portfolio.Investments {
+					if inv.CompanyName == order.CompanyName {
+						owned = " ★"
+					}
+				}
+			}
+			text := fmt.Sprintf("%d. %s %-18s %.2f%% @ $%s (fair $%s) • %s • %dmo%s",
+				i+1, side, truncate(order.CompanyName, 18), order.EquityPercent,
+				formatCompactMoney(order.Price), formatCompactMoney(order.FairValue),
+				truncate(party, 14), order.ExpiresIn, owned)
+			b.WriteString(center.Render(rowStyle(i == s.selectedOrder).Render(text)))
 			b.WriteString("\n")
 		}
+		help = "1-9 select • b buy ask • h hit bid (★ = you hold it) • x pull listing • " + help
+
+	case 2:
+		if len(gs.Portfolio.Investments) == 0 {
+			b.WriteString(infoStyle.Render("No positions to list"))
+		}
+		for i, inv := range gs.Portfolio.Investments {
+			if i >= 9 {
+				break
+			}
+			fair := int64(0)
+			for j := range gs.AvailableStartups {
+				if gs.AvailableStartups[j].Name == inv.CompanyName {
+					fair = gs.SecondaryFairValue(&gs.AvailableStartups[j], inv.EquityPercent)
+				}
+			}
+			text := fmt.Sprintf("%d. %-20s %.2f%% • cost $%s • secondary $%s",
+				i+1, truncate(inv.CompanyName, 20), inv.EquityPercent,
+				formatCompactMoney(inv.AmountInvested), formatCompactMoney(fair))
+			b.WriteString(center.Render(rowStyle(i == s.selectedPosition).Render(text)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(infoStyle.Render(fmt.Sprintf("Listing price: %+.0f%% vs fair value", s.listPremium*100)))
+		b.WriteString("\n")
+		if gs.ContinuationVehicleAvailable() {
+			if deal, err := gs.QuoteContinuationVehicle(); err == nil {
+				b.WriteString(infoStyle.Render(fmt.Sprintf("🔁 Continuation vehicle: %d winners, NAV $%s → $%s to LPs after fees (press v)",
+					len(deal.Companies), formatCompactMoney(deal.NAV), formatCompactMoney(deal.Proceeds))))
+				b.WriteString("\n")
+			}
+		}
+		help = "1-9 select • l list half • L list all • +/- price • v continuation vehicle • " + help
+	}
+
+	if s.secondaryMsg != "" {
+		b.WriteString("\n")
+		b.WriteString(infoStyle.Render(s.secondaryMsg))
 	}
 
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	if s.selectedSecondaryOffer >= 0 {
-		b.WriteString(helpStyle.Render("a accept • r reject • esc back"))
+	b.WriteString(helpStyle.Render(help))
+
+	return b.String()
+}
+
+// runSimulation re-runs the portfolio Monte Carlo, seeded so the same month gives the same picture
+func (s *VCTurnScreen) runSimulation() {
+	gs := s.gameData.GameState
+	rng := rand.New(rand.NewSource(gs.Seed + int64(gs.Portfolio.Turn)*7919 + int64(len(gs.Portfolio.Investments))))
+	s.simulation = gs.SimulatePortfolio(2000, rng)
+}
+
+func (s *VCTurnScreen) renderConstruction() string {
+	gs := s.gameData.GameState
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Green).
+		Bold(true).
+		Width(70).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🧮 PORTFOLIO CONSTRUCTION")))
+	b.WriteString("\n")
+
+	tabs := []string{"Plan & Reserves", "Monte Carlo"}
+	var tabLine strings.Builder
+	for i, tab := range tabs {
+		style := lipgloss.NewStyle().Padding(0, 2).Foreground(styles.Gray)
+		if i == s.constructionTab {
+			style = style.Foreground(styles.Cyan).Bold(true).Underline(true)
+		}
+		tabLine.WriteString(style.Render(tab))
+	}
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(tabLine.String()))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Green).
+		Padding(0, 2).
+		Width(70)
+
+	var content strings.Builder
+	labelStyle := lipgloss.NewStyle().Foreground(styles.Yellow)
+	if s.constructionTab == 0 {
+		plan := gs.GetPortfolioPlan()
+		content.WriteString(labelStyle.Render("PLAN"))
+		content.WriteString(fmt.Sprintf("\nChecks: %d written / %d target", len(gs.Portfolio.Investments), plan.TargetChecks))
+		content.WriteString(fmt.Sprintf("\nReserve ratio: %.0f%%", plan.ReserveRatio*100))
+		content.WriteString(fmt.Sprintf("\nCheck size: Pre-Seed $%s • Seed $%s",
+			formatCompactMoney(plan.CheckByStage["Pre-Seed"]), formatCompactMoney(plan.CheckByStage["Seed"])))
+		content.WriteString(fmt.Sprintf("\nMax per sector: %d", plan.MaxPerSector))
+
+		content.WriteString("\n\n")
+		content.WriteString(labelStyle.Render("PROJECTED FOLLOW-ONS"))
+		needs := gs.ProjectFollowOnNeeds()
+		var total int64
+		for i, need := range needs {
+			total += need.ProRataAmount
+			if i < 8 {
+				content.WriteString(fmt.Sprintf("\nM%-3d %-18s %-16s pro-rata $%s",
+					need.Turn, truncate(need.CompanyName, 18), truncate(need.RoundName, 16), formatCompactMoney(need.ProRataAmount)))
+			}
+		}
+		if len(needs) > 8 {
+			content.WriteString(fmt.Sprintf("\n... and %d more", len(needs)-8))
+		}
+		if len(needs) == 0 {
+			content.WriteString("\nNo rounds scheduled at portfolio companies")
+		}
+		content.WriteString(fmt.Sprintf("\nTotal pro-rata: $%s • Available: $%s",
+			formatCompactMoney(total), formatCompactMoney(gs.Portfolio.Cash+gs.Portfolio.FollowOnReserve)))
 	} else {
-		b.WriteString(helpStyle.Render("1-9 select offer • esc back"))
+		sim := s.simulation
+		content.WriteString(labelStyle.Render(fmt.Sprintf("FUND OUTCOMES (%d simulated runs)", sim.Runs)))
+		if sim.Runs > 0 {
+			content.WriteString(fmt.Sprintf("\nP10 %.2fx • Median %.2fx • P90 %.2fx • Mean %.2fx", sim.P10, sim.P50, sim.P90, sim.Mean))
+			content.WriteString(fmt.Sprintf("\nChance of losing money: %.0f%% • Chance of 3x+: %.0f%%", sim.ProbLoss*100, sim.ProbTripled*100))
+			content.WriteString(fmt.Sprintf("\nExpected value: $%s", formatCompactMoney(sim.ExpectedValue)))
+			content.WriteString("\n\n")
+			content.WriteString(renderOutcomeHistogram(sim.Multiples))
+		}
+	}
+
+	warnings := gs.PlanDivergence(s.simulation)
+	if len(warnings) > 0 {
+		content.WriteString("\n\n")
+		content.WriteString(labelStyle.Render("PLAN VS REALITY"))
+		for _, w := range warnings {
+			content.WriteString("\n" + lipgloss.NewStyle().Foreground(styles.Red).Render(w))
+		}
+	}
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(box.Render(content.String())))
+	b.WriteString("\n\n")
+
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("tab switch • +/- checks • [/] reserves • r re-run • esc back"))
+
+	return b.String()
+}
+
+// renderOutcomeHistogram draws the distribution of fund multiples as horizontal bars
+func renderOutcomeHistogram(multiples []float64) string {
+	buckets := []struct {
+		label string
+		max   float64
+	}{
+		{"<0.5x", 0.5}, {"0.5-1x", 1}, {"1-2x", 2}, {"2-3x", 3}, {"3-5x", 5}, {"5x+", math.Inf(1)},
+	}
+	counts := make([]int, len(buckets))
+	for _, m := range multiples {
+		for i, bucket := range buckets {
+			if m < bucket.max {
+				counts[i]++
+				break
+			}
+		}
 	}
 
+	var b strings.Builder
+	for i, bucket := range buckets {
+		share := float64(counts[i]) / float64(len(multiples))
+		bar := strings.Repeat("█", int(share*40))
+		b.WriteString(fmt.Sprintf("%-7s %-40s %3.0f%%", bucket.label, bar, share*100))
+		if i < len(buckets)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func (s *VCTurnScreen) renderDiligence() string {
+	gs := s.gameData.GameState
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(styles.Black).
+		Background(styles.Cyan).
+		Bold(true).
+		Width(70).
+		Align(lipgloss.Center)
+
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(headerStyle.Render("🔍 DEALS IN DILIGENCE")))
+	b.WriteString("\n\n")
+
+	center := lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center)
+	if len(gs.PendingDDDecisions) == 0 {
+		b.WriteString(center.Foreground(styles.Yellow).Render("No deals in diligence. Start workstreams from the investment screen."))
+	}
+
+	for i, d := range gs.PendingDDDecisions {
+		borderColor := styles.Cyan
+		if i == s.selectedDD {
+			borderColor = styles.Green
+		}
+		boxStyle := lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(borderColor).
+			Padding(0, 1).
+			Width(70)
+
+		var box strings.Builder
+		box.WriteString(fmt.Sprintf("%d. %s - $%s, round closes month %d (%s leading)\n",
+			i+1, d.CompanyName, formatCompactMoney(d.InvestmentAmount), d.RoundCloseTurn, d.LeadInvestor))
+		for _, id := range d.Workstreams {
+			ws, _ := game.GetDDWorkstream(id)
+			status := fmt.Sprintf("⏳ due month %d", d.Turn+ws.Turns)
+			for _, done := range d.Completed {
+				if done == id {
+					status = "✓ done"
+				}
+			}
+			box.WriteString(fmt.Sprintf("   %-28s %s\n", ws.Name, status))
+		}
+		if i == s.selectedDD {
+			for _, f := range d.Findings {
+				switch f.Type {
+				case "red_flag":
+					box.WriteString(lipgloss.NewStyle().Foreground(styles.Red).Render("   🚩 " + f.Description))
+				case "green_flag":
+					box.WriteString(lipgloss.NewStyle().Foreground(styles.Green).Render("   ✓ " + f.Description))
+				default:
+					box.WriteString("   ℹ️  " + f.Description)
+				}
+				box.WriteString("\n")
+			}
+		}
+
+		b.WriteString(center.Render(boxStyle.Render(strings.TrimRight(box.String(), "\n"))))
+		b.WriteString("\n")
+	}
+
+	if s.ddMsg != "" {
+		b.WriteString("\n")
+		b.WriteString(center.Foreground(styles.Yellow).Render(s.ddMsg))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
+	b.WriteString(helpStyle.Render("1-9 select • i invest • x pass • esc back"))
+
 	return b.String()
 }
 
@@ -1396,17 +1946,42 @@ func (s *VCTurnScreen) renderBoardVote() string {
 	} else {
 		b.WriteString(powerStyle.Render("Voting Power: 1 vote"))
 	}
+	b.WriteString("\n\n")
+
+	// The rest of the board and which way they lean
+	directors := s.lobbyableDirectors()
+	voteIndex := s.currentVoteIndex()
+	var board strings.Builder
+	board.WriteString(lipgloss.NewStyle().Foreground(styles.Cyan).Bold(true).Render("THE BOARD"))
+	for i, d := range directors {
+		marker := "  "
+		if i == s.lobbyTarget%len(directors) {
+			marker = "► "
+		}
+		who := d.Role
+		if d.Firm != "" {
+			who = d.Firm
+		}
+		board.WriteString(fmt.Sprintf("\n%s%-18s %-20s leans %s %.0f%%",
+			marker, truncate(d.Name, 18), truncate(who, 20), vote.OptionA, gs.DirectorLean(voteIndex, d.Name)*100))
+	}
+	boardBox := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(styles.Gray).
+		Padding(0, 1).
+		Width(65)
+	b.WriteString(lipgloss.NewStyle().Width(s.width).Align(lipgloss.Center).Render(boardBox.Render(board.String())))
 
 	// Message
 	if s.boardVoteMsg != "" {
 		b.WriteString("\n")
-		msgStyle := lipgloss.NewStyle().Foreground(styles.Red).Width(s.width).Align(lipgloss.Center)
+		msgStyle := lipgloss.NewStyle().Foreground(styles.Yellow).Width(s.width).Align(lipgloss.Center)
 		b.WriteString(msgStyle.Render(s.boardVoteMsg))
 	}
 
 	b.WriteString("\n\n")
 	helpStyle := lipgloss.NewStyle().Foreground(styles.Gray).Width(s.width).Align(lipgloss.Center)
-	b.WriteString(helpStyle.Render("Press A to Accept/Approve • Press B to Reject/Decline"))
+	b.WriteString(helpStyle.Render("A approve • B reject • l next director • y/n lobby them for A/B"))
 
 	return b.String()
 }
@@ -1443,3 +2018,20 @@ func (s *VCTurnScreen) renderConfirmQuit() string {
 
 	return b.String()
 }
+
+// netWorthChart plots the player's net worth against the top AI rivals
+func netWorthChart(gs *game.GameState, width, height int) *components.LineChart {
+	chart := components.NewLineChart("📈 NET WORTH VS RIVALS", width, height)
+	chart.AddSeries("You", components.FloatSeries(gs.NetWorthSeries()), styles.Green)
+
+	rivals := append([]game.AIPlayer{}, gs.AIPlayers...)
+	sort.Slice(rivals, func(i, j int) bool { return rivals[i].Portfolio.NetWorth > rivals[j].Portfolio.NetWorth })
+	colors := []lipgloss.Color{styles.Magenta, styles.Yellow, styles.Orange}
+	for i, ai := range rivals {
+		if i >= len(colors) {
+			break
+		}
+		chart.AddSeries(truncate(ai.Firm, 14), components.FloatSeries(gs.RivalSeries(ai.Firm)), colors[i])
+	}
+	return chart
+}